@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"mcpv/internal/infra/audit"
+)
+
+func newAuditCmd(opts *cliOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log utilities",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "verify <path>",
+		Short: "Verify the hash chain of an audit log and its rotated segments",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			report, err := audit.Verify(args[0])
+			if err != nil {
+				return err
+			}
+			if opts.jsonOutput {
+				if err := writeJSON(report); err != nil {
+					return err
+				}
+			} else {
+				printAuditReport(report)
+			}
+			if !report.OK() {
+				return fmt.Errorf("audit chain verification failed: %d problem(s)", len(report.Problems))
+			}
+			return nil
+		},
+	})
+	return cmd
+}
+
+func printAuditReport(report audit.Report) {
+	fmt.Printf("files=%d records=%d seq=%d..%d\n", len(report.Files), report.Records, report.FirstSeq, report.LastSeq)
+	for _, problem := range report.Problems {
+		if problem.Seq > 0 {
+			fmt.Printf("%s:%d seq=%d: %s\n", problem.File, problem.Line, problem.Seq, problem.Reason)
+			continue
+		}
+		fmt.Printf("%s:%d: %s\n", problem.File, problem.Line, problem.Reason)
+	}
+	if report.OK() {
+		fmt.Println("chain ok")
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func newCallersCmd(opts *cliOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callers",
+		Short: "List registered callers",
+		Long:  "List callers registered with the core, with the client product, version, workspace root and MCP capabilities they reported.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.ListCallers(ctx, &controlv1.ListCallersRequest{})
+				if err != nil {
+					return err
+				}
+				return printCallers(resp.GetCallers(), opts.jsonOutput)
+			})
+		},
+	}
+	return cmd
+}
+
+func printCallers(callers []*controlv1.ActiveCaller, jsonOutput bool) error {
+	if jsonOutput {
+		items := make([]map[string]any, 0, len(callers))
+		for _, c := range callers {
+			item := map[string]any{
+				"caller":        c.GetCaller(),
+				"pid":           c.GetPid(),
+				"lastHeartbeat": time.Unix(0, c.GetLastHeartbeatUnixNano()).UTC().Format(time.RFC3339),
+			}
+			if len(c.GetTags()) > 0 {
+				item["tags"] = c.GetTags()
+			}
+			if c.GetServer() != "" {
+				item["server"] = c.GetServer()
+			}
+			if c.GetProfile() != "" {
+				item["profile"] = c.GetProfile()
+			}
+			if info := c.GetClientInfo(); info != nil {
+				client := map[string]any{
+					"name":          info.GetName(),
+					"version":       info.GetVersion(),
+					"workspaceRoot": info.GetWorkspaceRoot(),
+					"capabilities":  clientCapabilities(info),
+				}
+				if roots := info.GetRootList(); len(roots) > 0 {
+					list := make([]map[string]string, 0, len(roots))
+					for _, root := range roots {
+						list = append(list, map[string]string{"uri": root.GetUri(), "name": root.GetName()})
+					}
+					client["roots"] = list
+				}
+				item["client"] = client
+			}
+			items = append(items, item)
+		}
+		return writeJSON(map[string]any{"callers": items})
+	}
+	if len(callers) == 0 {
+		fmt.Println("no registered callers")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CALLER\tPID\tCLIENT\tCAPABILITIES\tWORKSPACE\tPROFILE")
+	for _, c := range callers {
+		client, capabilities, workspace := "-", "-", "-"
+		if info := c.GetClientInfo(); info != nil {
+			if info.GetName() != "" {
+				client = strings.TrimSpace(info.GetName() + " " + info.GetVersion())
+			}
+			if names := clientCapabilities(info); len(names) > 0 {
+				capabilities = strings.Join(names, ",")
+			}
+			if info.GetWorkspaceRoot() != "" {
+				workspace = info.GetWorkspaceRoot()
+			}
+		}
+		profile := c.GetProfile()
+		if profile == "" {
+			profile = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", c.GetCaller(), c.GetPid(), client, capabilities, workspace, profile)
+	}
+	return w.Flush()
+}
+
+func clientCapabilities(info *controlv1.ClientInfo) []string {
+	names := []string{}
+	if info.GetElicitation() {
+		names = append(names, "elicitation")
+	}
+	if info.GetRoots() {
+		names = append(names, "roots")
+	}
+	if info.GetSampling() {
+		names = append(names, "sampling")
+	}
+	return names
+}
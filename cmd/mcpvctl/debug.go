@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func newDebugCmd(opts *cliOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Diagnostics tools",
+	}
+	cmd.AddCommand(newDebugBundleCmd(opts), newDebugEventsCmd(opts))
+	return cmd
+}
+
+func newDebugBundleCmd(opts *cliOptions) *cobra.Command {
+	var output, mode, logLevel string
+	var include []string
+	var maxLogs, maxEvents int32
+	var stuckThreshold time.Duration
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Export a diagnostics bundle",
+		Long:  "Export a zip archive with the diagnostics report, init events, logs, runtime and init snapshots, the sanitized catalog and metrics. --mode safe (default) masks secrets; deep keeps sensitive attributes captured by the diagnostics hub and requires observability.debug.deepDiagnosticsEnabled. --include limits the sections to snapshot, metrics, logs, events or stuck.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &controlv1.ExportDiagnosticsRequest{
+				Caller:           strings.TrimSpace(opts.caller),
+				Mode:             strings.TrimSpace(mode),
+				LogLevel:         strings.TrimSpace(logLevel),
+				MaxLogEntries:    maxLogs,
+				MaxEventEntries:  maxEvents,
+				StuckThresholdMs: stuckThreshold.Milliseconds(),
+			}
+			for _, section := range include {
+				switch strings.ToLower(strings.TrimSpace(section)) {
+				case "snapshot":
+					req.IncludeSnapshot = true
+				case "metrics":
+					req.IncludeMetrics = true
+				case "logs":
+					req.IncludeLogs = true
+				case "events":
+					req.IncludeEvents = true
+				case "stuck":
+					req.IncludeStuck = true
+				default:
+					return fmt.Errorf("--include: unknown section %q", section)
+				}
+			}
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.ExportDiagnostics(ctx, req)
+				if err != nil {
+					return err
+				}
+				generatedAt := time.Unix(0, resp.GetGeneratedAtUnixNano()).UTC()
+				path := strings.TrimSpace(output)
+				if path == "" {
+					path = fmt.Sprintf("mcpv-diagnostics-%s.zip", generatedAt.Format("20060102-150405"))
+				}
+				if err := os.WriteFile(path, resp.GetArchive(), 0o600); err != nil {
+					return err
+				}
+				if opts.jsonOutput {
+					return writeJSON(map[string]any{
+						"path":        path,
+						"size":        len(resp.GetArchive()),
+						"generatedAt": generatedAt.Format(time.RFC3339Nano),
+					})
+				}
+				fmt.Printf("wrote %s (%d bytes)\n", path, len(resp.GetArchive()))
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "archive path (default mcpv-diagnostics-<time>.zip)")
+	cmd.Flags().StringVar(&mode, "mode", "safe", "redaction mode (safe, deep)")
+	cmd.Flags().StringSliceVar(&include, "include", nil, "sections to export (snapshot, metrics, logs, events, stuck); default all")
+	cmd.Flags().StringVar(&logLevel, "log-level", "", "minimum log level to export (default info)")
+	cmd.Flags().Int32Var(&maxLogs, "max-logs", 0, "maximum log entries (default 200)")
+	cmd.Flags().Int32Var(&maxEvents, "max-events", 0, "maximum diagnostics events (default 2000)")
+	cmd.Flags().DurationVar(&stuckThreshold, "stuck-threshold", 0, "report servers stuck in a step for at least this long (default 30s)")
+	return cmd
+}
+
+func newDebugEventsCmd(opts *cliOptions) *cobra.Command {
+	var specKey, step, phase, attemptID string
+	var follow bool
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show diagnostics step events",
+		Long:  "Show the server startup and acquire step events buffered by the core as a timeline, then keep streaming new ones with --follow. --server, --spec-key, --step, --phase and --attempt filter the events; ELAPSED is the time since the first event of the attempt.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &controlv1.WatchDiagnosticsEventsRequest{
+				SpecKey:     strings.TrimSpace(specKey),
+				Server:      strings.TrimSpace(opts.server),
+				Step:        strings.TrimSpace(step),
+				Phase:       strings.TrimSpace(phase),
+				AttemptId:   strings.TrimSpace(attemptID),
+				Replay:      true,
+				ReplayLimit: limit,
+				Follow:      follow,
+			}
+			ctx, cancel := signalAwareContext(cmd.Context())
+			defer cancel()
+			return withSession(ctx, opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient, caller string) error {
+				req.Caller = caller
+				stream, err := client.WatchDiagnosticsEvents(ctx, req)
+				if err != nil {
+					return err
+				}
+				timeline := newEventTimeline()
+				if !opts.jsonOutput {
+					timeline.printHeader()
+				}
+				return watchStream(stream.Recv, func(event *controlv1.DiagnosticsEvent) error {
+					if opts.jsonOutput {
+						return writeJSON(diagnosticsEventJSON(event))
+					}
+					timeline.print(event)
+					return nil
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&specKey, "spec-key", "", "filter by spec key")
+	cmd.Flags().StringVar(&step, "step", "", "filter by step (launcher_start, initialize_call, acquire_failure, ...)")
+	cmd.Flags().StringVar(&phase, "phase", "", "filter by phase (enter, exit, error)")
+	cmd.Flags().StringVar(&attemptID, "attempt", "", "filter by attempt ID")
+	cmd.Flags().Int32Var(&limit, "limit", 0, "replay only the latest buffered events (default all)")
+	cmd.Flags().BoolVar(&follow, "follow", false, "keep streaming new events")
+	return cmd
+}
+
+func diagnosticsEventJSON(event *controlv1.DiagnosticsEvent) map[string]any {
+	item := map[string]any{
+		"seq":        event.GetSeq(),
+		"specKey":    event.GetSpecKey(),
+		"serverName": event.GetServerName(),
+		"attemptId":  event.GetAttemptId(),
+		"step":       event.GetStep(),
+		"phase":      event.GetPhase(),
+		"timestamp":  time.Unix(0, event.GetTimestampUnixNano()).UTC().Format(time.RFC3339Nano),
+		"durationMs": event.GetDurationMs(),
+	}
+	if event.GetError() != "" {
+		item["error"] = event.GetError()
+	}
+	if raw := event.GetAttributesJson(); len(raw) > 0 {
+		item["attributes"] = json.RawMessage(raw)
+	}
+	return item
+}
+
+// eventTimeline prints events as aligned rows and tracks when each attempt
+// started so rows show the elapsed time within the attempt.
+type eventTimeline struct {
+	attemptStarts map[string]time.Time
+}
+
+func newEventTimeline() *eventTimeline {
+	return &eventTimeline{attemptStarts: make(map[string]time.Time)}
+}
+
+func (t *eventTimeline) printHeader() {
+	fmt.Printf("%-12s  %-16s  %-20s  %-5s  %8s  %8s  %s\n", "TIME", "SERVER", "STEP", "PHASE", "ELAPSED", "DURATION", "DETAIL")
+}
+
+func (t *eventTimeline) print(event *controlv1.DiagnosticsEvent) {
+	at := time.Unix(0, event.GetTimestampUnixNano())
+	elapsed := "-"
+	if attempt := event.GetAttemptId(); attempt != "" {
+		start, ok := t.attemptStarts[attempt]
+		if !ok {
+			start = at
+			t.attemptStarts[attempt] = at
+		}
+		elapsed = "+" + at.Sub(start).Round(time.Millisecond).String()
+	}
+	duration := "-"
+	if ms := event.GetDurationMs(); ms > 0 {
+		duration = (time.Duration(ms) * time.Millisecond).String()
+	}
+	server := event.GetServerName()
+	if server == "" {
+		server = event.GetSpecKey()
+	}
+	fmt.Printf("%-12s  %-16s  %-20s  %-5s  %8s  %8s  %s\n",
+		at.Local().Format("15:04:05.000"), server, event.GetStep(), event.GetPhase(), elapsed, duration, eventDetail(event))
+}
+
+func eventDetail(event *controlv1.DiagnosticsEvent) string {
+	if event.GetError() != "" {
+		return event.GetError()
+	}
+	var attrs map[string]string
+	if err := json.Unmarshal(event.GetAttributesJson(), &attrs); err != nil || len(attrs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+"="+attrs[key])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+var errNotReady = errors.New("core is not ready")
+
+func newHealthCmd(opts *cliOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Show core readiness per criterion",
+		Long:  "Show the readiness checks behind /readyz: bootstrap, required plugins, servers at minReady and the RPC listener, as selected by observability.readiness. Exits non-zero when the core is not ready.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.GetReadiness(ctx, &controlv1.GetReadinessRequest{})
+				if err != nil {
+					return err
+				}
+				if err := printReadiness(resp, opts.jsonOutput); err != nil {
+					return err
+				}
+				if !resp.GetReady() {
+					cmd.SilenceUsage = true
+					return errNotReady
+				}
+				return nil
+			})
+		},
+	}
+	return cmd
+}
+
+func printReadiness(resp *controlv1.GetReadinessResponse, jsonOutput bool) error {
+	if jsonOutput {
+		checks := make([]map[string]any, 0, len(resp.GetChecks()))
+		for _, check := range resp.GetChecks() {
+			components := make([]map[string]any, 0, len(check.GetComponents()))
+			for _, component := range check.GetComponents() {
+				components = append(components, map[string]any{
+					"name":    component.GetName(),
+					"ready":   component.GetReady(),
+					"message": component.GetMessage(),
+				})
+			}
+			checks = append(checks, map[string]any{
+				"name":       check.GetName(),
+				"ready":      check.GetReady(),
+				"message":    check.GetMessage(),
+				"components": components,
+			})
+		}
+		return writeJSON(map[string]any{
+			"ready":     resp.GetReady(),
+			"checkedAt": time.Unix(0, resp.GetCheckedAtUnixNano()).UTC().Format(time.RFC3339Nano),
+			"checks":    checks,
+		})
+	}
+
+	status := "ready"
+	if !resp.GetReady() {
+		status = "not ready"
+	}
+	fmt.Printf("Status: %s\n", status)
+	if len(resp.GetChecks()) == 0 {
+		fmt.Println("no readiness criteria configured")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tREADY\tDETAIL")
+	for _, check := range resp.GetChecks() {
+		fmt.Fprintf(w, "%s\t%t\t%s\n", check.GetName(), check.GetReady(), check.GetMessage())
+		for _, component := range check.GetComponents() {
+			fmt.Fprintf(w, "  %s\t%t\t%s\n", component.GetName(), component.GetReady(), component.GetMessage())
+		}
+	}
+	return w.Flush()
+}
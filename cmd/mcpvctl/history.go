@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func newHistoryCmd(opts *cliOptions) *cobra.Command {
+	var tool, status, since, until string
+	var cursor *string
+	var limit *int32
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Query recorded tool calls",
+		Long:  "Query the core's persistent call history, newest first. --caller and --server filter by caller and server; --since and --until take a duration before now (1h) or an RFC 3339 time.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			now := time.Now()
+			sinceAt, err := parseTimeFlag(since, now)
+			if err != nil {
+				return fmt.Errorf("--since: %w", err)
+			}
+			untilAt, err := parseTimeFlag(until, now)
+			if err != nil {
+				return fmt.Errorf("--until: %w", err)
+			}
+			req := &controlv1.QueryCallHistoryRequest{
+				Caller: strings.TrimSpace(opts.caller),
+				Server: strings.TrimSpace(opts.server),
+				Tool:   strings.TrimSpace(tool),
+				Status: strings.TrimSpace(status),
+				Cursor: strings.TrimSpace(*cursor),
+				Limit:  *limit,
+			}
+			if !sinceAt.IsZero() {
+				req.SinceUnixNano = sinceAt.UnixNano()
+			}
+			if !untilAt.IsZero() {
+				req.UntilUnixNano = untilAt.UnixNano()
+			}
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.QueryCallHistory(ctx, req)
+				if err != nil {
+					return err
+				}
+				return printCallHistory(resp, opts.jsonOutput)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&tool, "tool", "", "filter by tool name")
+	cmd.Flags().StringVar(&status, "status", "", "filter by status (ok, error, rejected)")
+	cmd.Flags().StringVar(&since, "since", "", "only calls at or after this time (duration or RFC 3339)")
+	cmd.Flags().StringVar(&until, "until", "", "only calls at or before this time (duration or RFC 3339)")
+	cursor = bindCursorFlag(cmd, "pagination cursor")
+	limit = bindLimitFlag(cmd, "page size")
+	return cmd
+}
+
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a duration or RFC 3339 time, got %q", value)
+	}
+	return at, nil
+}
+
+func printCallHistory(resp *controlv1.QueryCallHistoryResponse, jsonOutput bool) error {
+	records := resp.GetRecords()
+	if jsonOutput {
+		items := make([]map[string]any, 0, len(records))
+		for _, r := range records {
+			item := map[string]any{
+				"id":            r.GetId(),
+				"time":          time.Unix(0, r.GetTimeUnixNano()).UTC().Format(time.RFC3339Nano),
+				"caller":        r.GetCaller(),
+				"server":        r.GetServer(),
+				"tool":          r.GetTool(),
+				"durationMs":    r.GetDurationMs(),
+				"status":        r.GetStatus(),
+				"argumentBytes": r.GetArgumentBytes(),
+				"resultBytes":   r.GetResultBytes(),
+			}
+			if r.GetReason() != "" {
+				item["reason"] = r.GetReason()
+			}
+			if len(r.GetArgumentsJson()) > 0 {
+				item["arguments"] = json.RawMessage(r.GetArgumentsJson())
+			}
+			if len(r.GetResultJson()) > 0 {
+				item["result"] = json.RawMessage(r.GetResultJson())
+			}
+			items = append(items, item)
+		}
+		out := map[string]any{"records": items}
+		if resp.GetNextCursor() != "" {
+			out["nextCursor"] = resp.GetNextCursor()
+		}
+		return writeJSON(out)
+	}
+	if len(records) == 0 {
+		fmt.Println("no recorded calls")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tCALLER\tSERVER\tTOOL\tSTATUS\tDURATION\tARGS\tRESULT\tREASON")
+	for _, r := range records {
+		server, reason := r.GetServer(), r.GetReason()
+		if server == "" {
+			server = "-"
+		}
+		if reason == "" {
+			reason = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%dms\t%d\t%d\t%s\n",
+			time.Unix(0, r.GetTimeUnixNano()).Local().Format(time.DateTime),
+			r.GetCaller(), server, r.GetTool(), r.GetStatus(), r.GetDurationMs(),
+			r.GetArgumentBytes(), r.GetResultBytes(), reason,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if resp.GetNextCursor() != "" {
+		fmt.Printf("next cursor: %s\n", resp.GetNextCursor())
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -12,11 +13,16 @@ import (
 )
 
 func newInfoCmd(opts *cliOptions) *cobra.Command {
+	var effective bool
 	cmd := &cobra.Command{
 		Use:   "info",
 		Short: "Show core build info",
+		Long:  "Show core build info. With --effective, also register the caller and show its matched client profile and effective tool set.",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			ctx := cmd.Context()
+			if effective {
+				return showEffectiveInfo(ctx, opts)
+			}
 			return withClient(ctx, opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
 				resp, err := client.GetInfo(ctx, &controlv1.GetInfoRequest{})
 				if err != nil {
@@ -30,9 +36,79 @@ func newInfoCmd(opts *cliOptions) *cobra.Command {
 			})
 		},
 	}
+	cmd.Flags().BoolVar(&effective, "effective", false, "show the caller's client profile and effective tools")
 	return cmd
 }
 
+// showEffectiveInfo registers the caller like other session commands so the
+// core resolves its profile, then reports what that caller sees.
+func showEffectiveInfo(ctx context.Context, opts *cliOptions) error {
+	client, err := dialClient(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	control := client.Control()
+
+	info, err := control.GetInfo(ctx, &controlv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+	caller := resolveCaller(opts.caller)
+	profile := ""
+	if !opts.noRegister {
+		resp, err := control.RegisterCaller(ctx, &controlv1.RegisterCallerRequest{
+			Caller: caller,
+			Pid:    int64(os.Getpid()),
+			Tags:   normalizeTags(opts.tags),
+			Server: strings.TrimSpace(opts.server),
+		})
+		if err != nil {
+			return err
+		}
+		profile = resp.GetProfile()
+		defer func() {
+			unregisterCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, _ = control.UnregisterCaller(unregisterCtx, &controlv1.UnregisterCallerRequest{Caller: caller})
+		}()
+	}
+
+	tools, err := control.ListTools(ctx, &controlv1.ListToolsRequest{Caller: caller})
+	if err != nil {
+		return err
+	}
+	subAgent, err := control.IsSubAgentEnabled(ctx, &controlv1.IsSubAgentEnabledRequest{Caller: caller})
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(tools.GetSnapshot().GetTools()))
+	for _, tool := range tools.GetSnapshot().GetTools() {
+		names = append(names, tool.GetName())
+	}
+
+	if opts.jsonOutput {
+		return writeJSON(map[string]any{
+			"name":     info.GetName(),
+			"version":  info.GetVersion(),
+			"build":    info.GetBuild(),
+			"caller":   caller,
+			"profile":  profile,
+			"subAgent": subAgent.GetEnabled(),
+			"tools":    names,
+		})
+	}
+	if profile == "" {
+		profile = "(none)"
+	}
+	fmt.Printf("Name: %s\nVersion: %s\nBuild: %s\n", info.GetName(), info.GetVersion(), info.GetBuild())
+	fmt.Printf("Caller: %s\nProfile: %s\nSubAgent: %t\nTools: %d\n", caller, profile, subAgent.GetEnabled(), len(names))
+	for _, name := range names {
+		fmt.Printf("  %s\n", name)
+	}
+	return nil
+}
+
 func newRegisterCmd(opts *cliOptions) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register",
@@ -53,6 +129,10 @@ func newRegisterCmd(opts *cliOptions) *cobra.Command {
 				if opts.jsonOutput {
 					return writeJSON(map[string]string{"profile": resp.GetProfile()})
 				}
+				if resp.GetProfile() == "" {
+					fmt.Printf("Registered caller %q\n", caller)
+					return nil
+				}
 				fmt.Printf("Registered caller %q (profile: %s)\n", caller, resp.GetProfile())
 				return nil
 			})
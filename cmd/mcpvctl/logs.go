@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,19 +13,48 @@ import (
 )
 
 func newLogsCmd(opts *cliOptions) *cobra.Command {
-	var minLevel string
+	var minLevel, since, until, grep string
+	var follow bool
 	cmd := &cobra.Command{
 		Use:   "logs",
 		Short: "Stream logs",
+		Long:  "Stream live logs. --since and --until read persisted logs first and take a duration before now (1h) or an RFC 3339 time; with --since, add --follow to keep tailing afterwards. --server limits output to one server.",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			level, err := parseLogLevel(minLevel)
 			if err != nil {
 				return err
 			}
+			now := time.Now()
+			sinceAt, err := parseTimeFlag(since, now)
+			if err != nil {
+				return fmt.Errorf("--since: %w", err)
+			}
+			untilAt, err := parseTimeFlag(until, now)
+			if err != nil {
+				return fmt.Errorf("--until: %w", err)
+			}
+			if follow && !untilAt.IsZero() {
+				return errors.New("--follow and --until are mutually exclusive")
+			}
+			if !sinceAt.IsZero() && !follow && untilAt.IsZero() {
+				untilAt = now
+			}
+			req := &controlv1.StreamLogsRequest{
+				MinLevel: level,
+				Server:   strings.TrimSpace(opts.server),
+				Grep:     grep,
+			}
+			if !sinceAt.IsZero() {
+				req.SinceUnixNano = sinceAt.UnixNano()
+			}
+			if !untilAt.IsZero() {
+				req.UntilUnixNano = untilAt.UnixNano()
+			}
 			ctx, cancel := signalAwareContext(cmd.Context())
 			defer cancel()
 			return withSession(ctx, opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient, caller string) error {
-				stream, err := client.StreamLogs(ctx, &controlv1.StreamLogsRequest{Caller: caller, MinLevel: level})
+				req.Caller = caller
+				stream, err := client.StreamLogs(ctx, req)
 				if err != nil {
 					return err
 				}
@@ -34,6 +65,10 @@ func newLogsCmd(opts *cliOptions) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&minLevel, "min-level", "info", "minimum log level")
+	cmd.Flags().StringVar(&since, "since", "", "read persisted logs from this time (duration or RFC 3339)")
+	cmd.Flags().StringVar(&until, "until", "", "read persisted logs up to this time (duration or RFC 3339)")
+	cmd.Flags().StringVar(&grep, "grep", "", "only entries whose message matches this regular expression")
+	cmd.Flags().BoolVar(&follow, "follow", false, "keep streaming live logs after --since")
 	return cmd
 }
 
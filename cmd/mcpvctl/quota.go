@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func newQuotaCmd(opts *cliOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "Show rate limit and daily quota usage",
+		Long:  "Show rate limit and daily quota usage for --caller, or for every tracked caller when omitted.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.GetQuotaStatus(ctx, &controlv1.GetQuotaStatusRequest{
+					Caller: strings.TrimSpace(opts.caller),
+				})
+				if err != nil {
+					return err
+				}
+				return printQuotaStatuses(resp.GetStatuses(), opts.jsonOutput)
+			})
+		},
+	}
+	return cmd
+}
+
+func printQuotaStatuses(statuses []*controlv1.QuotaStatus, jsonOutput bool) error {
+	if jsonOutput {
+		items := make([]map[string]any, 0, len(statuses))
+		for _, s := range statuses {
+			item := map[string]any{
+				"rule":  s.GetRule(),
+				"key":   s.GetKey(),
+				"scope": s.GetScope(),
+			}
+			if s.GetRate() > 0 {
+				item["rate"] = s.GetRate()
+				item["periodSeconds"] = s.GetPeriodSeconds()
+				item["burst"] = s.GetBurst()
+				item["tokensAvailable"] = s.GetTokensAvailable()
+			}
+			if s.GetDailyQuota() > 0 {
+				item["dailyQuota"] = s.GetDailyQuota()
+				item["quotaUsed"] = s.GetQuotaUsed()
+				item["quotaRemaining"] = s.GetQuotaRemaining()
+				item["resetAt"] = time.Unix(0, s.GetResetAtUnixNano()).UTC().Format(time.RFC3339)
+			}
+			items = append(items, item)
+		}
+		return writeJSON(map[string]any{"statuses": items})
+	}
+	if len(statuses) == 0 {
+		fmt.Println("no rate limits apply")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tKEY\tRATE\tTOKENS\tQUOTA\tRESET")
+	for _, s := range statuses {
+		key := s.GetKey()
+		if key == "" {
+			key = "(shared)"
+		}
+		rate, tokens := "-", "-"
+		if s.GetRate() > 0 {
+			rate = fmt.Sprintf("%d/%ds", s.GetRate(), s.GetPeriodSeconds())
+			tokens = fmt.Sprintf("%.1f/%d", s.GetTokensAvailable(), s.GetBurst())
+		}
+		quota, reset := "-", "-"
+		if s.GetDailyQuota() > 0 {
+			quota = fmt.Sprintf("%d/%d", s.GetQuotaUsed(), s.GetDailyQuota())
+			reset = time.Unix(0, s.GetResetAtUnixNano()).UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", s.GetRule(), key, rate, tokens, quota, reset)
+	}
+	return w.Flush()
+}
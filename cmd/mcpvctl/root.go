@@ -82,6 +82,12 @@ func newRootCommand() *cobra.Command {
 		newRuntimeCmd(&opts),
 		newInitCmd(&opts),
 		newSubAgentCmd(&opts),
+		newAuditCmd(&opts),
+		newQuotaCmd(&opts),
+		newCallersCmd(&opts),
+		newHistoryCmd(&opts),
+		newDebugCmd(&opts),
+		newHealthCmd(&opts),
 	)
 
 	return root
@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -32,7 +35,7 @@ func newRuntimeCmd(opts *cliOptions) *cobra.Command {
 						return writeJSON(snapshot)
 					}
 					fmt.Printf("runtime snapshot etag=%s servers=%d\n", snapshot.GetEtag(), len(snapshot.GetStatuses()))
-					return nil
+					return printRuntimeInstances(snapshot)
 				})
 			})
 		},
@@ -40,3 +43,53 @@ func newRuntimeCmd(opts *cliOptions) *cobra.Command {
 	lastETag = bindLastETagFlag(cmd)
 	return cmd
 }
+
+// printRuntimeInstances lists the instances of a snapshot with their sampled
+// resource usage, followed by the savings from idle reaping per server.
+func printRuntimeInstances(snapshot *controlv1.RuntimeStatusSnapshot) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SERVER\tINSTANCE\tSTATE\tBUSY\tPID\tCPU\tRSS\tFDS\tTHREADS")
+	for _, status := range snapshot.GetStatuses() {
+		for _, inst := range status.GetInstances() {
+			pid, cpu, rss, fds, threads := "-", "-", "-", "-", "-"
+			if inst.GetPid() > 0 {
+				pid = fmt.Sprintf("%d", inst.GetPid())
+			}
+			if res := inst.GetResources(); res != nil {
+				cpu = (time.Duration(res.GetCpuTimeMs()) * time.Millisecond).String()
+				rss = formatBytes(float64(res.GetRssBytes()))
+				fds = fmt.Sprintf("%d", res.GetOpenFds())
+				threads = fmt.Sprintf("%d", res.GetThreads())
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				status.GetServerName(), inst.GetId(), inst.GetState(), inst.GetBusyCount(), pid, cpu, rss, fds, threads)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, status := range snapshot.GetStatuses() {
+		metrics := status.GetMetrics()
+		if metrics.GetIdleReaps() == 0 {
+			continue
+		}
+		saved := time.Duration(metrics.GetSavedInstanceSeconds() * float64(time.Second)).Round(time.Second)
+		fmt.Printf("  %s: %d idle reaps saved %s instance time, %s-seconds RSS\n",
+			status.GetServerName(), metrics.GetIdleReaps(), saved, formatBytes(metrics.GetSavedRssByteSeconds()))
+	}
+	return nil
+}
+
+func formatBytes(n float64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%.0fB", n)
+	}
+	suffixes := []string{"KiB", "MiB", "GiB", "TiB", "PiB"}
+	i := -1
+	for n >= unit && i < len(suffixes)-1 {
+		n /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f%s", n, suffixes[i])
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func newToolsStatsCmd(opts *cliOptions) *cobra.Command {
+	var tool string
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show per-tool latency percentiles and SLO burn rates",
+		Long:  "Show call counts, error rates and approximate p50/p95/p99 latency per tool since the core started. --server and --tool filter the tools; configured SLOs are always listed.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withClient(cmd.Context(), opts, func(ctx context.Context, client controlv1.ControlPlaneServiceClient) error {
+				resp, err := client.GetToolStats(ctx, &controlv1.GetToolStatsRequest{
+					Server: strings.TrimSpace(opts.server),
+					Tool:   strings.TrimSpace(tool),
+				})
+				if err != nil {
+					return err
+				}
+				return printToolStats(resp, opts.jsonOutput)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&tool, "tool", "", "filter by tool name")
+	return cmd
+}
+
+func printToolStats(resp *controlv1.GetToolStatsResponse, jsonOutput bool) error {
+	tools, slos := resp.GetTools(), resp.GetSlos()
+	if jsonOutput {
+		toolItems := make([]map[string]any, 0, len(tools))
+		for _, t := range tools {
+			toolItems = append(toolItems, map[string]any{
+				"server": t.GetServer(),
+				"tool":   t.GetTool(),
+				"calls":  t.GetCalls(),
+				"errors": t.GetErrors(),
+				"p50Ms":  t.GetP50Ms(),
+				"p95Ms":  t.GetP95Ms(),
+				"p99Ms":  t.GetP99Ms(),
+				"maxMs":  t.GetMaxMs(),
+			})
+		}
+		sloItems := make([]map[string]any, 0, len(slos))
+		for _, s := range slos {
+			sloItems = append(sloItems, map[string]any{
+				"name":            s.GetName(),
+				"server":          s.GetServer(),
+				"tool":            s.GetTool(),
+				"latencyTargetMs": s.GetLatencyTargetMs(),
+				"errorBudget":     s.GetErrorBudget(),
+				"windowSeconds":   s.GetWindowSeconds(),
+				"windowCalls":     s.GetWindowCalls(),
+				"windowBadCalls":  s.GetWindowBadCalls(),
+				"burnRate":        s.GetBurnRate(),
+			})
+		}
+		return writeJSON(map[string]any{"tools": toolItems, "slos": sloItems})
+	}
+
+	if len(tools) == 0 {
+		fmt.Println("no tool calls recorded")
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SERVER\tTOOL\tCALLS\tERRORS\tP50\tP95\tP99\tMAX")
+		for _, t := range tools {
+			errRate := 0.0
+			if t.GetCalls() > 0 {
+				errRate = float64(t.GetErrors()) / float64(t.GetCalls()) * 100
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d (%.1f%%)\t%s\t%s\t%s\t%s\n",
+				t.GetServer(), t.GetTool(), t.GetCalls(), t.GetErrors(), errRate,
+				formatMillis(t.GetP50Ms()), formatMillis(t.GetP95Ms()), formatMillis(t.GetP99Ms()), formatMillis(t.GetMaxMs()),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(slos) == 0 {
+		return nil
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SLO\tSERVER\tTOOL\tTARGET\tBUDGET\tWINDOW\tCALLS\tBAD\tBURN")
+	for _, s := range slos {
+		server, tool, target := s.GetServer(), s.GetTool(), "-"
+		if server == "" {
+			server = "*"
+		}
+		if tool == "" {
+			tool = "*"
+		}
+		if s.GetLatencyTargetMs() > 0 {
+			target = fmt.Sprintf("%dms", s.GetLatencyTargetMs())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f%%\t%dm\t%d\t%d\t%.2f\n",
+			s.GetName(), server, tool, target, s.GetErrorBudget()*100, s.GetWindowSeconds()/60,
+			s.GetWindowCalls(), s.GetWindowBadCalls(), s.GetBurnRate(),
+		)
+	}
+	return w.Flush()
+}
+
+func formatMillis(ms float64) string {
+	if ms < 10 {
+		return fmt.Sprintf("%.2fms", ms)
+	}
+	return fmt.Sprintf("%.0fms", ms)
+}
@@ -19,6 +19,7 @@ func newToolsCmd(opts *cliOptions) *cobra.Command {
 		newToolsWatchCmd(opts),
 		newToolsCallCmd(opts),
 		newToolsCallTaskCmd(opts),
+		newToolsStatsCmd(opts),
 	)
 	return cmd
 }
@@ -39,9 +39,14 @@ type gatewayOptions struct {
 	launchUIOnFail      bool
 	urlScheme           string
 	transport           string
+	pinTools            bool
+	workspaceRoot       string
 	httpAddr            string
 	httpPath            string
+	httpSocketMode      string
+	httpAllowedUIDs     []int
 	httpToken           string
+	httpTokenFile       string
 	httpAllowedOrigins  []string
 	httpJSONResponse    bool
 	httpSessionTimeout  int
@@ -64,6 +69,7 @@ func main() {
 		transport:           "streamable-http",
 		httpAddr:            "127.0.0.1:8090",
 		httpPath:            "/mcp",
+		httpSocketMode:      domain.DefaultRPCSocketMode,
 		httpSessionTimeout:  0,
 		httpEventStoreBytes: 0,
 	}
@@ -111,6 +117,8 @@ func main() {
 			}
 			ctx, cancel := signalAwareContext(cmd.Context())
 			defer cancel()
+			stopTracing := startGatewayTracing(ctx, opts.logger)
+			defer stopTracing()
 
 			clientCfg := rpc.ClientConfig{
 				Address:                 opts.rpcAddress,
@@ -140,9 +148,11 @@ func main() {
 			}
 
 			gw := gateway.NewGateway(clientCfg, opts.caller, gatewayTags, gatewayServer, opts.logger)
+			gw.SetToolPinning(opts.pinTools)
 			var err error
 			switch opts.transport {
 			case "stdio":
+				gw.SetWorkspaceRoot(resolveWorkspaceRoot(opts.workspaceRoot))
 				err = gw.Run(ctx)
 			case "streamable-http":
 				if err := validateHTTPGatewayOptions(opts); err != nil {
@@ -151,7 +161,10 @@ func main() {
 				err = gw.RunStreamableHTTP(ctx, gateway.HTTPOptions{
 					Addr:               opts.httpAddr,
 					Path:               opts.httpPath,
+					SocketMode:         opts.httpSocketMode,
+					AllowedPeerUIDs:    opts.httpAllowedUIDs,
 					Token:              opts.httpToken,
+					TokenFile:          opts.httpTokenFile,
 					AllowedOrigins:     opts.httpAllowedOrigins,
 					JSONResponse:       opts.httpJSONResponse,
 					SessionTimeout:     time.Duration(opts.httpSessionTimeout) * time.Second,
@@ -198,9 +211,14 @@ func main() {
 	root.PersistentFlags().BoolVar(&opts.launchUIOnFail, "launch-ui-on-fail", false, "attempt to launch mcpv UI if connection fails")
 	root.PersistentFlags().StringVar(&opts.urlScheme, "url-scheme", "mcpv", "URL scheme to use for launching UI (mcpv or mcpvev)")
 	root.PersistentFlags().StringVar(&opts.transport, "transport", opts.transport, "gateway transport (stdio or streamable-http)")
-	root.PersistentFlags().StringVar(&opts.httpAddr, "http-addr", opts.httpAddr, "streamable HTTP listen address")
+	root.PersistentFlags().BoolVar(&opts.pinTools, "pin-tools", false, "freeze each session's tool list at its first tools/list; queued changes are applied by the mcpv_refresh_tools tool or a new session")
+	root.PersistentFlags().StringVar(&opts.workspaceRoot, "workspace-root", "", "workspace root reported to mcpv for stdio transport (defaults to the working directory)")
+	root.PersistentFlags().StringVar(&opts.httpAddr, "http-addr", opts.httpAddr, "streamable HTTP listen address (host:port, unix:///path or fd://[name] for socket activation)")
 	root.PersistentFlags().StringVar(&opts.httpPath, "http-path", opts.httpPath, "streamable HTTP endpoint path")
+	root.PersistentFlags().StringVar(&opts.httpSocketMode, "http-socket-mode", opts.httpSocketMode, "file mode for a unix:// streamable HTTP socket")
+	root.PersistentFlags().IntSliceVar(&opts.httpAllowedUIDs, "http-allowed-uid", nil, "restrict unix socket peers to these user IDs (Linux unix sockets only; other listeners are rejected; repeatable)")
 	root.PersistentFlags().StringVar(&opts.httpToken, "http-token", "", "streamable HTTP bearer token (required for non-localhost)")
+	root.PersistentFlags().StringVar(&opts.httpTokenFile, "http-token-file", "", "token table mapping bearer tokens or JWTs to caller identities (reloaded on change)")
 	root.PersistentFlags().StringArrayVar(&opts.httpAllowedOrigins, "http-allowed-origin", nil, "allowed CORS origin (repeatable or *)")
 	root.PersistentFlags().BoolVar(&opts.httpJSONResponse, "http-json-response", false, "use application/json responses instead of SSE")
 	root.PersistentFlags().IntVar(&opts.httpSessionTimeout, "http-session-timeout", opts.httpSessionTimeout, "streamable HTTP session idle timeout in seconds (0 disables)")
@@ -252,12 +270,22 @@ func applyGatewayFlagBindings(flags *pflag.FlagSet, opts *gatewayOptions) {
 			opts.urlScheme, _ = flags.GetString("url-scheme")
 		case "transport":
 			opts.transport, _ = flags.GetString("transport")
+		case "pin-tools":
+			opts.pinTools, _ = flags.GetBool("pin-tools")
+		case "workspace-root":
+			opts.workspaceRoot, _ = flags.GetString("workspace-root")
 		case "http-addr":
 			opts.httpAddr, _ = flags.GetString("http-addr")
 		case "http-path":
 			opts.httpPath, _ = flags.GetString("http-path")
+		case "http-socket-mode":
+			opts.httpSocketMode, _ = flags.GetString("http-socket-mode")
+		case "http-allowed-uid":
+			opts.httpAllowedUIDs, _ = flags.GetIntSlice("http-allowed-uid")
 		case "http-token":
 			opts.httpToken, _ = flags.GetString("http-token")
+		case "http-token-file":
+			opts.httpTokenFile, _ = flags.GetString("http-token-file")
 		case "http-allowed-origin":
 			opts.httpAllowedOrigins, _ = flags.GetStringArray("http-allowed-origin")
 		case "http-json-response":
@@ -287,6 +315,17 @@ func deriveCallerName() string {
 	return base
 }
 
+func resolveWorkspaceRoot(root string) string {
+	if root = strings.TrimSpace(root); root != "" {
+		return root
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return wd
+}
+
 func signalAwareContext(parent context.Context) (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(parent)
 
@@ -320,8 +359,8 @@ func validateHTTPGatewayOptions(opts gatewayOptions) error {
 	if strings.TrimSpace(opts.httpAddr) == "" {
 		return errors.New("http address is required")
 	}
-	if !isLocalhostAddr(opts.httpAddr) && strings.TrimSpace(opts.httpToken) == "" {
-		return errors.New("http token is required when binding to non-localhost address")
+	if !isLocalhostAddr(opts.httpAddr) && strings.TrimSpace(opts.httpToken) == "" && strings.TrimSpace(opts.httpTokenFile) == "" {
+		return errors.New("http token or token file is required when binding to non-localhost address")
 	}
 	if opts.httpTLSEnabled {
 		if strings.TrimSpace(opts.httpTLSCertFile) == "" || strings.TrimSpace(opts.httpTLSKeyFile) == "" {
@@ -332,6 +371,12 @@ func validateHTTPGatewayOptions(opts gatewayOptions) error {
 }
 
 func isLocalhostAddr(addr string) bool {
+	// Unix sockets are guarded by file permissions; inherited sockets are
+	// checked against their bound address once the gateway starts.
+	if strings.HasPrefix(addr, "unix://") || strings.HasPrefix(addr, "fd://") {
+		return true
+	}
+	addr = strings.TrimPrefix(addr, "tcp://")
 	host := addr
 	if strings.Contains(addr, ":") {
 		if h, _, err := net.SplitHostPort(addr); err == nil {
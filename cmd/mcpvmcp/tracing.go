@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
+)
+
+const gatewayTracingServiceName = "mcpv-mcp"
+
+// startGatewayTracing exports gateway spans when the standard OTEL_EXPORTER_OTLP
+// variables point at a collector. Without them the gateway installs no tracer,
+// so the core's sampling decision applies to the whole trace.
+func startGatewayTracing(ctx context.Context, logger *zap.Logger) func() {
+	cfg, ok := gatewayTracingConfigFromEnv(os.Getenv)
+	if !ok {
+		return func() {}
+	}
+	tracing := telemetry.NewTracing(telemetry.TracingOptions{
+		ServiceName: cfg.ServiceName,
+		Logger:      logger,
+	})
+	tracing.Install()
+	if err := tracing.Apply(ctx, cfg); err != nil {
+		logger.Warn("tracing disabled", zap.Error(err))
+	}
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tracing.Shutdown(shutdownCtx)
+	}
+}
+
+func gatewayTracingConfigFromEnv(getenv func(string) string) (domain.TracingConfig, bool) {
+	endpoint := strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"))
+	if endpoint == "" {
+		endpoint = strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	}
+	if endpoint == "" {
+		return domain.TracingConfig{}, false
+	}
+
+	exporter := domain.TracingExporterOTLPGRPC
+	protocol := strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"))
+	if protocol == "" {
+		protocol = strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_PROTOCOL"))
+	}
+	if strings.HasPrefix(protocol, "http/") {
+		exporter = domain.TracingExporterOTLPHTTP
+	}
+
+	ratio := domain.DefaultTracingSamplingRatio
+	if raw := strings.TrimSpace(getenv("OTEL_TRACES_SAMPLER_ARG")); raw != "" {
+		if parsed, err := strconv.ParseFloat(raw, 64); err == nil && parsed >= 0 && parsed <= 1 {
+			ratio = parsed
+		}
+	}
+
+	serviceName := strings.TrimSpace(getenv("OTEL_SERVICE_NAME"))
+	if serviceName == "" {
+		serviceName = gatewayTracingServiceName
+	}
+
+	return domain.TracingConfig{
+		Enabled:       true,
+		Exporter:      exporter,
+		Endpoint:      endpoint,
+		SamplingRatio: ratio,
+		ServiceName:   serviceName,
+	}, true
+}
@@ -1,12 +1,10 @@
 package app
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"sync"
 
-	"github.com/prometheus/common/expfmt"
 	"go.uber.org/zap"
 
 	"mcpv/internal/app/controlplane"
@@ -130,21 +128,10 @@ func (a *App) MetricsText() (string, error) {
 	a.mu.RLock()
 	application := a.application
 	a.mu.RUnlock()
-	if application == nil || application.registry == nil {
+	if application == nil {
 		return "", errors.New("metrics registry not available")
 	}
-	families, err := application.registry.Gather()
-	if err != nil {
-		return "", err
-	}
-	var buf bytes.Buffer
-	encoder := expfmt.NewEncoder(&buf, expfmt.NewFormat(expfmt.TypeTextPlain))
-	for _, family := range families {
-		if err := encoder.Encode(family); err != nil {
-			return "", err
-		}
-	}
-	return buf.String(), nil
+	return application.MetricsText()
 }
 
 // Diagnostics returns the diagnostics hub if available.
@@ -1,19 +1,28 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
 	"go.uber.org/zap"
 
 	"mcpv/internal/app/bootstrap"
 	"mcpv/internal/app/controlplane"
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/alerts"
+	"mcpv/internal/infra/audit"
+	"mcpv/internal/infra/callhistory"
 	pluginmanager "mcpv/internal/infra/plugin/manager"
+	"mcpv/internal/infra/ratelimit"
+	"mcpv/internal/infra/responsecache"
 	"mcpv/internal/infra/rpc"
 	"mcpv/internal/infra/telemetry"
 	"mcpv/internal/infra/telemetry/diagnostics"
+	"mcpv/internal/infra/toolstats"
 )
 
 // Application wires the core runtime and dependencies.
@@ -36,6 +45,14 @@ type Application struct {
 	rpcServer     *rpc.Server
 	reloadManager *controlplane.ReloadManager
 	pluginManager *pluginmanager.Manager
+	auditor       *audit.Auditor
+	callHistory   *callhistory.Recorder
+	logStore      *telemetry.LogStore
+	logs          *telemetry.LogBroadcaster
+	toolStats     *toolstats.Tracker
+	alerts        *alerts.Notifier
+	rateLimiter   *ratelimit.Limiter
+	responseCache *responsecache.Cache
 }
 
 // ApplicationOptions captures dependencies and settings for Application.
@@ -55,6 +72,14 @@ type ApplicationOptions struct {
 	RPCServer         *rpc.Server
 	ReloadManager     *controlplane.ReloadManager
 	PluginManager     *pluginmanager.Manager
+	Auditor           *audit.Auditor
+	CallHistory       *callhistory.Recorder
+	LogStore          *telemetry.LogStore
+	Logs              *telemetry.LogBroadcaster
+	ToolStats         *toolstats.Tracker
+	Alerts            *alerts.Notifier
+	RateLimiter       *ratelimit.Limiter
+	ResponseCache     *responsecache.Cache
 }
 
 // NewApplication constructs the core application runtime.
@@ -85,11 +110,20 @@ func NewApplication(opts ApplicationOptions) *Application {
 		rpcServer:     opts.RPCServer,
 		reloadManager: opts.ReloadManager,
 		pluginManager: opts.PluginManager,
+		auditor:       opts.Auditor,
+		callHistory:   opts.CallHistory,
+		logStore:      opts.LogStore,
+		logs:          opts.Logs,
+		toolStats:     opts.ToolStats,
+		alerts:        opts.Alerts,
+		rateLimiter:   opts.RateLimiter,
+		responseCache: opts.ResponseCache,
 	}
 }
 
 // Run starts the core services and blocks until shutdown.
 func (a *Application) Run() error {
+	startedAt := time.Now()
 	a.logger.Info("configuration loaded",
 		zap.String("config", a.configPath),
 		zap.Int("servers", a.summary.TotalServers),
@@ -100,6 +134,16 @@ func (a *Application) Run() error {
 		a.onReady(a.controlPlane)
 	}
 
+	if a.alerts != nil {
+		a.startup.SetAlertPublisher(a.alerts)
+		a.reloadManager.SetAlertPublisher(a.alerts)
+		if a.state != nil {
+			if runtime := a.state.RuntimeState(); runtime != nil {
+				runtime.SetAlertPublisher(a.alerts)
+			}
+		}
+	}
+
 	if a.startup != nil {
 		a.startup.Bootstrap(a.ctx)
 	}
@@ -112,13 +156,26 @@ func (a *Application) Run() error {
 		a.startup.StartInit(a.ctx)
 	}
 
+	a.controlPlane.SetReadiness(controlplane.ReadinessSources{
+		Plugins:    a.GetPluginStatus,
+		RPCServing: a.rpcServer.Serving,
+	})
+
 	metricsEnabled, healthzEnabled := resolveObservabilityDefaults(a.observability)
+	tracing := telemetry.NewTracing(telemetry.TracingOptions{
+		ServiceName: a.summary.Runtime.Observability.Tracing.ServiceName,
+		Logger:      a.logger,
+	})
+	tracing.Install()
 	obsController := telemetry.NewObservabilityController(telemetry.ObservabilityControllerOptions{
 		DefaultMetricsEnabled: metricsEnabled,
 		DefaultHealthzEnabled: healthzEnabled,
 		Registry:              a.registry,
 		Health:                a.health,
+		Readiness:             a.controlPlane.GetReadiness,
+		Tracing:               tracing,
 		Logger:                a.logger,
+		DebugState:            a.controlPlane.DebugState,
 	})
 	if a.reloadManager != nil {
 		a.reloadManager.SetObservabilityController(obsController)
@@ -134,7 +191,8 @@ func (a *Application) Run() error {
 	}
 
 	subAgentConfig := a.summary.Runtime.SubAgent
-	if subAgentConfig.Enabled && len(subAgentConfig.EnabledTags) > 0 && subAgentConfig.Model != "" && subAgentConfig.Provider != "" {
+	subAgentWanted := len(subAgentConfig.EnabledTags) > 0 || domain.ProfilesEnableSubAgent(a.summary.Runtime.Clients)
+	if subAgentConfig.Enabled && subAgentWanted && subAgentConfig.Model != "" && subAgentConfig.Provider != "" {
 		subAgent, err := controlplane.InitializeSubAgent(a.ctx, subAgentConfig, a.controlPlane, a.metrics, a.logger)
 		if err != nil {
 			a.logger.Warn("failed to initialize SubAgent", zap.Error(err))
@@ -147,6 +205,36 @@ func (a *Application) Run() error {
 		}
 	}
 
+	if a.rateLimiter != nil {
+		a.controlPlane.SetQuotaReporter(a.rateLimiter)
+		go a.rateLimiter.Run(a.ctx)
+	}
+
+	if a.callHistory != nil {
+		a.controlPlane.SetCallHistory(a.callHistory)
+	}
+
+	if a.logStore != nil {
+		a.controlPlane.SetLogStore(a.logStore)
+	}
+
+	if a.toolStats != nil {
+		a.controlPlane.SetToolStats(a.toolStats)
+		go a.toolStats.Run(a.ctx)
+	}
+
+	a.controlPlane.SetDiagnostics(controlplane.DiagnosticsSources{
+		Hub:        a.diagnostics,
+		Metrics:    a.MetricsText,
+		ConfigPath: a.configPath,
+		StartedAt:  startedAt,
+		Plugins:    a.GetPluginStatus,
+	})
+
+	if a.responseCache != nil {
+		go a.responseCache.Run(a.ctx)
+	}
+
 	a.controlPlane.StartClientMonitor(a.ctx)
 
 	a.scheduler.StartIdleManager(defaultIdleManagerInterval)
@@ -172,6 +260,30 @@ func (a *Application) Run() error {
 		a.scheduler.StopPingManager()
 		a.scheduler.StopIdleManager()
 		a.scheduler.StopAll(context.Background())
+		if err := a.auditor.Close(); err != nil {
+			a.logger.Warn("audit log close failed", zap.Error(err))
+		}
+		if err := a.callHistory.Close(); err != nil {
+			a.logger.Warn("call history close failed", zap.Error(err))
+		}
+		if a.logs != nil {
+			// Detaching the sink flushes queued entries before the store closes.
+			a.logs.SetSink(nil, nil)
+		}
+		if err := a.logStore.Close(); err != nil {
+			a.logger.Warn("log store close failed", zap.Error(err))
+		}
+		if err := a.alerts.Close(); err != nil {
+			a.logger.Warn("alert notifier close failed", zap.Error(err))
+		}
+		if err := a.rateLimiter.Close(); err != nil {
+			a.logger.Warn("quota state flush failed", zap.Error(err))
+		}
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tracing.Shutdown(flushCtx); err != nil {
+			a.logger.Warn("trace export flush failed", zap.Error(err))
+		}
 	}()
 
 	return a.rpcServer.Run(a.ctx)
@@ -193,6 +305,25 @@ func (a *Application) IsPluginRunning(name string) bool {
 	return a.pluginManager.IsRunning(name)
 }
 
+// MetricsText exports the current Prometheus metrics in text format.
+func (a *Application) MetricsText() (string, error) {
+	if a == nil || a.registry == nil {
+		return "", errors.New("metrics registry not available")
+	}
+	families, err := a.registry.Gather()
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.NewFormat(expfmt.TypeTextPlain))
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
 // Diagnostics returns the diagnostics hub if configured.
 func (a *Application) Diagnostics() *diagnostics.Hub {
 	if a == nil {
@@ -627,7 +627,7 @@ func (m *Manager) fetchPrompts(ctx context.Context, instance *domain.Instance) (
 
 func (m *Manager) filterAndNameTools(tools []*mcp.Tool, specKey string, spec domain.ServerSpec) []domain.ToolDefinition {
 	allowed := allowedToolNames(spec)
-	result := make([]domain.ToolDefinition, 0, len(tools))
+	defs := make([]domain.ToolDefinition, 0, len(tools))
 
 	for _, tool := range tools {
 		if tool == nil || tool.Name == "" {
@@ -640,9 +640,17 @@ func (m *Manager) filterAndNameTools(tools []*mcp.Tool, specKey string, spec dom
 		def := mcpcodec.ToolFromMCP(tool)
 		def.SpecKey = specKey
 		def.ServerName = spec.Name
-		result = append(result, def)
+		defs = append(defs, def)
 	}
 
+	result, skipped := domain.ApplyToolOverrides(spec, defs)
+	for _, def := range skipped {
+		m.logger.Warn("skip tool name already exposed by another tool",
+			zap.String("server", spec.Name),
+			zap.String("tool", def.Name),
+			zap.String("upstream", def.UpstreamName),
+		)
+	}
 	return result
 }
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -148,3 +149,34 @@ func (l *minimalLifecycleStub) StartInstance(_ context.Context, specKey string,
 func (l *minimalLifecycleStub) StopInstance(_ context.Context, _ *domain.Instance, _ string) error {
 	return nil
 }
+
+func TestBootstrapManager_FilterAndNameToolsAppliesOverrides(t *testing.T) {
+	manager := NewManager(Options{
+		Scheduler: &minimalSchedulerStub{},
+		Lifecycle: &minimalLifecycleStub{},
+		Cache:     domain.NewMetadataCache(),
+		Logger:    zap.NewNop(),
+	})
+	spec := domain.ServerSpec{
+		Name:        "github",
+		ExposeTools: []string{"create_issue"},
+		Tools: map[string]domain.ToolOverride{
+			"create_issue": {Name: "file_bug", Aliases: []string{"open_issue"}, Description: "File a bug."},
+		},
+	}
+	tools := []*mcp.Tool{
+		{Name: "create_issue", Description: "Creates an issue.", InputSchema: map[string]any{"type": "object"}},
+		{Name: "delete_repo", InputSchema: map[string]any{"type": "object"}},
+	}
+
+	defs := manager.filterAndNameTools(tools, "spec-github", spec)
+	require.Len(t, defs, 2)
+	require.Equal(t, "file_bug", defs[0].Name)
+	require.Equal(t, "open_issue", defs[1].Name)
+	for _, def := range defs {
+		require.Equal(t, "create_issue", def.UpstreamName)
+		require.Equal(t, "File a bug.", def.Description)
+		require.Equal(t, "spec-github", def.SpecKey)
+		require.Equal(t, "github", def.ServerName)
+	}
+}
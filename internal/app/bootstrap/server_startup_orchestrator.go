@@ -50,6 +50,14 @@ func (o *ServerStartupOrchestrator) StartInit(ctx context.Context) {
 	o.initManager.Start(ctx)
 }
 
+// SetAlertPublisher forwards init state transitions to the alert publisher.
+func (o *ServerStartupOrchestrator) SetAlertPublisher(alerts domain.AlertPublisher) {
+	if o == nil || o.initManager == nil {
+		return
+	}
+	o.initManager.SetAlertPublisher(alerts)
+}
+
 // StopInit stops background initialization work.
 func (o *ServerStartupOrchestrator) StopInit() {
 	if o == nil || o.initManager == nil {
@@ -0,0 +1,79 @@
+package serverinit
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"mcpv/internal/domain"
+)
+
+// SetAlertPublisher attaches the publisher notified of init state
+// transitions; nil detaches it.
+func (m *Manager) SetAlertPublisher(alerts domain.AlertPublisher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alerts = alerts
+}
+
+// settleState records the last settled state of a spec and returns the alert
+// for a change between settled states. Pending and starting are transient:
+// every retry passes through them, so they never reset the settled state.
+// Callers hold m.mu.
+func (m *Manager) settleState(status domain.ServerInitStatus) (domain.AlertEvent, bool) {
+	switch status.State {
+	case domain.ServerInitPending, domain.ServerInitStarting:
+		return domain.AlertEvent{}, false
+	}
+	prev := m.settled[status.SpecKey]
+	m.settled[status.SpecKey] = status.State
+	return initTransitionAlert(prev, status)
+}
+
+// initTransitionAlert maps a settled state change to an alert event. Failures
+// caused by the manager shutting down are not reported.
+func initTransitionAlert(prev domain.ServerInitState, status domain.ServerInitStatus) (domain.AlertEvent, bool) {
+	if prev == status.State || status.AttemptStep == "context_done" {
+		return domain.AlertEvent{}, false
+	}
+	var eventType domain.AlertEventType
+	var message string
+	switch status.State {
+	case domain.ServerInitFailed:
+		eventType = domain.AlertEventServerInitFailed
+		message = fmt.Sprintf("server %s failed to initialize", status.ServerName)
+	case domain.ServerInitSuspended:
+		eventType = domain.AlertEventServerInitSuspended
+		message = fmt.Sprintf("server %s init retries suspended", status.ServerName)
+	case domain.ServerInitDegraded:
+		eventType = domain.AlertEventServerInitDegraded
+		message = fmt.Sprintf("server %s is degraded: %d of %d instances ready", status.ServerName, status.Ready, status.MinReady)
+	case domain.ServerInitReady:
+		switch prev {
+		case domain.ServerInitFailed, domain.ServerInitSuspended, domain.ServerInitDegraded:
+		default:
+			return domain.AlertEvent{}, false
+		}
+		eventType = domain.AlertEventServerInitRecovered
+		message = fmt.Sprintf("server %s recovered", status.ServerName)
+	default:
+		return domain.AlertEvent{}, false
+	}
+	if status.LastError != "" {
+		message += ": " + status.LastError
+	}
+	return domain.AlertEvent{
+		Type:    eventType,
+		Time:    time.Now(),
+		Server:  status.ServerName,
+		Message: message,
+		Attributes: map[string]string{
+			"specKey":       status.SpecKey,
+			"previousState": string(prev),
+			"state":         string(status.State),
+			"ready":         strconv.Itoa(status.Ready),
+			"minReady":      strconv.Itoa(status.MinReady),
+			"retryCount":    strconv.Itoa(status.RetryCount),
+		},
+	}, true
+}
@@ -25,6 +25,8 @@ type Manager struct {
 	probe     diagnostics.Probe
 
 	mu         sync.Mutex
+	alerts     domain.AlertPublisher
+	settled    map[string]domain.ServerInitState
 	statuses   map[string]domain.ServerInitStatus
 	causes     map[string]domain.StartCause
 	targets    map[string]int
@@ -65,6 +67,7 @@ func NewManager(
 		causes:     make(map[string]domain.StartCause),
 		targets:    make(map[string]int),
 		running:    make(map[string]struct{}),
+		settled:    make(map[string]domain.ServerInitState),
 		retryBase:  retryBase,
 		retryMax:   retryMax,
 		maxRetries: maxRetries,
@@ -139,6 +142,7 @@ func (m *Manager) ApplyCatalogState(state *domain.CatalogState) {
 			delete(m.statuses, specKey)
 			delete(m.targets, specKey)
 			delete(m.causes, specKey)
+			delete(m.settled, specKey)
 		}
 	}
 	started := m.started
@@ -748,14 +752,20 @@ func errString(err error) string {
 
 func (m *Manager) updateStatus(specKey string, mutate func(*domain.ServerInitStatus)) {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	status, ok := m.statuses[specKey]
 	if !ok {
+		m.mu.Unlock()
 		return
 	}
 	mutate(&status)
 	m.statuses[specKey] = status
+	alerts := m.alerts
+	event, publish := m.settleState(status)
+	m.mu.Unlock()
+
+	if alerts != nil && publish {
+		alerts.PublishAlert(event)
+	}
 }
 
 func (m *Manager) getStatus(specKey string) (domain.ServerInitStatus, bool) {
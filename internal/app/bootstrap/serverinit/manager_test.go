@@ -122,6 +122,34 @@ func TestManager_RetrySpecResets(t *testing.T) {
 	require.Equal(t, 0, status.RetryCount)
 }
 
+func TestManager_PublishesInitAlerts(t *testing.T) {
+	spec := domain.ServerSpec{Name: "zeta", MinReady: 2, ActivationMode: domain.ActivationAlwaysOn}
+	specKey := specKeyFor(t, spec)
+	scheduler := newInitSchedulerStub(map[string][]setResult{
+		specKey: {
+			{ready: 1, failed: 1, err: errors.New("initialization error")},
+			{ready: 2, failed: 1},
+		},
+	})
+
+	manager := NewManager(scheduler, newTestState(map[string]domain.ServerSpec{spec.Name: spec}, initRuntimeConfig(2)), zap.NewNop(), diagnostics.NoopProbe{})
+	alerts := &alertRecorder{}
+	manager.SetAlertPublisher(alerts)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	manager.Start(ctx)
+
+	waitForStatus(t, manager, specKey, domain.ServerInitReady, 2)
+	events := alerts.Events()
+	require.Len(t, events, 2)
+	require.Equal(t, domain.AlertEventServerInitDegraded, events[0].Type)
+	require.Equal(t, "zeta", events[0].Server)
+	require.Contains(t, events[0].Message, "initialization error")
+	require.Equal(t, domain.AlertEventServerInitRecovered, events[1].Type)
+	require.Equal(t, string(domain.ServerInitDegraded), events[1].Attributes["previousState"])
+}
+
 func TestManager_OnDemandReadyWithoutInstances(t *testing.T) {
 	// On-demand server with minReady=0 should report ready immediately
 	// because the spec/metadata is loaded successfully, no instances needed.
@@ -191,6 +219,23 @@ func specKeyFor(t *testing.T, spec domain.ServerSpec) string {
 	return domain.SpecFingerprint(spec)
 }
 
+type alertRecorder struct {
+	mu     sync.Mutex
+	events []domain.AlertEvent
+}
+
+func (r *alertRecorder) PublishAlert(event domain.AlertEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *alertRecorder) Events() []domain.AlertEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]domain.AlertEvent(nil), r.events...)
+}
+
 type setResult struct {
 	ready  int
 	failed int
@@ -0,0 +1,165 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+
+	"mcpv/internal/app/controlplane/registry"
+	"mcpv/internal/domain"
+)
+
+const (
+	defaultLogLimit = 50
+	maxLogLimit     = 500
+
+	adminStopReason = "admin tool"
+)
+
+// Service serves mcpv's own control operations as MCP tools. Calls reach it
+// through tool discovery, after governance has run.
+type Service struct {
+	state    State
+	registry *registry.ClientRegistry
+	status   StatusReader
+	logs     LogSource
+	editor   ServerEditor
+}
+
+// NewService constructs the admin toolset service.
+func NewService(state State, registry *registry.ClientRegistry, status StatusReader, logs LogSource, editor ServerEditor) *Service {
+	return &Service{
+		state:    state,
+		registry: registry,
+		status:   status,
+		logs:     logs,
+		editor:   editor,
+	}
+}
+
+// ToolsFor returns the admin tool definitions visible to a client.
+func (s *Service) ToolsFor(client string) []domain.ToolDefinition {
+	if !s.allows(client) {
+		return nil
+	}
+	return adminToolDefinitions()
+}
+
+// CallTool runs an admin tool. It reports false when the name is not an admin
+// tool visible to the client, so the caller can route it elsewhere.
+func (s *Service) CallTool(ctx context.Context, client, name string, args json.RawMessage) (json.RawMessage, bool, error) {
+	if !domain.IsAdminToolName(name) || !s.allows(client) {
+		return nil, false, nil
+	}
+	var (
+		value map[string]any
+		err   error
+	)
+	switch name {
+	case domain.AdminToolListServers:
+		value, err = s.listServers(ctx)
+	case domain.AdminToolRuntimeStatus:
+		value, err = s.runtimeStatus(ctx, args)
+	case domain.AdminToolServerInitStatus:
+		value, err = s.serverInitStatus(ctx, args)
+	case domain.AdminToolServerLogs:
+		value, err = s.serverLogs(args)
+	case domain.AdminToolRestartServer:
+		value, err = s.restartServer(ctx, args)
+	case domain.AdminToolStopServer:
+		value, err = s.stopServer(ctx, args)
+	case domain.AdminToolSetServerEnabled:
+		value, err = s.setServerEnabled(ctx, args)
+	default:
+		return nil, false, nil
+	}
+	s.state.Logger().Info("admin tool called",
+		zap.String("client", client),
+		zap.String("tool", name),
+		zap.Bool("failed", err != nil),
+	)
+	var result *mcp.CallToolResult
+	if err != nil {
+		result = errorResult(err)
+	} else {
+		result = structuredResult(value)
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, true, fmt.Errorf("marshal admin tool result: %w", err)
+	}
+	return raw, true, nil
+}
+
+func (s *Service) allows(client string) bool {
+	if s == nil {
+		return false
+	}
+	cfg := s.state.Runtime().AdminTools
+	if !cfg.Enabled {
+		return false
+	}
+	var tags []string
+	if s.registry != nil {
+		resolved, err := s.registry.ResolveClientTags(client)
+		if err != nil {
+			return false
+		}
+		tags = resolved
+	}
+	return cfg.AllowsCaller(client, tags)
+}
+
+type serverArgs struct {
+	Server string `json:"server"`
+}
+
+type logArgs struct {
+	Server   string          `json:"server"`
+	Limit    int             `json:"limit"`
+	MinLevel domain.LogLevel `json:"minLevel"`
+}
+
+type enabledArgs struct {
+	Server  string `json:"server"`
+	Enabled *bool  `json:"enabled"`
+}
+
+type serverEntry struct {
+	Name      string `json:"name"`
+	SpecKey   string `json:"specKey,omitempty"`
+	Disabled  bool   `json:"disabled"`
+	Strategy  string `json:"strategy,omitempty"`
+	MinReady  int    `json:"minReady"`
+	Instances int    `json:"instances"`
+	Busy      int    `json:"busy"`
+	InitState string `json:"initState,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+type poolEntry struct {
+	Server      string                 `json:"server"`
+	SpecKey     string                 `json:"specKey"`
+	MinReady    int                    `json:"minReady"`
+	Instances   []instanceEntry        `json:"instances"`
+	Metrics     domain.PoolMetrics     `json:"metrics"`
+	Diagnostics domain.PoolDiagnostics `json:"diagnostics"`
+}
+
+type instanceEntry struct {
+	ID         string `json:"id"`
+	State      string `json:"state"`
+	BusyCount  int    `json:"busyCount"`
+	LastActive string `json:"lastActive,omitempty"`
+}
+
+type logEntry struct {
+	Timestamp string         `json:"timestamp"`
+	Level     string         `json:"level"`
+	Logger    string         `json:"logger,omitempty"`
+	Message   string         `json:"message"`
+	Fields    map[string]any `json:"fields,omitempty"`
+}
@@ -0,0 +1,276 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/app/bootstrap"
+	"mcpv/internal/app/controlplane/registry"
+	"mcpv/internal/domain"
+)
+
+func TestService_ToolsVisibleToConfiguredCallers(t *testing.T) {
+	service, reg, _ := newTestService(t, domain.AdminToolsConfig{Enabled: true, Callers: []string{"ops-*"}})
+	registerClient(t, reg, "ops-1", nil)
+	registerClient(t, reg, "cursor", nil)
+
+	tools := service.ToolsFor("ops-1")
+	require.Len(t, tools, 7)
+	for _, tool := range tools {
+		require.True(t, domain.IsAdminToolName(tool.Name))
+		require.Equal(t, domain.AdminToolServerType, tool.ServerName)
+	}
+	require.Empty(t, service.ToolsFor("cursor"))
+	require.Empty(t, service.ToolsFor("unregistered"))
+
+	_, handled, err := service.CallTool(context.Background(), "cursor", domain.AdminToolListServers, nil)
+	require.NoError(t, err)
+	require.False(t, handled)
+}
+
+func TestService_DisabledByDefault(t *testing.T) {
+	service, reg, _ := newTestService(t, domain.AdminToolsConfig{Callers: []string{"*"}})
+	registerClient(t, reg, "ops-1", nil)
+
+	require.Empty(t, service.ToolsFor("ops-1"))
+	_, handled, err := service.CallTool(context.Background(), "ops-1", domain.AdminToolListServers, nil)
+	require.NoError(t, err)
+	require.False(t, handled)
+}
+
+func TestService_ListServersIncludesDisabled(t *testing.T) {
+	service, reg, _ := newTestService(t, domain.AdminToolsConfig{Enabled: true, Tags: []string{"admin"}})
+	registerClient(t, reg, "ops-1", []string{"admin"})
+
+	result := callTool(t, service, "ops-1", domain.AdminToolListServers, nil)
+	require.False(t, result.IsError)
+	servers := result.Structured["servers"].([]any)
+	require.Len(t, servers, 2)
+	first := servers[0].(map[string]any)
+	require.Equal(t, "github", first["name"])
+	require.Equal(t, "spec-github", first["specKey"])
+	require.Equal(t, float64(1), first["instances"])
+	require.Equal(t, "ready", first["initState"])
+	second := servers[1].(map[string]any)
+	require.Equal(t, "legacy", second["name"])
+	require.Equal(t, true, second["disabled"])
+}
+
+func TestService_ServerLogsFiltersByServerAndLevel(t *testing.T) {
+	service, reg, _ := newTestService(t, domain.AdminToolsConfig{Enabled: true, Callers: []string{"ops-*"}})
+	registerClient(t, reg, "ops-1", nil)
+
+	result := callTool(t, service, "ops-1", domain.AdminToolServerLogs, map[string]any{
+		"server":   "github",
+		"minLevel": "warning",
+		"limit":    1,
+	})
+	require.False(t, result.IsError)
+	entries := result.Structured["entries"].([]any)
+	require.Len(t, entries, 1)
+	require.Equal(t, "rate limited", entries[0].(map[string]any)["message"])
+
+	result = callTool(t, service, "ops-1", domain.AdminToolServerLogs, map[string]any{"server": "missing"})
+	require.True(t, result.IsError)
+	require.Contains(t, result.Text, `unknown server "missing"`)
+}
+
+func TestService_LifecycleTools(t *testing.T) {
+	service, reg, state := newTestService(t, domain.AdminToolsConfig{Enabled: true, Callers: []string{"ops-*"}})
+	registerClient(t, reg, "ops-1", nil)
+
+	result := callTool(t, service, "ops-1", domain.AdminToolStopServer, map[string]any{"server": "github"})
+	require.False(t, result.IsError)
+	result = callTool(t, service, "ops-1", domain.AdminToolRestartServer, map[string]any{"server": "github"})
+	require.False(t, result.IsError)
+	require.Equal(t, []string{"spec-github", "spec-github"}, state.scheduler.stopped)
+
+	result = callTool(t, service, "ops-1", domain.AdminToolStopServer, map[string]any{"server": "legacy"})
+	require.True(t, result.IsError)
+	require.Contains(t, result.Text, `server "legacy" is disabled`)
+
+	result = callTool(t, service, "ops-1", domain.AdminToolSetServerEnabled, map[string]any{"server": "legacy", "enabled": true})
+	require.False(t, result.IsError)
+	require.Equal(t, map[string]bool{"legacy": false}, service.editor.(*fakeEditor).disabled)
+
+	result = callTool(t, service, "ops-1", domain.AdminToolSetServerEnabled, map[string]any{"server": "legacy"})
+	require.True(t, result.IsError)
+	require.Contains(t, result.Text, "enabled is required")
+}
+
+type toolResult struct {
+	IsError    bool
+	Text       string
+	Structured map[string]any
+}
+
+func callTool(t *testing.T, service *Service, client, name string, args map[string]any) toolResult {
+	t.Helper()
+	var raw json.RawMessage
+	if args != nil {
+		encoded, err := json.Marshal(args)
+		require.NoError(t, err)
+		raw = encoded
+	}
+	out, handled, err := service.CallTool(context.Background(), client, name, raw)
+	require.NoError(t, err)
+	require.True(t, handled)
+
+	var decoded struct {
+		IsError bool `json:"isError"`
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+		StructuredContent map[string]any `json:"structuredContent"`
+	}
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	result := toolResult{IsError: decoded.IsError, Structured: decoded.StructuredContent}
+	if len(decoded.Content) > 0 {
+		result.Text = decoded.Content[0].Text
+	}
+	return result
+}
+
+func registerClient(t *testing.T, reg *registry.ClientRegistry, client string, tags []string) {
+	t.Helper()
+	_, err := reg.RegisterClient(context.Background(), client, 1000, tags, "", domain.ClientInfo{})
+	require.NoError(t, err)
+}
+
+func newTestService(t *testing.T, cfg domain.AdminToolsConfig) (*Service, *registry.ClientRegistry, *fakeState) {
+	t.Helper()
+	state := &fakeState{
+		runtime: domain.RuntimeConfig{AdminTools: cfg},
+		specs: map[string]domain.ServerSpec{
+			"github": {Name: "github", Strategy: domain.StrategyStateless, MinReady: 1},
+			"legacy": {Name: "legacy", Disabled: true},
+		},
+		specKeys:  map[string]string{"github": "spec-github"},
+		scheduler: &fakeScheduler{},
+	}
+	reg := registry.NewClientRegistry(state)
+	now := time.Now()
+	status := fakeStatus{
+		pools: []domain.PoolInfo{{
+			SpecKey:    "spec-github",
+			ServerName: "github",
+			MinReady:   1,
+			Instances:  []domain.InstanceInfo{{ID: "inst-1", State: domain.InstanceStateReady, LastActive: now}},
+		}},
+		inits: []domain.ServerInitStatus{{SpecKey: "spec-github", ServerName: "github", State: domain.ServerInitReady}},
+	}
+	logs := fakeLogs{entries: []domain.LogEntry{
+		serverLog("github", domain.LogLevelError, "upstream failed", now),
+		serverLog("legacy", domain.LogLevelError, "other server", now),
+		serverLog("github", domain.LogLevelInfo, "started", now),
+		serverLog("github", domain.LogLevelWarning, "rate limited", now),
+	}}
+	return NewService(state, reg, status, logs, &fakeEditor{}), reg, state
+}
+
+func serverLog(server string, level domain.LogLevel, message string, ts time.Time) domain.LogEntry {
+	return domain.LogEntry{
+		Logger:    "mcpv",
+		Level:     level,
+		Timestamp: ts,
+		Data: map[string]any{
+			"message": message,
+			"fields":  map[string]any{"serverType": server},
+		},
+	}
+}
+
+type fakeState struct {
+	runtime   domain.RuntimeConfig
+	specs     map[string]domain.ServerSpec
+	specKeys  map[string]string
+	scheduler *fakeScheduler
+}
+
+func (s *fakeState) Runtime() domain.RuntimeConfig {
+	return s.runtime
+}
+
+func (s *fakeState) Catalog() domain.Catalog {
+	return domain.Catalog{Specs: s.specs, Runtime: s.runtime}
+}
+
+func (s *fakeState) ServerSpecKeys() map[string]string {
+	return s.specKeys
+}
+
+func (s *fakeState) SpecRegistry() map[string]domain.ServerSpec {
+	out := make(map[string]domain.ServerSpec, len(s.specKeys))
+	for name, specKey := range s.specKeys {
+		out[specKey] = s.specs[name]
+	}
+	return out
+}
+
+func (s *fakeState) Scheduler() domain.Scheduler {
+	return s.scheduler
+}
+
+func (s *fakeState) Startup() *bootstrap.ServerStartupOrchestrator {
+	return nil
+}
+
+func (s *fakeState) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeState) Logger() *zap.Logger {
+	return zap.NewNop()
+}
+
+type fakeScheduler struct {
+	domain.Scheduler
+	stopped []string
+}
+
+func (f *fakeScheduler) SetDesiredMinReady(_ context.Context, _ string, _ int) error {
+	return nil
+}
+
+func (f *fakeScheduler) StopSpec(_ context.Context, specKey, _ string) error {
+	f.stopped = append(f.stopped, specKey)
+	return nil
+}
+
+type fakeStatus struct {
+	pools []domain.PoolInfo
+	inits []domain.ServerInitStatus
+}
+
+func (f fakeStatus) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, error) {
+	return f.pools, nil
+}
+
+func (f fakeStatus) GetServerInitStatus(_ context.Context) ([]domain.ServerInitStatus, error) {
+	return f.inits, nil
+}
+
+type fakeLogs struct {
+	entries []domain.LogEntry
+}
+
+func (f fakeLogs) Logs() []domain.LogEntry {
+	return f.entries
+}
+
+type fakeEditor struct {
+	disabled map[string]bool
+}
+
+func (f *fakeEditor) SetServerDisabled(_ context.Context, serverName string, disabled bool) error {
+	if f.disabled == nil {
+		f.disabled = make(map[string]bool)
+	}
+	f.disabled[serverName] = disabled
+	return nil
+}
@@ -0,0 +1,346 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
+)
+
+var logLevelOrder = []domain.LogLevel{
+	domain.LogLevelDebug,
+	domain.LogLevelInfo,
+	domain.LogLevelNotice,
+	domain.LogLevelWarning,
+	domain.LogLevelError,
+	domain.LogLevelCritical,
+	domain.LogLevelAlert,
+	domain.LogLevelEmergency,
+}
+
+func (s *Service) listServers(ctx context.Context) (map[string]any, error) {
+	specs := s.state.Catalog().Specs
+	specKeys := s.state.ServerSpecKeys()
+	pools := s.poolsByServer(ctx)
+	inits := s.initByServer(ctx)
+
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	servers := make([]serverEntry, 0, len(names))
+	for _, name := range names {
+		spec := specs[name]
+		entry := serverEntry{
+			Name:     name,
+			SpecKey:  specKeys[name],
+			Disabled: spec.Disabled,
+			Strategy: string(spec.Strategy),
+			MinReady: spec.MinReady,
+		}
+		if pool, ok := pools[name]; ok {
+			entry.Instances = len(pool.Instances)
+			for _, inst := range pool.Instances {
+				if inst.BusyCount > 0 {
+					entry.Busy++
+				}
+			}
+		}
+		if status, ok := inits[name]; ok {
+			entry.InitState = string(status.State)
+			entry.LastError = status.LastError
+		}
+		servers = append(servers, entry)
+	}
+	return map[string]any{"servers": servers}, nil
+}
+
+func (s *Service) runtimeStatus(ctx context.Context, args json.RawMessage) (map[string]any, error) {
+	var in serverArgs
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	if in.Server != "" {
+		if _, err := s.lookupServer(in.Server); err != nil {
+			return nil, err
+		}
+	}
+	if s.status == nil {
+		return nil, errors.New("runtime status is not available")
+	}
+	pools, err := s.status.GetPoolStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]poolEntry, 0, len(pools))
+	for _, pool := range pools {
+		if in.Server != "" && pool.ServerName != in.Server {
+			continue
+		}
+		out = append(out, toPoolEntry(pool))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Server < out[j].Server })
+	return map[string]any{"pools": out}, nil
+}
+
+func (s *Service) serverInitStatus(ctx context.Context, args json.RawMessage) (map[string]any, error) {
+	var in serverArgs
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	if in.Server != "" {
+		if _, err := s.lookupServer(in.Server); err != nil {
+			return nil, err
+		}
+	}
+	if s.status == nil {
+		return nil, errors.New("server init status is not available")
+	}
+	statuses, err := s.status.GetServerInitStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.ServerInitStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if in.Server != "" && status.ServerName != in.Server {
+			continue
+		}
+		out = append(out, status)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ServerName < out[j].ServerName })
+	return map[string]any{"servers": out}, nil
+}
+
+func (s *Service) serverLogs(args json.RawMessage) (map[string]any, error) {
+	var in logArgs
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	specKey, err := s.lookupServer(in.Server)
+	if err != nil {
+		return nil, err
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultLogLimit
+	}
+	if limit > maxLogLimit {
+		limit = maxLogLimit
+	}
+	minRank := 0
+	if in.MinLevel != "" {
+		minRank = logLevelRank(in.MinLevel)
+		if minRank < 0 {
+			return nil, fmt.Errorf("unknown log level %q", in.MinLevel)
+		}
+	}
+	if s.logs == nil {
+		return map[string]any{"server": in.Server, "entries": []logEntry{}}, nil
+	}
+
+	matched := make([]logEntry, 0, limit)
+	entries := s.logs.Logs()
+	for i := len(entries) - 1; i >= 0 && len(matched) < limit; i-- {
+		entry := entries[i]
+		if logLevelRank(entry.Level) < minRank {
+			continue
+		}
+		fields, _ := entry.Data["fields"].(map[string]any)
+		serverType, _ := fields[telemetry.FieldServerType].(string)
+		if serverType == "" || (serverType != in.Server && serverType != specKey) {
+			continue
+		}
+		matched = append(matched, toLogEntry(entry, fields))
+	}
+	for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+		matched[i], matched[j] = matched[j], matched[i]
+	}
+	return map[string]any{"server": in.Server, "entries": matched}, nil
+}
+
+func (s *Service) restartServer(ctx context.Context, args json.RawMessage) (map[string]any, error) {
+	specKey, server, err := s.runningServerArg(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.Scheduler().StopSpec(ctx, specKey, adminStopReason); err != nil {
+		return nil, fmt.Errorf("stop server %q: %w", server, err)
+	}
+	// Servers without warm instances have nothing to retry and start again on
+	// their next call.
+	started := s.state.Startup().RetryInit(specKey) == nil
+	return map[string]any{"server": server, "stopped": true, "restarted": started}, nil
+}
+
+func (s *Service) stopServer(ctx context.Context, args json.RawMessage) (map[string]any, error) {
+	specKey, server, err := s.runningServerArg(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.Scheduler().StopSpec(ctx, specKey, adminStopReason); err != nil {
+		return nil, fmt.Errorf("stop server %q: %w", server, err)
+	}
+	return map[string]any{"server": server, "stopped": true}, nil
+}
+
+func (s *Service) setServerEnabled(ctx context.Context, args json.RawMessage) (map[string]any, error) {
+	var in enabledArgs
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	if in.Enabled == nil {
+		return nil, errors.New("enabled is required")
+	}
+	if _, ok := s.state.Catalog().Specs[in.Server]; !ok {
+		return nil, fmt.Errorf("unknown server %q", in.Server)
+	}
+	if s.editor == nil {
+		return nil, errors.New("config editing is not available")
+	}
+	if err := s.editor.SetServerDisabled(ctx, in.Server, !*in.Enabled); err != nil {
+		return nil, err
+	}
+	return map[string]any{"server": in.Server, "enabled": *in.Enabled}, nil
+}
+
+// runningServerArg resolves the server argument of lifecycle tools.
+func (s *Service) runningServerArg(args json.RawMessage) (string, string, error) {
+	var in serverArgs
+	if err := decodeArgs(args, &in); err != nil {
+		return "", "", err
+	}
+	specKey, err := s.lookupServer(in.Server)
+	if err != nil {
+		return "", "", err
+	}
+	if specKey == "" {
+		return "", "", fmt.Errorf("server %q is disabled", in.Server)
+	}
+	if s.state.Scheduler() == nil {
+		return "", "", errors.New("scheduler is not available")
+	}
+	return specKey, in.Server, nil
+}
+
+// lookupServer returns the spec key of a configured server. Disabled servers
+// have no spec key.
+func (s *Service) lookupServer(name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", errors.New("server is required")
+	}
+	if _, ok := s.state.Catalog().Specs[name]; !ok {
+		return "", fmt.Errorf("unknown server %q", name)
+	}
+	return s.state.ServerSpecKeys()[name], nil
+}
+
+func (s *Service) poolsByServer(ctx context.Context) map[string]domain.PoolInfo {
+	out := make(map[string]domain.PoolInfo)
+	if s.status == nil {
+		return out
+	}
+	pools, err := s.status.GetPoolStatus(ctx)
+	if err != nil {
+		return out
+	}
+	for _, pool := range pools {
+		out[pool.ServerName] = pool
+	}
+	return out
+}
+
+func (s *Service) initByServer(ctx context.Context) map[string]domain.ServerInitStatus {
+	out := make(map[string]domain.ServerInitStatus)
+	if s.status == nil {
+		return out
+	}
+	statuses, err := s.status.GetServerInitStatus(ctx)
+	if err != nil {
+		return out
+	}
+	for _, status := range statuses {
+		out[status.ServerName] = status
+	}
+	return out
+}
+
+func toPoolEntry(pool domain.PoolInfo) poolEntry {
+	instances := make([]instanceEntry, 0, len(pool.Instances))
+	for _, inst := range pool.Instances {
+		entry := instanceEntry{
+			ID:        inst.ID,
+			State:     string(inst.State),
+			BusyCount: inst.BusyCount,
+		}
+		if !inst.LastActive.IsZero() {
+			entry.LastActive = inst.LastActive.UTC().Format(time.RFC3339)
+		}
+		instances = append(instances, entry)
+	}
+	return poolEntry{
+		Server:      pool.ServerName,
+		SpecKey:     pool.SpecKey,
+		MinReady:    pool.MinReady,
+		Instances:   instances,
+		Metrics:     pool.Metrics,
+		Diagnostics: pool.Diagnostics,
+	}
+}
+
+func toLogEntry(entry domain.LogEntry, fields map[string]any) logEntry {
+	message, _ := entry.Data["message"].(string)
+	return logEntry{
+		Timestamp: entry.Timestamp.UTC().Format(time.RFC3339Nano),
+		Level:     string(entry.Level),
+		Logger:    entry.Logger,
+		Message:   message,
+		Fields:    fields,
+	}
+}
+
+func logLevelRank(level domain.LogLevel) int {
+	for i, candidate := range logLevelOrder {
+		if candidate == level {
+			return i
+		}
+	}
+	return -1
+}
+
+func decodeArgs(args json.RawMessage, out any) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(args, out); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+func structuredResult(value map[string]any) *mcp.CallToolResult {
+	text, err := json.Marshal(value)
+	if err != nil {
+		return errorResult(err)
+	}
+	return &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: string(text)}},
+		StructuredContent: value,
+	}
+}
+
+func errorResult(err error) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("error: %s", err.Error())}},
+	}
+}
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/app/bootstrap"
+	"mcpv/internal/domain"
+)
+
+type State interface {
+	Runtime() domain.RuntimeConfig
+	Catalog() domain.Catalog
+	ServerSpecKeys() map[string]string
+	Scheduler() domain.Scheduler
+	Startup() *bootstrap.ServerStartupOrchestrator
+	Logger() *zap.Logger
+}
+
+// StatusReader provides pool and server init status snapshots.
+type StatusReader interface {
+	GetPoolStatus(ctx context.Context) ([]domain.PoolInfo, error)
+	domain.ServerInitStatusReader
+}
+
+// LogSource returns recently captured log entries.
+type LogSource interface {
+	Logs() []domain.LogEntry
+}
+
+// ServerEditor persists server enablement to the catalog file.
+type ServerEditor interface {
+	SetServerDisabled(ctx context.Context, serverName string, disabled bool) error
+}
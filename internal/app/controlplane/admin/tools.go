@@ -0,0 +1,120 @@
+package admin
+
+import "mcpv/internal/domain"
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func serverArgSchema(required bool, description string) map[string]any {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"server": map[string]any{
+				"type":        "string",
+				"description": description,
+			},
+		},
+	}
+	if required {
+		schema["required"] = []string{"server"}
+	}
+	return schema
+}
+
+func readOnlyAnnotations(title string) *domain.ToolAnnotations {
+	return &domain.ToolAnnotations{
+		Title:         title,
+		ReadOnlyHint:  true,
+		OpenWorldHint: boolPtr(false),
+	}
+}
+
+func mutatingAnnotations(title string, destructive, idempotent bool) *domain.ToolAnnotations {
+	return &domain.ToolAnnotations{
+		Title:           title,
+		DestructiveHint: boolPtr(destructive),
+		IdempotentHint:  idempotent,
+		OpenWorldHint:   boolPtr(false),
+	}
+}
+
+func adminToolDefinitions() []domain.ToolDefinition {
+	tools := []domain.ToolDefinition{
+		{
+			Name:        domain.AdminToolListServers,
+			Description: "List servers configured in mcpv with their enablement, running instances and initialization state.",
+			InputSchema: map[string]any{"type": "object", "properties": map[string]any{}},
+			Annotations: readOnlyAnnotations("List mcpv servers"),
+		},
+		{
+			Name:        domain.AdminToolRuntimeStatus,
+			Description: "Show instance pools, instance states and call metrics for all servers or one server.",
+			InputSchema: serverArgSchema(false, "Server name; omit for all servers"),
+			Annotations: readOnlyAnnotations("mcpv runtime status"),
+		},
+		{
+			Name:        domain.AdminToolServerInitStatus,
+			Description: "Show server initialization state, retry count and the last startup error.",
+			InputSchema: serverArgSchema(false, "Server name; omit for all servers"),
+			Annotations: readOnlyAnnotations("mcpv server init status"),
+		},
+		{
+			Name:        domain.AdminToolServerLogs,
+			Description: "Return recent log entries mcpv captured for a server, including its stderr output.",
+			InputSchema: map[string]any{
+				"type":     "object",
+				"required": []string{"server"},
+				"properties": map[string]any{
+					"server": map[string]any{"type": "string", "description": "Server name"},
+					"limit": map[string]any{
+						"type":        "integer",
+						"minimum":     1,
+						"maximum":     maxLogLimit,
+						"description": "Maximum number of entries to return, newest last",
+					},
+					"minLevel": map[string]any{
+						"type": "string",
+						"enum": []string{
+							string(domain.LogLevelDebug),
+							string(domain.LogLevelInfo),
+							string(domain.LogLevelNotice),
+							string(domain.LogLevelWarning),
+							string(domain.LogLevelError),
+						},
+					},
+				},
+			},
+			Annotations: readOnlyAnnotations("mcpv server logs"),
+		},
+		{
+			Name:        domain.AdminToolRestartServer,
+			Description: "Stop every instance of a server and start it again when it keeps warm instances.",
+			InputSchema: serverArgSchema(true, "Server name"),
+			Annotations: mutatingAnnotations("Restart mcpv server", true, false),
+		},
+		{
+			Name:        domain.AdminToolStopServer,
+			Description: "Stop every running instance of a server. On-demand servers start again on the next call.",
+			InputSchema: serverArgSchema(true, "Server name"),
+			Annotations: mutatingAnnotations("Stop mcpv server", true, true),
+		},
+		{
+			Name:        domain.AdminToolSetServerEnabled,
+			Description: "Enable or disable a server in the mcpv config file. The change applies on the next config reload.",
+			InputSchema: map[string]any{
+				"type":     "object",
+				"required": []string{"server", "enabled"},
+				"properties": map[string]any{
+					"server":  map[string]any{"type": "string", "description": "Server name"},
+					"enabled": map[string]any{"type": "boolean"},
+				},
+			},
+			Annotations: mutatingAnnotations("Enable or disable mcpv server", false, true),
+		},
+	}
+	for i := range tools {
+		tools[i].ServerName = domain.AdminToolServerType
+	}
+	return tools
+}
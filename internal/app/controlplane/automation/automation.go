@@ -67,7 +67,13 @@ func (a *Service) IsSubAgentEnabledForClient(client string) bool {
 
 func (a *Service) isSubAgentEnabledForClient(client string) bool {
 	cfg := a.state.Runtime().SubAgent
-	if !cfg.Enabled || len(cfg.EnabledTags) == 0 {
+	if !cfg.Enabled {
+		return false
+	}
+	if profile, ok, err := a.registry.ResolveClientProfile(client); err == nil && ok && profile.SubAgent != nil {
+		return *profile.SubAgent
+	}
+	if len(cfg.EnabledTags) == 0 {
 		return false
 	}
 
@@ -157,6 +157,7 @@ func TestIsSubAgentEnabledForClient(t *testing.T) {
 	tests := []struct {
 		name         string
 		config       domain.SubAgentConfig
+		profiles     []domain.ClientProfile
 		clientTags   []string
 		expectEnable bool
 	}{
@@ -196,15 +197,33 @@ func TestIsSubAgentEnabledForClient(t *testing.T) {
 			clientTags:   []string{"vscode"},
 			expectEnable: false,
 		},
+		{
+			name: "profile turns on without enabled tags",
+			config: domain.SubAgentConfig{
+				Enabled: true,
+			},
+			profiles:     []domain.ClientProfile{{Name: "agent", Callers: []string{"client"}, SubAgent: boolPtr(true)}},
+			expectEnable: true,
+		},
+		{
+			name: "profile turns off despite matching tag",
+			config: domain.SubAgentConfig{
+				Enabled:     true,
+				EnabledTags: []string{"vscode"},
+			},
+			profiles:     []domain.ClientProfile{{Name: "plain", Callers: []string{"client"}, SubAgent: boolPtr(false)}},
+			clientTags:   []string{"vscode"},
+			expectEnable: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			runtime := domain.RuntimeConfig{SubAgent: tt.config}
+			runtime := domain.RuntimeConfig{SubAgent: tt.config, Clients: tt.profiles}
 			regState := fakeRegistryState{runtime: runtime}
 			reg := registry.NewClientRegistry(regState)
 
-			_, err := reg.RegisterClient(context.Background(), "client", 1, tt.clientTags, "")
+			_, err := reg.RegisterClient(context.Background(), "client", 1, tt.clientTags, "", domain.ClientInfo{})
 			require.NoError(t, err)
 
 			service := NewAutomationService(fakeAutomationState{runtime: runtime}, reg, nil)
@@ -214,3 +233,7 @@ func TestIsSubAgentEnabledForClient(t *testing.T) {
 		})
 	}
 }
+
+func boolPtr(value bool) *bool {
+	return &value
+}
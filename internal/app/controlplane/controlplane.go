@@ -8,6 +8,7 @@ import (
 
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/aggregator"
+	"mcpv/internal/infra/telemetry"
 )
 
 // ControlPlane aggregates control plane services behind a facade.
@@ -19,6 +20,11 @@ type ControlPlane struct {
 	prompts       *PromptDiscoveryService
 	observability *ObservabilityService
 	automation    *AutomationService
+	quota         domain.QuotaAPI
+	callHistory   domain.CallHistoryAPI
+	toolStats     domain.ToolStatsAPI
+	diagnostics   DiagnosticsSources
+	readiness     ReadinessSources
 }
 
 // NewControlPlane constructs a control plane facade from services.
@@ -30,7 +36,11 @@ func NewControlPlane(
 	prompts *PromptDiscoveryService,
 	observability *ObservabilityService,
 	automation *AutomationService,
+	admin *AdminService,
 ) *ControlPlane {
+	if admin != nil {
+		tools.SetAdminTools(admin)
+	}
 	return &ControlPlane{
 		state:         state,
 		registry:      registry,
@@ -53,8 +63,13 @@ func (c *ControlPlane) Info(_ context.Context) (domain.ControlPlaneInfo, error)
 }
 
 // RegisterClient registers a client with the control plane.
-func (c *ControlPlane) RegisterClient(ctx context.Context, client string, pid int, tags []string, server string) (domain.ClientRegistration, error) {
-	return c.registry.RegisterClient(ctx, client, pid, tags, server)
+func (c *ControlPlane) RegisterClient(ctx context.Context, client string, pid int, tags []string, server string, info domain.ClientInfo) (domain.ClientRegistration, error) {
+	return c.registry.RegisterClient(ctx, client, pid, tags, server, info)
+}
+
+// ClientInfo returns the client info a registered caller reported.
+func (c *ControlPlane) ClientInfo(client string) (domain.ClientInfo, bool) {
+	return c.registry.ClientInfo(client)
 }
 
 // UnregisterClient unregisters a client.
@@ -148,13 +163,18 @@ func (c *ControlPlane) GetPromptAll(ctx context.Context, name string, args json.
 }
 
 // StreamLogs streams logs for a client.
-func (c *ControlPlane) StreamLogs(ctx context.Context, client string, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	return c.observability.StreamLogs(ctx, client, minLevel)
+func (c *ControlPlane) StreamLogs(ctx context.Context, client string, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	return c.observability.StreamLogs(ctx, client, query)
 }
 
 // StreamLogsAllServers streams logs across all servers.
-func (c *ControlPlane) StreamLogsAllServers(ctx context.Context, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	return c.observability.StreamLogsAllServers(ctx, minLevel)
+func (c *ControlPlane) StreamLogsAllServers(ctx context.Context, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	return c.observability.StreamLogsAllServers(ctx, query)
+}
+
+// SetLogStore sets the store used to backfill historical log queries.
+func (c *ControlPlane) SetLogStore(store *telemetry.LogStore) {
+	c.observability.SetLogStore(store)
 }
 
 // GetCatalog returns the current catalog.
@@ -241,6 +261,48 @@ func (c *ControlPlane) SetSubAgent(agent domain.SubAgent) {
 	c.automation.SetSubAgent(agent)
 }
 
+// SetQuotaReporter sets the source of rate limit and quota status.
+func (c *ControlPlane) SetQuotaReporter(reporter domain.QuotaAPI) {
+	c.quota = reporter
+}
+
+// GetQuotaStatus returns rate limit and quota status for a caller, or for all
+// tracked keys when caller is empty. It is empty when rate limits are disabled.
+func (c *ControlPlane) GetQuotaStatus(ctx context.Context, caller string) ([]domain.QuotaStatus, error) {
+	if c.quota == nil {
+		return nil, nil
+	}
+	return c.quota.GetQuotaStatus(ctx, caller)
+}
+
+// SetCallHistory sets the source of recorded call history.
+func (c *ControlPlane) SetCallHistory(history domain.CallHistoryAPI) {
+	c.callHistory = history
+}
+
+// QueryCallHistory returns recorded calls, newest first. It is empty when the
+// call history is disabled.
+func (c *ControlPlane) QueryCallHistory(ctx context.Context, query domain.CallHistoryQuery) (domain.CallHistoryPage, error) {
+	if c.callHistory == nil {
+		return domain.CallHistoryPage{}, nil
+	}
+	return c.callHistory.QueryCallHistory(ctx, query)
+}
+
+// SetToolStats sets the source of per-tool latency statistics.
+func (c *ControlPlane) SetToolStats(stats domain.ToolStatsAPI) {
+	c.toolStats = stats
+}
+
+// GetToolStats returns per-tool latency statistics and SLO status. It is empty
+// when no tracker is configured.
+func (c *ControlPlane) GetToolStats(ctx context.Context, query domain.ToolStatsQuery) (domain.ToolStats, error) {
+	if c.toolStats == nil {
+		return domain.ToolStats{}, nil
+	}
+	return c.toolStats.GetToolStats(ctx, query)
+}
+
 // IsSubAgentEnabledForClient reports whether SubAgent is enabled for a client.
 func (c *ControlPlane) IsSubAgentEnabledForClient(client string) bool {
 	return c.automation.IsSubAgentEnabledForClient(client)
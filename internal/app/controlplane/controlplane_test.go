@@ -1,7 +1,11 @@
 package controlplane
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"testing"
 	"time"
 
@@ -10,6 +14,8 @@ import (
 
 	"mcpv/internal/app/runtime"
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/manager"
+	"mcpv/internal/infra/telemetry/diagnostics"
 )
 
 func TestControlPlane_RequiresRegistration(t *testing.T) {
@@ -37,7 +43,7 @@ func TestControlPlane_RegisterUnregister(t *testing.T) {
 		Runtime: domain.RuntimeConfig{},
 	}, sched)
 
-	registration, err := cp.RegisterClient(context.Background(), "client", 1234, nil, "")
+	registration, err := cp.RegisterClient(context.Background(), "client", 1234, nil, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	require.Equal(t, "client", registration.Client)
 	require.Equal(t, []minReadyCall{{specKey: specKey, minReady: 1}}, sched.minReadyCalls)
@@ -46,6 +52,162 @@ func TestControlPlane_RegisterUnregister(t *testing.T) {
 	require.Equal(t, []stopCall{{specKey: specKey, reason: "client inactive"}}, sched.stopCalls)
 }
 
+func TestControlPlane_DebugState(t *testing.T) {
+	lastAccess := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	scheduler := &fakeScheduler{pools: []domain.PoolInfo{{
+		SpecKey:    "spec-a",
+		ServerName: "a",
+		MinReady:   1,
+		Instances: []domain.InstanceInfo{{
+			ID:    "a-1",
+			State: domain.InstanceStateBusy,
+			PID:   4242,
+		}},
+		Diagnostics: domain.PoolDiagnostics{Waiters: 3, StartInFlight: true},
+		Sticky:      []domain.StickyBindingInfo{{RoutingKey: "session-1", InstanceID: "a-1", LastAccess: lastAccess}},
+	}}}
+	cp := newTestControlPlane(context.Background(), domain.Catalog{}, scheduler)
+	cp.SetDiagnostics(DiagnosticsSources{
+		Plugins: func() []manager.Status {
+			return []manager.Status{{Name: "audit", Running: true}}
+		},
+	})
+
+	payload, err := cp.DebugState(context.Background())
+	require.NoError(t, err)
+
+	var state struct {
+		Pools []struct {
+			SpecKey       string `json:"specKey"`
+			Waiters       int    `json:"waiters"`
+			StartInFlight bool   `json:"startInFlight"`
+			Instances     []struct {
+				ID  string `json:"id"`
+				PID int    `json:"pid"`
+			} `json:"instances"`
+			Sticky []domain.StickyBindingInfo `json:"sticky"`
+		} `json:"pools"`
+		Clients    []json.RawMessage `json:"clients"`
+		Plugins    []manager.Status  `json:"plugins"`
+		IndexETags map[string]string `json:"indexETags"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &state))
+	require.Len(t, state.Pools, 1)
+	require.Equal(t, "spec-a", state.Pools[0].SpecKey)
+	require.Equal(t, 3, state.Pools[0].Waiters)
+	require.True(t, state.Pools[0].StartInFlight)
+	require.Equal(t, "a-1", state.Pools[0].Instances[0].ID)
+	require.Equal(t, 4242, state.Pools[0].Instances[0].PID)
+	require.Equal(t, []domain.StickyBindingInfo{{RoutingKey: "session-1", InstanceID: "a-1", LastAccess: lastAccess}}, state.Pools[0].Sticky)
+	require.NotNil(t, state.Clients)
+	require.Equal(t, []manager.Status{{Name: "audit", Running: true}}, state.Plugins)
+	require.Contains(t, state.IndexETags, "runtimeStatus")
+}
+
+func TestControlPlane_ExportDiagnostics(t *testing.T) {
+	spec := domain.ServerSpec{
+		Name:            "spec-a",
+		Cmd:             []string{"/bin/true"},
+		Env:             map[string]string{"API_TOKEN": "super-secret"},
+		MaxConcurrent:   1,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	}
+	cp := newTestControlPlane(context.Background(), domain.Catalog{
+		Specs:   map[string]domain.ServerSpec{spec.Name: spec},
+		Runtime: domain.RuntimeConfig{},
+	}, &fakeScheduler{})
+	cp.SetDiagnostics(DiagnosticsSources{
+		Metrics:    func() (string, error) { return "mcpv_up 1\n", nil },
+		ConfigPath: "/etc/mcpv/catalog.yaml",
+		StartedAt:  time.Now().Add(-time.Minute),
+	})
+
+	export, err := cp.ExportDiagnostics(context.Background(), domain.DiagnosticsExportOptions{})
+	require.NoError(t, err)
+	require.False(t, export.GeneratedAt.IsZero())
+
+	reader, err := zip.NewReader(bytes.NewReader(export.Archive), int64(len(export.Archive)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, rc.Close())
+		require.NoError(t, err)
+		files[file.Name] = string(data)
+	}
+	require.Contains(t, files["snapshot.json"], "/etc/mcpv/catalog.yaml")
+	require.Contains(t, files["catalog.json"], "API_TOKEN")
+	require.NotContains(t, files["catalog.json"], "super-secret")
+	require.Equal(t, "mcpv_up 1\n", files["metrics.txt"])
+	require.Contains(t, files["report.md"], "Core State:           running")
+
+	_, err = cp.ExportDiagnostics(context.Background(), domain.DiagnosticsExportOptions{Mode: "everything"})
+	require.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	_, err = cp.ExportDiagnostics(context.Background(), domain.DiagnosticsExportOptions{Mode: domain.DiagnosticsRedactionDeep})
+	require.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestControlPlane_ExportDiagnosticsDeepWhenEnabled(t *testing.T) {
+	cp := newTestControlPlane(context.Background(), domain.Catalog{
+		Runtime: domain.RuntimeConfig{Observability: domain.ObservabilityConfig{
+			Debug: domain.ObservabilityDebugConfig{DeepDiagnosticsEnabled: true},
+		}},
+	}, &fakeScheduler{})
+
+	export, err := cp.ExportDiagnostics(context.Background(), domain.DiagnosticsExportOptions{Mode: domain.DiagnosticsRedactionDeep})
+	require.NoError(t, err)
+	require.NotEmpty(t, export.Archive)
+}
+
+func TestControlPlane_WatchDiagnosticsEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := diagnostics.NewHub(ctx, nil, diagnostics.HubOptions{})
+	cp := newTestControlPlane(ctx, domain.Catalog{Specs: map[string]domain.ServerSpec{}}, &fakeScheduler{})
+	cp.SetDiagnostics(DiagnosticsSources{Hub: hub})
+
+	now := time.Now()
+	hub.Record(diagnostics.Event{ServerName: "github", Step: diagnostics.StepLauncherStart, Phase: diagnostics.PhaseEnter, Timestamp: now})
+	hub.Record(diagnostics.Event{ServerName: "jira", Step: diagnostics.StepLauncherStart, Phase: diagnostics.PhaseEnter, Timestamp: now})
+	hub.Record(diagnostics.Event{ServerName: "github", Step: diagnostics.StepLauncherStart, Phase: diagnostics.PhaseExit, Timestamp: now})
+	require.Eventually(t, func() bool { return len(hub.Events()) == 3 }, time.Second, 5*time.Millisecond)
+
+	events, err := cp.WatchDiagnosticsEvents(ctx, domain.DiagnosticsEventQuery{Server: "github", Replay: true, Follow: true})
+	require.NoError(t, err)
+	next := func() domain.DiagnosticsEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for diagnostics event")
+			return domain.DiagnosticsEvent{}
+		}
+	}
+	require.Equal(t, uint64(1), next().Seq)
+	require.Equal(t, uint64(3), next().Seq)
+
+	hub.Record(diagnostics.Event{ServerName: "jira", Step: diagnostics.StepInitializeCall, Phase: diagnostics.PhaseEnter, Timestamp: now})
+	hub.Record(diagnostics.Event{
+		ServerName: "github",
+		Step:       diagnostics.StepInitializeCall,
+		Phase:      diagnostics.PhaseEnter,
+		Timestamp:  now,
+		Attributes: map[string]string{"cmd": "./server --token=secret"},
+	})
+	live := next()
+	require.Equal(t, uint64(5), live.Seq)
+	require.Equal(t, "***", live.Attributes["cmd"])
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-events
+		return !ok
+	}, time.Second, 5*time.Millisecond)
+}
+
 func newTestControlPlane(
 	ctx context.Context,
 	catalog domain.Catalog,
@@ -57,13 +219,13 @@ func newTestControlPlane(
 	}
 	runtimeState := runtime.NewStateFromSpecKeys(state.Summary.ServerSpecKeys)
 	controlState := NewState(ctx, runtimeState, scheduler, nil, &state, zap.NewNop())
-	registry := NewClientRegistry(controlState)
+	registry := NewClientRegistry(controlState, nil)
 	tools := NewToolDiscoveryService(controlState, registry)
 	resources := NewResourceDiscoveryService(controlState, registry)
 	prompts := NewPromptDiscoveryService(controlState, registry)
 	observability := NewObservabilityService(controlState, registry, nil)
 	automation := NewAutomationService(controlState, registry, tools)
-	return NewControlPlane(controlState, registry, tools, resources, prompts, observability, automation)
+	return NewControlPlane(controlState, registry, tools, resources, prompts, observability, automation, nil)
 }
 
 type minReadyCall struct {
@@ -79,6 +241,7 @@ type stopCall struct {
 type fakeScheduler struct {
 	minReadyCalls []minReadyCall
 	stopCalls     []stopCall
+	pools         []domain.PoolInfo
 }
 
 func (f *fakeScheduler) Acquire(_ context.Context, _, _ string) (*domain.Instance, error) {
@@ -114,5 +277,5 @@ func (f *fakeScheduler) StopPingManager()                 {}
 func (f *fakeScheduler) StopAll(_ context.Context)        {}
 
 func (f *fakeScheduler) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, error) {
-	return nil, nil
+	return f.pools, nil
 }
@@ -0,0 +1,122 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/manager"
+	"mcpv/internal/infra/telemetry/diagnostics"
+)
+
+type debugState struct {
+	GeneratedAt string                    `json:"generatedAt"`
+	Pools       []debugPoolState          `json:"pools"`
+	Clients     []diagnosticsActiveClient `json:"clients"`
+	Plugins     []manager.Status          `json:"plugins"`
+	IndexETags  debugIndexETags           `json:"indexETags"`
+	Errors      []diagnostics.BundleError `json:"errors,omitempty"`
+}
+
+type debugPoolState struct {
+	SpecKey       string                     `json:"specKey"`
+	ServerName    string                     `json:"serverName"`
+	MinReady      int                        `json:"minReady"`
+	Starting      int                        `json:"starting"`
+	StartInFlight bool                       `json:"startInFlight"`
+	Waiters       int                        `json:"waiters"`
+	Instances     []diagnosticsInstance      `json:"instances"`
+	Sticky        []domain.StickyBindingInfo `json:"sticky"`
+}
+
+type debugIndexETags struct {
+	Tools         string `json:"tools"`
+	Resources     string `json:"resources"`
+	Prompts       string `json:"prompts"`
+	RuntimeStatus string `json:"runtimeStatus"`
+}
+
+// DebugState dumps pool states, waiters, sticky bindings, registered
+// clients, plugin states and index ETags as JSON. Failing sources are listed
+// in the errors field.
+func (c *ControlPlane) DebugState(ctx context.Context) (json.RawMessage, error) {
+	state := debugState{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339Nano),
+		Pools:       []debugPoolState{},
+		Clients:     []diagnosticsActiveClient{},
+		Plugins:     []manager.Status{},
+	}
+	addError := func(source string, err error) {
+		state.Errors = append(state.Errors, diagnostics.BundleError{Source: source, Message: err.Error()})
+	}
+
+	if pools, err := c.GetPoolStatus(ctx); err != nil {
+		addError("pools", err)
+	} else {
+		for _, pool := range pools {
+			entry := debugPoolState{
+				SpecKey:       pool.SpecKey,
+				ServerName:    pool.ServerName,
+				MinReady:      pool.MinReady,
+				Starting:      pool.Diagnostics.Starting,
+				StartInFlight: pool.Diagnostics.StartInFlight,
+				Waiters:       pool.Diagnostics.Waiters,
+				Instances:     make([]diagnosticsInstance, 0, len(pool.Instances)),
+				Sticky:        pool.Sticky,
+			}
+			if entry.Sticky == nil {
+				entry.Sticky = []domain.StickyBindingInfo{}
+			}
+			for _, inst := range pool.Instances {
+				entry.Instances = append(entry.Instances, diagnosticsInstance{
+					ID:              inst.ID,
+					State:           inst.State,
+					BusyCount:       inst.BusyCount,
+					LastActive:      inst.LastActive,
+					SpawnedAt:       inst.SpawnedAt,
+					HandshakedAt:    inst.HandshakedAt,
+					LastHeartbeatAt: inst.LastHeartbeatAt,
+					PID:             inst.PID,
+					Resources:       inst.Resources,
+				})
+			}
+			state.Pools = append(state.Pools, entry)
+		}
+	}
+	if clients, err := c.ListActiveClients(ctx); err != nil {
+		addError("clients", err)
+	} else {
+		for _, client := range clients {
+			state.Clients = append(state.Clients, diagnosticsActiveClient{
+				Client:        client.Client,
+				PID:           client.PID,
+				Tags:          client.Tags,
+				Server:        client.Server,
+				Profile:       client.Profile,
+				LastHeartbeat: client.LastHeartbeat,
+			})
+		}
+	}
+	if c.diagnostics.Plugins != nil {
+		if plugins := c.diagnostics.Plugins(); plugins != nil {
+			state.Plugins = plugins
+		}
+	}
+	if runtime := c.state.RuntimeState(); runtime != nil {
+		if tools := runtime.Tools(); tools != nil {
+			state.IndexETags.Tools = tools.Snapshot().ETag
+		}
+		if resources := runtime.Resources(); resources != nil {
+			state.IndexETags.Resources = resources.Snapshot().ETag
+		}
+		if prompts := runtime.Prompts(); prompts != nil {
+			state.IndexETags.Prompts = prompts.Snapshot().ETag
+		}
+	}
+	if c.observability != nil {
+		state.IndexETags.RuntimeStatus = c.observability.RuntimeStatusETag()
+	}
+
+	return json.MarshalIndent(state, "", "  ")
+}
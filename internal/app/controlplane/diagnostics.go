@@ -0,0 +1,303 @@
+package controlplane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/manager"
+	"mcpv/internal/infra/telemetry/diagnostics"
+)
+
+// DiagnosticsSources supplies the core data a diagnostics bundle draws from
+// beyond what the control plane already knows.
+type DiagnosticsSources struct {
+	Hub        *diagnostics.Hub
+	Metrics    func() (string, error)
+	ConfigPath string
+	StartedAt  time.Time
+	// Plugins reports plugin states for DebugState.
+	Plugins func() []manager.Status
+}
+
+// SetDiagnostics sets the sources used by ExportDiagnostics and DebugState.
+func (c *ControlPlane) SetDiagnostics(sources DiagnosticsSources) {
+	c.diagnostics = sources
+}
+
+// ExportDiagnostics builds a diagnostics bundle and packs it as a zip archive.
+func (c *ControlPlane) ExportDiagnostics(ctx context.Context, opts domain.DiagnosticsExportOptions) (domain.DiagnosticsExport, error) {
+	switch opts.Mode {
+	case "":
+		opts.Mode = domain.DiagnosticsRedactionSafe
+	case domain.DiagnosticsRedactionSafe:
+	case domain.DiagnosticsRedactionDeep:
+		if !c.state.Runtime().Observability.Debug.DeepDiagnosticsEnabled {
+			return domain.DiagnosticsExport{}, fmt.Errorf("%w: deep diagnostics export requires observability.debug.deepDiagnosticsEnabled", domain.ErrPermissionDenied)
+		}
+	default:
+		return domain.DiagnosticsExport{}, fmt.Errorf("%w: redaction mode must be safe or deep", domain.ErrInvalidRequest)
+	}
+	bundle := diagnostics.BuildBundle(ctx, diagnostics.BundleSources{
+		Hub:      c.diagnostics.Hub,
+		Snapshot: c.diagnosticsSnapshot,
+		Catalog:  c.diagnosticsCatalog,
+		Metrics:  c.diagnostics.Metrics,
+	}, opts)
+	var buf bytes.Buffer
+	if err := diagnostics.WriteArchive(&buf, bundle); err != nil {
+		return domain.DiagnosticsExport{}, fmt.Errorf("write diagnostics archive: %w", err)
+	}
+	return domain.DiagnosticsExport{
+		Archive:     buf.Bytes(),
+		GeneratedAt: bundle.GeneratedTime(),
+	}, nil
+}
+
+// WatchDiagnosticsEvents streams redacted diagnostics events that match the
+// query. Buffered events are replayed first when requested; live events that
+// were already replayed are skipped by sequence number.
+func (c *ControlPlane) WatchDiagnosticsEvents(ctx context.Context, query domain.DiagnosticsEventQuery) (<-chan domain.DiagnosticsEvent, error) {
+	hub := c.diagnostics.Hub
+	if hub == nil {
+		return nil, domain.E(domain.CodeUnavailable, "watch diagnostics events", "diagnostics hub unavailable", nil)
+	}
+	var live <-chan diagnostics.Event
+	if query.Follow {
+		live = hub.Subscribe(ctx)
+	}
+	var backlog []diagnostics.Event
+	if query.Replay {
+		for _, event := range hub.Events() {
+			if diagnosticsEventMatches(event, query) {
+				backlog = append(backlog, event)
+			}
+		}
+		sort.SliceStable(backlog, func(i, j int) bool {
+			return backlog[i].Seq < backlog[j].Seq
+		})
+		if query.ReplayLimit > 0 && len(backlog) > query.ReplayLimit {
+			backlog = backlog[len(backlog)-query.ReplayLimit:]
+		}
+	}
+
+	out := make(chan domain.DiagnosticsEvent, diagnostics.DefaultSubscriberQueueSize)
+	go func() {
+		defer close(out)
+		send := func(event diagnostics.Event) bool {
+			select {
+			case out <- diagnostics.RedactEvent(event, domain.DiagnosticsRedactionSafe):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		var replayed uint64
+		for _, event := range backlog {
+			if !send(event) {
+				return
+			}
+			replayed = event.Seq
+		}
+		if live == nil {
+			return
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-live:
+				if !ok {
+					return
+				}
+				if event.Seq <= replayed || !diagnosticsEventMatches(event, query) {
+					continue
+				}
+				if !send(event) {
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func diagnosticsEventMatches(event diagnostics.Event, query domain.DiagnosticsEventQuery) bool {
+	switch {
+	case query.SpecKey != "" && event.SpecKey != query.SpecKey:
+		return false
+	case query.Server != "" && event.ServerName != query.Server:
+		return false
+	case query.Step != "" && event.Step != query.Step:
+		return false
+	case query.Phase != "" && string(event.Phase) != query.Phase:
+		return false
+	case query.AttemptID != "" && event.AttemptID != query.AttemptID:
+		return false
+	default:
+		return true
+	}
+}
+
+type diagnosticsSnapshot struct {
+	GeneratedAt        string                     `json:"generatedAt"`
+	ConfigPath         string                     `json:"configPath,omitempty"`
+	Core               diagnosticsCoreState       `json:"core"`
+	Info               *diagnosticsInfo           `json:"info,omitempty"`
+	Bootstrap          *diagnosticsBootstrap      `json:"bootstrap,omitempty"`
+	ActiveClients      []diagnosticsActiveClient  `json:"activeClients,omitempty"`
+	ServerInitStatuses []domain.ServerInitStatus  `json:"serverInitStatuses,omitempty"`
+	RuntimeStatuses    []diagnosticsRuntimeStatus `json:"runtimeStatuses,omitempty"`
+	Errors             []diagnostics.BundleError  `json:"errors,omitempty"`
+}
+
+type diagnosticsCoreState struct {
+	State    string `json:"state"`
+	UptimeMs int64  `json:"uptimeMs"`
+}
+
+type diagnosticsInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Build   string `json:"build"`
+}
+
+type diagnosticsBootstrap struct {
+	State     domain.BootstrapState `json:"state"`
+	Total     int                   `json:"total"`
+	Completed int                   `json:"completed"`
+	Failed    int                   `json:"failed"`
+	Current   string                `json:"current,omitempty"`
+	Errors    map[string]string     `json:"errors,omitempty"`
+}
+
+type diagnosticsActiveClient struct {
+	Client        string    `json:"client"`
+	PID           int       `json:"pid"`
+	Tags          []string  `json:"tags,omitempty"`
+	Server        string    `json:"server,omitempty"`
+	Profile       string    `json:"profile,omitempty"`
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+}
+
+type diagnosticsRuntimeStatus struct {
+	SpecKey     string                 `json:"specKey"`
+	ServerName  string                 `json:"serverName"`
+	MinReady    int                    `json:"minReady"`
+	Instances   []diagnosticsInstance  `json:"instances,omitempty"`
+	Metrics     domain.PoolMetrics     `json:"metrics"`
+	Diagnostics domain.PoolDiagnostics `json:"diagnostics"`
+}
+
+type diagnosticsInstance struct {
+	ID              string                   `json:"id"`
+	State           domain.InstanceState     `json:"state"`
+	BusyCount       int                      `json:"busyCount"`
+	LastActive      time.Time                `json:"lastActive"`
+	SpawnedAt       time.Time                `json:"spawnedAt"`
+	HandshakedAt    time.Time                `json:"handshakedAt"`
+	LastHeartbeatAt time.Time                `json:"lastHeartbeatAt"`
+	PID             int                      `json:"pid,omitempty"`
+	Resources       *domain.ProcessResources `json:"resources,omitempty"`
+}
+
+// diagnosticsSnapshot captures the core runtime and init state. Failing
+// sources are listed in the snapshot errors.
+func (c *ControlPlane) diagnosticsSnapshot(ctx context.Context) (json.RawMessage, error) {
+	now := time.Now().UTC()
+	snapshot := diagnosticsSnapshot{
+		GeneratedAt: now.Format(time.RFC3339Nano),
+		ConfigPath:  c.diagnostics.ConfigPath,
+		Core:        diagnosticsCoreState{State: "running"},
+	}
+	if !c.diagnostics.StartedAt.IsZero() {
+		snapshot.Core.UptimeMs = now.Sub(c.diagnostics.StartedAt).Milliseconds()
+	}
+	addError := func(source string, err error) {
+		snapshot.Errors = append(snapshot.Errors, diagnostics.BundleError{Source: source, Message: err.Error()})
+	}
+
+	if info, err := c.Info(ctx); err != nil {
+		addError("info", err)
+	} else {
+		snapshot.Info = &diagnosticsInfo{Name: info.Name, Version: info.Version, Build: info.Build}
+	}
+	if progress, err := c.GetBootstrapProgress(ctx); err != nil {
+		addError("bootstrap", err)
+	} else {
+		snapshot.Bootstrap = &diagnosticsBootstrap{
+			State:     progress.State,
+			Total:     progress.Total,
+			Completed: progress.Completed,
+			Failed:    progress.Failed,
+			Current:   progress.Current,
+			Errors:    progress.Errors,
+		}
+	}
+	if statuses, err := c.GetServerInitStatus(ctx); err != nil {
+		addError("serverInitStatus", err)
+	} else {
+		snapshot.ServerInitStatuses = statuses
+	}
+	if pools, err := c.GetPoolStatus(ctx); err != nil {
+		addError("runtimeStatus", err)
+	} else {
+		for _, pool := range pools {
+			status := diagnosticsRuntimeStatus{
+				SpecKey:     pool.SpecKey,
+				ServerName:  pool.ServerName,
+				MinReady:    pool.MinReady,
+				Metrics:     pool.Metrics,
+				Diagnostics: pool.Diagnostics,
+			}
+			for _, inst := range pool.Instances {
+				status.Instances = append(status.Instances, diagnosticsInstance{
+					ID:              inst.ID,
+					State:           inst.State,
+					BusyCount:       inst.BusyCount,
+					LastActive:      inst.LastActive,
+					SpawnedAt:       inst.SpawnedAt,
+					HandshakedAt:    inst.HandshakedAt,
+					LastHeartbeatAt: inst.LastHeartbeatAt,
+					PID:             inst.PID,
+					Resources:       inst.Resources,
+				})
+			}
+			snapshot.RuntimeStatuses = append(snapshot.RuntimeStatuses, status)
+		}
+	}
+	if clients, err := c.ListActiveClients(ctx); err != nil {
+		addError("activeClients", err)
+	} else {
+		for _, client := range clients {
+			snapshot.ActiveClients = append(snapshot.ActiveClients, diagnosticsActiveClient{
+				Client:        client.Client,
+				PID:           client.PID,
+				Tags:          client.Tags,
+				Server:        client.Server,
+				Profile:       client.Profile,
+				LastHeartbeat: client.LastHeartbeat,
+			})
+		}
+	}
+
+	return json.MarshalIndent(snapshot, "", "  ")
+}
+
+// diagnosticsCatalog exports the server specs with secrets redacted for the
+// bundle mode. Runtime settings are left out because they hold credentials
+// for plugins, alert sinks and the SubAgent provider.
+func (c *ControlPlane) diagnosticsCatalog(mode domain.DiagnosticsRedactionMode) (json.RawMessage, error) {
+	catalog := c.GetCatalog()
+	specs := make([]domain.ServerSpec, 0, len(catalog.Specs))
+	for _, spec := range catalog.Specs {
+		specs = append(specs, spec)
+	}
+	return json.MarshalIndent(struct {
+		Servers []domain.ServerSpec `json:"servers"`
+	}{Servers: diagnostics.SanitizeServerSpecs(specs, mode)}, "", "  ")
+}
@@ -0,0 +1,70 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/hashutil"
+)
+
+// AdminTools serves mcpv's built-in admin toolset.
+type AdminTools interface {
+	ToolsFor(client string) []domain.ToolDefinition
+	CallTool(ctx context.Context, client, name string, args json.RawMessage) (json.RawMessage, bool, error)
+}
+
+// SetAdminTools installs the admin toolset listed alongside upstream tools.
+func (d *ToolDiscoveryService) SetAdminTools(admin AdminTools) {
+	d.admin = admin
+}
+
+// withAdminTools adds the admin tools visible to the client. Upstream tools in
+// the reserved namespace are dropped so admin names always resolve to mcpv.
+func (d *ToolDiscoveryService) withAdminTools(client string, snapshot domain.ToolSnapshot) domain.ToolSnapshot {
+	if d.admin == nil {
+		return snapshot
+	}
+	adminTools := d.visibleAdminTools(client)
+	if len(adminTools) == 0 {
+		return snapshot
+	}
+	tools := make([]domain.ToolDefinition, 0, len(snapshot.Tools)+len(adminTools))
+	for _, tool := range snapshot.Tools {
+		if domain.IsAdminToolName(tool.Name) {
+			continue
+		}
+		tools = append(tools, tool)
+	}
+	tools = append(tools, adminTools...)
+	return domain.ToolSnapshot{
+		ETag:  hashutil.ToolETag(d.state.Logger(), tools),
+		Tools: tools,
+	}
+}
+
+func (d *ToolDiscoveryService) visibleAdminTools(client string) []domain.ToolDefinition {
+	tools := d.admin.ToolsFor(client)
+	profile, ok := d.clientProfile(client)
+	if !ok {
+		return tools
+	}
+	filtered := tools[:0]
+	for _, tool := range tools {
+		if profile.AllowsTool(tool.Name, tool.Name) {
+			filtered = append(filtered, tool)
+		}
+	}
+	return filtered
+}
+
+// callAdminTool runs an admin tool when the name is one the client can see.
+func (d *ToolDiscoveryService) callAdminTool(ctx context.Context, client, name string, args json.RawMessage) (json.RawMessage, bool, error) {
+	if d.admin == nil || !domain.IsAdminToolName(name) {
+		return nil, false, nil
+	}
+	if profile, ok := d.clientProfile(client); ok && !profile.AllowsTool(name, name) {
+		return nil, false, nil
+	}
+	return d.admin.CallTool(ctx, client, name, args)
+}
@@ -62,7 +62,7 @@ func (d *PromptDiscoveryService) GetPrompt(ctx context.Context, client, name str
 		if _, ok := runtime.Prompts().ResolveForServer(serverName, name); !ok {
 			return nil, domain.ErrPromptNotFound
 		}
-		ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+		ctx = domain.WithRouteContext(ctx, d.routeContext(client, nil))
 		return runtime.Prompts().GetPromptForServer(ctx, serverName, name, args)
 	}
 	visibleSpecKeys, err := d.resolveVisibleSpecKeys(client)
@@ -81,7 +81,7 @@ func (d *PromptDiscoveryService) GetPrompt(ctx context.Context, client, name str
 	} else if !d.isServerVisible(visibleSpecSet, target.ServerType) {
 		return nil, domain.ErrPromptNotFound
 	}
-	ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+	ctx = domain.WithRouteContext(ctx, d.routeContext(client, nil))
 	return runtime.Prompts().GetPrompt(ctx, name, args)
 }
 
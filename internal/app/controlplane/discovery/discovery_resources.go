@@ -62,7 +62,7 @@ func (d *ResourceDiscoveryService) ReadResource(ctx context.Context, client, uri
 		if _, ok := runtime.Resources().ResolveForServer(serverName, uri); !ok {
 			return nil, domain.ErrResourceNotFound
 		}
-		ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+		ctx = domain.WithRouteContext(ctx, d.routeContext(client, nil))
 		return runtime.Resources().ReadResourceForServer(ctx, serverName, uri)
 	}
 	visibleSpecKeys, err := d.resolveVisibleSpecKeys(client)
@@ -81,7 +81,7 @@ func (d *ResourceDiscoveryService) ReadResource(ctx context.Context, client, uri
 	} else if !d.isServerVisible(visibleSpecSet, target.ServerType) {
 		return nil, domain.ErrResourceNotFound
 	}
-	ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+	ctx = domain.WithRouteContext(ctx, d.routeContext(client, nil))
 	return runtime.Resources().ReadResource(ctx, uri)
 }
 
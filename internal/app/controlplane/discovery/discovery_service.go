@@ -18,6 +18,8 @@ type Options[Snapshot any] struct {
 	FilterSnapshot         func(Snapshot, []string) Snapshot
 	ServerSnapshotForList  func(serverName string, index snapshotIndex[Snapshot]) (Snapshot, bool)
 	ServerSnapshotForWatch func(serverName string, index snapshotIndex[Snapshot]) (Snapshot, bool)
+	// ShapeSnapshot adjusts a client's visible snapshot after server filtering.
+	ShapeSnapshot func(client string, snapshot Snapshot) Snapshot
 }
 
 type Service[Snapshot any] struct {
@@ -26,6 +28,7 @@ type Service[Snapshot any] struct {
 	filterSnapshot         func(Snapshot, []string) Snapshot
 	serverSnapshotForList  func(serverName string, index snapshotIndex[Snapshot]) (Snapshot, bool)
 	serverSnapshotForWatch func(serverName string, index snapshotIndex[Snapshot]) (Snapshot, bool)
+	shapeSnapshot          func(client string, snapshot Snapshot) Snapshot
 }
 
 func NewDiscoveryService[Snapshot any](state State, registry *registry.ClientRegistry, opts Options[Snapshot]) *Service[Snapshot] {
@@ -35,6 +38,7 @@ func NewDiscoveryService[Snapshot any](state State, registry *registry.ClientReg
 		filterSnapshot:         opts.FilterSnapshot,
 		serverSnapshotForList:  opts.ServerSnapshotForList,
 		serverSnapshotForWatch: opts.ServerSnapshotForWatch,
+		shapeSnapshot:          opts.ShapeSnapshot,
 	}
 	if service.filterSnapshot == nil {
 		service.filterSnapshot = func(snapshot Snapshot, _ []string) Snapshot {
@@ -46,6 +50,11 @@ func NewDiscoveryService[Snapshot any](state State, registry *registry.ClientReg
 			return index.SnapshotForServer(serverName)
 		}
 	}
+	if service.shapeSnapshot == nil {
+		service.shapeSnapshot = func(_ string, snapshot Snapshot) Snapshot {
+			return snapshot
+		}
+	}
 	if service.serverSnapshotForWatch == nil {
 		service.serverSnapshotForWatch = func(serverName string, index snapshotIndex[Snapshot]) (Snapshot, bool) {
 			return index.SnapshotForServer(serverName)
@@ -72,14 +81,14 @@ func (d *Service[Snapshot]) ListSnapshot(_ context.Context, client string) (Snap
 		if !ok {
 			return zeroSnapshot[Snapshot](), nil
 		}
-		return snapshot, nil
+		return d.shapeSnapshot(client, snapshot), nil
 	}
 	visibleSpecKeys, err := d.resolveVisibleSpecKeys(client)
 	if err != nil {
 		return zeroSnapshot[Snapshot](), err
 	}
 	snapshot := index.Snapshot()
-	return d.filterSnapshot(snapshot, visibleSpecKeys), nil
+	return d.shapeSnapshot(client, d.filterSnapshot(snapshot, visibleSpecKeys)), nil
 }
 
 func (d *Service[Snapshot]) ListSnapshotAll(_ context.Context) (Snapshot, error) {
@@ -158,7 +167,7 @@ func (d *Service[Snapshot]) sendFilteredSnapshot(ch chan<- Snapshot, client stri
 			return
 		}
 		select {
-		case ch <- serverSnapshot:
+		case ch <- d.shapeSnapshot(client, serverSnapshot):
 		default:
 		}
 		return
@@ -167,7 +176,7 @@ func (d *Service[Snapshot]) sendFilteredSnapshot(ch chan<- Snapshot, client stri
 	if err != nil {
 		return
 	}
-	filtered := d.filterSnapshot(snapshot, visibleSpecKeys)
+	filtered := d.shapeSnapshot(client, d.filterSnapshot(snapshot, visibleSpecKeys))
 	select {
 	case ch <- filtered:
 	default:
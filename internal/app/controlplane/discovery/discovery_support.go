@@ -22,6 +22,13 @@ func (d discoverySupport) resolveVisibleSpecKeys(client string) ([]string, error
 	return d.registry.ResolveVisibleSpecKeys(client)
 }
 
+// routeContext builds the routing metadata for a call made by client,
+// carrying the identity the client acts for.
+func (d discoverySupport) routeContext(client string, visibleSpecKeys map[string]struct{}) domain.RouteContext {
+	info, _ := d.registry.ClientInfo(client)
+	return domain.RouteContext{Client: client, Identity: info.Identity, VisibleSpecKeys: visibleSpecKeys}
+}
+
 func (d discoverySupport) visibleServers(visibleSpecKeys []string) (map[string]struct{}, map[string]struct{}) {
 	visibleServers := make(map[string]struct{})
 	visibleSpecSet := make(map[string]struct{})
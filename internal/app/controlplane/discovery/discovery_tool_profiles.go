@@ -0,0 +1,204 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/hashutil"
+)
+
+// profileToolRoute maps a tool name in a profile view back to the tool index.
+// An empty server means the name is routed through the merged index.
+type profileToolRoute struct {
+	server string
+	name   string
+}
+
+type profileToolView struct {
+	snapshot domain.ToolSnapshot
+	routes   map[string]profileToolRoute
+}
+
+func (d *ToolDiscoveryService) shapeToolSnapshot(client string, snapshot domain.ToolSnapshot) domain.ToolSnapshot {
+	if profile, ok := d.clientProfile(client); ok {
+		snapshot = d.profileToolView(client, profile, snapshot).snapshot
+	}
+	return d.withAdminTools(client, snapshot)
+}
+
+// clientProfile returns the client's profile when it changes the tool view.
+func (d *ToolDiscoveryService) clientProfile(client string) (domain.ClientProfile, bool) {
+	profile, ok, err := d.registry.ResolveClientProfile(client)
+	if err != nil || !ok {
+		return domain.ClientProfile{}, false
+	}
+	if !profile.FiltersTools() && !d.overridesNamespace(profile) {
+		return domain.ClientProfile{}, false
+	}
+	return profile, true
+}
+
+func (d *ToolDiscoveryService) overridesNamespace(profile domain.ClientProfile) bool {
+	strategy := profile.ToolNamespaceStrategy
+	return strategy != "" && strategy != d.state.Runtime().ToolNamespaceStrategy
+}
+
+// profileToolView applies profile tool filters and the namespace override to
+// the snapshot already filtered by server visibility. Virtual tools also need
+// the profile to allow every step.
+func (d *ToolDiscoveryService) profileToolView(client string, profile domain.ClientProfile, snapshot domain.ToolSnapshot) profileToolView {
+	serverName, _ := d.resolveClientServer(client)
+	tools := snapshot.Tools
+	var routes map[string]profileToolRoute
+	switch {
+	case serverName != "":
+		routes = make(map[string]profileToolRoute, len(tools))
+		for _, tool := range tools {
+			routes[tool.Name] = profileToolRoute{server: serverName, name: tool.Name}
+		}
+	case d.overridesNamespace(profile):
+		tools, routes = d.renamespaceTools(tools, profile.ToolNamespaceStrategy)
+	default:
+		routes = make(map[string]profileToolRoute, len(tools))
+		for _, tool := range tools {
+			routes[tool.Name] = profileToolRoute{name: tool.Name}
+		}
+	}
+
+	filtered := make([]domain.ToolDefinition, 0, len(tools))
+	for _, tool := range tools {
+		if !profile.AllowsTool(tool.Name, tool.UpstreamToolName()) ||
+			(tool.ServerName == "" && !d.virtualToolVisible(tool.Name, nil, profile)) {
+			delete(routes, tool.Name)
+			continue
+		}
+		filtered = append(filtered, tool)
+	}
+	if len(filtered) == 0 {
+		return profileToolView{routes: routes}
+	}
+	return profileToolView{
+		snapshot: domain.ToolSnapshot{
+			ETag:  hashutil.ToolETag(d.state.Logger(), filtered),
+			Tools: filtered,
+		},
+		routes: routes,
+	}
+}
+
+// renamespaceTools rebuilds tool names from per-server snapshots using the
+// given strategy. Flat-name conflicts get a server suffix like the index does.
+// Tools without a server, such as virtual tools, keep their names.
+func (d *ToolDiscoveryService) renamespaceTools(tools []domain.ToolDefinition, strategy domain.ToolNamespaceStrategy) ([]domain.ToolDefinition, map[string]profileToolRoute) {
+	routes := make(map[string]profileToolRoute, len(tools))
+	out := make([]domain.ToolDefinition, 0, len(tools))
+	servers := make(map[string]struct{})
+	for _, tool := range tools {
+		if tool.ServerName == "" {
+			routes[tool.Name] = profileToolRoute{name: tool.Name}
+			out = append(out, tool)
+			continue
+		}
+		servers[tool.ServerName] = struct{}{}
+	}
+
+	runtime := d.state.RuntimeState()
+	if runtime == nil || runtime.Tools() == nil {
+		return out, routes
+	}
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	specKeys := d.state.ServerSpecKeys()
+
+	for _, serverName := range names {
+		snapshot, ok := d.serverSnapshotForList(serverName, runtime.Tools())
+		if !ok {
+			continue
+		}
+		local := append([]domain.ToolDefinition(nil), snapshot.Tools...)
+		sort.Slice(local, func(i, j int) bool { return local[i].Name < local[j].Name })
+		for _, tool := range local {
+			name := tool.Name
+			if strategy != domain.ToolNamespaceStrategyFlat {
+				name = serverName + "." + tool.Name
+			}
+			if _, exists := routes[name]; exists {
+				if strategy != domain.ToolNamespaceStrategyFlat {
+					continue
+				}
+				name = flatConflictName(tool.Name, serverName, routes)
+				if name == "" {
+					continue
+				}
+			}
+			routes[name] = profileToolRoute{server: serverName, name: tool.Name}
+			tool.Name = name
+			tool.ServerName = serverName
+			if tool.SpecKey == "" {
+				tool.SpecKey = specKeys[serverName]
+			}
+			out = append(out, tool)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, routes
+}
+
+func flatConflictName(name, serverName string, taken map[string]profileToolRoute) string {
+	candidate := fmt.Sprintf("%s_%s", name, serverName)
+	if _, ok := taken[candidate]; !ok {
+		return candidate
+	}
+	for i := 2; i < 100; i++ {
+		candidate = fmt.Sprintf("%s_%s_%d", name, serverName, i)
+		if _, ok := taken[candidate]; !ok {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// callProfileTool resolves a tool name through the client's profile view so
+// hidden tools stay uncallable and renamed tools reach their server.
+func (d *ToolDiscoveryService) callProfileTool(ctx context.Context, client string, profile domain.ClientProfile, name string, args json.RawMessage, routingKey string) (json.RawMessage, error) {
+	runtime := d.state.RuntimeState()
+	serverName, err := d.resolveClientServer(client)
+	if err != nil {
+		return nil, err
+	}
+	var visible domain.ToolSnapshot
+	var visibleSpecSet map[string]struct{}
+	if serverName != "" {
+		visible, _ = d.serverSnapshotForList(serverName, runtime.Tools())
+	} else {
+		visibleSpecKeys, err := d.resolveVisibleSpecKeys(client)
+		if err != nil {
+			return nil, err
+		}
+		visible = d.filterToolSnapshot(runtime.Tools().Snapshot(), visibleSpecKeys)
+		visibleSpecSet = toSpecKeySet(visibleSpecKeys)
+	}
+	route, ok := d.profileToolView(client, profile, visible).routes[name]
+	if !ok {
+		return nil, domain.ErrToolNotFound
+	}
+
+	routeCtx := d.routeContext(client, visibleSpecSet)
+	routeCtx.Profile = profile
+	ctx = domain.WithRouteContext(ctx, routeCtx)
+	ctx = domain.WithStartCause(ctx, domain.StartCause{
+		Reason:   domain.StartCauseToolCall,
+		Client:   client,
+		ToolName: name,
+	})
+	if route.server != "" {
+		return runtime.Tools().CallToolForServer(ctx, route.server, route.name, args, routingKey)
+	}
+	return runtime.Tools().CallTool(ctx, route.name, args, routingKey)
+}
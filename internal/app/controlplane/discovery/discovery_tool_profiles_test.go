@@ -0,0 +1,141 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/app/bootstrap"
+	"mcpv/internal/app/controlplane/registry"
+	"mcpv/internal/app/runtime"
+	"mcpv/internal/domain"
+)
+
+func TestToolDiscoveryService_ProfileFiltersTools(t *testing.T) {
+	state := profileTestState{runtime: domain.RuntimeConfig{
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix,
+		Clients: []domain.ClientProfile{{
+			Name:         "cursor-readonly",
+			Callers:      []string{"cursor*"},
+			IncludeTools: []string{"get_*", "search"},
+		}},
+	}}
+	reg := registry.NewClientRegistry(state)
+	service := NewToolDiscoveryService(state, reg)
+
+	_, err := reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+	_, err = reg.RegisterClient(context.Background(), "vscode", 1002, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+
+	snapshot := domain.ToolSnapshot{Tools: []domain.ToolDefinition{
+		{Name: "github.create_issue", UpstreamName: "create_issue", ServerName: "github"},
+		{Name: "github.get_issue", UpstreamName: "get_issue", ServerName: "github"},
+		{Name: "search"},
+	}}
+
+	shaped := service.shapeToolSnapshot("cursor-1", snapshot)
+	names := make([]string, 0, len(shaped.Tools))
+	for _, tool := range shaped.Tools {
+		names = append(names, tool.Name)
+	}
+	require.Equal(t, []string{"github.get_issue", "search"}, names)
+	require.NotEmpty(t, shaped.ETag)
+
+	view := service.profileToolView("cursor-1", state.runtime.Clients[0], snapshot)
+	require.Equal(t, profileToolRoute{name: "github.get_issue"}, view.routes["github.get_issue"])
+	_, ok := view.routes["github.create_issue"]
+	require.False(t, ok)
+
+	require.Equal(t, snapshot, service.shapeToolSnapshot("vscode", snapshot))
+}
+
+type profileTestState struct {
+	runtime domain.RuntimeConfig
+}
+
+func (s profileTestState) Catalog() domain.Catalog {
+	return domain.Catalog{Runtime: s.runtime}
+}
+
+func (s profileTestState) ServerSpecKeys() map[string]string {
+	return map[string]string{}
+}
+
+func (s profileTestState) SpecRegistry() map[string]domain.ServerSpec {
+	return map[string]domain.ServerSpec{}
+}
+
+func (s profileTestState) Runtime() domain.RuntimeConfig {
+	return s.runtime
+}
+
+func (s profileTestState) RuntimeState() *runtime.State {
+	return nil
+}
+
+func (s profileTestState) Logger() *zap.Logger {
+	return zap.NewNop()
+}
+
+func (s profileTestState) Context() context.Context {
+	return context.Background()
+}
+
+func (s profileTestState) Scheduler() domain.Scheduler {
+	return nil
+}
+
+func (s profileTestState) Startup() *bootstrap.ServerStartupOrchestrator {
+	return nil
+}
+
+func TestToolDiscoveryService_AdminToolsShapeSnapshotAndCalls(t *testing.T) {
+	state := profileTestState{}
+	reg := registry.NewClientRegistry(state)
+	service := NewToolDiscoveryService(state, reg)
+	admin := &fakeAdminTools{client: "ops"}
+	service.SetAdminTools(admin)
+
+	_, err := reg.RegisterClient(context.Background(), "ops", 1001, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+
+	snapshot := domain.ToolSnapshot{ETag: "upstream", Tools: []domain.ToolDefinition{
+		{Name: "search"},
+		{Name: domain.AdminToolListServers, ServerName: "impostor"},
+	}}
+	shaped := service.shapeToolSnapshot("ops", snapshot)
+	require.Len(t, shaped.Tools, 2)
+	require.Equal(t, "search", shaped.Tools[0].Name)
+	require.Equal(t, domain.AdminToolServerType, shaped.Tools[1].ServerName)
+	require.NotEqual(t, snapshot.ETag, shaped.ETag)
+	require.Equal(t, snapshot, service.shapeToolSnapshot("other", snapshot))
+
+	result, err := service.CallTool(context.Background(), "ops", domain.AdminToolListServers, nil, "")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ok":true}`, string(result))
+	require.Equal(t, []string{domain.AdminToolListServers}, admin.calls)
+}
+
+type fakeAdminTools struct {
+	client string
+	calls  []string
+}
+
+func (f *fakeAdminTools) ToolsFor(client string) []domain.ToolDefinition {
+	if client != f.client {
+		return nil
+	}
+	return []domain.ToolDefinition{{Name: domain.AdminToolListServers, ServerName: domain.AdminToolServerType}}
+}
+
+func (f *fakeAdminTools) CallTool(_ context.Context, client, name string, _ json.RawMessage) (json.RawMessage, bool, error) {
+	if client != f.client {
+		return nil, false, nil
+	}
+	f.calls = append(f.calls, name)
+	return json.RawMessage(`{"ok":true}`), true, nil
+}
@@ -16,6 +16,7 @@ import (
 
 type ToolDiscoveryService struct {
 	*Service[domain.ToolSnapshot]
+	admin AdminTools
 }
 
 func NewToolDiscoveryService(state State, registry *registry.ClientRegistry) *ToolDiscoveryService {
@@ -26,6 +27,7 @@ func NewToolDiscoveryService(state State, registry *registry.ClientRegistry) *To
 	service.Service = base
 	base.filterSnapshot = service.filterToolSnapshot
 	base.serverSnapshotForList = service.serverSnapshotForList
+	base.shapeSnapshot = service.shapeToolSnapshot
 	return service
 }
 
@@ -76,15 +78,21 @@ func (d *ToolDiscoveryService) CallTool(ctx context.Context, client, name string
 	if err != nil {
 		return nil, err
 	}
+	if result, handled, err := d.callAdminTool(ctx, client, name, args); handled {
+		return result, err
+	}
 	runtime := d.state.RuntimeState()
 	if runtime == nil || runtime.Tools() == nil {
 		return nil, domain.ErrToolNotFound
 	}
+	if profile, ok := d.clientProfile(client); ok {
+		return d.callProfileTool(ctx, client, profile, name, args, routingKey)
+	}
 	if serverName != "" {
 		if _, ok := runtime.Tools().ResolveForServer(serverName, name); !ok {
 			return nil, domain.ErrToolNotFound
 		}
-		ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+		ctx = domain.WithRouteContext(ctx, d.routeContext(client, nil))
 		ctx = domain.WithStartCause(ctx, domain.StartCause{
 			Reason:   domain.StartCauseToolCall,
 			Client:   client,
@@ -105,10 +113,14 @@ func (d *ToolDiscoveryService) CallTool(ctx context.Context, client, name string
 		if _, ok := visibleSpecSet[target.SpecKey]; !ok {
 			return nil, domain.ErrToolNotFound
 		}
+	} else if target.ServerType == domain.VirtualToolServerType {
+		if !d.virtualToolVisible(name, visibleSpecSet, domain.ClientProfile{}) {
+			return nil, domain.ErrToolNotFound
+		}
 	} else if !d.isServerVisible(visibleSpecSet, target.ServerType) {
 		return nil, domain.ErrToolNotFound
 	}
-	ctx = domain.WithRouteContext(ctx, domain.RouteContext{Client: client})
+	ctx = domain.WithRouteContext(ctx, d.routeContext(client, visibleSpecSet))
 	ctx = domain.WithStartCause(ctx, domain.StartCause{
 		Reason:   domain.StartCauseToolCall,
 		Client:   client,
@@ -170,6 +182,8 @@ func (d *ToolDiscoveryService) filterToolSnapshot(snapshot domain.ToolSnapshot,
 			if _, ok := visibleSpecSet[tool.SpecKey]; !ok {
 				continue
 			}
+		} else if !d.virtualToolVisible(tool.Name, visibleSpecSet, domain.ClientProfile{}) {
+			continue
 		}
 		filtered = append(filtered, tool)
 	}
@@ -191,6 +205,42 @@ func (d *ToolDiscoveryService) filterToolSnapshot(snapshot domain.ToolSnapshot,
 	}
 }
 
+// virtualToolVisible reports whether every step of a virtual tool targets a
+// server in visibleSpecSet and a tool the profile allows. A nil visibleSpecSet
+// skips the server check. Tools that are not virtual report true.
+func (d *ToolDiscoveryService) virtualToolVisible(name string, visibleSpecSet map[string]struct{}, profile domain.ClientProfile) bool {
+	for _, tool := range d.state.Runtime().VirtualTools {
+		if tool.Name != name {
+			continue
+		}
+		runtime := d.state.RuntimeState()
+		if runtime == nil || runtime.Tools() == nil {
+			return false
+		}
+		for _, step := range tool.Steps {
+			target, ok := runtime.Tools().Resolve(step.Tool)
+			if !ok || target.ServerType == domain.VirtualToolServerType {
+				return false
+			}
+			if !profile.AllowsTool(step.Tool, target.ToolName) {
+				return false
+			}
+			if visibleSpecSet == nil {
+				continue
+			}
+			if target.SpecKey != "" {
+				if _, ok := visibleSpecSet[target.SpecKey]; !ok {
+					return false
+				}
+			} else if !d.isServerVisible(visibleSpecSet, target.ServerType) {
+				return false
+			}
+		}
+		return true
+	}
+	return true
+}
+
 func (d *ToolDiscoveryService) cachedToolSnapshotForServer(serverName string) domain.ToolSnapshot {
 	if serverName == "" {
 		return domain.ToolSnapshot{}
@@ -11,5 +11,6 @@ type State interface {
 	RuntimeState() *runtime.State
 	ServerSpecKeys() map[string]string
 	SpecRegistry() map[string]domain.ServerSpec
+	Runtime() domain.RuntimeConfig
 	Logger() *zap.Logger
 }
@@ -27,6 +27,7 @@ type Service struct {
 	state    State
 	registry *registry.ClientRegistry
 	logs     *telemetry.LogBroadcaster
+	logStore atomic.Pointer[telemetry.LogStore]
 
 	idxMu                      sync.RWMutex
 	runtimeStatusIdx           *aggregator.RuntimeStatusIndex
@@ -44,30 +45,65 @@ func NewObservabilityService(state State, registry *registry.ClientRegistry, log
 }
 
 // StreamLogs streams logs for a caller.
-func (o *Service) StreamLogs(ctx context.Context, client string, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
+func (o *Service) StreamLogs(ctx context.Context, client string, query domain.LogQuery) (<-chan domain.LogEntry, error) {
 	if _, err := o.registry.ResolveVisibleSpecKeys(client); err != nil {
 		return closedLogEntryChannel(), err
 	}
-	return o.streamLogs(ctx, minLevel)
+	return o.streamLogs(ctx, query)
 }
 
 // StreamLogsAllServers streams logs across all servers.
-func (o *Service) StreamLogsAllServers(ctx context.Context, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	return o.streamLogs(ctx, minLevel)
+func (o *Service) StreamLogsAllServers(ctx context.Context, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	return o.streamLogs(ctx, query)
 }
 
-func (o *Service) streamLogs(ctx context.Context, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	if o.logs == nil {
+// SetLogStore sets the store used to backfill historical log queries.
+func (o *Service) SetLogStore(store *telemetry.LogStore) {
+	o.logStore.Store(store)
+}
+
+// streamLogs sends persisted entries in the query window first, then live
+// entries unless the window is closed. Live entries already covered by the
+// backfill are skipped by sequence number.
+func (o *Service) streamLogs(ctx context.Context, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	filter, err := telemetry.NewLogFilter(query)
+	if err != nil {
+		return closedLogEntryChannel(), err
+	}
+	backfill := !query.Since.IsZero() || !query.Until.IsZero()
+	follow := query.Until.IsZero()
+	if o.logs == nil && !backfill {
 		return closedLogEntryChannel(), nil
 	}
-	if minLevel == "" {
-		minLevel = domain.LogLevelDebug
+
+	// Subscribe before reading history so nothing logged in between is lost.
+	var source <-chan domain.LogEntry
+	if follow && o.logs != nil {
+		source = o.logs.Subscribe(ctx)
+	}
+	var history []domain.LogEntry
+	if backfill {
+		history, err = o.logStore.Load().Read(filter)
+		if err != nil {
+			return closedLogEntryChannel(), err
+		}
 	}
-	source := o.logs.Subscribe(ctx)
 	out := make(chan domain.LogEntry, telemetry.DefaultLogBufferSize)
 
 	go func() {
 		defer close(out)
+		var lastSeq uint64
+		for _, entry := range history {
+			select {
+			case out <- entry:
+				lastSeq = max(lastSeq, entry.Seq)
+			case <-ctx.Done():
+				return
+			}
+		}
+		if source == nil {
+			return
+		}
 		for {
 			select {
 			case <-ctx.Done():
@@ -76,7 +112,7 @@ func (o *Service) streamLogs(ctx context.Context, minLevel domain.LogLevel) (<-c
 				if !ok {
 					return
 				}
-				if compareLogLevel(entry.Level, minLevel) < 0 {
+				if !filter.Match(entry) || entry.Seq <= lastSeq {
 					continue
 				}
 				select {
@@ -256,6 +292,15 @@ func (o *Service) SetServerInitIndex(idx *aggregator.ServerInitIndex) {
 	go o.runServerInitWorker()
 }
 
+// RuntimeStatusETag returns the ETag of the current runtime status snapshot.
+func (o *Service) RuntimeStatusETag() string {
+	idx := o.runtimeStatusIndex()
+	if idx == nil {
+		return ""
+	}
+	return idx.Current().ETag
+}
+
 func (o *Service) runRuntimeStatusWorker() {
 	ticker := time.NewTicker(runtimeStatusRefreshInterval)
 	defer ticker.Stop()
@@ -312,42 +357,6 @@ func (o *Service) serverInitIndex() *aggregator.ServerInitIndex {
 	return idx
 }
 
-func compareLogLevel(a, b domain.LogLevel) int {
-	ar := logLevelRank(a)
-	br := logLevelRank(b)
-	switch {
-	case ar < br:
-		return -1
-	case ar > br:
-		return 1
-	default:
-		return 0
-	}
-}
-
-func logLevelRank(level domain.LogLevel) int {
-	switch level {
-	case domain.LogLevelDebug:
-		return 0
-	case domain.LogLevelInfo:
-		return 1
-	case domain.LogLevelNotice:
-		return 2
-	case domain.LogLevelWarning:
-		return 3
-	case domain.LogLevelError:
-		return 4
-	case domain.LogLevelCritical:
-		return 5
-	case domain.LogLevelAlert:
-		return 6
-	case domain.LogLevelEmergency:
-		return 7
-	default:
-		return 0
-	}
-}
-
 func closedLogEntryChannel() chan domain.LogEntry {
 	ch := make(chan domain.LogEntry)
 	close(ch)
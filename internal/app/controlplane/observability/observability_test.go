@@ -1,6 +1,7 @@
 package observability
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -8,8 +9,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
 )
 
 func TestFilterRuntimeStatusSnapshot_FiltersAndSorts(t *testing.T) {
@@ -77,3 +81,112 @@ func TestFilterServerInitStatusSnapshot_FiltersAndSorts(t *testing.T) {
 	require.Equal(t, now, filtered.GeneratedAt)
 	require.Equal(t, expected, filtered.Statuses)
 }
+
+func TestStreamLogsBackfillsFromStoreThenTails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store, err := telemetry.OpenLogStore(telemetry.LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	start := time.Now().Add(-time.Hour)
+	for i, message := range []string{"old", "recent"} {
+		require.NoError(t, store.Write(domain.LogEntry{
+			Level:     domain.LogLevelInfo,
+			Timestamp: start.Add(time.Duration(i) * 30 * time.Minute),
+			Data:      map[string]any{"message": message},
+		}))
+	}
+
+	logs := telemetry.NewLogBroadcaster(zapcore.DebugLevel)
+	service := NewObservabilityService(nil, nil, logs)
+	service.SetLogStore(store)
+
+	entries, err := service.StreamLogsAllServers(ctx, domain.LogQuery{Since: start.Add(time.Minute)})
+	require.NoError(t, err)
+	require.Equal(t, "recent", receiveLogMessage(t, entries))
+
+	zap.New(logs.Core()).Info("live")
+	require.Equal(t, "live", receiveLogMessage(t, entries))
+}
+
+func TestStreamLogsKeepsLiveEntriesSharingBackfillTimestamp(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store, err := telemetry.OpenLogStore(telemetry.LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	at := time.Now().Add(-time.Minute)
+	logs := telemetry.NewLogBroadcaster(zapcore.DebugLevel)
+	logger := zap.New(logs.Core(), zap.WithClock(fixedClock{at: at}))
+	logs.SetSink(store, nil)
+	logger.Info("persisted")
+	// Replacing the sink flushes the queued entry to the store.
+	logs.SetSink(store, nil)
+	t.Cleanup(func() { logs.SetSink(nil, nil) })
+
+	service := NewObservabilityService(nil, nil, logs)
+	service.SetLogStore(store)
+
+	entries, err := service.StreamLogsAllServers(ctx, domain.LogQuery{Since: at.Add(-time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, "persisted", receiveLogMessage(t, entries))
+
+	logger.Info("live one")
+	logger.Info("live two")
+	require.Equal(t, "live one", receiveLogMessage(t, entries))
+	require.Equal(t, "live two", receiveLogMessage(t, entries))
+}
+
+type fixedClock struct {
+	at time.Time
+}
+
+func (c fixedClock) Now() time.Time { return c.at }
+
+func (c fixedClock) NewTicker(d time.Duration) *time.Ticker { return time.NewTicker(d) }
+
+func TestStreamLogsClosesAfterBoundedBackfill(t *testing.T) {
+	store, err := telemetry.OpenLogStore(telemetry.LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	now := time.Now()
+	require.NoError(t, store.Write(domain.LogEntry{
+		Level:     domain.LogLevelInfo,
+		Timestamp: now.Add(-time.Minute),
+		Data:      map[string]any{"message": "persisted"},
+	}))
+
+	service := NewObservabilityService(nil, nil, telemetry.NewLogBroadcaster(zapcore.DebugLevel))
+	service.SetLogStore(store)
+
+	entries, err := service.StreamLogsAllServers(context.Background(), domain.LogQuery{Since: now.Add(-time.Hour), Until: now})
+	require.NoError(t, err)
+	require.Equal(t, "persisted", receiveLogMessage(t, entries))
+	select {
+	case _, ok := <-entries:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not close after backfill")
+	}
+
+	_, err = service.StreamLogsAllServers(context.Background(), domain.LogQuery{Grep: "["})
+	require.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func receiveLogMessage(t *testing.T, entries <-chan domain.LogEntry) string {
+	t.Helper()
+	select {
+	case entry, ok := <-entries:
+		require.True(t, ok)
+		message, _ := entry.Data["message"].(string)
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for log entry")
+		return ""
+	}
+}
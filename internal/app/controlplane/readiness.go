@@ -0,0 +1,142 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/manager"
+)
+
+// ReadinessSources supplies the readiness inputs owned by the application.
+type ReadinessSources struct {
+	Plugins    func() []manager.Status
+	RPCServing func() bool
+}
+
+// SetReadiness sets the sources used by GetReadiness.
+func (c *ControlPlane) SetReadiness(sources ReadinessSources) {
+	c.readiness = sources
+}
+
+// GetReadiness evaluates the readiness criteria of the current runtime config.
+func (c *ControlPlane) GetReadiness(ctx context.Context) (domain.ReadinessReport, error) {
+	cfg := c.state.Runtime().Observability.Readiness
+	report := domain.ReadinessReport{
+		Ready:     true,
+		CheckedAt: time.Now(),
+	}
+	if cfg.Bootstrap {
+		progress, err := c.GetBootstrapProgress(ctx)
+		if err != nil {
+			return domain.ReadinessReport{}, err
+		}
+		report.Checks = append(report.Checks, bootstrapReadiness(progress))
+	}
+	if cfg.RequiredPlugins {
+		var plugins []manager.Status
+		if c.readiness.Plugins != nil {
+			plugins = c.readiness.Plugins()
+		}
+		report.Checks = append(report.Checks, pluginReadiness(plugins))
+	}
+	if len(cfg.Servers) > 0 {
+		statuses, err := c.GetServerInitStatus(ctx)
+		if err != nil {
+			return domain.ReadinessReport{}, err
+		}
+		report.Checks = append(report.Checks, serverReadiness(cfg.Servers, statuses))
+	}
+	if cfg.RPCListener {
+		check := domain.ReadinessCheck{Name: domain.ReadinessCheckRPCListener, Ready: true}
+		if c.readiness.RPCServing == nil || !c.readiness.RPCServing() {
+			check.Ready = false
+			check.Message = "rpc listener is not serving"
+		}
+		report.Checks = append(report.Checks, check)
+	}
+	for _, check := range report.Checks {
+		if !check.Ready {
+			report.Ready = false
+		}
+	}
+	return report, nil
+}
+
+func bootstrapReadiness(progress domain.BootstrapProgress) domain.ReadinessCheck {
+	check := domain.ReadinessCheck{
+		Name:  domain.ReadinessCheckBootstrap,
+		Ready: progress.State == domain.BootstrapCompleted,
+	}
+	if !check.Ready {
+		check.Message = fmt.Sprintf("bootstrap %s (%d/%d completed, %d failed)",
+			progress.State, progress.Completed, progress.Total, progress.Failed)
+	}
+	return check
+}
+
+// pluginReadiness requires every required plugin to be running.
+func pluginReadiness(plugins []manager.Status) domain.ReadinessCheck {
+	check := domain.ReadinessCheck{Name: domain.ReadinessCheckRequiredPlugins, Ready: true}
+	notRunning := 0
+	for _, plugin := range plugins {
+		if !plugin.Required {
+			continue
+		}
+		component := domain.ReadinessComponent{Name: plugin.Name, Ready: plugin.Running}
+		if !plugin.Running {
+			notRunning++
+			component.Message = plugin.Error
+			if component.Message == "" {
+				component.Message = "not running"
+			}
+		}
+		check.Components = append(check.Components, component)
+	}
+	if notRunning > 0 {
+		check.Ready = false
+		check.Message = fmt.Sprintf("%d of %d required plugins not running", notRunning, len(check.Components))
+	}
+	return check
+}
+
+// serverReadiness requires every listed server, matched by name or spec key,
+// to have at least minReady ready instances.
+func serverReadiness(servers []string, statuses []domain.ServerInitStatus) domain.ReadinessCheck {
+	check := domain.ReadinessCheck{Name: domain.ReadinessCheckServers, Ready: true}
+	notReady := 0
+	for _, name := range servers {
+		component := domain.ReadinessComponent{Name: name}
+		found := false
+		for _, status := range statuses {
+			if status.ServerName != name && status.SpecKey != name {
+				continue
+			}
+			found = true
+			// A server with minReady 0 starts on demand; listing it still
+			// requires one ready instance, or the check would always pass.
+			required := max(status.MinReady, 1)
+			component.Ready = status.Ready >= required
+			if !component.Ready {
+				component.Message = fmt.Sprintf("%s: %d/%d ready", status.State, status.Ready, required)
+				if status.LastError != "" {
+					component.Message += ": " + status.LastError
+				}
+			}
+			break
+		}
+		if !found {
+			component.Message = "server not configured"
+		}
+		if !component.Ready {
+			notReady++
+		}
+		check.Components = append(check.Components, component)
+	}
+	if notReady > 0 {
+		check.Ready = false
+		check.Message = fmt.Sprintf("%d of %d servers below minReady", notReady, len(servers))
+	}
+	return check
+}
@@ -0,0 +1,97 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/manager"
+)
+
+func TestControlPlane_GetReadiness(t *testing.T) {
+	catalog := domain.Catalog{Runtime: domain.RuntimeConfig{
+		Observability: domain.ObservabilityConfig{Readiness: domain.ReadinessConfig{
+			Bootstrap:       true,
+			RequiredPlugins: true,
+			RPCListener:     true,
+			Servers:         []string{"github"},
+		}},
+	}}
+	cp := newTestControlPlane(context.Background(), catalog, &fakeScheduler{})
+	serving := false
+	cp.SetReadiness(ReadinessSources{
+		Plugins: func() []manager.Status {
+			return []manager.Status{
+				{Name: "audit", Running: true, Required: true},
+				{Name: "authz", Required: true, Error: "handshake failed"},
+				{Name: "optional"},
+			}
+		},
+		RPCServing: func() bool { return serving },
+	})
+
+	report, err := cp.GetReadiness(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.Ready)
+	require.Len(t, report.Checks, 4)
+
+	checks := make(map[string]domain.ReadinessCheck, len(report.Checks))
+	for _, check := range report.Checks {
+		checks[check.Name] = check
+	}
+	require.True(t, checks[domain.ReadinessCheckBootstrap].Ready)
+	plugins := checks[domain.ReadinessCheckRequiredPlugins]
+	require.False(t, plugins.Ready)
+	require.Equal(t, []domain.ReadinessComponent{
+		{Name: "audit", Ready: true},
+		{Name: "authz", Message: "handshake failed"},
+	}, plugins.Components)
+	require.Equal(t, []domain.ReadinessComponent{
+		{Name: "github", Message: "server not configured"},
+	}, checks[domain.ReadinessCheckServers].Components)
+	require.False(t, checks[domain.ReadinessCheckRPCListener].Ready)
+
+	serving = true
+	cp.SetReadiness(ReadinessSources{RPCServing: func() bool { return serving }})
+	report, err = cp.GetReadiness(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.Ready)
+	for _, check := range report.Checks {
+		require.Equal(t, check.Name != domain.ReadinessCheckServers, check.Ready, check.Name)
+	}
+}
+
+func TestControlPlane_GetReadinessWithoutCriteria(t *testing.T) {
+	cp := newTestControlPlane(context.Background(), domain.Catalog{}, &fakeScheduler{})
+
+	report, err := cp.GetReadiness(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.Ready)
+	require.Empty(t, report.Checks)
+}
+
+func TestServerReadiness(t *testing.T) {
+	statuses := []domain.ServerInitStatus{
+		{SpecKey: "spec-a", ServerName: "a", MinReady: 1, Ready: 1, State: domain.ServerInitReady},
+		{SpecKey: "spec-b", ServerName: "b", MinReady: 2, Ready: 1, State: domain.ServerInitDegraded, LastError: "exit 1"},
+		{SpecKey: "spec-c", ServerName: "c", MinReady: 0, Ready: 0, State: domain.ServerInitReady},
+	}
+
+	check := serverReadiness([]string{"a"}, statuses)
+	require.True(t, check.Ready)
+	require.Empty(t, check.Message)
+
+	check = serverReadiness([]string{"spec-a", "b"}, statuses)
+	require.False(t, check.Ready)
+	require.Equal(t, "1 of 2 servers below minReady", check.Message)
+	require.Equal(t, []domain.ReadinessComponent{
+		{Name: "spec-a", Ready: true},
+		{Name: "b", Message: "degraded: 1/2 ready: exit 1"},
+	}, check.Components)
+
+	check = serverReadiness([]string{"c"}, statuses)
+	require.False(t, check.Ready, "a listed server with minReady 0 needs one ready instance")
+	require.Equal(t, []domain.ReadinessComponent{{Name: "c", Message: "ready: 0/1 ready"}}, check.Components)
+}
@@ -3,6 +3,7 @@ package registry
 import (
 	"context"
 	"errors"
+	"reflect"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ type ClientRegistry struct {
 	state    State
 	resolver *VisibilityResolver
 	probe    CallerProbe
+	roots    domain.RootsStore
 
 	mu               sync.Mutex
 	activeClients    map[string]clientState
@@ -47,6 +49,11 @@ func NewClientRegistry(state State) *ClientRegistry {
 	}
 }
 
+// SetRootsStore configures where caller roots are published.
+func (r *ClientRegistry) SetRootsStore(store domain.RootsStore) {
+	r.roots = store
+}
+
 // StartMonitor begins monitoring client heartbeats.
 func (r *ClientRegistry) StartMonitor(ctx context.Context) {
 	runtime := r.state.Runtime()
@@ -114,7 +121,7 @@ func (r *ClientRegistry) UpdateRuntimeConfig(ctx context.Context, prev, next dom
 }
 
 // RegisterClient registers a client and returns registration metadata.
-func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid int, tags []string, server string) (domain.ClientRegistration, error) {
+func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid int, tags []string, server string, info domain.ClientInfo) (domain.ClientRegistration, error) {
 	if client == "" {
 		return domain.ClientRegistration{}, errors.New("client is required")
 	}
@@ -123,10 +130,8 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 	}
 	normalizedTags := r.resolver.NormalizeTags(tags)
 	normalizedServer := r.resolver.NormalizeServerName(server)
-	if normalizedServer != "" && len(normalizedTags) > 0 {
-		return domain.ClientRegistration{}, errors.New("server and tags are mutually exclusive")
-	}
-	visibleSpecKeys, visibleServerCount := r.resolver.VisibleSpecKeys(normalizedTags, normalizedServer)
+	profile, hasProfile := r.resolver.ResolveProfile(r.state.Runtime(), r.principalFor(client, pid, info), normalizedTags)
+	visibleSpecKeys, visibleServerCount := r.resolver.VisibleSpecKeys(normalizedTags, normalizedServer, profile)
 	internalClient := isInternalClientName(client)
 
 	var toActivate []string
@@ -138,14 +143,31 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 
 	r.mu.Lock()
 	if existing, ok := r.activeClients[client]; ok {
-		selectorChanged = !r.resolver.TagsEqual(existing.tags, normalizedTags) || existing.server != normalizedServer
+		// Registrations without client info, such as retries from helpers that
+		// share the caller name, keep what the same process reported before.
+		if info.IsZero() && existing.pid == pid {
+			info = existing.info
+		}
+		selectorChanged = !r.resolver.TagsEqual(existing.tags, normalizedTags) ||
+			existing.server != normalizedServer ||
+			!reflect.DeepEqual(existing.profile, profile)
 		if existing.pid == pid && !selectorChanged {
+			infoChanged := !existing.info.Equal(info)
 			existing.lastHeartbeat = now
+			existing.info = info
 			r.activeClients[client] = existing
+			if infoChanged && !internalClient {
+				snapshot = r.snapshotActiveClientsLocked(now)
+			}
 			r.mu.Unlock()
+			if infoChanged && !internalClient {
+				r.broadcastActiveClients(finalizeActiveClientSnapshot(snapshot))
+				r.publishRoots(client, info)
+			}
 			return domain.ClientRegistration{
 				Client:             client,
 				Tags:               normalizedTags,
+				Profile:            profile.Name,
 				VisibleServerCount: visibleServerCount,
 			}, nil
 		}
@@ -155,8 +177,11 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 		existing.pid = pid
 		existing.tags = normalizedTags
 		existing.server = normalizedServer
+		existing.profile = profile
+		existing.hasProfile = hasProfile
 		existing.specKeys = visibleSpecKeys
 		existing.lastHeartbeat = now
+		existing.info = info
 		r.activeClients[client] = existing
 		if !internalClient {
 			applySpecDelta(r.specCounts, toActivate, toDeactivate)
@@ -168,8 +193,11 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 			pid:           pid,
 			tags:          normalizedTags,
 			server:        normalizedServer,
+			profile:       profile,
+			hasProfile:    hasProfile,
 			specKeys:      visibleSpecKeys,
 			lastHeartbeat: now,
+			info:          info,
 		}
 		if !internalClient {
 			applySpecDelta(r.specCounts, visibleSpecKeys, nil)
@@ -193,6 +221,7 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 	}
 	if shouldBroadcast {
 		r.broadcastActiveClients(finalizeActiveClientSnapshot(snapshot))
+		r.publishRoots(client, info)
 	}
 	if selectorChanged {
 		r.broadcastClientChange(ClientChangeEvent{Client: client})
@@ -201,10 +230,36 @@ func (r *ClientRegistry) RegisterClient(ctx context.Context, client string, pid
 	return domain.ClientRegistration{
 		Client:             client,
 		Tags:               normalizedTags,
+		Profile:            profile.Name,
 		VisibleServerCount: visibleServerCount,
 	}, nil
 }
 
+// ClientInfo returns the client info a registered caller reported.
+func (r *ClientRegistry) ClientInfo(client string) (domain.ClientInfo, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.activeClients[client]
+	if !ok {
+		return domain.ClientInfo{}, false
+	}
+	return state.info, true
+}
+
+// principalFor returns the name profiles match for a registration. Without
+// client info, the identity reported earlier by the same process is kept.
+func (r *ClientRegistry) principalFor(client string, pid int, info domain.ClientInfo) string {
+	if !info.IsZero() {
+		return info.Principal(client)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.activeClients[client]; ok && existing.pid == pid {
+		return existing.info.Principal(client)
+	}
+	return client
+}
+
 // UnregisterClient unregisters a client.
 func (r *ClientRegistry) UnregisterClient(ctx context.Context, client string) error {
 	if client == "" {
@@ -235,10 +290,20 @@ func (r *ClientRegistry) UnregisterClient(ctx context.Context, client string) er
 	}
 	if shouldBroadcast {
 		r.broadcastActiveClients(finalizeActiveClientSnapshot(snapshot))
+		if r.roots != nil {
+			r.roots.RemoveCaller(client)
+		}
 	}
 	return nil
 }
 
+func (r *ClientRegistry) publishRoots(client string, info domain.ClientInfo) {
+	if r.roots == nil {
+		return
+	}
+	r.roots.SetCallerRoots(client, info.Roots)
+}
+
 // ListActiveClients lists active clients.
 func (r *ClientRegistry) ListActiveClients(_ context.Context) ([]domain.ActiveClient, error) {
 	now := time.Now()
@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
@@ -19,10 +20,13 @@ func (r *ClientRegistry) ApplyCatalogUpdate(ctx context.Context, update domain.C
 	changedClients := make([]string, 0)
 
 	for client, state := range r.activeClients {
-		nextSpecKeys, _ := r.resolver.VisibleSpecKeysForCatalog(update.Snapshot.Catalog, update.Snapshot.Summary.ServerSpecKeys, state.tags, state.server)
-		if !sameKeySet(state.specKeys, nextSpecKeys) {
+		profile, hasProfile := r.resolver.ResolveProfile(update.Snapshot.Summary.Runtime, state.info.Principal(client), state.tags)
+		nextSpecKeys, _ := r.resolver.VisibleSpecKeysForCatalog(update.Snapshot.Catalog, update.Snapshot.Summary.ServerSpecKeys, state.tags, state.server, profile)
+		if !sameKeySet(state.specKeys, nextSpecKeys) || !reflect.DeepEqual(state.profile, profile) {
 			changedClients = append(changedClients, client)
 			state.specKeys = nextSpecKeys
+			state.profile = profile
+			state.hasProfile = hasProfile
 			r.activeClients[client] = state
 		}
 		if !isInternalClientName(client) {
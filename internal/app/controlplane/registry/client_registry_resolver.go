@@ -1,17 +1,50 @@
 package registry
 
 import (
+	"sort"
 	"time"
 
 	"mcpv/internal/domain"
 )
 
+// ResolveClientTags returns the client's tags. A name that is not a registered
+// caller but an identity reported in client info resolves to the union of the
+// tags of the callers acting for it.
 func (r *ClientRegistry) ResolveClientTags(client string) ([]string, error) {
 	state, ok := r.loadClientState(client)
-	if !ok {
-		return nil, domain.ErrClientNotRegistered
+	if ok {
+		return append([]string(nil), state.tags...), nil
+	}
+	if tags, ok := r.identityTags(client); ok {
+		return tags, nil
+	}
+	return nil, domain.ErrClientNotRegistered
+}
+
+func (r *ClientRegistry) identityTags(identity string) ([]string, bool) {
+	if identity == "" {
+		return nil, false
 	}
-	return append([]string(nil), state.tags...), nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seen := make(map[string]struct{})
+	var tags []string
+	found := false
+	for _, state := range r.activeClients {
+		if state.info.Identity != identity {
+			continue
+		}
+		found = true
+		for _, tag := range state.tags {
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+			seen[tag] = struct{}{}
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags, found
 }
 
 func (r *ClientRegistry) ResolveClientServer(client string) (string, error) {
@@ -22,6 +55,16 @@ func (r *ClientRegistry) ResolveClientServer(client string) (string, error) {
 	return state.server, nil
 }
 
+// ResolveClientProfile returns the profile matched when the client registered
+// or at the last catalog update.
+func (r *ClientRegistry) ResolveClientProfile(client string) (domain.ClientProfile, bool, error) {
+	state, ok := r.loadClientState(client)
+	if !ok {
+		return domain.ClientProfile{}, false, domain.ErrClientNotRegistered
+	}
+	return state.profile, state.hasProfile, nil
+}
+
 func (r *ClientRegistry) ResolveVisibleSpecKeys(client string) ([]string, error) {
 	state, ok := r.loadClientState(client)
 	if !ok {
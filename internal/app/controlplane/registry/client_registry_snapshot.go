@@ -18,7 +18,9 @@ func (r *ClientRegistry) snapshotActiveClientsLocked(now time.Time) domain.Activ
 			PID:           state.pid,
 			Tags:          append([]string(nil), state.tags...),
 			Server:        state.server,
+			Profile:       state.profile.Name,
 			LastHeartbeat: state.lastHeartbeat,
+			Info:          state.info,
 		})
 	}
 
@@ -11,6 +11,7 @@ import (
 
 	"mcpv/internal/app/bootstrap"
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/notifications"
 )
 
 type fakeState struct {
@@ -198,13 +199,13 @@ func TestRegistry_SharedServerNotKilled(t *testing.T) {
 	}, sched)
 	reg := NewClientRegistry(state)
 
-	_, err := reg.RegisterClient(context.Background(), "clientA", 1001, []string{"git"}, "")
+	_, err := reg.RegisterClient(context.Background(), "clientA", 1001, []string{"git"}, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(sched.minReadyCalls))
 	require.Equal(t, specKey, sched.minReadyCalls[0].specKey)
 	require.Equal(t, 1, sched.minReadyCalls[0].minReady)
 
-	_, err = reg.RegisterClient(context.Background(), "clientB", 1002, []string{"git"}, "")
+	_, err = reg.RegisterClient(context.Background(), "clientB", 1002, []string{"git"}, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(sched.minReadyCalls), "should not start server again")
 
@@ -258,11 +259,11 @@ func TestRegistry_SharedServerMultipleTags(t *testing.T) {
 	}, sched)
 	reg := NewClientRegistry(state)
 
-	_, err := reg.RegisterClient(context.Background(), "clientA", 1001, []string{"git", "docker"}, "")
+	_, err := reg.RegisterClient(context.Background(), "clientA", 1001, []string{"git", "docker"}, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	require.Equal(t, 2, len(sched.minReadyCalls), "should start both servers")
 
-	_, err = reg.RegisterClient(context.Background(), "clientB", 1002, []string{"git"}, "")
+	_, err = reg.RegisterClient(context.Background(), "clientB", 1002, []string{"git"}, "", domain.ClientInfo{})
 	require.NoError(t, err)
 
 	reg.mu.Lock()
@@ -284,3 +285,190 @@ func TestRegistry_SharedServerMultipleTags(t *testing.T) {
 	require.Equal(t, 2, len(sched.stopCalls), "git server should now be stopped")
 	require.Equal(t, gitKey, sched.stopCalls[1].specKey)
 }
+
+func TestRegistry_ClientProfileLimitsServers(t *testing.T) {
+	gitSpec := domain.ServerSpec{
+		Name:            "git-server",
+		Cmd:             []string{"/bin/git"},
+		MaxConcurrent:   1,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	}
+	dockerSpec := domain.ServerSpec{
+		Name:            "docker-server",
+		Cmd:             []string{"/bin/docker"},
+		MaxConcurrent:   1,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	}
+	gitKey := domain.SpecFingerprint(gitSpec)
+	dockerKey := domain.SpecFingerprint(dockerSpec)
+	catalog := domain.Catalog{
+		Specs: map[string]domain.ServerSpec{
+			gitSpec.Name:    gitSpec,
+			dockerSpec.Name: dockerSpec,
+		},
+		Runtime: domain.RuntimeConfig{
+			Clients: []domain.ClientProfile{{
+				Name:           "cursor-readonly",
+				Callers:        []string{"cursor*"},
+				IncludeServers: []string{"git-*"},
+			}},
+		},
+	}
+
+	sched := &fakeScheduler{}
+	reg := NewClientRegistry(newFakeState(context.Background(), catalog, sched))
+
+	registration, err := reg.RegisterClient(context.Background(), "cursor-42", 1001, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+	require.Equal(t, "cursor-readonly", registration.Profile)
+	require.Equal(t, 1, registration.VisibleServerCount)
+	require.Equal(t, []minReadyCall{{specKey: gitKey, minReady: 1}}, sched.minReadyCalls)
+
+	profile, ok, err := reg.ResolveClientProfile("cursor-42")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "cursor-readonly", profile.Name)
+
+	next := catalog
+	next.Runtime = domain.RuntimeConfig{}
+	nextState, err := domain.NewCatalogState(next, 2, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, reg.ApplyCatalogUpdate(context.Background(), domain.CatalogUpdate{Snapshot: nextState}))
+
+	_, ok, err = reg.ResolveClientProfile("cursor-42")
+	require.NoError(t, err)
+	require.False(t, ok)
+	keys, err := reg.ResolveVisibleSpecKeys("cursor-42")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{gitKey, dockerKey}, keys)
+}
+
+func TestRegistry_ServerSelectorHonorsCallerTags(t *testing.T) {
+	gitSpec := domain.ServerSpec{
+		Name:            "git-server",
+		Cmd:             []string{"/bin/git"},
+		Tags:            []string{"git"},
+		MaxConcurrent:   1,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	}
+	dbSpec := domain.ServerSpec{
+		Name:            "db-server",
+		Cmd:             []string{"/bin/db"},
+		Tags:            []string{"db"},
+		MaxConcurrent:   1,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	}
+	catalog := domain.Catalog{
+		Specs: map[string]domain.ServerSpec{
+			gitSpec.Name: gitSpec,
+			dbSpec.Name:  dbSpec,
+		},
+	}
+	reg := NewClientRegistry(newFakeState(context.Background(), catalog, &fakeScheduler{}))
+
+	registration, err := reg.RegisterClient(context.Background(), "team-git", 1001, []string{"git"}, "git-server", domain.ClientInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 1, registration.VisibleServerCount)
+
+	registration, err = reg.RegisterClient(context.Background(), "team-git-db", 1002, []string{"git"}, "db-server", domain.ClientInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, registration.VisibleServerCount)
+	keys, err := reg.ResolveVisibleSpecKeys("team-git-db")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestRegistry_ResolvesProfileAndTagsByIdentity(t *testing.T) {
+	catalog := domain.Catalog{
+		Runtime: domain.RuntimeConfig{
+			Clients: []domain.ClientProfile{{
+				Name:    "alice-profile",
+				Callers: []string{"alice"},
+			}},
+		},
+	}
+	reg := NewClientRegistry(newFakeState(context.Background(), catalog, &fakeScheduler{}))
+	info := domain.ClientInfo{Identity: "alice"}
+
+	registration, err := reg.RegisterClient(context.Background(), "alice:server:git", 1001, []string{"git"}, "git", info)
+	require.NoError(t, err)
+	require.Equal(t, "alice-profile", registration.Profile)
+	registration, err = reg.RegisterClient(context.Background(), "alice:tags:db", 1001, []string{"db"}, "", info)
+	require.NoError(t, err)
+	require.Equal(t, "alice-profile", registration.Profile)
+
+	tags, err := reg.ResolveClientTags("alice")
+	require.NoError(t, err)
+	require.Equal(t, []string{"db", "git"}, tags)
+	_, err = reg.ResolveClientTags("bob")
+	require.ErrorIs(t, err, domain.ErrClientNotRegistered)
+}
+
+func TestRegistry_ClientInfoStoredAndKeptOnHeartbeat(t *testing.T) {
+	reg := NewClientRegistry(newFakeState(context.Background(), domain.Catalog{}, &fakeScheduler{}))
+	updates, err := reg.WatchActiveClients(context.Background())
+	require.NoError(t, err)
+	<-updates
+
+	info := domain.ClientInfo{
+		Name:          "cursor",
+		Version:       "1.2.0",
+		WorkspaceRoot: "/work/app",
+		Capabilities:  domain.ClientCapabilities{Roots: true},
+	}
+	_, err = reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+	<-updates
+
+	_, err = reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", info)
+	require.NoError(t, err)
+	snapshot := <-updates
+	require.Len(t, snapshot.Clients, 1)
+	require.Equal(t, info, snapshot.Clients[0].Info)
+
+	_, err = reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+	got, ok := reg.ClientInfo("cursor-1")
+	require.True(t, ok)
+	require.Equal(t, info, got)
+
+	_, err = reg.RegisterClient(context.Background(), "cursor-1", 1002, nil, "", domain.ClientInfo{})
+	require.NoError(t, err)
+	got, ok = reg.ClientInfo("cursor-1")
+	require.True(t, ok)
+	require.True(t, got.IsZero())
+
+	_, ok = reg.ClientInfo("missing")
+	require.False(t, ok)
+}
+
+func TestRegistry_PublishesCallerRoots(t *testing.T) {
+	reg := NewClientRegistry(newFakeState(context.Background(), domain.Catalog{}, &fakeScheduler{}))
+	hub := notifications.NewRootsHub()
+	reg.SetRootsStore(hub)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := hub.SubscribeRootsChanges(ctx)
+
+	roots := []domain.Root{{URI: "file:///work/app", Name: "app"}}
+	_, err := reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", domain.ClientInfo{
+		Capabilities: domain.ClientCapabilities{Roots: true},
+		Roots:        roots,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cursor-1", <-changes)
+	require.Equal(t, roots, hub.CallerRoots("cursor-1"))
+
+	next := []domain.Root{{URI: "file:///work/lib"}}
+	_, err = reg.RegisterClient(context.Background(), "cursor-1", 1001, nil, "", domain.ClientInfo{
+		Capabilities: domain.ClientCapabilities{Roots: true},
+		Roots:        next,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cursor-1", <-changes)
+	require.Equal(t, next, hub.CallerRoots("cursor-1"))
+
+	require.NoError(t, reg.UnregisterClient(context.Background(), "cursor-1"))
+	require.Equal(t, "cursor-1", <-changes)
+	require.Empty(t, hub.CallerRoots("cursor-1"))
+}
@@ -1,11 +1,18 @@
 package registry
 
-import "time"
+import (
+	"time"
+
+	"mcpv/internal/domain"
+)
 
 type clientState struct {
 	pid           int
 	tags          []string
 	server        string
+	profile       domain.ClientProfile
+	hasProfile    bool
 	specKeys      []string
 	lastHeartbeat time.Time
+	info          domain.ClientInfo
 }
@@ -15,13 +15,21 @@ func NewVisibilityResolver(state State) *VisibilityResolver {
 	return &VisibilityResolver{state: state}
 }
 
-func (v *VisibilityResolver) VisibleSpecKeys(tags []string, server string) ([]string, int) {
+// ResolveProfile returns the client profile matching a caller, if any.
+func (v *VisibilityResolver) ResolveProfile(runtime domain.RuntimeConfig, client string, tags []string) (domain.ClientProfile, bool) {
+	return domain.MatchClientProfile(runtime.Clients, client, tags)
+}
+
+// VisibleSpecKeys resolves the spec keys visible to a selector. Tags also
+// narrow a server selector, which then resolves only when the server carries
+// one of them. A zero profile leaves tag and server visibility unchanged.
+func (v *VisibilityResolver) VisibleSpecKeys(tags []string, server string, profile domain.ClientProfile) ([]string, int) {
 	catalog := v.state.Catalog()
 	serverSpecKeys := v.state.ServerSpecKeys()
-	return v.VisibleSpecKeysForCatalog(catalog, serverSpecKeys, tags, server)
+	return v.VisibleSpecKeysForCatalog(catalog, serverSpecKeys, tags, server, profile)
 }
 
-func (v *VisibilityResolver) VisibleSpecKeysForCatalog(catalog domain.Catalog, serverSpecKeys map[string]string, tags []string, server string) ([]string, int) {
+func (v *VisibilityResolver) VisibleSpecKeysForCatalog(catalog domain.Catalog, serverSpecKeys map[string]string, tags []string, server string, profile domain.ClientProfile) ([]string, int) {
 	if len(serverSpecKeys) == 0 {
 		return nil, 0
 	}
@@ -30,7 +38,14 @@ func (v *VisibilityResolver) VisibleSpecKeysForCatalog(catalog domain.Catalog, s
 		if !ok {
 			return nil, 0
 		}
-		if _, ok := catalog.Specs[server]; !ok {
+		spec, ok := catalog.Specs[server]
+		if !ok {
+			return nil, 0
+		}
+		if !isVisibleToTags(tags, spec.Tags) {
+			return nil, 0
+		}
+		if !profile.AllowsServer(server) {
 			return nil, 0
 		}
 		return []string{specKey}, 1
@@ -42,6 +57,9 @@ func (v *VisibilityResolver) VisibleSpecKeysForCatalog(catalog domain.Catalog, s
 		if !ok {
 			continue
 		}
+		if !profile.AllowsServer(name) {
+			continue
+		}
 		if isVisibleToTags(tags, spec.Tags) {
 			serverCount++
 			visible[specKey] = struct{}{}
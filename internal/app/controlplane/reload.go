@@ -37,6 +37,7 @@ type ReloadManager struct {
 	logger        *zap.Logger
 	observer      *reloadpkg.Observer
 	transaction   *reloadpkg.Transaction
+	alerts        domain.AlertPublisher
 	appliedRev    atomic.Uint64
 	started       atomic.Bool
 }
@@ -104,6 +105,17 @@ func (m *ReloadManager) SetObservabilityController(controller *telemetry.Observa
 	m.observability = controller
 }
 
+// SetAlertPublisher attaches the publisher notified of failed and rolled back
+// reloads and of circuit breakers opened by runtime states the reload creates.
+// It must be called before Start.
+func (m *ReloadManager) SetAlertPublisher(alerts domain.AlertPublisher) {
+	if m == nil {
+		return
+	}
+	m.alerts = alerts
+	m.observer.SetAlertPublisher(alerts)
+}
+
 // Reload forces a catalog reload and waits for application.
 func (m *ReloadManager) Reload(ctx context.Context) error {
 	if ctx == nil {
@@ -334,6 +346,7 @@ func (m *ReloadManager) buildStateRegistryStep(prev domain.CatalogState, update
 	runtimeCreated := false
 	if runtime == nil {
 		runtime = appRuntime.NewState(&update.Snapshot, m.scheduler, m.metrics, m.health, m.metadataCache, m.listChanges, m.coreLogger)
+		runtime.SetAlertPublisher(m.alerts)
 		runtimeCreated = true
 	}
 	shouldUpdateRuntime := !runtimeCreated && (diff.RuntimeChanged || diff.HasSpecChanges())
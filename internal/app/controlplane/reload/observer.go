@@ -1,6 +1,8 @@
 package reload
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -12,6 +14,7 @@ type Observer struct {
 	metrics    domain.Metrics
 	coreLogger *zap.Logger
 	logger     *zap.Logger
+	alerts     domain.AlertPublisher
 }
 
 func NewObserver(metrics domain.Metrics, coreLogger, logger *zap.Logger) *Observer {
@@ -35,6 +38,12 @@ func (o *Observer) SetCoreLogger(logger *zap.Logger) {
 	o.coreLogger = logger
 }
 
+// SetAlertPublisher attaches the publisher notified of failed and rolled back
+// reloads.
+func (o *Observer) SetAlertPublisher(alerts domain.AlertPublisher) {
+	o.alerts = alerts
+}
+
 func (o *Observer) RecordReloadSuccess(source domain.CatalogUpdateSource, action domain.ReloadAction) {
 	if o.metrics == nil {
 		return
@@ -87,6 +96,18 @@ func (o *Observer) HandleApplyError(update domain.CatalogUpdate, err error, dura
 		zap.Error(err),
 	}
 	o.ObserveReloadApply(reloadMode, domain.ReloadApplyResultFailure, stage, duration)
+	if o.alerts != nil {
+		o.alerts.PublishAlert(domain.AlertEvent{
+			Type:    domain.AlertEventReloadFailed,
+			Time:    time.Now(),
+			Message: "config reload apply failed: " + err.Error(),
+			Attributes: map[string]string{
+				"revision":     strconv.FormatUint(update.Snapshot.Revision, 10),
+				"reloadMode":   string(reloadMode),
+				"failureStage": stage,
+			},
+		})
+	}
 	if reloadMode == domain.ReloadModeStrict {
 		o.coreLogger.Fatal("config reload apply failed; shutting down", fields...)
 	}
@@ -106,6 +127,18 @@ func (o *Observer) ObserveReloadApply(mode domain.ReloadMode, result domain.Relo
 }
 
 func (o *Observer) ObserveReloadRollback(mode domain.ReloadMode, result domain.ReloadRollbackResult, summary string, duration time.Duration) {
+	if o.alerts != nil {
+		o.alerts.PublishAlert(domain.AlertEvent{
+			Type:    domain.AlertEventReloadRollback,
+			Time:    time.Now(),
+			Message: fmt.Sprintf("config reload rolled back after step %s failed (rollback %s)", summary, result),
+			Attributes: map[string]string{
+				"reloadMode":     string(mode),
+				"failureStage":   summary,
+				"rollbackResult": string(result),
+			},
+		})
+	}
 	if o.metrics == nil {
 		return
 	}
@@ -82,3 +82,38 @@ func TestReloadTransactionApply_RollbackFailure(t *testing.T) {
 	require.ErrorIs(t, err, applyErr)
 	require.ErrorIs(t, err, rollbackErr)
 }
+
+func TestReloadTransactionApply_PublishesRollbackAlert(t *testing.T) {
+	alerts := &alertRecorder{}
+	observer := NewObserver(nil, zap.NewNop(), zap.NewNop())
+	observer.SetAlertPublisher(alerts)
+
+	steps := []Step{
+		{
+			Name:     "step1",
+			Apply:    func(context.Context) error { return nil },
+			Rollback: func(context.Context) error { return nil },
+		},
+		{
+			Name:  "step2",
+			Apply: func(context.Context) error { return errors.New("apply failed") },
+		},
+	}
+
+	transaction := NewTransaction(observer, zap.NewNop())
+	require.Error(t, transaction.Apply(context.Background(), steps, domain.ReloadModeLenient))
+
+	require.Len(t, alerts.events, 1)
+	event := alerts.events[0]
+	require.Equal(t, domain.AlertEventReloadRollback, event.Type)
+	require.Equal(t, "step2", event.Attributes["failureStage"])
+	require.Equal(t, string(domain.ReloadRollbackResultSuccess), event.Attributes["rollbackResult"])
+}
+
+type alertRecorder struct {
+	events []domain.AlertEvent
+}
+
+func (r *alertRecorder) PublishAlert(event domain.AlertEvent) {
+	r.events = append(r.events, event)
+}
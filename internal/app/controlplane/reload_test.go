@@ -48,9 +48,9 @@ func TestReloadManager_ApplyUpdate_UpdatesRuntimeAndRegistry(t *testing.T) {
 	startup := bootstrap.NewServerStartupOrchestrator(initManager, nil, zap.NewNop())
 	runtimeState := runtime.NewStateFromSpecKeys(prevState.Summary.ServerSpecKeys)
 	state := NewState(context.Background(), runtimeState, scheduler, startup, &prevState, zap.NewNop())
-	registry := NewClientRegistry(state)
+	registry := NewClientRegistry(state, nil)
 
-	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "")
+	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	scheduler.minReadyCalls = nil
 
@@ -100,9 +100,9 @@ func TestReloadManager_ApplyUpdate_RemovesServer(t *testing.T) {
 	scheduler := &schedulerStub{}
 	runtimeState := runtime.NewStateFromSpecKeys(prevState.Summary.ServerSpecKeys)
 	state := NewState(context.Background(), runtimeState, scheduler, nil, &prevState, zap.NewNop())
-	registry := NewClientRegistry(state)
+	registry := NewClientRegistry(state, nil)
 
-	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "")
+	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	scheduler.stopCalls = nil
 
@@ -143,7 +143,7 @@ func TestReloadManager_ApplyUpdate_PluginApplyFailureRetainsState(t *testing.T)
 
 	scheduler := &schedulerStub{}
 	state := NewState(context.Background(), runtime.NewStateFromSpecKeys(prevState.Summary.ServerSpecKeys), scheduler, nil, &prevState, zap.NewNop())
-	registry := NewClientRegistry(state)
+	registry := NewClientRegistry(state, nil)
 
 	pluginManager, err := pluginmanager.NewManager(pluginmanager.Options{Logger: zap.NewNop(), RootDir: t.TempDir()})
 	require.NoError(t, err)
@@ -180,7 +180,7 @@ func TestReloadManager_ApplyUpdate_SchedulerErrorDoesNotAdvanceState(t *testing.
 	scheduler := &schedulerStub{applyErr: errors.New("apply failed")}
 	runtimeState := runtime.NewStateFromSpecKeys(prevState.Summary.ServerSpecKeys)
 	state := NewState(context.Background(), runtimeState, scheduler, nil, &prevState, zap.NewNop())
-	registry := NewClientRegistry(state)
+	registry := NewClientRegistry(state, nil)
 
 	manager := NewReloadManager(nil, state, registry, scheduler, nil, nil, nil, nil, nil, nil, nil, zap.NewNop())
 	update := domain.CatalogUpdate{
@@ -213,9 +213,9 @@ func TestReloadManager_ApplyUpdate_RegistryErrorRollsBackState(t *testing.T) {
 	scheduler := &schedulerStub{}
 	runtimeState := runtime.NewStateFromSpecKeys(prevState.Summary.ServerSpecKeys)
 	state := NewState(context.Background(), runtimeState, scheduler, nil, &prevState, zap.NewNop())
-	registry := NewClientRegistry(state)
+	registry := NewClientRegistry(state, nil)
 
-	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "")
+	_, err := registry.RegisterClient(context.Background(), "client-1", 1, nil, "", domain.ClientInfo{})
 	require.NoError(t, err)
 	scheduler.setMinReadyErr = errors.New("min ready failed")
 
@@ -1,11 +1,15 @@
 package controlplane
 
 import (
+	"mcpv/internal/app/controlplane/admin"
 	"mcpv/internal/app/controlplane/automation"
 	"mcpv/internal/app/controlplane/discovery"
 	"mcpv/internal/app/controlplane/observability"
 	"mcpv/internal/app/controlplane/registry"
+	catalogeditor "mcpv/internal/infra/catalog/editor"
+	"mcpv/internal/infra/notifications"
 	"mcpv/internal/infra/telemetry"
+	"mcpv/internal/infra/telemetry/diagnostics"
 )
 
 type ClientRegistry = registry.ClientRegistry
@@ -20,8 +24,12 @@ type ObservabilityService = observability.Service
 
 type AutomationService = automation.Service
 
-func NewClientRegistry(state *State) *ClientRegistry {
-	return registry.NewClientRegistry(state)
+type AdminService = admin.Service
+
+func NewClientRegistry(state *State, roots *notifications.RootsHub) *ClientRegistry {
+	reg := registry.NewClientRegistry(state)
+	reg.SetRootsStore(roots)
+	return reg
 }
 
 func NewToolDiscoveryService(state *State, registry *ClientRegistry) *ToolDiscoveryService {
@@ -43,3 +51,8 @@ func NewObservabilityService(state *State, registry *ClientRegistry, logs *telem
 func NewAutomationService(state *State, registry *ClientRegistry, tools *ToolDiscoveryService) *AutomationService {
 	return automation.NewAutomationService(state, registry, tools)
 }
+
+func NewAdminService(state *State, registry *ClientRegistry, observability *ObservabilityService, hub *diagnostics.Hub, configPath string) *AdminService {
+	editor := catalogeditor.NewEditor(configPath, state.Logger())
+	return admin.NewService(state, registry, observability, hub, editor)
+}
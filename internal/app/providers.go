@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -12,6 +13,9 @@ import (
 	"mcpv/internal/app/controlplane"
 	"mcpv/internal/app/runtime"
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/alerts"
+	"mcpv/internal/infra/audit"
+	"mcpv/internal/infra/callhistory"
 	"mcpv/internal/infra/elicitation"
 	"mcpv/internal/infra/governance"
 	"mcpv/internal/infra/lifecycle"
@@ -19,11 +23,15 @@ import (
 	"mcpv/internal/infra/pipeline"
 	pluginmanager "mcpv/internal/infra/plugin/manager"
 	"mcpv/internal/infra/probe"
+	"mcpv/internal/infra/ratelimit"
+	"mcpv/internal/infra/redaction"
+	"mcpv/internal/infra/responsecache"
 	"mcpv/internal/infra/rpc"
 	"mcpv/internal/infra/sampling"
 	"mcpv/internal/infra/scheduler"
 	"mcpv/internal/infra/telemetry"
 	"mcpv/internal/infra/telemetry/diagnostics"
+	"mcpv/internal/infra/toolstats"
 	"mcpv/internal/infra/transport"
 )
 
@@ -41,8 +49,12 @@ func ConfigPath(cfg ServeConfig) string {
 }
 
 // NewMetrics constructs metrics backed by Prometheus.
-func NewMetrics(registry *prometheus.Registry) domain.Metrics {
-	return telemetry.NewPrometheusMetrics(registry)
+func NewMetrics(registry *prometheus.Registry, state *domain.CatalogState) domain.Metrics {
+	metrics := telemetry.NewPrometheusMetrics(registry)
+	if state != nil {
+		metrics.SetMaxToolsPerServer(state.Summary.Runtime.Observability.ToolStats.MaxToolsPerServer)
+	}
+	return metrics
 }
 
 // NewHealthTracker constructs a health tracker.
@@ -70,6 +82,11 @@ func NewListChangeHub() *notifications.ListChangeHub {
 	return notifications.NewListChangeHub()
 }
 
+// NewRootsHub constructs the caller roots hub.
+func NewRootsHub() *notifications.RootsHub {
+	return notifications.NewRootsHub()
+}
+
 // NewCommandLauncher constructs a launcher for stdio servers.
 func NewCommandLauncher(logger *zap.Logger, probe diagnostics.Probe) domain.Launcher {
 	return transport.NewCommandLauncher(transport.CommandLauncherOptions{
@@ -84,6 +101,7 @@ func NewMCPTransport(
 	listChanges *notifications.ListChangeHub,
 	samplingHandler domain.SamplingHandler,
 	elicitationHandler domain.ElicitationHandler,
+	roots *notifications.RootsHub,
 	probe diagnostics.Probe,
 ) domain.Transport {
 	stdioTransport := transport.NewMCPTransport(transport.MCPTransportOptions{
@@ -91,6 +109,7 @@ func NewMCPTransport(
 		ListChangeEmitter:  listChanges,
 		SamplingHandler:    samplingHandler,
 		ElicitationHandler: elicitationHandler,
+		RootsProvider:      roots,
 		Probe:              probe,
 	})
 	httpTransport := transport.NewStreamableHTTPTransport(transport.StreamableHTTPTransportOptions{
@@ -98,6 +117,7 @@ func NewMCPTransport(
 		ListChangeEmitter:  listChanges,
 		SamplingHandler:    samplingHandler,
 		ElicitationHandler: elicitationHandler,
+		RootsProvider:      roots,
 		Probe:              probe,
 	})
 	return transport.NewCompositeTransport(transport.CompositeTransportOptions{
@@ -113,21 +133,27 @@ func NewLifecycleManager(
 	transport domain.Transport,
 	samplingHandler domain.SamplingHandler,
 	elicitationHandler domain.ElicitationHandler,
+	roots *notifications.RootsHub,
 	probe diagnostics.Probe,
 	logger *zap.Logger,
 ) domain.Lifecycle {
 	manager := lifecycle.NewManager(ctx, launcher, transport, probe, logger)
 	manager.SetSamplingHandler(samplingHandler)
 	manager.SetElicitationHandler(elicitationHandler)
+	manager.SetRootsProvider(roots)
 	return manager
 }
 
 // NewPluginManager constructs the governance plugin manager.
-func NewPluginManager(logger *zap.Logger, metrics domain.Metrics) (*pluginmanager.Manager, error) {
-	return pluginmanager.NewManager(pluginmanager.Options{
+func NewPluginManager(logger *zap.Logger, metrics domain.Metrics, notifier *alerts.Notifier) (*pluginmanager.Manager, error) {
+	opts := pluginmanager.Options{
 		Logger:  logger,
 		Metrics: metrics,
-	})
+	}
+	if notifier != nil {
+		opts.Alerts = notifier
+	}
+	return pluginmanager.NewManager(opts)
 }
 
 // NewPipelineEngine constructs the governance pipeline engine and applies initial specs.
@@ -143,9 +169,193 @@ func NewPipelineEngine(state *domain.CatalogState, manager *pluginmanager.Manage
 	return engine, nil
 }
 
+// NewAuditor opens the built-in audit log when enabled in the runtime config.
+func NewAuditor(state *domain.CatalogState, logger *zap.Logger) (*audit.Auditor, error) {
+	if state == nil || !state.Summary.Runtime.Audit.Enabled {
+		return nil, nil
+	}
+	return audit.NewAuditor(audit.Options{
+		Config: state.Summary.Runtime.Audit,
+		Logger: logger,
+	})
+}
+
+// NewCallHistoryRecorder opens the persistent call journal when enabled in the
+// runtime config.
+func NewCallHistoryRecorder(state *domain.CatalogState, logger *zap.Logger) (*callhistory.Recorder, error) {
+	if state == nil || !state.Summary.Runtime.CallHistory.Enabled {
+		return nil, nil
+	}
+	return callhistory.NewRecorder(callhistory.Options{
+		Config: state.Summary.Runtime.CallHistory,
+		Logger: logger,
+	})
+}
+
+// NewToolStatsTracker builds the per-tool latency tracker.
+func NewToolStatsTracker(state *domain.CatalogState, metrics domain.Metrics) *toolstats.Tracker {
+	var cfg domain.ToolStatsConfig
+	if state != nil {
+		cfg = state.Summary.Runtime.Observability.ToolStats
+	}
+	return toolstats.NewTracker(toolstats.Options{
+		Config:  cfg,
+		Metrics: metrics,
+	})
+}
+
+// NewAlertNotifier builds the alert sinks when alerts are enabled in the
+// runtime config.
+func NewAlertNotifier(state *domain.CatalogState, metrics domain.Metrics, logger *zap.Logger) (*alerts.Notifier, error) {
+	if state == nil || !state.Summary.Runtime.Alerts.Enabled {
+		return nil, nil
+	}
+	return alerts.NewNotifier(alerts.Options{
+		Config:  state.Summary.Runtime.Alerts,
+		Metrics: metrics,
+		Logger:  logger,
+	})
+}
+
+// NewLogStore opens the on-disk log store when enabled in the runtime config
+// and attaches it to the log broadcaster.
+func NewLogStore(state *domain.CatalogState, logs *telemetry.LogBroadcaster, logger *zap.Logger) (*telemetry.LogStore, error) {
+	if state == nil || !state.Summary.Runtime.LogStore.Enabled {
+		if logs != nil {
+			logs.SetSink(nil, nil)
+		}
+		return nil, nil
+	}
+	cfg := state.Summary.Runtime.LogStore
+	store, err := telemetry.OpenLogStore(telemetry.LogStoreOptions{
+		Dir:          cfg.Dir,
+		MaxSizeBytes: int64(cfg.MaxSizeMB) << 20,
+		MaxAge:       time.Duration(cfg.MaxAgeHours) * time.Hour,
+		MaxBackups:   cfg.MaxBackups,
+		MinLevel:     cfg.MinLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if logs != nil {
+		logs.SetSink(store, logger)
+	}
+	return store, nil
+}
+
+// NewRedactionPolicy builds the built-in response redaction policy when enabled.
+func NewRedactionPolicy(state *domain.CatalogState, metrics domain.Metrics, logger *zap.Logger) (*redaction.Policy, error) {
+	if state == nil || !state.Summary.Runtime.Redaction.Enabled {
+		return nil, nil
+	}
+	return redaction.NewPolicy(state.Summary.Runtime.Redaction, metrics, logger)
+}
+
+// NewRateLimiter builds the built-in rate limit policy when enabled.
+func NewRateLimiter(
+	state *domain.CatalogState,
+	cpState *controlplane.State,
+	registry *controlplane.ClientRegistry,
+	metrics domain.Metrics,
+	logger *zap.Logger,
+) (*ratelimit.Limiter, error) {
+	if state == nil || !state.Summary.Runtime.RateLimits.Enabled {
+		return nil, nil
+	}
+	return ratelimit.NewLimiter(ratelimit.Options{
+		Config:  state.Summary.Runtime.RateLimits,
+		Tags:    registry.ResolveClientTags,
+		Tools:   runtimeToolResolver(cpState),
+		Metrics: metrics,
+		Logger:  logger,
+	})
+}
+
+func runtimeToolResolver(cpState *controlplane.State) ratelimit.ToolResolver {
+	return func(name string) (string, string, bool) {
+		if cpState == nil {
+			return "", "", false
+		}
+		runtime := cpState.RuntimeState()
+		if runtime == nil || runtime.Tools() == nil {
+			return "", "", false
+		}
+		target, ok := runtime.Tools().Resolve(name)
+		if !ok {
+			return "", "", false
+		}
+		return target.ServerType, target.ToolName, true
+	}
+}
+
+// NewResponseCache builds the response cache when enabled and attaches it to
+// the runtime indexes.
+func NewResponseCache(
+	state *domain.CatalogState,
+	runtimeState *runtime.State,
+	listChanges *notifications.ListChangeHub,
+	metrics domain.Metrics,
+	logger *zap.Logger,
+) *responsecache.Cache {
+	if state == nil || !state.Summary.Runtime.ResponseCache.Enabled {
+		return nil
+	}
+	cache := responsecache.New(responsecache.Options{
+		Config:      state.Summary.Runtime.ResponseCache,
+		ListChanges: listChanges,
+		Metrics:     metrics,
+		Logger:      logger,
+	})
+	if runtimeState != nil {
+		runtimeState.SetResponseCache(cache)
+	}
+	return cache
+}
+
 // NewGovernanceExecutor constructs the governance executor.
-func NewGovernanceExecutor(engine *pipeline.Engine) *governance.Executor {
-	return governance.NewExecutor(engine)
+// The rate limiter runs first so rejected calls never reach plugins, and the
+// redaction policy runs first in the pipeline's content slot so responses are
+// masked before content and audit plugins see them. Inner calls made by
+// virtual tools are governed by the same executor.
+func NewGovernanceExecutor(
+	state *domain.CatalogState,
+	runtimeState *runtime.State,
+	engine *pipeline.Engine,
+	limiter *ratelimit.Limiter,
+	redactionPolicy *redaction.Policy,
+	auditor *audit.Auditor,
+	history *callhistory.Recorder,
+	stats *toolstats.Tracker,
+	notifier *alerts.Notifier,
+) *governance.Executor {
+	if redactionPolicy != nil && engine != nil {
+		engine.SetBuiltins(redactionPolicy.Builtin())
+	}
+	var executor *governance.Executor
+	if limiter == nil {
+		executor = governance.NewExecutor(engine)
+	} else {
+		executor = governance.NewExecutorWithPolicies(limiter, governance.NewPipelinePolicy(engine))
+	}
+	if auditor != nil {
+		executor.AddObserver(auditor)
+	}
+	if history != nil {
+		executor.AddObserver(history)
+	}
+	if stats != nil {
+		executor.AddObserver(stats)
+	}
+	if notifier != nil {
+		executor.AddObserver(notifier)
+	}
+	if state != nil {
+		executor.ForwardMetadata(state.Summary.Runtime.Governance.ForwardMetadata)
+	}
+	if runtimeState != nil {
+		runtimeState.SetCallGovernor(executor.Execute)
+	}
+	return executor
 }
 
 // NewSamplingHandler builds a sampling handler using the SubAgent config.
@@ -272,6 +482,6 @@ func provideControlPlaneState(
 }
 
 // NewRPCServer constructs the RPC server.
-func NewRPCServer(control rpc.ControlPlaneAPI, executor *governance.Executor, state *domain.CatalogState, logger *zap.Logger) *rpc.Server {
-	return rpc.NewServer(control, executor, state.Summary.Runtime.RPC, logger)
+func NewRPCServer(control rpc.ControlPlaneAPI, executor *governance.Executor, state *domain.CatalogState, metrics domain.Metrics, logger *zap.Logger) *rpc.Server {
+	return rpc.NewServer(control, executor, state.Summary.Runtime.RPC, metrics, logger)
 }
@@ -9,6 +9,7 @@ import (
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/aggregator"
 	"mcpv/internal/infra/notifications"
+	"mcpv/internal/infra/responsecache"
 	"mcpv/internal/infra/router"
 	"mcpv/internal/infra/telemetry"
 )
@@ -22,8 +23,9 @@ type State struct {
 	resources     *aggregator.ResourceIndex
 	prompts       *aggregator.PromptIndex
 
-	mu     sync.RWMutex
-	active bool
+	mu            sync.RWMutex
+	active        bool
+	responseCache *responsecache.Cache
 }
 
 // NewState constructs runtime indexes for a catalog snapshot.
@@ -84,6 +86,20 @@ func (r *State) Activate(ctx context.Context) {
 	}
 }
 
+// SetAlertPublisher attaches the publisher notified when an index opens its
+// circuit breaker for a server; nil detaches it.
+func (r *State) SetAlertPublisher(alerts domain.AlertPublisher) {
+	if r.tools != nil {
+		r.tools.SetAlertPublisher(alerts)
+	}
+	if r.resources != nil {
+		r.resources.SetAlertPublisher(alerts)
+	}
+	if r.prompts != nil {
+		r.prompts.SetAlertPublisher(alerts)
+	}
+}
+
 // Deactivate stops indexes for the runtime state.
 func (r *State) Deactivate() {
 	r.mu.Lock()
@@ -119,8 +135,12 @@ func (r *State) SpecKeys() []string {
 func (r *State) UpdateCatalog(catalog domain.Catalog, specKeys map[string]string, runtime domain.RuntimeConfig) {
 	r.mu.Lock()
 	r.specKeys = copySpecKeyMap(specKeys)
+	responseCache := r.responseCache
 	r.mu.Unlock()
 
+	// Cached responses may come from servers whose spec just changed.
+	responseCache.Purge()
+
 	if r.tools != nil {
 		r.tools.UpdateSpecs(catalog.Specs, specKeys, runtime)
 	}
@@ -170,6 +190,26 @@ func (r *State) Tools() *aggregator.ToolIndex {
 	return r.tools
 }
 
+// SetCallGovernor routes the inner calls of virtual tools through governance.
+func (r *State) SetCallGovernor(governor domain.ToolCallGovernor) {
+	if r.tools != nil {
+		r.tools.SetCallGovernor(governor)
+	}
+}
+
+// SetResponseCache attaches the response cache to the tool and resource indexes.
+func (r *State) SetResponseCache(cache *responsecache.Cache) {
+	r.mu.Lock()
+	r.responseCache = cache
+	r.mu.Unlock()
+	if r.tools != nil {
+		r.tools.SetResponseCache(cache)
+	}
+	if r.resources != nil {
+		r.resources.SetResponseCache(cache)
+	}
+}
+
 // Resources returns the resource index.
 func (r *State) Resources() *aggregator.ResourceIndex {
 	return r.resources
@@ -22,7 +22,6 @@ func InitializeApplication(ctx context.Context, cfg ServeConfig, logging Logging
 	appLogging := NewLogging(logging)
 	logger := NewLogger(appLogging)
 	registry := NewMetricsRegistry()
-	metrics := NewMetrics(registry)
 	healthTracker := NewHealthTracker()
 	logBroadcaster := NewLogBroadcaster(appLogging)
 	hub := NewDiagnosticsHub(ctx, logBroadcaster)
@@ -35,13 +34,15 @@ func InitializeApplication(ctx context.Context, cfg ServeConfig, logging Logging
 	if err != nil {
 		return nil, err
 	}
+	metrics := NewMetrics(registry, catalogState)
 	probe := NewDiagnosticsProbe(hub)
 	launcher := NewCommandLauncher(logger, probe)
 	listChangeHub := NewListChangeHub()
 	samplingHandler := NewSamplingHandler(ctx, catalogState, logger)
 	elicitationHandler := NewElicitationHandler(logger)
-	transport := NewMCPTransport(logger, listChangeHub, samplingHandler, elicitationHandler, probe)
-	lifecycle := NewLifecycleManager(ctx, launcher, transport, samplingHandler, elicitationHandler, probe, logger)
+	rootsHub := NewRootsHub()
+	transport := NewMCPTransport(logger, listChangeHub, samplingHandler, elicitationHandler, rootsHub, probe)
+	lifecycle := NewLifecycleManager(ctx, launcher, transport, samplingHandler, elicitationHandler, rootsHub, probe, logger)
 	pingProbe := NewPingProbe()
 	scheduler, err := NewScheduler(lifecycle, catalogState, pingProbe, metrics, healthTracker, probe, logger)
 	if err != nil {
@@ -53,14 +54,19 @@ func InitializeApplication(ctx context.Context, cfg ServeConfig, logging Logging
 	metadataManager := NewBootstrapManagerProvider(lifecycle, scheduler, catalogState, metadataCache, logger)
 	serverStartupOrchestrator := bootstrap.NewServerStartupOrchestrator(manager, metadataManager, logger)
 	controlplaneState := provideControlPlaneState(ctx, state, catalogState, scheduler, serverStartupOrchestrator, logger)
-	clientRegistry := controlplane.NewClientRegistry(controlplaneState)
+	clientRegistry := controlplane.NewClientRegistry(controlplaneState, rootsHub)
 	toolDiscoveryService := controlplane.NewToolDiscoveryService(controlplaneState, clientRegistry)
 	resourceDiscoveryService := controlplane.NewResourceDiscoveryService(controlplaneState, clientRegistry)
 	promptDiscoveryService := controlplane.NewPromptDiscoveryService(controlplaneState, clientRegistry)
 	service := controlplane.NewObservabilityService(controlplaneState, clientRegistry, logBroadcaster)
 	automationService := controlplane.NewAutomationService(controlplaneState, clientRegistry, toolDiscoveryService)
-	controlPlane := controlplane.NewControlPlane(controlplaneState, clientRegistry, toolDiscoveryService, resourceDiscoveryService, promptDiscoveryService, service, automationService)
-	managerManager, err := NewPluginManager(logger, metrics)
+	adminService := controlplane.NewAdminService(controlplaneState, clientRegistry, service, hub, string2)
+	controlPlane := controlplane.NewControlPlane(controlplaneState, clientRegistry, toolDiscoveryService, resourceDiscoveryService, promptDiscoveryService, service, automationService, adminService)
+	notifier, err := NewAlertNotifier(catalogState, metrics, logger)
+	if err != nil {
+		return nil, err
+	}
+	managerManager, err := NewPluginManager(logger, metrics, notifier)
 	if err != nil {
 		return nil, err
 	}
@@ -68,8 +74,30 @@ func InitializeApplication(ctx context.Context, cfg ServeConfig, logging Logging
 	if err != nil {
 		return nil, err
 	}
-	executor := NewGovernanceExecutor(engine)
-	server := NewRPCServer(controlPlane, executor, catalogState, logger)
+	limiter, err := NewRateLimiter(catalogState, controlplaneState, clientRegistry, metrics, logger)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := NewRedactionPolicy(catalogState, metrics, logger)
+	if err != nil {
+		return nil, err
+	}
+	auditor, err := NewAuditor(catalogState, logger)
+	if err != nil {
+		return nil, err
+	}
+	recorder, err := NewCallHistoryRecorder(catalogState, logger)
+	if err != nil {
+		return nil, err
+	}
+	logStore, err := NewLogStore(catalogState, logBroadcaster, logger)
+	if err != nil {
+		return nil, err
+	}
+	tracker := NewToolStatsTracker(catalogState, metrics)
+	executor := NewGovernanceExecutor(catalogState, state, engine, limiter, policy, auditor, recorder, tracker, notifier)
+	cache := NewResponseCache(catalogState, state, listChangeHub, metrics, logger)
+	server := NewRPCServer(controlPlane, executor, catalogState, metrics, logger)
 	reloadManager := controlplane.NewReloadManager(dynamicCatalogProvider, controlplaneState, clientRegistry, scheduler, serverStartupOrchestrator, managerManager, engine, metrics, healthTracker, metadataCache, listChangeHub, logger)
 	applicationOptions := ApplicationOptions{
 		Context:           ctx,
@@ -87,6 +115,14 @@ func InitializeApplication(ctx context.Context, cfg ServeConfig, logging Logging
 		RPCServer:         server,
 		ReloadManager:     reloadManager,
 		PluginManager:     managerManager,
+		Auditor:           auditor,
+		CallHistory:       recorder,
+		LogStore:          logStore,
+		Logs:              logBroadcaster,
+		ToolStats:         tracker,
+		Alerts:            notifier,
+		RateLimiter:       limiter,
+		ResponseCache:     cache,
 	}
 	application := NewApplication(applicationOptions)
 	return application, nil
@@ -32,6 +32,7 @@ var CoreInfraSet = wire.NewSet(
 	NewMetrics,
 	NewHealthTracker,
 	NewListChangeHub,
+	NewRootsHub,
 	NewCommandLauncher,
 	NewSamplingHandler,
 	NewElicitationHandler,
@@ -53,6 +54,14 @@ var ReloadableAppSet = wire.NewSet(
 	newRuntimeState,
 	provideControlPlaneState,
 	NewPipelineEngine,
+	NewRateLimiter,
+	NewResponseCache,
+	NewRedactionPolicy,
+	NewAuditor,
+	NewCallHistoryRecorder,
+	NewLogStore,
+	NewToolStatsTracker,
+	NewAlertNotifier,
 	NewGovernanceExecutor,
 	controlplane.NewClientRegistry,
 	controlplane.NewToolDiscoveryService,
@@ -60,6 +69,7 @@ var ReloadableAppSet = wire.NewSet(
 	controlplane.NewPromptDiscoveryService,
 	controlplane.NewObservabilityService,
 	controlplane.NewAutomationService,
+	controlplane.NewAdminService,
 	controlplane.NewControlPlane,
 	NewRPCServer,
 	controlplane.NewReloadManager,
@@ -0,0 +1,44 @@
+package domain
+
+import "strings"
+
+// AdminToolPrefix is the reserved namespace of the built-in admin toolset.
+const AdminToolPrefix = "mcpv_"
+
+// AdminToolServerType marks tool definitions served by mcpv itself.
+const AdminToolServerType = "mcpv.admin"
+
+// Built-in admin tool names.
+const (
+	AdminToolListServers      = AdminToolPrefix + "list_servers"
+	AdminToolRuntimeStatus    = AdminToolPrefix + "runtime_status"
+	AdminToolServerInitStatus = AdminToolPrefix + "server_init_status"
+	AdminToolServerLogs       = AdminToolPrefix + "server_logs"
+	AdminToolRestartServer    = AdminToolPrefix + "restart_server"
+	AdminToolStopServer       = AdminToolPrefix + "stop_server"
+	AdminToolSetServerEnabled = AdminToolPrefix + "set_server_enabled"
+)
+
+// AdminToolsConfig enables mcpv's own control operations as MCP tools. The
+// toolset is off by default and only listed for matching callers.
+type AdminToolsConfig struct {
+	Enabled bool `json:"enabled"`
+	// Callers are glob patterns matched against the caller name.
+	Callers []string `json:"callers,omitempty"`
+	// Tags match callers registered with any of these tags.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// AllowsCaller reports whether the toolset is enabled and visible to the
+// caller. Every selector that is set must match, as with client profiles.
+func (c AdminToolsConfig) AllowsCaller(client string, tags []string) bool {
+	if !c.Enabled {
+		return false
+	}
+	return ClientProfile{Callers: c.Callers, Tags: c.Tags}.Matches(client, tags)
+}
+
+// IsAdminToolName reports whether a tool name falls in the admin namespace.
+func IsAdminToolName(name string) bool {
+	return strings.HasPrefix(name, AdminToolPrefix)
+}
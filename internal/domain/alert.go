@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+// AlertEventType identifies an operational event that alert rules can match.
+type AlertEventType string
+
+const (
+	// AlertEventServerInitFailed fires when a server's initialization enters the failed state.
+	AlertEventServerInitFailed AlertEventType = "server_init_failed"
+	// AlertEventServerInitSuspended fires when init retries for a server are suspended
+	// after exhausting the retry budget or hitting a fatal error.
+	AlertEventServerInitSuspended AlertEventType = "server_init_suspended"
+	// AlertEventServerInitDegraded fires when a server has fewer ready instances than required.
+	AlertEventServerInitDegraded AlertEventType = "server_init_degraded"
+	// AlertEventServerInitRecovered fires when a failed, suspended or degraded server becomes ready.
+	AlertEventServerInitRecovered AlertEventType = "server_init_recovered"
+	// AlertEventReloadFailed fires when applying a config reload fails.
+	AlertEventReloadFailed AlertEventType = "reload_failed"
+	// AlertEventReloadRollback fires when a failed reload step is rolled back.
+	AlertEventReloadRollback AlertEventType = "reload_rollback"
+	// AlertEventPluginStartFailed fires when a governance plugin fails to start.
+	AlertEventPluginStartFailed AlertEventType = "plugin_start_failed"
+	// AlertEventRejectionSpike fires when governance rejects more calls to a
+	// server than the configured threshold within the spike window.
+	AlertEventRejectionSpike AlertEventType = "governance_rejection_spike"
+	// AlertEventCircuitOpen fires when metadata refreshes for a server fail often
+	// enough to open the circuit breaker and drop its cached metadata.
+	AlertEventCircuitOpen AlertEventType = "circuit_open"
+	// AlertEventInstanceOOM fires when a plugin instance traps after exhausting
+	// its memory limit.
+	AlertEventInstanceOOM AlertEventType = "instance_oom"
+)
+
+// AlertEventTypes lists every alert event type in a stable order.
+var AlertEventTypes = []AlertEventType{
+	AlertEventServerInitFailed,
+	AlertEventServerInitSuspended,
+	AlertEventServerInitDegraded,
+	AlertEventServerInitRecovered,
+	AlertEventReloadFailed,
+	AlertEventReloadRollback,
+	AlertEventPluginStartFailed,
+	AlertEventRejectionSpike,
+	AlertEventCircuitOpen,
+	AlertEventInstanceOOM,
+}
+
+// AlertEvent describes one operational event delivered to alert sinks.
+type AlertEvent struct {
+	Type       AlertEventType    `json:"type"`
+	Time       time.Time         `json:"time"`
+	Server     string            `json:"server,omitempty"`
+	Message    string            `json:"message"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// AlertPublisher accepts operational events for alerting. Implementations
+// must not block the caller.
+type AlertPublisher interface {
+	PublishAlert(event AlertEvent)
+}
+
+// AlertSinkType selects how alert events are delivered.
+type AlertSinkType string
+
+const (
+	// AlertSinkWebhook POSTs events as JSON to a URL.
+	AlertSinkWebhook AlertSinkType = "webhook"
+	// AlertSinkCommand runs a local command with the event JSON on stdin.
+	AlertSinkCommand AlertSinkType = "command"
+	// AlertSinkFile appends events as JSON lines to a file.
+	AlertSinkFile AlertSinkType = "file"
+)
+
+// AlertsConfig configures operational event notifications.
+type AlertsConfig struct {
+	Enabled        bool                      `json:"enabled"`
+	Sinks          []AlertSinkConfig         `json:"sinks,omitempty"`
+	Rules          []AlertRuleConfig         `json:"rules,omitempty"`
+	RejectionSpike AlertRejectionSpikeConfig `json:"rejectionSpike"`
+}
+
+// AlertSinkConfig declares a delivery target for alert events.
+type AlertSinkConfig struct {
+	Name string        `json:"name"`
+	Type AlertSinkType `json:"type"`
+	// URL is the webhook endpoint.
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Secret signs webhook bodies with HMAC-SHA256; SecretEnvVar names an
+	// environment variable holding it instead.
+	Secret       string `json:"secret,omitempty"`
+	SecretEnvVar string `json:"secretEnvVar,omitempty"`
+	// Command is the argv of the command sink.
+	Command []string `json:"command,omitempty"`
+	// Path is the file sink destination.
+	Path           string `json:"path,omitempty"`
+	TimeoutSeconds int    `json:"timeoutSeconds"`
+	// MaxRetries bounds redelivery attempts after a failure; retries back off
+	// exponentially from RetryBackoffMs.
+	MaxRetries     int `json:"maxRetries"`
+	RetryBackoffMs int `json:"retryBackoffMs"`
+	// DebounceSeconds drops repeats of an event type for the same server
+	// delivered to this sink within the window.
+	DebounceSeconds int `json:"debounceSeconds"`
+}
+
+// AlertRuleConfig routes matching events to sinks. Empty Events or Servers
+// match everything.
+type AlertRuleConfig struct {
+	Name    string           `json:"name"`
+	Events  []AlertEventType `json:"events,omitempty"`
+	Servers []string         `json:"servers,omitempty"`
+	Sinks   []string         `json:"sinks"`
+}
+
+// AlertRejectionSpikeConfig sets when governance rejections count as a spike.
+type AlertRejectionSpikeConfig struct {
+	Threshold     int `json:"threshold"`
+	WindowSeconds int `json:"windowSeconds"`
+}
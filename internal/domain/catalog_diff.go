@@ -194,6 +194,7 @@ func stripToolSpecFields(spec ServerSpec) ServerSpec {
 	spec.Name = ""
 	spec.Tags = nil
 	spec.ExposeTools = nil
+	spec.Tools = nil
 	return spec
 }
 
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// Governance metadata keys describing the caller's MCP client.
+const (
+	MetadataClientName          = "client.name"
+	MetadataClientVersion       = "client.version"
+	MetadataClientWorkspaceRoot = "client.workspaceRoot"
+	// MetadataClientCapabilities lists the client's optional MCP capabilities,
+	// comma separated, e.g. "elicitation,roots,sampling".
+	MetadataClientCapabilities = "client.capabilities"
+)
+
+// MCP client capabilities tracked for callers.
+const (
+	ClientCapabilitySampling    = "sampling"
+	ClientCapabilityElicitation = "elicitation"
+	ClientCapabilityRoots       = "roots"
+)
+
+// ClientInfo describes the MCP client behind a caller, as reported when the
+// caller registers.
+type ClientInfo struct {
+	Name          string
+	Version       string
+	WorkspaceRoot string
+	// Identity names the authenticated principal the caller acts for, such
+	// as a gateway token identity. Governance, rate limits and profiles key
+	// on it instead of the per-connection caller name.
+	Identity     string
+	Capabilities ClientCapabilities
+	// Roots lists the client's roots when it supports them.
+	Roots []Root
+}
+
+// ClientCapabilities records which client-side MCP features a caller can
+// answer.
+type ClientCapabilities struct {
+	Sampling    bool
+	Elicitation bool
+	Roots       bool
+}
+
+// IsZero reports whether no client info was provided.
+func (i ClientInfo) IsZero() bool {
+	return i.Equal(ClientInfo{})
+}
+
+// Equal reports whether both infos describe the same client state.
+func (i ClientInfo) Equal(other ClientInfo) bool {
+	return i.Name == other.Name &&
+		i.Version == other.Version &&
+		i.WorkspaceRoot == other.WorkspaceRoot &&
+		i.Identity == other.Identity &&
+		i.Capabilities == other.Capabilities &&
+		slices.Equal(i.Roots, other.Roots)
+}
+
+// Principal returns the identity the caller acts for, or caller itself when
+// no identity was reported.
+func (i ClientInfo) Principal(caller string) string {
+	if i.Identity != "" {
+		return i.Identity
+	}
+	return caller
+}
+
+// Names returns the enabled capabilities in sorted order.
+func (c ClientCapabilities) Names() []string {
+	var names []string
+	if c.Elicitation {
+		names = append(names, ClientCapabilityElicitation)
+	}
+	if c.Roots {
+		names = append(names, ClientCapabilityRoots)
+	}
+	if c.Sampling {
+		names = append(names, ClientCapabilitySampling)
+	}
+	return names
+}
+
+// Metadata returns the governance metadata entries for the client info.
+// Empty fields are omitted.
+func (i ClientInfo) Metadata() map[string]string {
+	out := make(map[string]string, 4)
+	if i.Name != "" {
+		out[MetadataClientName] = i.Name
+	}
+	if i.Version != "" {
+		out[MetadataClientVersion] = i.Version
+	}
+	if i.WorkspaceRoot != "" {
+		out[MetadataClientWorkspaceRoot] = i.WorkspaceRoot
+	}
+	if names := i.Capabilities.Names(); len(names) > 0 {
+		out[MetadataClientCapabilities] = strings.Join(names, ",")
+	}
+	return out
+}
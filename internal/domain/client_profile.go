@@ -0,0 +1,119 @@
+package domain
+
+import "path"
+
+// ClientProfile shapes what matching callers see. Profiles are evaluated in
+// order and the first match wins.
+type ClientProfile struct {
+	Name string `json:"name"`
+	// Callers are glob patterns matched against the caller name.
+	Callers []string `json:"callers,omitempty"`
+	// Tags match callers registered with any of these tags.
+	Tags []string `json:"tags,omitempty"`
+	// IncludeServers and ExcludeServers are glob patterns on server names.
+	IncludeServers []string `json:"includeServers,omitempty"`
+	ExcludeServers []string `json:"excludeServers,omitempty"`
+	// IncludeTools and ExcludeTools are glob patterns matched against the
+	// exposed tool name and the upstream tool name.
+	IncludeTools []string `json:"includeTools,omitempty"`
+	ExcludeTools []string `json:"excludeTools,omitempty"`
+	// ToolNamespaceStrategy overrides the runtime strategy when set.
+	ToolNamespaceStrategy ToolNamespaceStrategy `json:"toolNamespaceStrategy,omitempty"`
+	// SubAgent forces SubAgent on or off regardless of subAgent.enabledTags.
+	SubAgent *bool `json:"subAgent,omitempty"`
+}
+
+// MatchClientProfile returns the first profile matching the caller.
+func MatchClientProfile(profiles []ClientProfile, client string, tags []string) (ClientProfile, bool) {
+	for _, profile := range profiles {
+		if profile.Matches(client, tags) {
+			return profile, true
+		}
+	}
+	return ClientProfile{}, false
+}
+
+// ProfilesEnableSubAgent reports whether any profile turns SubAgent on.
+func ProfilesEnableSubAgent(profiles []ClientProfile) bool {
+	for _, profile := range profiles {
+		if profile.SubAgent != nil && *profile.SubAgent {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether the caller name and tags satisfy every selector set
+// on the profile.
+func (p ClientProfile) Matches(client string, tags []string) bool {
+	if len(p.Callers) == 0 && len(p.Tags) == 0 {
+		return false
+	}
+	if len(p.Callers) > 0 && !matchAnyPattern(p.Callers, client) {
+		return false
+	}
+	if len(p.Tags) > 0 && !hasAnyTag(p.Tags, tags) {
+		return false
+	}
+	return true
+}
+
+// AllowsServer reports whether a server passes the include and exclude lists.
+func (p ClientProfile) AllowsServer(name string) bool {
+	return allowedByPatterns(p.IncludeServers, p.ExcludeServers, name)
+}
+
+// AllowsTool reports whether a tool passes the include and exclude lists. Any
+// of the given names may match a pattern.
+func (p ClientProfile) AllowsTool(names ...string) bool {
+	return allowedByPatterns(p.IncludeTools, p.ExcludeTools, names...)
+}
+
+// FiltersTools reports whether the profile restricts the tool set.
+func (p ClientProfile) FiltersTools() bool {
+	return len(p.IncludeTools) > 0 || len(p.ExcludeTools) > 0
+}
+
+func allowedByPatterns(include, exclude []string, names ...string) bool {
+	if len(include) > 0 {
+		included := false
+		for _, name := range names {
+			if matchAnyPattern(include, name) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, name := range names {
+		if matchAnyPattern(exclude, name) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyPattern(patterns []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, value); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyTag(want, have []string) bool {
+	for _, tag := range have {
+		for _, candidate := range want {
+			if tag == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
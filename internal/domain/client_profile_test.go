@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchClientProfile_FirstMatchWins(t *testing.T) {
+	profiles := []ClientProfile{
+		{Name: "cursor-repo", Callers: []string{"cursor*"}, Tags: []string{"repo-x"}},
+		{Name: "cursor", Callers: []string{"cursor*"}},
+		{Name: "batch", Tags: []string{"batch"}},
+	}
+
+	profile, ok := MatchClientProfile(profiles, "cursor-1", []string{"repo-x"})
+	require.True(t, ok)
+	require.Equal(t, "cursor-repo", profile.Name)
+
+	profile, ok = MatchClientProfile(profiles, "cursor-1", nil)
+	require.True(t, ok)
+	require.Equal(t, "cursor", profile.Name)
+
+	profile, ok = MatchClientProfile(profiles, "worker", []string{"batch"})
+	require.True(t, ok)
+	require.Equal(t, "batch", profile.Name)
+
+	_, ok = MatchClientProfile(profiles, "vscode", nil)
+	require.False(t, ok)
+}
+
+func TestClientProfile_AllowsTool(t *testing.T) {
+	profile := ClientProfile{
+		IncludeTools: []string{"github.get_*", "read_*"},
+		ExcludeTools: []string{"*_secret"},
+	}
+
+	require.True(t, profile.AllowsTool("github.get_issue", "get_issue"))
+	require.True(t, profile.AllowsTool("fs.read_file", "read_file"))
+	require.False(t, profile.AllowsTool("github.create_issue", "create_issue"))
+	require.False(t, profile.AllowsTool("fs.read_secret", "read_secret"))
+	require.True(t, ClientProfile{}.AllowsTool("anything"))
+}
+
+func TestClientProfile_AllowsServer(t *testing.T) {
+	profile := ClientProfile{IncludeServers: []string{"github", "fs*"}, ExcludeServers: []string{"fs-write"}}
+
+	require.True(t, profile.AllowsServer("github"))
+	require.True(t, profile.AllowsServer("fs-read"))
+	require.False(t, profile.AllowsServer("fs-write"))
+	require.False(t, profile.AllowsServer("slack"))
+}
@@ -35,6 +35,12 @@ const (
 	DefaultToolNamespaceStrategy = ToolNamespaceStrategyPrefix
 	// DefaultObservabilityListenAddress is the default observability listen address.
 	DefaultObservabilityListenAddress = "0.0.0.0:9090"
+	// DefaultTracingExporter is the default trace exporter.
+	DefaultTracingExporter = TracingExporterOTLPGRPC
+	// DefaultTracingSamplingRatio is the default fraction of root traces sampled.
+	DefaultTracingSamplingRatio = 1.0
+	// DefaultTracingServiceName is the default service.name resource attribute.
+	DefaultTracingServiceName = "mcpv"
 	// DefaultRPCListenAddress is the default RPC listen address.
 	DefaultRPCListenAddress = "unix:///tmp/mcpv.sock"
 	// DefaultRPCMaxRecvMsgSize is the default RPC max receive size in bytes.
@@ -53,6 +59,51 @@ const (
 	DefaultPluginHandshakeTimeoutSeconds = 30
 	// DefaultPluginCallTimeoutMs is the default plugin call timeout in milliseconds.
 	DefaultPluginCallTimeoutMs = 3000
+	// DefaultPluginWasmMemoryLimitMB is the default linear memory limit for wasm plugins in MiB.
+	DefaultPluginWasmMemoryLimitMB = 128
+	// DefaultPluginWasmFuelLimit is the default per-call fuel budget for wasm plugins,
+	// counted in guest function entries plus loop iterations.
+	DefaultPluginWasmFuelLimit = 10_000_000
+	// DefaultAuditMaxSizeMB is the default audit log segment size before rotation in MiB.
+	DefaultAuditMaxSizeMB = 100
+	// DefaultLogStoreMaxSizeMB is the default log file size before rotation in MiB.
+	DefaultLogStoreMaxSizeMB = 10
+	// DefaultLogStoreMaxAgeHours is the default retention of rotated log files in hours.
+	DefaultLogStoreMaxAgeHours = 168
+	// DefaultLogStoreMaxBackups is the default number of rotated files kept per log.
+	DefaultLogStoreMaxBackups = 5
+	// DefaultLogStoreMinLevel is the default minimum level written to disk.
+	DefaultLogStoreMinLevel = LogLevelInfo
+	// DefaultCallHistoryMaxAgeHours is the default call history retention in hours.
+	DefaultCallHistoryMaxAgeHours = 168
+	// DefaultCallHistoryMaxEntries is the default number of retained call records.
+	DefaultCallHistoryMaxEntries = 100_000
+	// DefaultCallHistoryMaxPayloadBytes is the default size limit of stored arguments and results.
+	DefaultCallHistoryMaxPayloadBytes = 16 * 1024
+	// DefaultToolStatsMaxToolsPerServer is the default number of tool labels exported per server.
+	DefaultToolStatsMaxToolsPerServer = 50
+	// DefaultToolSLOWindowMinutes is the default window over which SLO burn rates are computed.
+	DefaultToolSLOWindowMinutes = 60
+	// DefaultAlertSinkTimeoutSeconds is the default timeout of one alert delivery attempt.
+	DefaultAlertSinkTimeoutSeconds = 10
+	// DefaultAlertSinkMaxRetries is the default number of redelivery attempts per alert.
+	DefaultAlertSinkMaxRetries = 3
+	// DefaultAlertSinkRetryBackoffMs is the default delay before the first alert redelivery.
+	DefaultAlertSinkRetryBackoffMs = 500
+	// DefaultAlertSinkDebounceSeconds is the default window in which repeated alerts are dropped.
+	DefaultAlertSinkDebounceSeconds = 60
+	// DefaultAlertRejectionSpikeThreshold is the default rejection count that triggers a spike alert.
+	DefaultAlertRejectionSpikeThreshold = 20
+	// DefaultAlertRejectionSpikeWindowSeconds is the default window for counting rejections.
+	DefaultAlertRejectionSpikeWindowSeconds = 60
+	// DefaultResponseCacheTTLSeconds is the default lifetime of cached responses.
+	DefaultResponseCacheTTLSeconds = 60
+	// DefaultResponseCacheMaxEntries is the default number of cached responses.
+	DefaultResponseCacheMaxEntries = 1000
+	// DefaultResponseCacheMaxBytes is the default total size of cached responses.
+	DefaultResponseCacheMaxBytes = 64 << 20
+	// DefaultResponseCacheMaxEntryBytes is the default size limit of one cached response.
+	DefaultResponseCacheMaxEntryBytes = 1 << 20
 	// InternalUIClientName is the reserved client name used by the UI runtime.
 	InternalUIClientName = "mcpv-ui-internal"
 
@@ -24,6 +24,8 @@ type ToolDefinition struct {
 	Meta         Meta             `json:"meta"`
 	SpecKey      string           `json:"specKey"`
 	ServerName   string           `json:"serverName"`
+	// UpstreamName is the server-side tool name when an override renamed it.
+	UpstreamName string `json:"upstreamName,omitempty"`
 }
 
 // ToolSnapshot is a versioned snapshot of tools.
@@ -37,6 +39,10 @@ type ToolTarget struct {
 	ServerType string
 	SpecKey    string
 	ToolName   string
+	// FixedArgs are merged into call arguments before routing.
+	FixedArgs map[string]any
+	// ReadOnly mirrors the tool's readOnlyHint annotation.
+	ReadOnly bool
 }
 
 // ResourceDefinition describes a resource exposed by a server.
@@ -130,6 +136,21 @@ type LogEntry struct {
 	Level     LogLevel
 	Timestamp time.Time
 	Data      map[string]any
+	// Seq orders entries published by this process; zero when unknown.
+	Seq uint64
+}
+
+// LogQuery filters a log stream. When Since or Until is set, persisted entries
+// in that window are sent first; a zero Until keeps the stream open for live
+// entries afterwards.
+type LogQuery struct {
+	MinLevel LogLevel
+	// Server matches entries logged by or about one server.
+	Server string
+	// Grep is a regular expression matched against the log message.
+	Grep  string
+	Since time.Time
+	Until time.Time
 }
 
 // ActiveClient represents a registered client in the control plane.
@@ -138,7 +159,9 @@ type ActiveClient struct {
 	PID           int
 	Tags          []string
 	Server        string
+	Profile       string
 	LastHeartbeat time.Time
+	Info          ClientInfo
 }
 
 // ActiveClientSnapshot contains a snapshot of active clients.
@@ -166,14 +189,16 @@ type ServerRuntimeStatus struct {
 
 // InstanceStatusInfo represents the status of a single server instance.
 type InstanceStatusInfo struct {
-	ID              string        `json:"id"`
-	State           InstanceState `json:"state"`
-	BusyCount       int           `json:"busyCount"`
-	LastActive      time.Time     `json:"lastActive"`
-	SpawnedAt       time.Time     `json:"spawnedAt"`
-	HandshakedAt    time.Time     `json:"handshakedAt"`
-	LastHeartbeatAt time.Time     `json:"lastHeartbeatAt"`
-	LastStartCause  *StartCause   `json:"lastStartCause"`
+	ID              string            `json:"id"`
+	State           InstanceState     `json:"state"`
+	BusyCount       int               `json:"busyCount"`
+	LastActive      time.Time         `json:"lastActive"`
+	SpawnedAt       time.Time         `json:"spawnedAt"`
+	HandshakedAt    time.Time         `json:"handshakedAt"`
+	LastHeartbeatAt time.Time         `json:"lastHeartbeatAt"`
+	LastStartCause  *StartCause       `json:"lastStartCause"`
+	PID             int               `json:"pid,omitempty"`
+	Resources       *ProcessResources `json:"resources,omitempty"`
 }
 
 // PoolStats contains aggregated statistics for a server pool.
@@ -213,14 +238,17 @@ type InfoAPI interface {
 type ClientRegistration struct {
 	Client             string
 	Tags               []string
+	Profile            string
 	VisibleServerCount int
 }
 
 // RegistryAPI manages client registration and monitoring.
 type RegistryAPI interface {
-	RegisterClient(ctx context.Context, client string, pid int, tags []string, server string) (ClientRegistration, error)
+	RegisterClient(ctx context.Context, client string, pid int, tags []string, server string, info ClientInfo) (ClientRegistration, error)
 	UnregisterClient(ctx context.Context, client string) error
 	ListActiveClients(ctx context.Context) ([]ActiveClient, error)
+	// ClientInfo returns the client info a registered caller reported.
+	ClientInfo(client string) (ClientInfo, bool)
 	WatchActiveClients(ctx context.Context) (<-chan ActiveClientSnapshot, error)
 }
 
@@ -250,8 +278,8 @@ type ServerInitStatusReader interface {
 
 // ObservabilityAPI exposes runtime status and log streaming.
 type ObservabilityAPI interface {
-	StreamLogs(ctx context.Context, client string, minLevel LogLevel) (<-chan LogEntry, error)
-	StreamLogsAllServers(ctx context.Context, minLevel LogLevel) (<-chan LogEntry, error)
+	StreamLogs(ctx context.Context, client string, query LogQuery) (<-chan LogEntry, error)
+	StreamLogsAllServers(ctx context.Context, query LogQuery) (<-chan LogEntry, error)
 	GetPoolStatus(ctx context.Context) ([]PoolInfo, error)
 	ServerInitStatusReader
 	RetryServerInit(ctx context.Context, specKey string) error
@@ -289,6 +317,227 @@ type TasksAPI interface {
 	CancelTask(ctx context.Context, client, taskID string) (Task, error)
 }
 
+// QuotaStatus reports the rate limit and daily quota state of one rule key.
+type QuotaStatus struct {
+	Rule            string
+	Key             string
+	Scope           RateLimitScope
+	Rate            int
+	PeriodSeconds   int
+	Burst           int
+	TokensAvailable float64
+	DailyQuota      int
+	QuotaUsed       int
+	QuotaRemaining  int
+	ResetAt         time.Time
+}
+
+// QuotaAPI exposes rate limit and quota status.
+type QuotaAPI interface {
+	GetQuotaStatus(ctx context.Context, caller string) ([]QuotaStatus, error)
+}
+
+// CallStatus classifies the outcome of a recorded call.
+type CallStatus string
+
+const (
+	// CallStatusOK marks a call that returned a result.
+	CallStatusOK CallStatus = "ok"
+	// CallStatusError marks a call that failed or returned a tool error.
+	CallStatusError CallStatus = "error"
+	// CallStatusRejected marks a call rejected by governance.
+	CallStatusRejected CallStatus = "rejected"
+)
+
+// CallRecord is one entry of the call history journal.
+type CallRecord struct {
+	// ID orders records by time and doubles as a pagination cursor.
+	ID       string
+	Time     time.Time
+	Caller   string
+	Server   string
+	Tool     string
+	Duration time.Duration
+	Status   CallStatus
+	// Reason is the rejection code or error message of a failed call.
+	Reason        string
+	ArgumentBytes int
+	ResultBytes   int
+	// Arguments and Result are set only when payload capture is enabled.
+	Arguments json.RawMessage
+	Result    json.RawMessage
+}
+
+// CallHistoryQuery filters call history. Zero fields match everything.
+type CallHistoryQuery struct {
+	Caller string
+	Server string
+	Tool   string
+	Status CallStatus
+	Since  time.Time
+	Until  time.Time
+	// Cursor continues after the last record of a previous page.
+	Cursor string
+	Limit  int
+}
+
+// CallHistoryPage is one page of call records, newest first.
+type CallHistoryPage struct {
+	Records    []CallRecord
+	NextCursor string
+}
+
+// CallHistoryAPI exposes the persistent call journal.
+type CallHistoryAPI interface {
+	QueryCallHistory(ctx context.Context, query CallHistoryQuery) (CallHistoryPage, error)
+}
+
+// ToolLatencyStats summarizes the calls to one tool since the core started.
+// Percentiles come from an in-process sketch and are approximate.
+type ToolLatencyStats struct {
+	Server string
+	Tool   string
+	Calls  uint64
+	Errors uint64
+	P50    time.Duration
+	P95    time.Duration
+	P99    time.Duration
+	Max    time.Duration
+}
+
+// ToolSLOStatus reports the current burn rate of one tool SLO.
+type ToolSLOStatus struct {
+	Name           string
+	Server         string
+	Tool           string
+	LatencyTarget  time.Duration
+	ErrorBudget    float64
+	Window         time.Duration
+	WindowCalls    uint64
+	WindowBadCalls uint64
+	BurnRate       float64
+}
+
+// ToolStatsQuery filters tool statistics. Zero fields match everything.
+type ToolStatsQuery struct {
+	Server string
+	Tool   string
+}
+
+// ToolStats is a snapshot of per-tool latency statistics and SLO burn rates.
+type ToolStats struct {
+	Tools []ToolLatencyStats
+	SLOs  []ToolSLOStatus
+}
+
+// ToolStatsAPI exposes per-tool latency statistics.
+type ToolStatsAPI interface {
+	GetToolStats(ctx context.Context, query ToolStatsQuery) (ToolStats, error)
+}
+
+// DiagnosticsRedactionMode selects how much sensitive data a diagnostics bundle keeps.
+type DiagnosticsRedactionMode string
+
+const (
+	// DiagnosticsRedactionSafe masks sensitive values and omits captured secrets.
+	DiagnosticsRedactionSafe DiagnosticsRedactionMode = "safe"
+	// DiagnosticsRedactionDeep includes sensitive attributes captured by the diagnostics hub.
+	DiagnosticsRedactionDeep DiagnosticsRedactionMode = "deep"
+)
+
+// DiagnosticsExportOptions selects the contents of a diagnostics bundle.
+// When no Include flag is set every section is included.
+type DiagnosticsExportOptions struct {
+	Mode            DiagnosticsRedactionMode
+	IncludeSnapshot bool
+	IncludeMetrics  bool
+	IncludeLogs     bool
+	IncludeEvents   bool
+	IncludeStuck    bool
+	LogLevel        string
+	MaxLogEntries   int
+	MaxEventEntries int
+	StuckThreshold  time.Duration
+}
+
+// DiagnosticsExport is a diagnostics bundle packed as a zip archive.
+type DiagnosticsExport struct {
+	Archive     []byte
+	GeneratedAt time.Time
+}
+
+// DiagnosticsEvent is a redacted server startup or acquire step event.
+type DiagnosticsEvent struct {
+	Seq        uint64
+	SpecKey    string
+	ServerName string
+	AttemptID  string
+	Step       string
+	Phase      string
+	Timestamp  time.Time
+	Duration   time.Duration
+	Error      string
+	Attributes map[string]string
+}
+
+// DiagnosticsEventQuery filters diagnostics events. Empty filters match every event.
+type DiagnosticsEventQuery struct {
+	SpecKey   string
+	Server    string
+	Step      string
+	Phase     string
+	AttemptID string
+	// Replay sends the matching events still held in the buffer first.
+	Replay bool
+	// ReplayLimit keeps only the latest matching buffered events; 0 keeps all.
+	ReplayLimit int
+	// Follow keeps streaming newly recorded events after the replay.
+	Follow bool
+}
+
+// DiagnosticsAPI exports diagnostics bundles and streams diagnostics events.
+type DiagnosticsAPI interface {
+	ExportDiagnostics(ctx context.Context, opts DiagnosticsExportOptions) (DiagnosticsExport, error)
+	WatchDiagnosticsEvents(ctx context.Context, query DiagnosticsEventQuery) (<-chan DiagnosticsEvent, error)
+}
+
+// Readiness check names.
+const (
+	ReadinessCheckBootstrap       = "bootstrap"
+	ReadinessCheckRequiredPlugins = "requiredPlugins"
+	ReadinessCheckServers         = "servers"
+	ReadinessCheckRPCListener     = "rpcListener"
+)
+
+// ReadinessReport breaks readiness down by the configured criteria. The core
+// is ready when every check is ready.
+type ReadinessReport struct {
+	Ready     bool             `json:"ready"`
+	Checks    []ReadinessCheck `json:"checks"`
+	CheckedAt time.Time        `json:"checkedAt"`
+}
+
+// ReadinessCheck is the outcome of one readiness criterion.
+type ReadinessCheck struct {
+	Name    string `json:"name"`
+	Ready   bool   `json:"ready"`
+	Message string `json:"message,omitempty"`
+	// Components lists the plugins or servers a check covers.
+	Components []ReadinessComponent `json:"components,omitempty"`
+}
+
+// ReadinessComponent is the readiness of a single plugin or server.
+type ReadinessComponent struct {
+	Name    string `json:"name"`
+	Ready   bool   `json:"ready"`
+	Message string `json:"message,omitempty"`
+}
+
+// ReadinessAPI evaluates the configured readiness criteria.
+type ReadinessAPI interface {
+	GetReadiness(ctx context.Context) (ReadinessReport, error)
+}
+
 // StoreAPI exposes profile storage access.
 type StoreAPI interface {
 	GetCatalog() Catalog
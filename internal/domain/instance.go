@@ -9,6 +9,7 @@ type InstanceOptions struct {
 	SpecKey    string
 	State      InstanceState
 	Conn       Conn
+	PID        int
 	SpawnedAt  time.Time
 	LastActive time.Time
 }
@@ -21,6 +22,7 @@ func NewInstance(opts InstanceOptions) *Instance {
 		specKey:    opts.SpecKey,
 		state:      opts.State,
 		conn:       opts.Conn,
+		pid:        opts.PID,
 		spawnedAt:  opts.SpawnedAt,
 		lastActive: opts.LastActive,
 	}
@@ -209,6 +211,27 @@ func (i *Instance) SetLastStartCause(cause *StartCause) {
 	i.lastStartCause = CloneStartCause(cause)
 }
 
+// PID returns the process ID of the instance, or 0 when it has no local process.
+func (i *Instance) PID() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.pid
+}
+
+// Resources returns a copy of the last resource sample.
+func (i *Instance) Resources() *ProcessResources {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return CloneProcessResources(i.resources)
+}
+
+// SetResources updates the last resource sample.
+func (i *Instance) SetResources(resources *ProcessResources) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.resources = CloneProcessResources(resources)
+}
+
 // Info returns a snapshot of instance info.
 func (i *Instance) Info() InstanceInfo {
 	i.mu.RLock()
@@ -222,5 +245,7 @@ func (i *Instance) Info() InstanceInfo {
 		HandshakedAt:    i.handshakedAt,
 		LastHeartbeatAt: i.lastHeartbeatAt,
 		LastStartCause:  CloneStartCause(i.lastStartCause),
+		PID:             i.pid,
+		Resources:       CloneProcessResources(i.resources),
 	}
 }
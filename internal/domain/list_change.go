@@ -10,6 +10,8 @@ const (
 	ListChangeResources ListChangeKind = "resources"
 	// ListChangePrompts indicates a prompt list change.
 	ListChangePrompts ListChangeKind = "prompts"
+	// ListChangeResourceUpdated indicates the contents of one resource changed.
+	ListChangeResourceUpdated ListChangeKind = "resource_updated"
 )
 
 // ListChangeEvent describes a list change for a server.
@@ -17,6 +19,8 @@ type ListChangeEvent struct {
 	Kind       ListChangeKind
 	ServerType string
 	SpecKey    string
+	// URI is set for ListChangeResourceUpdated events.
+	URI string
 }
 
 // ListChangeEmitter emits list change events.
@@ -32,6 +32,8 @@ const (
 	RouteReasonAcquireFailed RouteReason = "acquire_failed"
 	// RouteReasonExecutionFailed indicates tool execution failed.
 	RouteReasonExecutionFailed RouteReason = "execution_failed"
+	// RouteReasonToolError indicates a tool call returned a result with isError set.
+	RouteReasonToolError RouteReason = "tool_error"
 	// RouteReasonUnknown indicates an unknown failure.
 	RouteReasonUnknown RouteReason = "unknown"
 )
@@ -80,9 +82,11 @@ const (
 type RouteMetric struct {
 	ServerType string
 	Client     string
-	Status     RouteStatus
-	Reason     RouteReason
-	Duration   time.Duration
+	// Tool is the tool name of a tools/call route; empty for other methods.
+	Tool     string
+	Status   RouteStatus
+	Reason   RouteReason
+	Duration time.Duration
 }
 
 // ReloadApplyResult describes the outcome of a reload apply.
@@ -147,6 +151,83 @@ type GovernanceRejectionMetric struct {
 	Code     string
 }
 
+// GovernanceRedactionMetric records content masked or rejected by a redaction rule.
+type GovernanceRedactionMetric struct {
+	Plugin string
+	Rule   string
+	Action string
+	Count  int
+}
+
+// RateLimitStateMetric reports the current token and quota levels of one rate limit key.
+type RateLimitStateMetric struct {
+	Rule       string
+	Key        string
+	Tokens     float64
+	QuotaUsed  int
+	QuotaLimit int
+}
+
+// ToolSLOBurnRateMetric reports the current burn rate of one tool SLO.
+type ToolSLOBurnRateMetric struct {
+	Name     string
+	Server   string
+	Tool     string
+	BurnRate float64
+}
+
+// ResponseCacheLookupMetric records one response cache lookup.
+type ResponseCacheLookupMetric struct {
+	Kind   string
+	Server string
+	Result string
+}
+
+// ResponseCacheSizeMetric reports the current size of the response cache.
+type ResponseCacheSizeMetric struct {
+	Entries int
+	Bytes   int
+}
+
+// InstanceResourcesMetric reports the summed resource usage of the sampled
+// instances of one server.
+type InstanceResourcesMetric struct {
+	ServerType string
+	Instances  int
+	CPUTime    time.Duration
+	RSSBytes   int64
+	OpenFDs    int
+	Threads    int
+}
+
+// IdleReapSavingsMetric reports resource-seconds saved by idle reaping since
+// the previous report.
+type IdleReapSavingsMetric struct {
+	ServerType      string
+	InstanceSeconds float64
+	RSSByteSeconds  float64
+}
+
+// AlertDeliveryResult describes the final outcome of delivering an alert to a sink.
+type AlertDeliveryResult string
+
+const (
+	// AlertDeliveryResultSuccess indicates the sink accepted the alert.
+	AlertDeliveryResultSuccess AlertDeliveryResult = "success"
+	// AlertDeliveryResultFailure indicates every delivery attempt failed.
+	AlertDeliveryResultFailure AlertDeliveryResult = "failure"
+)
+
+// AlertSuppressReason describes why an alert was not delivered to a sink.
+type AlertSuppressReason string
+
+const (
+	// AlertSuppressDebounce indicates a repeat within the sink debounce window.
+	AlertSuppressDebounce AlertSuppressReason = "debounce"
+	// AlertSuppressQueueFull indicates the sink queue was full.
+	AlertSuppressQueueFull AlertSuppressReason = "queue_full"
+)
+
 // Metrics records operational metrics for routing and instances.
 type Metrics interface {
 	ObserveRoute(metric RouteMetric)
@@ -159,6 +240,8 @@ type Metrics interface {
 	SetActiveInstances(serverType string, count int)
 	SetPoolCapacityRatio(serverType string, ratio float64)
 	SetPoolWaiters(serverType string, count int)
+	SetInstanceResources(metric InstanceResourcesMetric)
+	AddIdleReapSavings(metric IdleReapSavingsMetric)
 	ObservePoolAcquireFailure(serverType string, reason AcquireFailureReason)
 	ObserveSubAgentTokens(provider string, model string, tokens int)
 	ObserveSubAgentLatency(provider string, model string, duration time.Duration)
@@ -170,9 +253,20 @@ type Metrics interface {
 	ObserveReloadRollback(metric ReloadRollbackMetric)
 	RecordGovernanceOutcome(metric GovernanceOutcomeMetric)
 	RecordGovernanceRejection(metric GovernanceRejectionMetric)
+	RecordGovernanceRedaction(metric GovernanceRedactionMetric)
+	SetRateLimitState(metric RateLimitStateMetric)
+	DeleteRateLimitState(rule, key string)
+	SetToolSLOBurnRate(metric ToolSLOBurnRateMetric)
+	RecordResponseCacheLookup(metric ResponseCacheLookupMetric)
+	SetResponseCacheSize(metric ResponseCacheSizeMetric)
+	AddSuppressedToolListChanges(count int)
 	RecordPluginStart(metric PluginStartMetric)
 	RecordPluginHandshake(metric PluginHandshakeMetric)
 	SetPluginRunning(category PluginCategory, name string, running bool)
+	RecordAlertDelivery(sink string, result AlertDeliveryResult)
+	ObserveAlertDeliveryAttempt(sink string, duration time.Duration)
+	RecordAlertRetry(sink string)
+	RecordAlertSuppressed(sink string, reason AlertSuppressReason)
 }
 
 // PluginStartMetric tracks plugin startup/shutdown results.
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // PluginCategory defines the governance category for a plugin.
@@ -27,13 +28,27 @@ const (
 	PluginFlowResponse PluginFlow = "response"
 )
 
-// PluginSpec defines a governance plugin process.
+// PluginRuntime selects how a governance plugin is executed.
+type PluginRuntime string
+
+const (
+	// PluginRuntimeProcess runs the plugin as a child process speaking gRPC over a Unix socket.
+	PluginRuntimeProcess PluginRuntime = "process"
+	// PluginRuntimeWasm loads the plugin as an in-process WebAssembly module.
+	PluginRuntimeWasm PluginRuntime = "wasm"
+)
+
+// PluginSpec defines a governance plugin process or WebAssembly module.
 type PluginSpec struct {
 	Name               string            `json:"name"`
 	Category           PluginCategory    `json:"category"`
 	Required           bool              `json:"required"`
 	Disabled           bool              `json:"disabled,omitempty"`
+	Runtime            PluginRuntime     `json:"runtime,omitempty"`
 	Cmd                []string          `json:"cmd"`
+	Module             string            `json:"module,omitempty"`
+	MemoryLimitMB      int               `json:"memoryLimitMb,omitempty"`
+	FuelLimit          int64             `json:"fuelLimit,omitempty"`
 	Env                map[string]string `json:"env,omitempty"`
 	Cwd                string            `json:"cwd,omitempty"`
 	CommitHash         string            `json:"commitHash,omitempty"`
@@ -67,14 +82,45 @@ type GovernanceDecision struct {
 	ResponseJSON  json.RawMessage
 	RejectCode    string
 	RejectMessage string
+	// RetryAfter hints when a rejected request may succeed if retried.
+	RetryAfter time.Duration
+	// Metadata holds keys to add or overwrite in GovernanceRequest.Metadata.
+	Metadata map[string]string
+	// RemoveMetadata lists keys to delete from GovernanceRequest.Metadata.
+	RemoveMetadata []string
+}
+
+// HasMetadataMutations reports whether the decision changes request metadata.
+func (d GovernanceDecision) HasMetadataMutations() bool {
+	return len(d.Metadata) > 0 || len(d.RemoveMetadata) > 0
+}
+
+// ApplyMetadata returns a copy of metadata with the decision's removals and
+// then its additions applied. The input map is not modified.
+func (d GovernanceDecision) ApplyMetadata(metadata map[string]string) map[string]string {
+	if !d.HasMetadataMutations() {
+		return metadata
+	}
+	updated := make(map[string]string, len(metadata)+len(d.Metadata))
+	for key, value := range metadata {
+		updated[key] = value
+	}
+	for _, key := range d.RemoveMetadata {
+		delete(updated, key)
+	}
+	for key, value := range d.Metadata {
+		updated[key] = value
+	}
+	return updated
 }
 
 // GovernanceRejection represents a plugin rejection with MCP-facing details.
 type GovernanceRejection struct {
-	Category PluginCategory
-	Plugin   string
-	Code     string
-	Message  string
+	Category   PluginCategory
+	Plugin     string
+	Code       string
+	Message    string
+	RetryAfter time.Duration
 }
 
 func (g GovernanceRejection) Error() string {
@@ -105,6 +151,19 @@ func NormalizePluginCategory(raw string) (PluginCategory, bool) {
 	}
 }
 
+// NormalizePluginRuntime ensures a runtime is valid; empty means process.
+func NormalizePluginRuntime(raw string) (PluginRuntime, bool) {
+	value := PluginRuntime(strings.ToLower(strings.TrimSpace(raw)))
+	switch value {
+	case "":
+		return PluginRuntimeProcess, true
+	case PluginRuntimeProcess, PluginRuntimeWasm:
+		return value, true
+	default:
+		return "", false
+	}
+}
+
 // NormalizePluginFlows normalizes plugin flows; empty means both request and response.
 func NormalizePluginFlows(raw []string) ([]PluginFlow, bool) {
 	if len(raw) == 0 {
@@ -0,0 +1,24 @@
+package domain
+
+import "context"
+
+// Root is a location the downstream client exposes to servers through
+// roots/list.
+type Root struct {
+	URI  string `json:"uri"`
+	Name string `json:"name,omitempty"`
+}
+
+// RootsStore records the roots each caller reported.
+type RootsStore interface {
+	SetCallerRoots(caller string, roots []Root)
+	RemoveCaller(caller string)
+}
+
+// RootsProvider answers roots/list requests from upstream servers with the
+// roots of the caller an instance serves.
+type RootsProvider interface {
+	CallerRoots(caller string) []Root
+	// SubscribeRootsChanges streams the names of callers whose roots changed.
+	SubscribeRootsChanges(ctx context.Context) <-chan string
+}
@@ -2,12 +2,45 @@ package domain
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
 // RouteContext carries client metadata for routing.
 type RouteContext struct {
 	Client string
+	// Identity is the principal the client acts for, when it reported one.
+	Identity string
+	// VisibleSpecKeys limits nested calls, such as virtual tool steps, to the
+	// servers the client may see. Nil leaves nested calls unrestricted.
+	VisibleSpecKeys map[string]struct{}
+	// Profile carries the client profile whose tool filters apply to nested
+	// calls. The zero profile allows every tool.
+	Profile ClientProfile
+}
+
+// SpecVisible reports whether the client may reach the server with specKey.
+func (r RouteContext) SpecVisible(specKey string) bool {
+	if r.VisibleSpecKeys == nil {
+		return true
+	}
+	_, ok := r.VisibleSpecKeys[specKey]
+	return ok
+}
+
+// ToolAllowed reports whether the client's profile lets nested calls reach a
+// tool known by any of names.
+func (r RouteContext) ToolAllowed(names ...string) bool {
+	return r.Profile.AllowsTool(names...)
+}
+
+// Principal returns the identity metrics and nested governance attribute the
+// call to, falling back to the client name.
+func (r RouteContext) Principal() string {
+	if r.Identity != "" {
+		return r.Identity
+	}
+	return r.Client
 }
 
 type routeContextKey struct{}
@@ -45,6 +78,26 @@ type StartCause struct {
 
 type startCauseKey struct{}
 
+// RouteTrace records the server that handled a routed request so callers above
+// the router (governance, auditing) can attribute the call.
+type RouteTrace struct {
+	mu         sync.Mutex
+	serverType string
+	specKey    string
+}
+
+type routeTraceKey struct{}
+
+// Target returns the last recorded server type and spec key.
+func (t *RouteTrace) Target() (serverType, specKey string) {
+	if t == nil {
+		return "", ""
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.serverType, t.specKey
+}
+
 // WithRouteContext attaches routing metadata to a context.
 func WithRouteContext(ctx context.Context, meta RouteContext) context.Context {
 	if ctx == nil {
@@ -91,3 +144,83 @@ func CloneStartCause(cause *StartCause) *StartCause {
 	}
 	return &copyCause
 }
+
+// WithRouteTrace attaches a fresh route trace to a context.
+func WithRouteTrace(ctx context.Context) (context.Context, *RouteTrace) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	trace := &RouteTrace{}
+	return context.WithValue(ctx, routeTraceKey{}, trace), trace
+}
+
+// RecordRouteTarget stores the routed server on the context trace, if any.
+func RecordRouteTarget(ctx context.Context, serverType, specKey string) {
+	if ctx == nil {
+		return
+	}
+	trace, ok := ctx.Value(routeTraceKey{}).(*RouteTrace)
+	if !ok || trace == nil {
+		return
+	}
+	trace.mu.Lock()
+	trace.serverType = serverType
+	trace.specKey = specKey
+	trace.mu.Unlock()
+}
+
+type upstreamMetaKey struct{}
+
+// WithUpstreamMeta attaches governance metadata that the router injects into
+// the upstream request's params._meta.
+func WithUpstreamMeta(ctx context.Context, meta map[string]string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(meta) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, upstreamMetaKey{}, meta)
+}
+
+// UpstreamMetaFromContext returns metadata to inject into upstream requests.
+func UpstreamMetaFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	meta, _ := ctx.Value(upstreamMetaKey{}).(map[string]string)
+	return meta
+}
+
+const (
+	// CacheBypassMetaKey is the request _meta key callers set to "bypass" to
+	// skip the response cache for one call.
+	CacheBypassMetaKey = "mcpv/cache"
+	// CacheBypassMetaValue is the _meta value that requests a cache bypass.
+	CacheBypassMetaValue = "bypass"
+)
+
+type cacheBypassKey struct{}
+
+// WithCacheBypass marks a request to skip response cache lookups.
+func WithCacheBypass(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+// CacheBypassFromContext reports whether a request asked to skip the response cache.
+func CacheBypassFromContext(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// CacheBypassRequested reports whether request _meta asks to skip the response cache.
+func CacheBypassRequested(meta map[string]any) bool {
+	value, _ := meta[CacheBypassMetaKey].(string)
+	return value == CacheBypassMetaValue
+}
@@ -64,15 +64,60 @@ func DiffRuntimeConfig(prev, next RuntimeConfig) RuntimeDiff {
 	if !reflect.DeepEqual(prev.Proxy, next.Proxy) {
 		diff.DynamicFields = append(diff.DynamicFields, "proxy")
 	}
-	if !reflect.DeepEqual(prev.Observability, next.Observability) {
+	// Tool stats limits and SLOs are read when metrics and the tracker are
+	// built, and the tracing service name is baked into the tracer resource,
+	// so they need a restart; the rest of observability is reapplied.
+	prevObservability, nextObservability := prev.Observability, next.Observability
+	prevObservability.ToolStats, nextObservability.ToolStats = ToolStatsConfig{}, ToolStatsConfig{}
+	prevObservability.Tracing.ServiceName, nextObservability.Tracing.ServiceName = "", ""
+	if !reflect.DeepEqual(prevObservability, nextObservability) {
 		diff.DynamicFields = append(diff.DynamicFields, "observability")
 	}
+	if !reflect.DeepEqual(prev.Observability.ToolStats, next.Observability.ToolStats) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "observability.toolStats")
+	}
+	if prev.Observability.Tracing.ServiceName != next.Observability.Tracing.ServiceName {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "observability.tracing.serviceName")
+	}
+	if !reflect.DeepEqual(prev.VirtualTools, next.VirtualTools) {
+		diff.DynamicFields = append(diff.DynamicFields, "virtualTools")
+	}
+	if !reflect.DeepEqual(prev.Clients, next.Clients) {
+		diff.DynamicFields = append(diff.DynamicFields, "clients")
+	}
+	if !reflect.DeepEqual(prev.AdminTools, next.AdminTools) {
+		diff.DynamicFields = append(diff.DynamicFields, "adminTools")
+	}
 	if !reflect.DeepEqual(prev.RPC, next.RPC) {
 		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "rpc")
 	}
 	if !reflect.DeepEqual(prev.SubAgent, next.SubAgent) {
 		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "subAgent")
 	}
+	if !reflect.DeepEqual(prev.Audit, next.Audit) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "audit")
+	}
+	if !reflect.DeepEqual(prev.Redaction, next.Redaction) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "redaction")
+	}
+	if !reflect.DeepEqual(prev.Governance, next.Governance) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "governance")
+	}
+	if !reflect.DeepEqual(prev.RateLimits, next.RateLimits) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "rateLimits")
+	}
+	if !reflect.DeepEqual(prev.ResponseCache, next.ResponseCache) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "responseCache")
+	}
+	if !reflect.DeepEqual(prev.Alerts, next.Alerts) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "alerts")
+	}
+	if !reflect.DeepEqual(prev.CallHistory, next.CallHistory) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "callHistory")
+	}
+	if !reflect.DeepEqual(prev.LogStore, next.LogStore) {
+		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "logStore")
+	}
 	if prev.BootstrapMode != next.BootstrapMode {
 		diff.RestartRequiredFields = append(diff.RestartRequiredFields, "bootstrapMode")
 	}
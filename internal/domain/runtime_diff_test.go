@@ -44,6 +44,9 @@ func TestDiffRuntimeConfig_RestartRequiredFields(t *testing.T) {
 		BootstrapConcurrency:    2,
 		BootstrapTimeoutSeconds: 10,
 		DefaultActivationMode:   ActivationAlwaysOn,
+		LogStore:                LogStoreConfig{Enabled: true, Dir: "/var/log/mcpv"},
+		CallHistory:             CallHistoryConfig{Enabled: true, Path: "/var/lib/mcpv/calls.jsonl"},
+		Alerts:                  AlertsConfig{Enabled: true},
 	}
 	next := prev
 	next.Observability.ListenAddress = "127.0.0.1:9091"
@@ -53,6 +56,11 @@ func TestDiffRuntimeConfig_RestartRequiredFields(t *testing.T) {
 	next.BootstrapConcurrency = 4
 	next.BootstrapTimeoutSeconds = 20
 	next.DefaultActivationMode = ActivationOnDemand
+	next.LogStore.Dir = "/tmp/mcpv-logs"
+	next.CallHistory.Enabled = false
+	next.Alerts.Enabled = false
+	next.Observability.ToolStats.MaxToolsPerServer = 10
+	next.Observability.Tracing.ServiceName = "mcpv-edge"
 
 	diff := DiffRuntimeConfig(prev, next)
 
@@ -62,5 +70,10 @@ func TestDiffRuntimeConfig_RestartRequiredFields(t *testing.T) {
 	require.Contains(t, diff.RestartRequiredFields, "bootstrapConcurrency")
 	require.Contains(t, diff.RestartRequiredFields, "bootstrapTimeoutSeconds")
 	require.Contains(t, diff.RestartRequiredFields, "defaultActivationMode")
+	require.Contains(t, diff.RestartRequiredFields, "logStore")
+	require.Contains(t, diff.RestartRequiredFields, "callHistory")
+	require.Contains(t, diff.RestartRequiredFields, "alerts")
+	require.Contains(t, diff.RestartRequiredFields, "observability.toolStats")
+	require.Contains(t, diff.RestartRequiredFields, "observability.tracing.serviceName")
 	require.True(t, diff.RequiresRestart())
 }
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// ToolOverride adjusts how a single upstream tool is exposed.
+type ToolOverride struct {
+	// Name replaces the upstream tool name.
+	Name string `json:"name,omitempty"`
+	// Aliases expose the same tool under additional names.
+	Aliases           []string `json:"aliases,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	AppendDescription string   `json:"appendDescription,omitempty"`
+	Title             string   `json:"title,omitempty"`
+	AppendTitle       string   `json:"appendTitle,omitempty"`
+	// FixedArgs hides input-schema properties and always sends these values upstream.
+	FixedArgs map[string]any `json:"fixedArgs,omitempty"`
+}
+
+// ToolOverrideFor returns the override configured for an upstream tool.
+func (s ServerSpec) ToolOverrideFor(upstream string) (ToolOverride, bool) {
+	if len(s.Tools) == 0 {
+		return ToolOverride{}, false
+	}
+	override, ok := s.Tools[upstream]
+	return override, ok
+}
+
+// ExposedToolNames returns the names a tool is exposed under, primary name first.
+func (o ToolOverride) ExposedToolNames(upstream string) []string {
+	primary := upstream
+	if o.Name != "" {
+		primary = o.Name
+	}
+	names := []string{primary}
+	seen := map[string]struct{}{primary: {}}
+	for _, alias := range o.Aliases {
+		if _, dup := seen[alias]; dup || alias == "" {
+			continue
+		}
+		seen[alias] = struct{}{}
+		names = append(names, alias)
+	}
+	return names
+}
+
+// ApplyToolOverride rewrites an upstream tool definition according to the spec.
+// The result holds one definition per exposed name, each carrying the upstream
+// name so calls can be mapped back.
+func ApplyToolOverride(spec ServerSpec, def ToolDefinition) []ToolDefinition {
+	upstream := def.UpstreamToolName()
+	def.UpstreamName = upstream
+	override, ok := spec.ToolOverrideFor(upstream)
+	if !ok {
+		def.Name = upstream
+		return []ToolDefinition{def}
+	}
+
+	if override.Description != "" {
+		def.Description = override.Description
+	}
+	def.Description = appendText(def.Description, override.AppendDescription)
+	if override.Title != "" {
+		def.Title = override.Title
+	}
+	def.Title = appendText(def.Title, override.AppendTitle)
+	if len(override.FixedArgs) > 0 {
+		def.InputSchema = hideSchemaProperties(def.InputSchema, override.FixedArgs)
+	}
+
+	names := override.ExposedToolNames(upstream)
+	result := make([]ToolDefinition, 0, len(names))
+	for i, name := range names {
+		exposed := def
+		if i > 0 {
+			exposed = CloneToolDefinition(def)
+		}
+		exposed.Name = name
+		result = append(result, exposed)
+	}
+	return result
+}
+
+// ApplyToolOverrides applies the spec's overrides to a server's tools. A
+// renamed or aliased name that equals a tool exposed under its own upstream
+// name is skipped, as is any later duplicate in upstream name order, so no
+// tool shadows another. Skipped definitions are returned for reporting.
+func ApplyToolOverrides(spec ServerSpec, defs []ToolDefinition) (exposed, skipped []ToolDefinition) {
+	ordered := append([]ToolDefinition(nil), defs...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].UpstreamToolName() < ordered[j].UpstreamToolName()
+	})
+	candidates := make([][]ToolDefinition, len(ordered))
+	ownNames := make(map[string]struct{}, len(ordered))
+	for i, def := range ordered {
+		candidates[i] = ApplyToolOverride(spec, def)
+		for _, candidate := range candidates[i] {
+			if candidate.Name == candidate.UpstreamName {
+				ownNames[candidate.Name] = struct{}{}
+			}
+		}
+	}
+
+	exposed = make([]ToolDefinition, 0, len(ordered))
+	taken := make(map[string]struct{}, len(ordered))
+	for _, tool := range candidates {
+		for _, def := range tool {
+			_, shadows := ownNames[def.Name]
+			if _, dup := taken[def.Name]; dup || (shadows && def.Name != def.UpstreamName) {
+				skipped = append(skipped, def)
+				continue
+			}
+			taken[def.Name] = struct{}{}
+			exposed = append(exposed, def)
+		}
+	}
+	return exposed, skipped
+}
+
+// UpstreamToolName returns the name the owning server knows the tool by.
+func (t ToolDefinition) UpstreamToolName() string {
+	if t.UpstreamName != "" {
+		return t.UpstreamName
+	}
+	return t.Name
+}
+
+// ApplyFixedArgs merges fixed argument values into a tools/call argument object.
+// Fixed values always win over caller-supplied ones.
+func ApplyFixedArgs(args json.RawMessage, fixed map[string]any) (json.RawMessage, error) {
+	if len(fixed) == 0 {
+		return args, nil
+	}
+	merged := make(map[string]any)
+	trimmed := strings.TrimSpace(string(args))
+	if trimmed != "" && trimmed != "null" {
+		if err := json.Unmarshal(args, &merged); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range fixed {
+		merged[key] = value
+	}
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func appendText(base, suffix string) string {
+	if suffix == "" {
+		return base
+	}
+	if base == "" {
+		return suffix
+	}
+	return base + " " + suffix
+}
+
+func hideSchemaProperties(schema any, hidden map[string]any) any {
+	obj, ok := schemaObject(schema)
+	if !ok {
+		return schema
+	}
+	if props, ok := obj["properties"].(map[string]any); ok {
+		for key := range hidden {
+			delete(props, key)
+		}
+	}
+	var required []any
+	switch typed := obj["required"].(type) {
+	case []any:
+		required = typed
+	case []string:
+		for _, name := range typed {
+			required = append(required, name)
+		}
+	}
+	if required != nil {
+		kept := make([]any, 0, len(required))
+		for _, item := range required {
+			if name, ok := item.(string); ok {
+				if _, hide := hidden[name]; hide {
+					continue
+				}
+			}
+			kept = append(kept, item)
+		}
+		if len(kept) == 0 {
+			delete(obj, "required")
+		} else {
+			obj["required"] = kept
+		}
+	}
+	return obj
+}
+
+func schemaObject(schema any) (map[string]any, bool) {
+	switch typed := schema.(type) {
+	case nil:
+		return nil, false
+	case map[string]any:
+		out, _ := CloneJSONValue(typed).(map[string]any)
+		return out, true
+	default:
+		raw, err := json.Marshal(typed)
+		if err != nil {
+			return nil, false
+		}
+		var out map[string]any
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return nil, false
+		}
+		return out, true
+	}
+}
+
+// SortedToolOverrideNames returns the upstream tool names with overrides.
+func SortedToolOverrideNames(overrides map[string]ToolOverride) []string {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyToolOverride_WithoutOverrideKeepsName(t *testing.T) {
+	defs := ApplyToolOverride(ServerSpec{}, ToolDefinition{Name: "echo", Description: "echo"})
+	require.Len(t, defs, 1)
+	require.Equal(t, "echo", defs[0].Name)
+	require.Equal(t, "echo", defs[0].UpstreamName)
+}
+
+func TestApplyToolOverride_RenamesAndAppendsTitle(t *testing.T) {
+	spec := ServerSpec{Tools: map[string]ToolOverride{
+		"create_issue": {Name: "file_bug", AppendTitle: "(bugs)"},
+	}}
+	first := ApplyToolOverride(spec, ToolDefinition{Name: "create_issue", Title: "Create"})
+	require.Len(t, first, 1)
+	require.Equal(t, "file_bug", first[0].Name)
+	require.Equal(t, "create_issue", first[0].UpstreamName)
+	require.Equal(t, "Create (bugs)", first[0].Title)
+}
+
+func TestApplyFixedArgs(t *testing.T) {
+	merged, err := ApplyFixedArgs(json.RawMessage(`{"title":"x","owner":"evil"}`), map[string]any{"owner": "acme"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"title":"x","owner":"acme"}`, string(merged))
+
+	merged, err = ApplyFixedArgs(nil, map[string]any{"owner": "acme"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"owner":"acme"}`, string(merged))
+
+	unchanged, err := ApplyFixedArgs(json.RawMessage(`{"a":1}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":1}`, string(unchanged))
+
+	_, err = ApplyFixedArgs(json.RawMessage(`[1]`), map[string]any{"owner": "acme"})
+	require.Error(t, err)
+}
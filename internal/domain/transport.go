@@ -10,6 +10,8 @@ import (
 type IOStreams struct {
 	Reader io.ReadCloser
 	Writer io.WriteCloser
+	// PID is the launched process ID, or 0 when the launcher has none.
+	PID int
 }
 
 // Conn represents an active transport connection.
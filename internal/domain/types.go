@@ -96,23 +96,24 @@ type StreamableHTTPConfig struct {
 
 // ServerSpec declares how to run and connect to a server.
 type ServerSpec struct {
-	Name                string                `json:"name"`
-	Transport           TransportKind         `json:"transport"`
-	Cmd                 []string              `json:"cmd"`
-	Env                 map[string]string     `json:"env,omitempty"`
-	Cwd                 string                `json:"cwd,omitempty"`
-	Tags                []string              `json:"tags,omitempty"`
-	IdleSeconds         int                   `json:"idleSeconds"`
-	MaxConcurrent       int                   `json:"maxConcurrent"`
-	Strategy            InstanceStrategy      `json:"strategy"`
-	SessionTTLSeconds   int                   `json:"sessionTTLSeconds,omitempty"`
-	Disabled            bool                  `json:"disabled,omitempty"`
-	MinReady            int                   `json:"minReady"`
-	ActivationMode      ActivationMode        `json:"activationMode"`
-	DrainTimeoutSeconds int                   `json:"drainTimeoutSeconds"`
-	ProtocolVersion     string                `json:"protocolVersion"`
-	ExposeTools         []string              `json:"exposeTools,omitempty"`
-	HTTP                *StreamableHTTPConfig `json:"http,omitempty"`
+	Name                string                  `json:"name"`
+	Transport           TransportKind           `json:"transport"`
+	Cmd                 []string                `json:"cmd"`
+	Env                 map[string]string       `json:"env,omitempty"`
+	Cwd                 string                  `json:"cwd,omitempty"`
+	Tags                []string                `json:"tags,omitempty"`
+	IdleSeconds         int                     `json:"idleSeconds"`
+	MaxConcurrent       int                     `json:"maxConcurrent"`
+	Strategy            InstanceStrategy        `json:"strategy"`
+	SessionTTLSeconds   int                     `json:"sessionTTLSeconds,omitempty"`
+	Disabled            bool                    `json:"disabled,omitempty"`
+	MinReady            int                     `json:"minReady"`
+	ActivationMode      ActivationMode          `json:"activationMode"`
+	DrainTimeoutSeconds int                     `json:"drainTimeoutSeconds"`
+	ProtocolVersion     string                  `json:"protocolVersion"`
+	ExposeTools         []string                `json:"exposeTools,omitempty"`
+	Tools               map[string]ToolOverride `json:"tools,omitempty"`
+	HTTP                *StreamableHTTPConfig   `json:"http,omitempty"`
 }
 
 // RuntimeConfig defines runtime-level settings for orchestration.
@@ -131,8 +132,19 @@ type RuntimeConfig struct {
 	ToolNamespaceStrategy      ToolNamespaceStrategy `json:"toolNamespaceStrategy"`
 	Proxy                      ProxyConfig           `json:"proxy,omitempty"`
 	Observability              ObservabilityConfig   `json:"observability"`
+	LogStore                   LogStoreConfig        `json:"logStore"`
 	RPC                        RPCConfig             `json:"rpc"`
 	SubAgent                   SubAgentConfig        `json:"subAgent"`
+	Audit                      AuditConfig           `json:"audit"`
+	CallHistory                CallHistoryConfig     `json:"callHistory"`
+	Redaction                  RedactionConfig       `json:"redaction"`
+	RateLimits                 RateLimitConfig       `json:"rateLimits"`
+	ResponseCache              ResponseCacheConfig   `json:"responseCache"`
+	Alerts                     AlertsConfig          `json:"alerts"`
+	Governance                 GovernanceConfig      `json:"governance"`
+	VirtualTools               []VirtualToolConfig   `json:"virtualTools,omitempty"`
+	Clients                    []ClientProfile       `json:"clients,omitempty"`
+	AdminTools                 AdminToolsConfig      `json:"adminTools"`
 
 	// Bootstrap configuration
 	BootstrapMode           BootstrapMode  `json:"bootstrapMode"`           // "metadata" or "disabled", default "metadata"
@@ -143,9 +155,210 @@ type RuntimeConfig struct {
 
 // ObservabilityConfig controls runtime observability endpoints.
 type ObservabilityConfig struct {
-	ListenAddress  string `json:"listenAddress"`
-	MetricsEnabled *bool  `json:"metricsEnabled,omitempty"`
-	HealthzEnabled *bool  `json:"healthzEnabled,omitempty"`
+	ListenAddress  string                   `json:"listenAddress"`
+	MetricsEnabled *bool                    `json:"metricsEnabled,omitempty"`
+	HealthzEnabled *bool                    `json:"healthzEnabled,omitempty"`
+	Tracing        TracingConfig            `json:"tracing"`
+	ToolStats      ToolStatsConfig          `json:"toolStats"`
+	Debug          ObservabilityDebugConfig `json:"debug"`
+	Readiness      ReadinessConfig          `json:"readiness"`
+}
+
+// ReadinessConfig selects the criteria evaluated by /readyz.
+type ReadinessConfig struct {
+	// Bootstrap requires the bootstrap to have completed.
+	Bootstrap bool `json:"bootstrap"`
+	// RequiredPlugins requires every enabled plugin marked required to be running.
+	RequiredPlugins bool `json:"requiredPlugins"`
+	// RPCListener requires the control plane RPC listener to be serving.
+	RPCListener bool `json:"rpcListener"`
+	// Servers lists the servers that must have reached minReady, and at least
+	// one ready instance when minReady is 0.
+	Servers []string `json:"servers,omitempty"`
+}
+
+// ObservabilityDebugConfig enables runtime introspection endpoints on the
+// observability listener. Both endpoints require the bearer token.
+type ObservabilityDebugConfig struct {
+	// PprofEnabled serves the Go profiler under /debug/pprof/.
+	PprofEnabled bool `json:"pprofEnabled"`
+	// StateEnabled serves a JSON dump of the core state under /debug/state.
+	StateEnabled bool `json:"stateEnabled"`
+	// DeepDiagnosticsEnabled allows diagnostics bundles in deep mode, which
+	// keep server env, config secrets and other sensitive attributes.
+	DeepDiagnosticsEnabled bool   `json:"deepDiagnosticsEnabled"`
+	Token                  string `json:"token,omitempty"`
+	TokenEnv               string `json:"tokenEnv,omitempty"`
+}
+
+// ToolStatsConfig configures per-tool latency metrics and SLOs.
+type ToolStatsConfig struct {
+	// MaxToolsPerServer caps the tool label values of route metrics per
+	// server. Tools outside the most called ones are reported as "_other".
+	MaxToolsPerServer int             `json:"maxToolsPerServer"`
+	SLOs              []ToolSLOConfig `json:"slos,omitempty"`
+}
+
+// ToolSLOConfig declares a latency target and error budget for the calls
+// matched by Server and Tool; empty selectors match every server or tool.
+// A call is bad when it fails or is slower than the latency target.
+type ToolSLOConfig struct {
+	Name   string `json:"name"`
+	Server string `json:"server,omitempty"`
+	Tool   string `json:"tool,omitempty"`
+	// LatencyTargetMs of zero counts only failed calls as bad.
+	LatencyTargetMs int `json:"latencyTargetMs"`
+	// ErrorBudget is the fraction of bad calls allowed, for example 0.01.
+	ErrorBudget   float64 `json:"errorBudget"`
+	WindowMinutes int     `json:"windowMinutes"`
+}
+
+// LogStoreConfig configures rotated on-disk log files for the core and
+// each upstream server.
+type LogStoreConfig struct {
+	Enabled bool   `json:"enabled"`
+	Dir     string `json:"dir"`
+	// MaxSizeMB rotates a log file once it grows past this size.
+	MaxSizeMB int `json:"maxSizeMb"`
+	// MaxAgeHours deletes rotated files older than this.
+	MaxAgeHours int `json:"maxAgeHours"`
+	// MaxBackups limits rotated files kept per log.
+	MaxBackups int      `json:"maxBackups"`
+	MinLevel   LogLevel `json:"minLevel"`
+}
+
+// TracingExporter selects the OTLP protocol used to export spans.
+type TracingExporter string
+
+const (
+	// TracingExporterOTLPGRPC exports spans with OTLP over gRPC.
+	TracingExporterOTLPGRPC TracingExporter = "otlp-grpc"
+	// TracingExporterOTLPHTTP exports spans with OTLP over HTTP.
+	TracingExporterOTLPHTTP TracingExporter = "otlp-http"
+)
+
+// TracingConfig configures OpenTelemetry tracing.
+type TracingConfig struct {
+	Enabled  bool            `json:"enabled"`
+	Exporter TracingExporter `json:"exporter"`
+	// Endpoint is host:port or a URL; empty uses the exporter's default and
+	// the standard OTEL_EXPORTER_OTLP_* environment variables.
+	Endpoint      string            `json:"endpoint,omitempty"`
+	Insecure      bool              `json:"insecure"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	SamplingRatio float64           `json:"samplingRatio"`
+	ServiceName   string            `json:"serviceName"`
+}
+
+// AuditConfig configures the built-in hash-chained audit log.
+type AuditConfig struct {
+	Enabled          bool     `json:"enabled"`
+	Path             string   `json:"path"`
+	MaxSizeMB        int      `json:"maxSizeMb"`
+	MaxAgeHours      int      `json:"maxAgeHours"`
+	MaxBackups       int      `json:"maxBackups"`
+	IncludeArguments bool     `json:"includeArguments"`
+	RedactKeys       []string `json:"redactKeys,omitempty"`
+}
+
+// CallHistoryConfig configures the persistent call journal.
+type CallHistoryConfig struct {
+	Enabled bool   `json:"enabled"`
+	Path    string `json:"path"`
+	// MaxAgeHours prunes older records; zero keeps records forever.
+	MaxAgeHours int `json:"maxAgeHours"`
+	// MaxEntries prunes the oldest records beyond this count; zero is unlimited.
+	MaxEntries int `json:"maxEntries"`
+	// IncludePayloads stores arguments and results with sensitive keys masked.
+	IncludePayloads bool     `json:"includePayloads"`
+	MaxPayloadBytes int      `json:"maxPayloadBytes"`
+	RedactKeys      []string `json:"redactKeys,omitempty"`
+}
+
+// RedactionAction selects what happens when a redaction rule matches.
+type RedactionAction string
+
+const (
+	// RedactionActionMask replaces matched content in the response.
+	RedactionActionMask RedactionAction = "mask"
+	// RedactionActionReject fails the call instead of returning the response.
+	RedactionActionReject RedactionAction = "reject"
+)
+
+// RedactionRule configures one detector applied to response content.
+type RedactionRule struct {
+	Name        string          `json:"name"`
+	Detector    string          `json:"detector"`
+	Pattern     string          `json:"pattern,omitempty"`
+	Action      RedactionAction `json:"action"`
+	Replacement string          `json:"replacement,omitempty"`
+	MinLength   int             `json:"minLength,omitempty"`
+	MinEntropy  float64         `json:"minEntropy,omitempty"`
+}
+
+// RedactionConfig configures the built-in response redaction policy.
+type RedactionConfig struct {
+	Enabled bool            `json:"enabled"`
+	Rules   []RedactionRule `json:"rules,omitempty"`
+}
+
+// GovernanceConfig configures how governance metadata leaves the pipeline.
+type GovernanceConfig struct {
+	// ForwardMetadata lists metadata keys injected into upstream params._meta.
+	ForwardMetadata []string `json:"forwardMetadata,omitempty"`
+}
+
+// RateLimitScope selects how a rate limit rule partitions its buckets.
+type RateLimitScope string
+
+const (
+	// RateLimitScopeCaller keeps a separate bucket and quota per caller.
+	RateLimitScopeCaller RateLimitScope = "caller"
+	// RateLimitScopeShared keeps one bucket and quota for all matching callers.
+	RateLimitScopeShared RateLimitScope = "shared"
+)
+
+// RateLimitRule limits tool calls matching the caller, tag, server and tool selectors.
+// Empty selectors match everything; caller, server and tool accept glob patterns.
+type RateLimitRule struct {
+	Name          string         `json:"name"`
+	Caller        string         `json:"caller,omitempty"`
+	Tag           string         `json:"tag,omitempty"`
+	Server        string         `json:"server,omitempty"`
+	Tool          string         `json:"tool,omitempty"`
+	Rate          int            `json:"rate,omitempty"`
+	PeriodSeconds int            `json:"periodSeconds,omitempty"`
+	Burst         int            `json:"burst,omitempty"`
+	DailyQuota    int            `json:"dailyQuota,omitempty"`
+	Scope         RateLimitScope `json:"scope"`
+}
+
+// RateLimitConfig configures the built-in rate limiting policy.
+type RateLimitConfig struct {
+	Enabled   bool            `json:"enabled"`
+	StatePath string          `json:"statePath,omitempty"`
+	Rules     []RateLimitRule `json:"rules,omitempty"`
+}
+
+// ResponseCacheToolRule marks matching tools as cacheable regardless of their
+// readOnlyHint. Server and tool accept glob patterns; tool matches the upstream name.
+type ResponseCacheToolRule struct {
+	Server     string `json:"server,omitempty"`
+	Tool       string `json:"tool"`
+	TTLSeconds int    `json:"ttlSeconds,omitempty"`
+}
+
+// ResponseCacheConfig configures the control plane cache for read-only tool
+// calls and resource reads.
+type ResponseCacheConfig struct {
+	Enabled       bool                    `json:"enabled"`
+	TTLSeconds    int                     `json:"ttlSeconds"`
+	MaxEntries    int                     `json:"maxEntries"`
+	MaxBytes      int                     `json:"maxBytes"`
+	MaxEntryBytes int                     `json:"maxEntryBytes"`
+	ReadOnlyTools bool                    `json:"readOnlyTools"`
+	Resources     bool                    `json:"resources"`
+	Tools         []ResponseCacheToolRule `json:"tools,omitempty"`
 }
 
 // RPCAuthMode defines the authentication mode for RPC.
@@ -266,6 +479,8 @@ type Instance struct {
 	errorCount       int64
 	totalDurationNs  int64
 	lastCallUnixNano int64
+	pid              int
+	resources        *ProcessResources
 }
 
 // InstanceInfo provides a read-only snapshot of instance state for status queries.
@@ -278,6 +493,27 @@ type InstanceInfo struct {
 	HandshakedAt    time.Time
 	LastHeartbeatAt time.Time
 	LastStartCause  *StartCause
+	PID             int
+	Resources       *ProcessResources
+}
+
+// ProcessResources is the sampled resource footprint of an instance's process group.
+type ProcessResources struct {
+	Processes int           `json:"processes"`
+	CPUTime   time.Duration `json:"cpuTime"`
+	RSSBytes  int64         `json:"rssBytes"`
+	OpenFDs   int           `json:"openFds"`
+	Threads   int           `json:"threads"`
+	SampledAt time.Time     `json:"sampledAt"`
+}
+
+// CloneProcessResources returns a copy of the resources or nil.
+func CloneProcessResources(resources *ProcessResources) *ProcessResources {
+	if resources == nil {
+		return nil
+	}
+	clone := *resources
+	return &clone
 }
 
 // PoolInfo provides a read-only snapshot of a pool's state for status queries.
@@ -288,6 +524,14 @@ type PoolInfo struct {
 	Instances   []InstanceInfo
 	Metrics     PoolMetrics
 	Diagnostics PoolDiagnostics
+	Sticky      []StickyBindingInfo
+}
+
+// StickyBindingInfo describes a routing key bound to an instance of a stateful pool.
+type StickyBindingInfo struct {
+	RoutingKey string    `json:"routingKey"`
+	InstanceID string    `json:"instanceId"`
+	LastAccess time.Time `json:"lastAccess"`
 }
 
 // PoolMetrics aggregates pool-level metrics.
@@ -298,6 +542,14 @@ type PoolMetrics struct {
 	TotalErrors   int64         `json:"totalErrors"`
 	TotalDuration time.Duration `json:"totalDuration"`
 	LastCallAt    time.Time     `json:"lastCallAt"`
+	// IdleReaps counts instances stopped by idle reaping.
+	IdleReaps int `json:"idleReaps"`
+	// SavedInstanceSeconds accumulates the time reaped instances stayed down
+	// before the pool started a replacement.
+	SavedInstanceSeconds float64 `json:"savedInstanceSeconds"`
+	// SavedRSSByteSeconds weighs the saved time by the last sampled RSS of
+	// each reaped instance.
+	SavedRSSByteSeconds float64 `json:"savedRssByteSeconds"`
 }
 
 // PoolDiagnostics captures recent operational details for troubleshooting.
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// VirtualToolServerType marks tool targets that are served by a virtual tool
+// instead of an upstream server.
+const VirtualToolServerType = "mcpv.virtual"
+
+// VirtualToolConfig declares a composite tool that chains upstream tool calls.
+type VirtualToolConfig struct {
+	Name        string            `json:"name"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	InputSchema map[string]any    `json:"inputSchema"`
+	Steps       []VirtualToolStep `json:"steps"`
+	Result      VirtualToolResult `json:"result"`
+}
+
+// VirtualToolStep calls one upstream tool. String values in Args may contain
+// {{ inputs.<path> }} and {{ steps.<id>.<path> }} expressions.
+type VirtualToolStep struct {
+	ID   string         `json:"id"`
+	Tool string         `json:"tool"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// VirtualToolResult selects what a virtual tool returns. When nothing is set
+// the final step's result is returned unchanged.
+type VirtualToolResult struct {
+	// Step returns the result of the named step unchanged.
+	Step string `json:"step,omitempty"`
+	// Aggregate returns every step output keyed by step ID.
+	Aggregate bool `json:"aggregate,omitempty"`
+	// Template renders a value from inputs and step outputs.
+	Template any `json:"template,omitempty"`
+}
+
+// ToolCallGovernor runs a tools/call request through governance, invoking next
+// when the request is admitted.
+type ToolCallGovernor func(ctx context.Context, req GovernanceRequest, next func(context.Context, GovernanceRequest) (json.RawMessage, error)) (json.RawMessage, error)
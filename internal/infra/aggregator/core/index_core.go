@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -76,6 +78,7 @@ type GenericIndex[Snapshot any, Target any, Cache any] struct {
 	refreshBeat *telemetry.Heartbeat
 	state       atomic.Value
 	failures    map[string]int
+	alerts      domain.AlertPublisher
 }
 
 func NewGenericIndex[Snapshot any, Target any, Cache any](opts GenericIndexOptions[Snapshot, Target, Cache]) *GenericIndex[Snapshot, Target, Cache] {
@@ -338,6 +341,7 @@ func (g *GenericIndex[Snapshot, Target, Cache]) Refresh(ctx context.Context) err
 							zap.Int("consecutiveFailures", failures),
 							zap.Error(res.err),
 						)
+						g.publishCircuitOpen(res.serverType, failures, res.err)
 					}
 					g.deleteCache(res.serverType)
 					changed = true
@@ -394,6 +398,33 @@ func (g *GenericIndex[Snapshot, Target, Cache]) deleteCache(serverType string) {
 	g.mu.Unlock()
 }
 
+// SetAlertPublisher attaches the publisher notified when the circuit breaker
+// opens for a server; nil detaches it.
+func (g *GenericIndex[Snapshot, Target, Cache]) SetAlertPublisher(alerts domain.AlertPublisher) {
+	g.mu.Lock()
+	g.alerts = alerts
+	g.mu.Unlock()
+}
+
+func (g *GenericIndex[Snapshot, Target, Cache]) publishCircuitOpen(serverType string, failures int, err error) {
+	g.mu.Lock()
+	alerts := g.alerts
+	g.mu.Unlock()
+	if alerts == nil {
+		return
+	}
+	alerts.PublishAlert(domain.AlertEvent{
+		Type:    domain.AlertEventCircuitOpen,
+		Time:    time.Now(),
+		Server:  serverType,
+		Message: fmt.Sprintf("%s for server %s: circuit breaker open after %d failures: %v", g.fetchErrorMessage, serverType, failures, err),
+		Attributes: map[string]string{
+			"index":               g.name,
+			"consecutiveFailures": strconv.Itoa(failures),
+		},
+	})
+}
+
 func (g *GenericIndex[Snapshot, Target, Cache]) recordFailure(serverType string) int {
 	g.mu.Lock()
 	defer g.mu.Unlock()
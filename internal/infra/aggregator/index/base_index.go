@@ -133,6 +133,12 @@ func (b *BaseIndex[Snapshot, Target, Cache, ServerSnapshot]) Start(ctx context.C
 	b.startBootstrapRefresh(baseCtx)
 }
 
+// SetAlertPublisher attaches the publisher notified when the circuit breaker
+// opens for a server; nil detaches it.
+func (b *BaseIndex[Snapshot, Target, Cache, ServerSnapshot]) SetAlertPublisher(alerts domain.AlertPublisher) {
+	b.index.SetAlertPublisher(alerts)
+}
+
 func (b *BaseIndex[Snapshot, Target, Cache, ServerSnapshot]) Stop() {
 	b.index.Stop()
 	b.clearBaseContext()
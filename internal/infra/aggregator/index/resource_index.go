@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -15,6 +16,7 @@ import (
 	"mcpv/internal/infra/aggregator/core"
 	"mcpv/internal/infra/hashutil"
 	"mcpv/internal/infra/mcpcodec"
+	"mcpv/internal/infra/responsecache"
 	"mcpv/internal/infra/telemetry"
 )
 
@@ -23,6 +25,9 @@ type ResourceIndex struct {
 	*BaseIndex[domain.ResourceSnapshot, domain.ResourceTarget, resourceCache, serverResourceSnapshot]
 	reqBuilder  core.RequestBuilder
 	listSupport *listSupportTracker
+
+	cacheMu sync.RWMutex
+	cache   *responsecache.Cache
 }
 
 type resourceCache struct {
@@ -122,25 +127,7 @@ func (a *ResourceIndex) ReadResource(ctx context.Context, uri string) (json.RawM
 	if !ok {
 		return nil, domain.ErrResourceNotFound
 	}
-
-	params := &mcp.ReadResourceParams{
-		URI: target.URI,
-	}
-	payload, err := a.reqBuilder.Build("resources/read", params)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := a.BaseIndex.Router().Route(ctx, target.ServerType, target.SpecKey, "", payload)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := decodeReadResourceResult(resp)
-	if err != nil {
-		return nil, err
-	}
-	return marshalReadResourceResult(result)
+	return a.readTarget(ctx, target)
 }
 
 // ReadResourceForServer routes a resource read to the owning server using a URI.
@@ -149,7 +136,24 @@ func (a *ResourceIndex) ReadResourceForServer(ctx context.Context, serverName, u
 	if !ok {
 		return nil, domain.ErrResourceNotFound
 	}
+	return a.readTarget(ctx, target)
+}
+
+// readTarget sends resources/read to the owning server, serving the result
+// from the response cache when resource caching is enabled.
+func (a *ResourceIndex) readTarget(ctx context.Context, target domain.ResourceTarget) (json.RawMessage, error) {
+	cache := a.responseCache()
+	if ttl, ok := cache.ResourceTTL(); ok {
+		key := responsecache.ResourceKey(ctx, target.ServerType, target.URI)
+		return cache.Fetch(ctx, key, ttl, func(ctx context.Context) (json.RawMessage, bool, error) {
+			result, err := a.routeRead(ctx, target)
+			return result, err == nil, err
+		})
+	}
+	return a.routeRead(ctx, target)
+}
 
+func (a *ResourceIndex) routeRead(ctx context.Context, target domain.ResourceTarget) (json.RawMessage, error) {
 	params := &mcp.ReadResourceParams{
 		URI: target.URI,
 	}
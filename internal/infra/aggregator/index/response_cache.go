@@ -0,0 +1,31 @@
+package index
+
+import "mcpv/internal/infra/responsecache"
+
+// SetResponseCache serves cacheable tool calls from cache. A nil cache
+// disables caching.
+func (a *ToolIndex) SetResponseCache(cache *responsecache.Cache) {
+	a.cacheMu.Lock()
+	a.cache = cache
+	a.cacheMu.Unlock()
+}
+
+func (a *ToolIndex) responseCache() *responsecache.Cache {
+	a.cacheMu.RLock()
+	defer a.cacheMu.RUnlock()
+	return a.cache
+}
+
+// SetResponseCache serves resource reads from cache. A nil cache disables
+// caching.
+func (a *ResourceIndex) SetResponseCache(cache *responsecache.Cache) {
+	a.cacheMu.Lock()
+	a.cache = cache
+	a.cacheMu.Unlock()
+}
+
+func (a *ResourceIndex) responseCache() *responsecache.Cache {
+	a.cacheMu.RLock()
+	defer a.cacheMu.RUnlock()
+	return a.cache
+}
@@ -101,6 +101,8 @@ func (idx *RuntimeStatusIndex) Refresh(ctx context.Context) error {
 				HandshakedAt:    inst.HandshakedAt,
 				LastHeartbeatAt: inst.LastHeartbeatAt,
 				LastStartCause:  domain.CloneStartCause(inst.LastStartCause),
+				PID:             inst.PID,
+				Resources:       domain.CloneProcessResources(inst.Resources),
 			})
 		}
 
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
@@ -16,6 +17,7 @@ import (
 	"mcpv/internal/infra/aggregator/core"
 	"mcpv/internal/infra/hashutil"
 	"mcpv/internal/infra/mcpcodec"
+	"mcpv/internal/infra/responsecache"
 	"mcpv/internal/infra/telemetry"
 )
 
@@ -24,6 +26,12 @@ type ToolIndex struct {
 	*BaseIndex[domain.ToolSnapshot, domain.ToolTarget, serverCache, serverToolSnapshot]
 	reqBuilder  core.RequestBuilder
 	listSupport *listSupportTracker
+
+	governorMu sync.RWMutex
+	governor   domain.ToolCallGovernor
+
+	cacheMu sync.RWMutex
+	cache   *responsecache.Cache
 }
 
 type serverCache struct {
@@ -149,26 +157,10 @@ func (a *ToolIndex) CallTool(ctx context.Context, name string, args json.RawMess
 	if !ok {
 		return nil, domain.ErrToolNotFound
 	}
-
-	params := &mcp.CallToolParams{
-		Name:      target.ToolName,
-		Arguments: json.RawMessage(args),
+	if target.ServerType == domain.VirtualToolServerType {
+		return a.callVirtual(ctx, target.ToolName, args, routingKey)
 	}
-	payload, err := a.reqBuilder.Build("tools/call", params)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := a.BaseIndex.Router().Route(ctx, target.ServerType, target.SpecKey, routingKey, payload)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := decodeToolResult(resp)
-	if err != nil {
-		return nil, err
-	}
-	return marshalToolResult(result)
+	return a.callTarget(ctx, target, args, routingKey)
 }
 
 // CallToolForServer routes a tool call to the owning server using a raw tool name.
@@ -177,26 +169,55 @@ func (a *ToolIndex) CallToolForServer(ctx context.Context, serverName, toolName
 	if !ok {
 		return nil, domain.ErrToolNotFound
 	}
+	return a.callTarget(ctx, target, args, routingKey)
+}
+
+// callTarget sends tools/call under the upstream tool name, adding any fixed
+// arguments configured through tool overrides. Cacheable tools are served from
+// the response cache when possible.
+func (a *ToolIndex) callTarget(ctx context.Context, target domain.ToolTarget, args json.RawMessage, routingKey string) (json.RawMessage, error) {
+	args, err := domain.ApplyFixedArgs(args, target.FixedArgs)
+	if err != nil {
+		return nil, fmt.Errorf("apply fixed arguments: %w", err)
+	}
+	cache := a.responseCache()
+	if ttl, ok := cache.ToolTTL(target.ServerType, target.ToolName, target.ReadOnly); ok {
+		if key, err := responsecache.ToolKey(ctx, target.ServerType, target.ToolName, args, routingKey); err == nil {
+			return cache.Fetch(ctx, key, ttl, func(ctx context.Context) (json.RawMessage, bool, error) {
+				return a.routeToolCall(ctx, target, args, routingKey)
+			})
+		}
+	}
+	result, _, err := a.routeToolCall(ctx, target, args, routingKey)
+	return result, err
+}
 
+// routeToolCall routes tools/call upstream and reports whether the result may
+// be cached. Error results are never cached.
+func (a *ToolIndex) routeToolCall(ctx context.Context, target domain.ToolTarget, args json.RawMessage, routingKey string) (json.RawMessage, bool, error) {
 	params := &mcp.CallToolParams{
 		Name:      target.ToolName,
-		Arguments: json.RawMessage(args),
+		Arguments: args,
 	}
 	payload, err := a.reqBuilder.Build("tools/call", params)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	resp, err := a.BaseIndex.Router().Route(ctx, target.ServerType, target.SpecKey, routingKey, payload)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	result, err := decodeToolResult(resp)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	raw, err := marshalToolResult(result)
+	if err != nil {
+		return nil, false, err
 	}
-	return marshalToolResult(result)
+	return raw, !result.IsError, nil
 }
 
 // UpdateSpecs replaces the registry backing the tool index.
@@ -258,6 +279,7 @@ func (a *ToolIndex) buildSnapshot(cache map[string]serverCache) (domain.ToolSnap
 					ServerType: target.ServerType,
 					SpecKey:    target.SpecKey,
 					ToolName:   target.ToolName,
+					FixedArgs:  target.FixedArgs,
 				}
 				targets[displayName] = existing
 			}
@@ -270,6 +292,7 @@ func (a *ToolIndex) buildSnapshot(cache map[string]serverCache) (domain.ToolSnap
 		}
 	}
 
+	merged = a.appendVirtualTools(cfg, merged, targets)
 	sort.Slice(merged, func(i, j int) bool { return merged[i].Name < merged[j].Name })
 
 	a.StoreServerSnapshots(serverSnapshots)
@@ -363,7 +386,7 @@ func (a *ToolIndex) cachedServerCache(serverType string, spec domain.ServerSpec)
 		if tool.Name == "" {
 			continue
 		}
-		if !allowed(tool.Name) {
+		if !allowed(tool.UpstreamToolName()) {
 			continue
 		}
 		if !mcpcodec.IsObjectSchema(tool.InputSchema) {
@@ -375,17 +398,13 @@ func (a *ToolIndex) cachedServerCache(serverType string, spec domain.ServerSpec)
 			continue
 		}
 
+		// Cached definitions already carry the overrides applied by the metadata manager.
 		toolDef := tool
-		toolDef.Name = tool.Name
 		toolDef.SpecKey = specKey
 		toolDef.ServerName = spec.Name
 
 		result = append(result, toolDef)
-		targets[tool.Name] = domain.ToolTarget{
-			ServerType: serverType,
-			SpecKey:    specKey,
-			ToolName:   tool.Name,
-		}
+		targets[tool.Name] = toolTarget(serverType, specKey, spec, tool.UpstreamToolName(), tool.Annotations)
 	}
 
 	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
@@ -404,8 +423,8 @@ func (a *ToolIndex) fetchServerTools(ctx context.Context, serverType string, spe
 	}
 
 	allowed := allowedTools(spec)
-	result := make([]domain.ToolDefinition, 0, len(tools))
-	targets := make(map[string]domain.ToolTarget)
+	defs := make([]domain.ToolDefinition, 0, len(tools))
+	upstreamTargets := make(map[string]domain.ToolTarget, len(tools))
 	logger := a.Logger()
 
 	for _, tool := range tools {
@@ -428,15 +447,23 @@ func (a *ToolIndex) fetchServerTools(ctx context.Context, serverType string, spe
 		}
 
 		def := mcpcodec.ToolFromMCP(tool)
-		def.Name = tool.Name
 		def.SpecKey = specKey
 		def.ServerName = spec.Name
-		result = append(result, def)
-		targets[tool.Name] = domain.ToolTarget{
-			ServerType: serverType,
-			SpecKey:    specKey,
-			ToolName:   tool.Name,
-		}
+		defs = append(defs, def)
+		upstreamTargets[tool.Name] = toolTarget(serverType, specKey, spec, tool.Name, def.Annotations)
+	}
+
+	result, skipped := domain.ApplyToolOverrides(spec, defs)
+	for _, def := range skipped {
+		logger.Warn("skip tool name already exposed by another tool",
+			zap.String("serverType", serverType),
+			zap.String("tool", def.Name),
+			zap.String("upstream", def.UpstreamName),
+		)
+	}
+	targets := make(map[string]domain.ToolTarget, len(result))
+	for _, exposed := range result {
+		targets[exposed.Name] = upstreamTargets[exposed.UpstreamName]
 	}
 
 	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
@@ -480,6 +507,20 @@ func namespaceTool(serverType, toolName string, strategy domain.ToolNamespaceStr
 	return fmt.Sprintf("%s.%s", serverType, toolName)
 }
 
+func toolTarget(serverType, specKey string, spec domain.ServerSpec, upstream string, annotations *domain.ToolAnnotations) domain.ToolTarget {
+	target := domain.ToolTarget{
+		ServerType: serverType,
+		SpecKey:    specKey,
+		ToolName:   upstream,
+		ReadOnly:   annotations != nil && annotations.ReadOnlyHint,
+	}
+	if override, ok := spec.ToolOverrideFor(upstream); ok {
+		target.FixedArgs = override.FixedArgs
+	}
+	return target
+}
+
+// allowedTools filters on upstream tool names, before overrides rename them.
 func allowedTools(spec domain.ServerSpec) func(string) bool {
 	if len(spec.ExposeTools) == 0 {
 		return func(_ string) bool { return true }
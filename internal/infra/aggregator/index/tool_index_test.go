@@ -16,6 +16,7 @@ import (
 
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/mcpcodec"
+	"mcpv/internal/infra/responsecache"
 )
 
 func TestToolIndex_SnapshotPrefixedTool(t *testing.T) {
@@ -179,6 +180,117 @@ func TestToolIndex_RespectsExposeToolsAllowlist(t *testing.T) {
 	require.Equal(t, "echo.echo", snapshot.Tools[0].Name)
 }
 
+func TestToolIndex_AppliesToolOverrides(t *testing.T) {
+	ctx := context.Background()
+	router := &fakeRouter{
+		tools: []*mcp.Tool{
+			{
+				Name:        "create_issue",
+				Title:       "Create issue",
+				Description: "Creates an issue.",
+				InputSchema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"owner": map[string]any{"type": "string"},
+						"title": map[string]any{"type": "string"},
+					},
+					"required": []any{"owner", "title"},
+				},
+			},
+		},
+		callResult: &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}},
+	}
+
+	specs := map[string]domain.ServerSpec{
+		"github": {
+			Name:        "github",
+			ExposeTools: []string{"create_issue"},
+			Tools: map[string]domain.ToolOverride{
+				"create_issue": {
+					Name:              "file_bug",
+					Aliases:           []string{"open_issue"},
+					AppendDescription: "Bugs only.",
+					Title:             "File bug",
+					FixedArgs:         map[string]any{"owner": "acme"},
+				},
+			},
+		},
+	}
+	specKeys := map[string]string{"github": "spec-github"}
+	cfg := domain.RuntimeConfig{ExposeTools: true, ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix}
+
+	index := NewToolIndex(router, specs, specKeys, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	snapshot := index.Snapshot()
+	require.Len(t, snapshot.Tools, 2)
+	require.Equal(t, "github.file_bug", snapshot.Tools[0].Name)
+	require.Equal(t, "github.open_issue", snapshot.Tools[1].Name)
+	tool := snapshot.Tools[0]
+	require.Equal(t, "File bug", tool.Title)
+	require.Equal(t, "Creates an issue. Bugs only.", tool.Description)
+	require.Equal(t, map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"title": map[string]any{"type": "string"}},
+		"required":   []any{"title"},
+	}, tool.InputSchema)
+
+	target, ok := index.Resolve("github.open_issue")
+	require.True(t, ok)
+	require.Equal(t, "create_issue", target.ToolName)
+
+	_, err := index.CallTool(ctx, "github.file_bug", json.RawMessage(`{"title":"crash","owner":"evil"}`), "")
+	require.NoError(t, err)
+	var params mcp.CallToolParams
+	require.NoError(t, json.Unmarshal(router.lastParams, &params))
+	require.Equal(t, "create_issue", params.Name)
+	require.Equal(t, map[string]any{"title": "crash", "owner": "acme"}, params.Arguments)
+
+	_, err = index.CallToolForServer(ctx, "github", "open_issue", nil, "")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(router.lastParams, &params))
+	require.Equal(t, "create_issue", params.Name)
+}
+
+func TestToolIndex_SkipsOverrideNamesThatShadowUpstreamTools(t *testing.T) {
+	ctx := context.Background()
+	router := &fakeRouter{
+		tools: []*mcp.Tool{
+			{Name: "search", InputSchema: map[string]any{"type": "object"}},
+			{Name: "search_code", InputSchema: map[string]any{"type": "object"}},
+			{Name: "list_repos", InputSchema: map[string]any{"type": "object"}},
+		},
+	}
+	specs := map[string]domain.ServerSpec{
+		"github": {
+			Name: "github",
+			Tools: map[string]domain.ToolOverride{
+				"search_code": {Name: "search", Aliases: []string{"code"}},
+				"list_repos":  {Aliases: []string{"code", "repos"}},
+			},
+		},
+	}
+	cfg := domain.RuntimeConfig{ExposeTools: true, ToolNamespaceStrategy: domain.ToolNamespaceStrategyFlat}
+
+	index := NewToolIndex(router, specs, map[string]string{"github": "spec-github"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	names := make([]string, 0)
+	for _, tool := range index.Snapshot().Tools {
+		names = append(names, tool.Name)
+	}
+	require.Equal(t, []string{"code", "list_repos", "repos", "search"}, names)
+
+	target, ok := index.Resolve("search")
+	require.True(t, ok)
+	require.Equal(t, "search", target.ToolName)
+	target, ok = index.Resolve("code")
+	require.True(t, ok)
+	require.Equal(t, "list_repos", target.ToolName)
+}
+
 func TestToolIndex_UsesCachedToolsWhenNoReadyInstance(t *testing.T) {
 	ctx := context.Background()
 	cache := domain.NewMetadataCache()
@@ -210,6 +322,38 @@ func TestToolIndex_UsesCachedToolsWhenNoReadyInstance(t *testing.T) {
 	require.Equal(t, "echo", snapshot.Tools[0].ServerName)
 }
 
+func TestToolIndex_CachedToolsKeepOverrideTargets(t *testing.T) {
+	cache := domain.NewMetadataCache()
+	cache.SetTools("spec-github", []domain.ToolDefinition{
+		{Name: "file_bug", UpstreamName: "create_issue", InputSchema: map[string]any{"type": "object"}},
+	}, "etag")
+
+	specs := map[string]domain.ServerSpec{
+		"github": {
+			Name:        "github",
+			ExposeTools: []string{"create_issue"},
+			Tools: map[string]domain.ToolOverride{
+				"create_issue": {Name: "file_bug", FixedArgs: map[string]any{"owner": "acme"}},
+			},
+		},
+	}
+	specKeys := map[string]string{"github": "spec-github"}
+	cfg := domain.RuntimeConfig{ExposeTools: true, ToolNamespaceStrategy: domain.ToolNamespaceStrategyFlat}
+
+	index := NewToolIndex(&failingRouter{err: domain.ErrNoReadyInstance}, specs, specKeys, cfg, cache, zap.NewNop(), nil, nil, nil)
+
+	snapshot := index.CachedSnapshot()
+	require.Len(t, snapshot.Tools, 1)
+	require.Equal(t, "file_bug", snapshot.Tools[0].Name)
+
+	index.Start(context.Background())
+	defer index.Stop()
+	target, ok := index.Resolve("file_bug")
+	require.True(t, ok)
+	require.Equal(t, "create_issue", target.ToolName)
+	require.Equal(t, map[string]any{"owner": "acme"}, target.FixedArgs)
+}
+
 func TestToolIndex_CachedSnapshot(t *testing.T) {
 	cache := domain.NewMetadataCache()
 	cache.SetTools("spec-echo", []domain.ToolDefinition{
@@ -397,17 +541,83 @@ func TestToolIndex_RefreshFailureOpensCircuitBreaker(t *testing.T) {
 	cfg := domain.RuntimeConfig{ExposeTools: true, ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix}
 
 	index := NewToolIndex(router, specs, specKeys, cfg, nil, zap.NewNop(), nil, nil, nil)
+	alerts := &recordingAlerts{}
+	index.SetAlertPublisher(alerts)
 	require.NoError(t, index.refresh(ctx))
 
 	snapshot := index.Snapshot()
 	require.Len(t, snapshot.Tools, 1)
 
-	for i := 0; i < domain.DefaultRefreshFailureThreshold; i++ {
+	for i := 0; i < domain.DefaultRefreshFailureThreshold+1; i++ {
 		_ = index.refresh(ctx)
 	}
 
 	snapshot = index.Snapshot()
 	require.Empty(t, snapshot.Tools)
+	require.Len(t, alerts.events, 1, "the alert fires once when the circuit opens")
+	require.Equal(t, domain.AlertEventCircuitOpen, alerts.events[0].Type)
+	require.Equal(t, "echo", alerts.events[0].Server)
+	require.Equal(t, "tool_index", alerts.events[0].Attributes["index"])
+}
+
+type recordingAlerts struct {
+	mu     sync.Mutex
+	events []domain.AlertEvent
+}
+
+func (r *recordingAlerts) PublishAlert(event domain.AlertEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func TestToolIndex_ResponseCacheServesReadOnlyTools(t *testing.T) {
+	ctx := context.Background()
+	router := &fakeRouter{
+		tools: []*mcp.Tool{
+			{
+				Name:        "list_repos",
+				InputSchema: map[string]any{"type": "object"},
+				Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+			},
+			{
+				Name:        "create_issue",
+				InputSchema: map[string]any{"type": "object"},
+			},
+		},
+		callResult: &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "ok"}},
+		},
+	}
+	specs := map[string]domain.ServerSpec{"github": {Name: "github"}}
+	specKeys := map[string]string{"github": "spec-github"}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix,
+	}
+
+	index := NewToolIndex(router, specs, specKeys, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.SetResponseCache(responsecache.New(responsecache.Options{
+		Config: domain.ResponseCacheConfig{Enabled: true, ReadOnlyTools: true},
+	}))
+	index.Start(ctx)
+	defer index.Stop()
+
+	_, err := index.CallTool(ctx, "github.list_repos", json.RawMessage(`{"org":"a","page":1}`), "")
+	require.NoError(t, err)
+	_, err = index.CallTool(ctx, "github.list_repos", json.RawMessage(`{ "page": 1, "org": "a" }`), "")
+	require.NoError(t, err)
+	require.Equal(t, 1, router.calls())
+
+	_, err = index.CallTool(domain.WithCacheBypass(ctx), "github.list_repos", json.RawMessage(`{"org":"a","page":1}`), "")
+	require.NoError(t, err)
+	require.Equal(t, 2, router.calls())
+
+	_, err = index.CallTool(ctx, "github.create_issue", json.RawMessage(`{}`), "")
+	require.NoError(t, err)
+	_, err = index.CallTool(ctx, "github.create_issue", json.RawMessage(`{}`), "")
+	require.NoError(t, err)
+	require.Equal(t, 4, router.calls())
 }
 
 type fakeRouter struct {
@@ -416,6 +626,8 @@ type fakeRouter struct {
 	mu             sync.Mutex
 	lastMethod     string
 	lastServerType string
+	lastParams     json.RawMessage
+	callCount      int
 }
 
 func (f *fakeRouter) Route(_ context.Context, serverType, _, _ string, payload json.RawMessage) (json.RawMessage, error) {
@@ -430,12 +642,16 @@ func (f *fakeRouter) Route(_ context.Context, serverType, _, _ string, payload j
 	f.mu.Lock()
 	f.lastMethod = req.Method
 	f.lastServerType = serverType
+	f.lastParams = req.Params
 	f.mu.Unlock()
 
 	switch req.Method {
 	case "tools/list":
 		return encodeResponse(req.ID, &mcp.ListToolsResult{Tools: f.tools})
 	case "tools/call":
+		f.mu.Lock()
+		f.callCount++
+		f.mu.Unlock()
 		if f.callResult == nil {
 			f.callResult = &mcp.CallToolResult{}
 		}
@@ -445,6 +661,12 @@ func (f *fakeRouter) Route(_ context.Context, serverType, _, _ string, payload j
 	}
 }
 
+func (f *fakeRouter) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.callCount
+}
+
 func (f *fakeRouter) last() (string, string) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
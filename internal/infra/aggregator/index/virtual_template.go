@@ -0,0 +1,108 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var templateExpr = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+// renderTemplate resolves {{ path }} expressions in strings nested inside value.
+// A string that is a single expression takes the referenced value with its JSON
+// type; expressions embedded in longer strings are substituted as text.
+func renderTemplate(value any, scope map[string]any) (any, error) {
+	switch typed := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, item := range typed {
+			rendered, err := renderTemplate(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = rendered
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(typed))
+		for i, item := range typed {
+			rendered, err := renderTemplate(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	case string:
+		return renderTemplateString(typed, scope)
+	default:
+		return value, nil
+	}
+}
+
+func renderTemplateString(text string, scope map[string]any) (any, error) {
+	matches := templateExpr.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text, nil
+	}
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(text) {
+		return lookupTemplatePath(text[matches[0][2]:matches[0][3]], scope)
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, match := range matches {
+		b.WriteString(text[last:match[0]])
+		value, err := lookupTemplatePath(text[match[2]:match[3]], scope)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(templateText(value))
+		last = match[1]
+	}
+	b.WriteString(text[last:])
+	return b.String(), nil
+}
+
+func lookupTemplatePath(path string, scope map[string]any) (any, error) {
+	if path == "" {
+		return nil, fmt.Errorf("empty template expression")
+	}
+	var current any = scope
+	for i, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			next, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("template %q: %q not found", path, strings.Join(strings.Split(path, ".")[:i+1], "."))
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("template %q: index %q out of range", path, segment)
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("template %q: cannot select %q from %T", path, segment, current)
+		}
+	}
+	return current, nil
+}
+
+func templateText(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	default:
+		raw, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(raw)
+	}
+}
@@ -0,0 +1,40 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	scope := map[string]any{
+		"inputs": map[string]any{"repo": "mcpv", "count": float64(3)},
+		"steps":  map[string]any{"list": map[string]any{"items": []any{"a", "b"}}},
+	}
+
+	rendered, err := renderTemplate(map[string]any{
+		"repo":   "{{ inputs.repo }}",
+		"count":  "{{inputs.count}}",
+		"label":  "{{ inputs.repo }}#{{ inputs.count }}",
+		"first":  []any{"{{ steps.list.items.1 }}", true},
+		"static": "plain",
+	}, scope)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"repo":   "mcpv",
+		"count":  float64(3),
+		"label":  "mcpv#3",
+		"first":  []any{"b", true},
+		"static": "plain",
+	}, rendered)
+}
+
+func TestRenderTemplate_MissingPath(t *testing.T) {
+	scope := map[string]any{"inputs": map[string]any{}}
+
+	_, err := renderTemplate("{{ inputs.repo }}", scope)
+	require.ErrorContains(t, err, `"inputs.repo" not found`)
+
+	_, err = renderTemplate("{{ inputs }}{{ }}", scope)
+	require.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	virtualStepOK      = "ok"
+	virtualStepError   = "error"
+	virtualStepSkipped = "skipped"
+)
+
+// virtualStepReport describes one step in the structured error of a failed
+// virtual tool call.
+type virtualStepReport struct {
+	ID     string `json:"id"`
+	Tool   string `json:"tool"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Detail any    `json:"detail,omitempty"`
+}
+
+// SetCallGovernor routes every inner call made by virtual tools through
+// governance. Without a governor inner calls go straight to the router.
+func (a *ToolIndex) SetCallGovernor(governor domain.ToolCallGovernor) {
+	a.governorMu.Lock()
+	a.governor = governor
+	a.governorMu.Unlock()
+}
+
+func (a *ToolIndex) callGovernor() domain.ToolCallGovernor {
+	a.governorMu.RLock()
+	defer a.governorMu.RUnlock()
+	return a.governor
+}
+
+// appendVirtualTools adds configured virtual tools to a snapshot. Upstream tools
+// keep their names when a virtual tool collides with them.
+func (a *ToolIndex) appendVirtualTools(cfg domain.RuntimeConfig, merged []domain.ToolDefinition, targets map[string]domain.ToolTarget) []domain.ToolDefinition {
+	for _, tool := range cfg.VirtualTools {
+		if _, exists := targets[tool.Name]; exists {
+			a.Logger().Warn("virtual tool skipped: name already used", zap.String("tool", tool.Name))
+			continue
+		}
+		merged = append(merged, domain.ToolDefinition{
+			Name:        tool.Name,
+			Title:       tool.Title,
+			Description: tool.Description,
+			InputSchema: domain.CloneJSONValue(tool.InputSchema),
+		})
+		targets[tool.Name] = domain.ToolTarget{
+			ServerType: domain.VirtualToolServerType,
+			ToolName:   tool.Name,
+		}
+	}
+	return merged
+}
+
+func (a *ToolIndex) virtualTool(name string) (domain.VirtualToolConfig, bool) {
+	_, _, cfg := a.SpecsSnapshot()
+	for _, tool := range cfg.VirtualTools {
+		if tool.Name == name {
+			return tool, true
+		}
+	}
+	return domain.VirtualToolConfig{}, false
+}
+
+// callVirtual runs the steps of a virtual tool in order. A failed step stops
+// the run and the result reports every step's status as structured content.
+func (a *ToolIndex) callVirtual(ctx context.Context, name string, args json.RawMessage, routingKey string) (json.RawMessage, error) {
+	tool, ok := a.virtualTool(name)
+	if !ok {
+		return nil, domain.ErrToolNotFound
+	}
+
+	inputs := map[string]any{}
+	if trimmed := strings.TrimSpace(string(args)); trimmed != "" && trimmed != "null" {
+		if err := json.Unmarshal(args, &inputs); err != nil {
+			return marshalToolResult(errorResult(fmt.Errorf("decode arguments: %w", err)))
+		}
+	}
+	outputs := make(map[string]any, len(tool.Steps))
+	scope := map[string]any{"inputs": inputs, "steps": outputs}
+	results := make(map[string]*mcp.CallToolResult, len(tool.Steps))
+	reports := make([]virtualStepReport, 0, len(tool.Steps))
+
+	for i, step := range tool.Steps {
+		report := virtualStepReport{ID: step.ID, Tool: step.Tool, Status: virtualStepOK}
+		result, err := a.runVirtualStep(ctx, step, scope, routingKey)
+		if err == nil && result.IsError {
+			err = errors.New(toolResultText(result))
+			report.Detail = result.StructuredContent
+		}
+		if err != nil {
+			report.Status = virtualStepError
+			report.Error = err.Error()
+			reports = append(reports, report)
+			for _, skipped := range tool.Steps[i+1:] {
+				reports = append(reports, virtualStepReport{ID: skipped.ID, Tool: skipped.Tool, Status: virtualStepSkipped})
+			}
+			return marshalToolResult(virtualFailure(tool.Name, step, err, reports))
+		}
+		results[step.ID] = result
+		outputs[step.ID] = toolResultValue(result)
+		reports = append(reports, report)
+	}
+
+	return a.virtualResult(tool, scope, results)
+}
+
+func (a *ToolIndex) runVirtualStep(ctx context.Context, step domain.VirtualToolStep, scope map[string]any, routingKey string) (*mcp.CallToolResult, error) {
+	rendered, err := renderTemplate(step.Args, scope)
+	if err != nil {
+		return nil, fmt.Errorf("render arguments: %w", err)
+	}
+	args, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("encode arguments: %w", err)
+	}
+
+	next := func(ctx context.Context, req domain.GovernanceRequest) (json.RawMessage, error) {
+		target, ok := a.Resolve(step.Tool)
+		if !ok || target.ServerType == domain.VirtualToolServerType {
+			return nil, domain.ErrToolNotFound
+		}
+		if routeCtx, ok := domain.RouteContextFrom(ctx); ok {
+			if !routeCtx.SpecVisible(a.targetSpecKey(target)) || !routeCtx.ToolAllowed(step.Tool, target.ToolName) {
+				return nil, domain.ErrToolNotFound
+			}
+		}
+		stepArgs := req.RequestJSON
+		if len(stepArgs) == 0 {
+			stepArgs = args
+		}
+		return a.callTarget(ctx, target, stepArgs, routingKey)
+	}
+
+	var raw json.RawMessage
+	req := domain.GovernanceRequest{
+		Method:      "tools/call",
+		ToolName:    step.Tool,
+		RoutingKey:  routingKey,
+		RequestJSON: args,
+	}
+	if routeCtx, ok := domain.RouteContextFrom(ctx); ok {
+		req.Caller = routeCtx.Principal()
+	}
+	if governor := a.callGovernor(); governor != nil {
+		raw, err = governor(ctx, req, next)
+	} else {
+		raw, err = next(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result mcp.CallToolResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("decode result: %w", err)
+	}
+	return &result, nil
+}
+
+// targetSpecKey returns the spec key serving a step target.
+func (a *ToolIndex) targetSpecKey(target domain.ToolTarget) string {
+	if target.SpecKey != "" {
+		return target.SpecKey
+	}
+	_, specKeys, _ := a.SpecsSnapshot()
+	return specKeys[target.ServerType]
+}
+
+func (a *ToolIndex) virtualResult(tool domain.VirtualToolConfig, scope map[string]any, results map[string]*mcp.CallToolResult) (json.RawMessage, error) {
+	switch {
+	case tool.Result.Template != nil:
+		value, err := renderTemplate(tool.Result.Template, scope)
+		if err != nil {
+			return marshalToolResult(errorResult(fmt.Errorf("render result: %w", err)))
+		}
+		return marshalToolResult(structuredResult(value))
+	case tool.Result.Aggregate:
+		return marshalToolResult(structuredResult(scope["steps"]))
+	default:
+		stepID := tool.Result.Step
+		if stepID == "" {
+			stepID = tool.Steps[len(tool.Steps)-1].ID
+		}
+		return marshalToolResult(results[stepID])
+	}
+}
+
+// toolResultValue exposes a step result to templates: structured content when
+// present, otherwise the text content decoded as JSON when possible.
+func toolResultValue(result *mcp.CallToolResult) any {
+	if result.StructuredContent != nil {
+		return normalizeJSONValue(result.StructuredContent)
+	}
+	text := toolResultText(result)
+	var decoded any
+	if err := json.Unmarshal([]byte(text), &decoded); err == nil {
+		return decoded
+	}
+	return text
+}
+
+func toolResultText(result *mcp.CallToolResult) string {
+	parts := make([]string, 0, len(result.Content))
+	for _, content := range result.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func normalizeJSONValue(value any) any {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return value
+	}
+	return out
+}
+
+func structuredResult(value any) *mcp.CallToolResult {
+	structured, ok := value.(map[string]any)
+	if !ok {
+		structured = map[string]any{"result": value}
+	}
+	return &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: templateText(structured)}},
+		StructuredContent: structured,
+	}
+}
+
+func virtualFailure(name string, step domain.VirtualToolStep, err error, reports []virtualStepReport) *mcp.CallToolResult {
+	message := fmt.Sprintf("virtual tool %s: step %q (%s) failed: %v", name, step.ID, step.Tool, err)
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{&mcp.TextContent{Text: message}},
+		StructuredContent: map[string]any{
+			"tool":       name,
+			"failedStep": step.ID,
+			"steps":      reports,
+		},
+	}
+}
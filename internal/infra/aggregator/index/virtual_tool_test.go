@@ -0,0 +1,272 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+)
+
+func TestToolIndex_VirtualToolChainsSteps(t *testing.T) {
+	ctx := context.Background()
+	router := &scriptedToolRouter{
+		tools: []*mcp.Tool{{Name: "search", InputSchema: objectSchema}, {Name: "fetch", InputSchema: objectSchema}},
+		results: map[string]*mcp.CallToolResult{
+			"search": {StructuredContent: map[string]any{"items": []any{map[string]any{"id": "doc-7"}}}},
+			"fetch":  {Content: []mcp.Content{&mcp.TextContent{Text: `{"body":"hello"}`}}},
+		},
+	}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyFlat,
+		VirtualTools: []domain.VirtualToolConfig{{
+			Name:        "lookup",
+			Description: "Search then fetch.",
+			InputSchema: map[string]any{"type": "object"},
+			Steps: []domain.VirtualToolStep{
+				{ID: "find", Tool: "search", Args: map[string]any{"query": "{{ inputs.q }}", "limit": 1}},
+				{ID: "get", Tool: "fetch", Args: map[string]any{"id": "{{ steps.find.items.0.id }}"}},
+			},
+			Result: domain.VirtualToolResult{Template: map[string]any{
+				"id":      "{{ steps.find.items.0.id }}",
+				"summary": "{{ inputs.q }}: {{ steps.get.body }}",
+			}},
+		}},
+	}
+	specs := map[string]domain.ServerSpec{"docs": {Name: "docs"}}
+	index := NewToolIndex(router, specs, map[string]string{"docs": "spec-docs"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	target, ok := index.Resolve("lookup")
+	require.True(t, ok)
+	require.Equal(t, domain.VirtualToolServerType, target.ServerType)
+
+	var governed []string
+	index.SetCallGovernor(func(ctx context.Context, req domain.GovernanceRequest, next func(context.Context, domain.GovernanceRequest) (json.RawMessage, error)) (json.RawMessage, error) {
+		governed = append(governed, req.ToolName+"@"+req.Caller)
+		return next(ctx, req)
+	})
+
+	callCtx := domain.WithRouteContext(ctx, domain.RouteContext{Client: "ide"})
+	raw, err := index.CallTool(callCtx, "lookup", json.RawMessage(`{"q":"greeting"}`), "")
+	require.NoError(t, err)
+
+	var result mcp.CallToolResult
+	require.NoError(t, json.Unmarshal(raw, &result))
+	require.False(t, result.IsError)
+	require.Equal(t, map[string]any{"id": "doc-7", "summary": "greeting: hello"}, result.StructuredContent)
+	require.Equal(t, []string{"search@ide", "fetch@ide"}, governed)
+	require.Equal(t, map[string]any{"query": "greeting", "limit": float64(1)}, router.argsFor("search"))
+	require.Equal(t, map[string]any{"id": "doc-7"}, router.argsFor("fetch"))
+}
+
+func TestToolIndex_VirtualToolReportsFailedStep(t *testing.T) {
+	ctx := context.Background()
+	router := &scriptedToolRouter{
+		tools: []*mcp.Tool{{Name: "search", InputSchema: objectSchema}, {Name: "fetch", InputSchema: objectSchema}, {Name: "notify", InputSchema: objectSchema}},
+		results: map[string]*mcp.CallToolResult{
+			"search": {Content: []mcp.Content{&mcp.TextContent{Text: "plain"}}},
+			"fetch":  {IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not found"}}},
+		},
+	}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyFlat,
+		VirtualTools: []domain.VirtualToolConfig{{
+			Name: "lookup",
+			Steps: []domain.VirtualToolStep{
+				{ID: "step1", Tool: "search"},
+				{ID: "step2", Tool: "fetch", Args: map[string]any{"q": "{{ steps.step1 }}"}},
+				{ID: "step3", Tool: "notify"},
+			},
+		}},
+	}
+	specs := map[string]domain.ServerSpec{"docs": {Name: "docs"}}
+	index := NewToolIndex(router, specs, map[string]string{"docs": "spec-docs"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	raw, err := index.CallTool(ctx, "lookup", nil, "")
+	require.NoError(t, err)
+
+	var result mcp.CallToolResult
+	require.NoError(t, json.Unmarshal(raw, &result))
+	require.True(t, result.IsError)
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "step2", structured["failedStep"])
+	steps, ok := structured["steps"].([]any)
+	require.True(t, ok)
+	require.Len(t, steps, 3)
+	require.Equal(t, "ok", steps[0].(map[string]any)["status"])
+	require.Equal(t, "error", steps[1].(map[string]any)["status"])
+	require.Equal(t, "not found", steps[1].(map[string]any)["error"])
+	require.Equal(t, "skipped", steps[2].(map[string]any)["status"])
+	require.Equal(t, map[string]any{"q": "plain"}, router.argsFor("fetch"))
+	require.Nil(t, router.argsFor("notify"))
+}
+
+func TestToolIndex_VirtualToolYieldsToUpstreamName(t *testing.T) {
+	ctx := context.Background()
+	router := &scriptedToolRouter{tools: []*mcp.Tool{{Name: "search", InputSchema: objectSchema}}}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyFlat,
+		VirtualTools: []domain.VirtualToolConfig{{
+			Name:  "search",
+			Steps: []domain.VirtualToolStep{{ID: "step1", Tool: "search"}},
+		}},
+	}
+	specs := map[string]domain.ServerSpec{"docs": {Name: "docs"}}
+	index := NewToolIndex(router, specs, map[string]string{"docs": "spec-docs"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	target, ok := index.Resolve("search")
+	require.True(t, ok)
+	require.Equal(t, "docs", target.ServerType)
+	require.Len(t, index.Snapshot().Tools, 1)
+}
+
+func TestToolIndex_VirtualToolStepsRespectCallerVisibility(t *testing.T) {
+	ctx := context.Background()
+	router := &scriptedToolRouter{tools: []*mcp.Tool{{Name: "search", InputSchema: objectSchema}}}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix,
+		VirtualTools: []domain.VirtualToolConfig{{
+			Name: "lookup",
+			Steps: []domain.VirtualToolStep{
+				{ID: "public", Tool: "docs.search"},
+				{ID: "secret", Tool: "vault.search"},
+			},
+		}},
+	}
+	specs := map[string]domain.ServerSpec{"docs": {Name: "docs"}, "vault": {Name: "vault"}}
+	index := NewToolIndex(router, specs, map[string]string{"docs": "spec-docs", "vault": "spec-vault"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	callCtx := domain.WithRouteContext(ctx, domain.RouteContext{
+		Client:          "ide",
+		VisibleSpecKeys: map[string]struct{}{"spec-docs": {}},
+	})
+	raw, err := index.CallTool(callCtx, "lookup", nil, "")
+	require.NoError(t, err)
+
+	var result mcp.CallToolResult
+	require.NoError(t, json.Unmarshal(raw, &result))
+	require.True(t, result.IsError)
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "secret", structured["failedStep"])
+
+	allCtx := domain.WithRouteContext(ctx, domain.RouteContext{Client: "ide"})
+	raw, err = index.CallTool(allCtx, "lookup", nil, "")
+	require.NoError(t, err)
+	result = mcp.CallToolResult{}
+	require.NoError(t, json.Unmarshal(raw, &result))
+	require.False(t, result.IsError)
+}
+
+func TestToolIndex_VirtualToolStepsRespectProfileToolFilter(t *testing.T) {
+	ctx := context.Background()
+	router := &scriptedToolRouter{tools: []*mcp.Tool{
+		{Name: "search", InputSchema: objectSchema},
+		{Name: "delete_repo", InputSchema: objectSchema},
+	}}
+	cfg := domain.RuntimeConfig{
+		ExposeTools:           true,
+		ToolNamespaceStrategy: domain.ToolNamespaceStrategyPrefix,
+		VirtualTools: []domain.VirtualToolConfig{{
+			Name: "cleanup",
+			Steps: []domain.VirtualToolStep{
+				{ID: "find", Tool: "github.search"},
+				{ID: "drop", Tool: "github.delete_repo"},
+			},
+		}},
+	}
+	specs := map[string]domain.ServerSpec{"github": {Name: "github"}}
+	index := NewToolIndex(router, specs, map[string]string{"github": "spec-github"}, cfg, nil, zap.NewNop(), nil, nil, nil)
+	index.Start(ctx)
+	defer index.Stop()
+
+	callCtx := domain.WithRouteContext(ctx, domain.RouteContext{
+		Client:  "ide",
+		Profile: domain.ClientProfile{Name: "safe", ExcludeTools: []string{"delete_repo"}},
+	})
+	raw, err := index.CallTool(callCtx, "cleanup", nil, "")
+	require.NoError(t, err)
+
+	var result mcp.CallToolResult
+	require.NoError(t, json.Unmarshal(raw, &result))
+	require.True(t, result.IsError)
+	structured, ok := result.StructuredContent.(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "drop", structured["failedStep"])
+}
+
+type scriptedToolRouter struct {
+	tools   []*mcp.Tool
+	results map[string]*mcp.CallToolResult
+
+	mu   sync.Mutex
+	args map[string]map[string]any
+}
+
+func (s *scriptedToolRouter) Route(_ context.Context, _, _, _ string, payload json.RawMessage) (json.RawMessage, error) {
+	msg, err := jsonrpc.DecodeMessage(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, ok := msg.(*jsonrpc.Request)
+	if !ok {
+		return nil, errors.New("invalid jsonrpc request")
+	}
+	switch req.Method {
+	case "tools/list":
+		return encodeResponse(req.ID, &mcp.ListToolsResult{Tools: s.tools})
+	case "tools/call":
+		var params struct {
+			Name      string         `json:"name"`
+			Arguments map[string]any `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		if s.args == nil {
+			s.args = make(map[string]map[string]any)
+		}
+		s.args[params.Name] = params.Arguments
+		s.mu.Unlock()
+		result := s.results[params.Name]
+		if result == nil {
+			result = &mcp.CallToolResult{}
+		}
+		return encodeResponse(req.ID, result)
+	default:
+		return nil, nil
+	}
+}
+
+func (s *scriptedToolRouter) RouteWithOptions(ctx context.Context, serverType, specKey, routingKey string, payload json.RawMessage, _ domain.RouteOptions) (json.RawMessage, error) {
+	return s.Route(ctx, serverType, specKey, routingKey, payload)
+}
+
+func (s *scriptedToolRouter) argsFor(tool string) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.args[tool]
+}
+
+var objectSchema = map[string]any{"type": "object"}
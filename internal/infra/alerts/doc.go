@@ -0,0 +1,5 @@
+package alerts
+
+// Package alerts routes operational events such as init failures, reload
+// rollbacks, plugin start failures and governance rejection spikes to webhook,
+// command and file sinks with debounce, retry and delivery metrics.
@@ -0,0 +1,276 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
+)
+
+const (
+	queueSize  = 64
+	maxBackoff = 30 * time.Second
+)
+
+// Options configures a Notifier.
+type Options struct {
+	Config domain.AlertsConfig
+	// Metrics records delivery outcomes; nil disables them.
+	Metrics domain.Metrics
+	Logger  *zap.Logger
+	// HTTPClient sends webhook deliveries; nil uses a default client.
+	HTTPClient *http.Client
+	Now        func() time.Time
+}
+
+// Notifier matches published events against the alert rules and delivers
+// them to the routed sinks. Each sink has its own queue and worker, so a slow
+// sink never delays the others and PublishAlert never blocks.
+type Notifier struct {
+	rules   []domain.AlertRuleConfig
+	workers map[string]*worker
+	spikes  *spikeDetector
+	now     func() time.Time
+	logger  *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ domain.AlertPublisher = (*Notifier)(nil)
+
+// NewNotifier builds the sinks described by the config and starts one
+// delivery worker per sink.
+func NewNotifier(opts Options) (*Notifier, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	metrics := opts.Metrics
+	if metrics == nil {
+		metrics = telemetry.NewNoopMetrics()
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	sinks := make(map[string]sink, len(opts.Config.Sinks))
+	for _, cfg := range opts.Config.Sinks {
+		built, err := newSink(cfg, client)
+		if err != nil {
+			return nil, err
+		}
+		sinks[cfg.Name] = built
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &Notifier{
+		rules:   opts.Config.Rules,
+		workers: make(map[string]*worker, len(sinks)),
+		spikes:  newSpikeDetector(opts.Config.RejectionSpike),
+		now:     now,
+		logger:  logger.Named("alerts"),
+		cancel:  cancel,
+	}
+	for _, cfg := range opts.Config.Sinks {
+		timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+		if timeout <= 0 {
+			timeout = domain.DefaultAlertSinkTimeoutSeconds * time.Second
+		}
+		w := &worker{
+			name:       cfg.Name,
+			sink:       sinks[cfg.Name],
+			timeout:    timeout,
+			maxRetries: cfg.MaxRetries,
+			backoff:    time.Duration(cfg.RetryBackoffMs) * time.Millisecond,
+			debounce:   time.Duration(cfg.DebounceSeconds) * time.Second,
+			queue:      make(chan domain.AlertEvent, queueSize),
+			lastSent:   make(map[debounceKey]time.Time),
+			metrics:    metrics,
+			logger:     n.logger.With(zap.String("sink", cfg.Name)),
+		}
+		n.workers[cfg.Name] = w
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			w.run(ctx)
+		}()
+	}
+	return n, nil
+}
+
+// PublishAlert routes the event to the sinks of every matching rule. A sink
+// matched by several rules receives the event once.
+func (n *Notifier) PublishAlert(event domain.AlertEvent) {
+	if n == nil {
+		return
+	}
+	now := n.now()
+	if event.Time.IsZero() {
+		event.Time = now
+	}
+	routed := make(map[string]struct{})
+	for _, rule := range n.rules {
+		if !ruleMatches(rule, event) {
+			continue
+		}
+		for _, name := range rule.Sinks {
+			if _, ok := routed[name]; ok {
+				continue
+			}
+			routed[name] = struct{}{}
+			if w, ok := n.workers[name]; ok {
+				w.enqueue(event, now)
+			}
+		}
+	}
+}
+
+// Close stops the delivery workers. Events still queued or waiting for a
+// retry are dropped.
+func (n *Notifier) Close() error {
+	if n == nil {
+		return nil
+	}
+	n.cancel()
+	n.wg.Wait()
+	return nil
+}
+
+// ruleMatches reports whether a rule selects the event. A rule with server
+// patterns never matches events that are not about a server.
+func ruleMatches(rule domain.AlertRuleConfig, event domain.AlertEvent) bool {
+	if len(rule.Events) > 0 {
+		matched := false
+		for _, eventType := range rule.Events {
+			if eventType == event.Type {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(rule.Servers) == 0 {
+		return true
+	}
+	for _, pattern := range rule.Servers {
+		if ok, _ := path.Match(pattern, event.Server); ok && event.Server != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type debounceKey struct {
+	eventType domain.AlertEventType
+	server    string
+}
+
+// worker owns the queue, debounce state and retry loop of one sink.
+type worker struct {
+	name       string
+	sink       sink
+	timeout    time.Duration
+	maxRetries int
+	backoff    time.Duration
+	debounce   time.Duration
+	queue      chan domain.AlertEvent
+	metrics    domain.Metrics
+	logger     *zap.Logger
+
+	mu       sync.Mutex
+	lastSent map[debounceKey]time.Time
+}
+
+// enqueue queues the event unless the debounce window suppresses it. Only a
+// queued event opens a new window, so a drop on a full queue does not mute the
+// next occurrence.
+func (w *worker) enqueue(event domain.AlertEvent, now time.Time) {
+	key := debounceKey{eventType: event.Type, server: event.Server}
+	w.mu.Lock()
+	if w.debounce > 0 {
+		if last, seen := w.lastSent[key]; seen && now.Sub(last) < w.debounce {
+			w.mu.Unlock()
+			w.metrics.RecordAlertSuppressed(w.name, domain.AlertSuppressDebounce)
+			return
+		}
+	}
+	select {
+	case w.queue <- event:
+		if w.debounce > 0 {
+			w.lastSent[key] = now
+		}
+		w.mu.Unlock()
+	default:
+		w.mu.Unlock()
+		w.metrics.RecordAlertSuppressed(w.name, domain.AlertSuppressQueueFull)
+		w.logger.Warn("alert queue full; dropping event", zap.String("event", string(event.Type)))
+	}
+}
+
+func (w *worker) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-w.queue:
+			w.deliver(ctx, event)
+		}
+	}
+}
+
+// deliver attempts the delivery once and then up to maxRetries more times,
+// doubling the backoff after each failure.
+func (w *worker) deliver(ctx context.Context, event domain.AlertEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		w.metrics.RecordAlertDelivery(w.name, domain.AlertDeliveryResultFailure)
+		w.logger.Warn("alert encode failed", zap.Error(err))
+		return
+	}
+	backoff := w.backoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, w.timeout)
+		started := time.Now()
+		err = w.sink.deliver(attemptCtx, event, body)
+		cancel()
+		w.metrics.ObserveAlertDeliveryAttempt(w.name, time.Since(started))
+		if err == nil {
+			w.metrics.RecordAlertDelivery(w.name, domain.AlertDeliveryResultSuccess)
+			return
+		}
+		if attempt >= w.maxRetries || ctx.Err() != nil {
+			w.metrics.RecordAlertDelivery(w.name, domain.AlertDeliveryResultFailure)
+			w.logger.Warn("alert delivery failed",
+				zap.String("event", string(event.Type)),
+				zap.Int("attempts", attempt+1),
+				zap.Error(err),
+			)
+			return
+		}
+		w.metrics.RecordAlertRetry(w.name)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			w.metrics.RecordAlertDelivery(w.name, domain.AlertDeliveryResultFailure)
+			return
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
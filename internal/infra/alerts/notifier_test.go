@@ -0,0 +1,331 @@
+package alerts
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry"
+)
+
+func TestNotifierWebhookSignsAndRetries(t *testing.T) {
+	var attempts atomic.Int32
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received <- r
+		bodies <- body
+	}))
+	t.Cleanup(server.Close)
+
+	registry := prometheus.NewRegistry()
+	notifier := newTestNotifier(t, telemetry.NewPrometheusMetrics(registry), domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{{
+			Name:           "ops",
+			Type:           domain.AlertSinkWebhook,
+			URL:            server.URL,
+			Secret:         "s3cret",
+			Headers:        map[string]string{"X-Team": "platform"},
+			MaxRetries:     3,
+			RetryBackoffMs: 1,
+		}},
+		Rules: []domain.AlertRuleConfig{{Name: "all", Sinks: []string{"ops"}}},
+	})
+
+	notifier.PublishAlert(domain.AlertEvent{
+		Type:    domain.AlertEventServerInitFailed,
+		Server:  "github",
+		Message: "server github failed to initialize",
+	})
+
+	req := waitFor(t, received)
+	body := waitFor(t, bodies)
+	require.Equal(t, Sign([]byte("s3cret"), body), req.Header.Get(SignatureHeader))
+	require.Equal(t, string(domain.AlertEventServerInitFailed), req.Header.Get(EventHeader))
+	require.Equal(t, "platform", req.Header.Get("X-Team"))
+
+	var event domain.AlertEvent
+	require.NoError(t, json.Unmarshal(body, &event))
+	require.Equal(t, "github", event.Server)
+	require.False(t, event.Time.IsZero())
+
+	require.Eventually(t, func() bool {
+		return gatheredCounter(t, registry, "mcpv_alert_deliveries_total", map[string]string{"sink": "ops", "result": "success"}) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(3), attempts.Load())
+}
+
+func TestNotifierGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	registry := prometheus.NewRegistry()
+	notifier := newTestNotifier(t, telemetry.NewPrometheusMetrics(registry), domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{{
+			Name:           "ops",
+			Type:           domain.AlertSinkWebhook,
+			URL:            server.URL,
+			MaxRetries:     2,
+			RetryBackoffMs: 1,
+		}},
+		Rules: []domain.AlertRuleConfig{{Name: "all", Sinks: []string{"ops"}}},
+	})
+
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventReloadFailed, Message: "reload failed"})
+
+	require.Eventually(t, func() bool {
+		return gatheredCounter(t, registry, "mcpv_alert_deliveries_total", map[string]string{"sink": "ops", "result": "failure"}) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(3), attempts.Load())
+}
+
+func TestNotifierDebouncesRepeatedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	now := time.Unix(1_700_000_000, 0).UTC()
+	registry := prometheus.NewRegistry()
+	notifier, err := NewNotifier(Options{
+		Config: domain.AlertsConfig{
+			Sinks: []domain.AlertSinkConfig{{
+				Name:            "journal",
+				Type:            domain.AlertSinkFile,
+				Path:            path,
+				DebounceSeconds: 60,
+			}},
+			Rules: []domain.AlertRuleConfig{{Name: "all", Sinks: []string{"journal"}}},
+		},
+		Metrics: telemetry.NewPrometheusMetrics(registry),
+		Now:     func() time.Time { return now },
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = notifier.Close() })
+
+	failed := domain.AlertEvent{Type: domain.AlertEventServerInitFailed, Server: "github", Message: "failed"}
+	notifier.PublishAlert(failed)
+	notifier.PublishAlert(failed)
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventServerInitFailed, Server: "jira", Message: "failed"})
+	now = now.Add(61 * time.Second)
+	notifier.PublishAlert(failed)
+
+	require.Eventually(t, func() bool { return len(readEvents(t, path)) == 3 }, 2*time.Second, 10*time.Millisecond)
+	servers := make([]string, 0, 3)
+	for _, event := range readEvents(t, path) {
+		servers = append(servers, event.Server)
+	}
+	require.Equal(t, []string{"github", "jira", "github"}, servers)
+
+	require.Equal(t, 1.0, gatheredCounter(t, registry, "mcpv_alerts_suppressed_total", map[string]string{"sink": "journal", "reason": "debounce"}))
+}
+
+func TestWorkerDropOnFullQueueKeepsDebounceOpen(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	w := &worker{
+		name:     "journal",
+		debounce: time.Minute,
+		queue:    make(chan domain.AlertEvent),
+		lastSent: make(map[debounceKey]time.Time),
+		metrics:  telemetry.NewPrometheusMetrics(registry),
+		logger:   zap.NewNop(),
+	}
+	now := time.Unix(1_700_000_000, 0).UTC()
+	event := domain.AlertEvent{Type: domain.AlertEventServerInitFailed, Server: "github"}
+
+	w.enqueue(event, now)
+	require.Empty(t, w.lastSent)
+
+	w.queue = make(chan domain.AlertEvent, 1)
+	w.enqueue(event, now.Add(time.Second))
+	require.Len(t, w.queue, 1)
+	w.enqueue(event, now.Add(2*time.Second))
+	require.Len(t, w.queue, 1)
+
+	require.Equal(t, 1.0, gatheredCounter(t, registry, "mcpv_alerts_suppressed_total", map[string]string{"sink": "journal", "reason": "queue_full"}))
+	require.Equal(t, 1.0, gatheredCounter(t, registry, "mcpv_alerts_suppressed_total", map[string]string{"sink": "journal", "reason": "debounce"}))
+}
+
+func TestNotifierRoutesByRule(t *testing.T) {
+	dir := t.TempDir()
+	initPath := filepath.Join(dir, "init.jsonl")
+	allPath := filepath.Join(dir, "all.jsonl")
+	notifier := newTestNotifier(t, nil, domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{
+			{Name: "init", Type: domain.AlertSinkFile, Path: initPath},
+			{Name: "all", Type: domain.AlertSinkFile, Path: allPath},
+		},
+		Rules: []domain.AlertRuleConfig{
+			{
+				Name:    "github-init",
+				Events:  []domain.AlertEventType{domain.AlertEventServerInitFailed, domain.AlertEventServerInitSuspended},
+				Servers: []string{"git*"},
+				Sinks:   []string{"init", "all"},
+			},
+			{Name: "everything", Sinks: []string{"all"}},
+		},
+	})
+
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventServerInitSuspended, Server: "github", Message: "suspended"})
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventServerInitSuspended, Server: "jira", Message: "suspended"})
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventReloadRollback, Message: "rolled back"})
+
+	require.Eventually(t, func() bool { return len(readEvents(t, allPath)) == 3 }, 2*time.Second, 10*time.Millisecond)
+	initEvents := readEvents(t, initPath)
+	require.Len(t, initEvents, 1)
+	require.Equal(t, "github", initEvents[0].Server)
+}
+
+func TestNotifierReportsRejectionSpikes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	notifier := newTestNotifier(t, nil, domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{{Name: "journal", Type: domain.AlertSinkFile, Path: path}},
+		Rules: []domain.AlertRuleConfig{{
+			Name:   "spikes",
+			Events: []domain.AlertEventType{domain.AlertEventRejectionSpike},
+			Sinks:  []string{"journal"},
+		}},
+		RejectionSpike: domain.AlertRejectionSpikeConfig{Threshold: 3, WindowSeconds: 60},
+	})
+
+	rejection := &domain.GovernanceDecision{
+		Category:      domain.PluginCategoryRateLimiting,
+		Plugin:        "ratelimit",
+		RejectCode:    "rate_limited",
+		RejectMessage: "too many calls",
+	}
+	for range 5 {
+		notifier.ObserveExecution(t.Context(), governance.Execution{
+			Request:   domain.GovernanceRequest{Method: "tools/call", Server: "github", ToolName: "search"},
+			Rejection: rejection,
+		})
+	}
+	notifier.ObserveExecution(t.Context(), governance.Execution{
+		Request: domain.GovernanceRequest{Method: "tools/call", Server: "github", ToolName: "search"},
+	})
+
+	require.Eventually(t, func() bool { return len(readEvents(t, path)) == 1 }, 2*time.Second, 10*time.Millisecond)
+	event := readEvents(t, path)[0]
+	require.Equal(t, domain.AlertEventRejectionSpike, event.Type)
+	require.Equal(t, "github", event.Server)
+	require.Equal(t, "rate_limited", event.Attributes["lastCode"])
+}
+
+func TestCommandSinkReceivesEventOnStdin(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+	out := filepath.Join(t.TempDir(), "out.txt")
+	notifier := newTestNotifier(t, nil, domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{{
+			Name:    "notify",
+			Type:    domain.AlertSinkCommand,
+			Command: []string{"sh", "-c", `{ echo "$MCPV_ALERT_TYPE $MCPV_ALERT_SERVER"; cat; } > "$0"`, out},
+		}},
+		Rules: []domain.AlertRuleConfig{{Name: "all", Sinks: []string{"notify"}}},
+	})
+
+	notifier.PublishAlert(domain.AlertEvent{Type: domain.AlertEventPluginStartFailed, Message: "plugin audit failed to start"})
+
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(out)
+		return err == nil && strings.Contains(string(data), "plugin audit failed to start")
+	}, 2*time.Second, 10*time.Millisecond)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "plugin_start_failed \n"))
+}
+
+func TestNewNotifierRequiresSecretEnvVar(t *testing.T) {
+	t.Setenv("MCPV_TEST_ALERT_SECRET", "")
+	_, err := NewNotifier(Options{Config: domain.AlertsConfig{
+		Sinks: []domain.AlertSinkConfig{{
+			Name:         "ops",
+			Type:         domain.AlertSinkWebhook,
+			URL:          "http://127.0.0.1:1",
+			SecretEnvVar: "MCPV_TEST_ALERT_SECRET",
+		}},
+	}})
+	require.ErrorContains(t, err, "MCPV_TEST_ALERT_SECRET")
+}
+
+func newTestNotifier(t *testing.T, metrics domain.Metrics, cfg domain.AlertsConfig) *Notifier {
+	t.Helper()
+	notifier, err := NewNotifier(Options{Config: cfg, Metrics: metrics})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = notifier.Close() })
+	return notifier
+}
+
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for delivery")
+		var zero T
+		return zero
+	}
+}
+
+func gatheredCounter(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, pair := range metric.GetLabel() {
+				if labels[pair.GetName()] == pair.GetValue() {
+					matched++
+				}
+			}
+			if matched == len(labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func readEvents(t *testing.T, path string) []domain.AlertEvent {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	var events []domain.AlertEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		var event domain.AlertEvent
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	return events
+}
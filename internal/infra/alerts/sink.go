@@ -0,0 +1,151 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of the webhook body as
+	// "sha256=<hex>" when the sink has a secret.
+	SignatureHeader = "X-Mcpv-Signature"
+	// EventHeader carries the alert event type of a webhook delivery.
+	EventHeader = "X-Mcpv-Event"
+
+	maxCommandOutput = 512
+)
+
+// sink delivers one encoded event. Errors are retried by the caller.
+type sink interface {
+	deliver(ctx context.Context, event domain.AlertEvent, body []byte) error
+}
+
+// Sign returns the signature header value for a webhook body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSink(cfg domain.AlertSinkConfig, client *http.Client) (sink, error) {
+	switch cfg.Type {
+	case domain.AlertSinkWebhook:
+		secret := cfg.Secret
+		if cfg.SecretEnvVar != "" {
+			secret = os.Getenv(cfg.SecretEnvVar)
+			if secret == "" {
+				return nil, fmt.Errorf("alert sink %q: environment variable %s is empty", cfg.Name, cfg.SecretEnvVar)
+			}
+		}
+		return &webhookSink{url: cfg.URL, headers: cfg.Headers, secret: []byte(secret), client: client}, nil
+	case domain.AlertSinkCommand:
+		if len(cfg.Command) == 0 {
+			return nil, fmt.Errorf("alert sink %q: command is required", cfg.Name)
+		}
+		return &commandSink{argv: cfg.Command}, nil
+	case domain.AlertSinkFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("alert sink %q: path is required", cfg.Name)
+		}
+		return &fileSink{path: cfg.Path}, nil
+	default:
+		return nil, fmt.Errorf("alert sink %q: unsupported type %q", cfg.Name, cfg.Type)
+	}
+}
+
+// webhookSink POSTs the event JSON and treats any non-2xx status as a failure.
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	secret  []byte
+	client  *http.Client
+}
+
+func (s *webhookSink) deliver(ctx context.Context, event domain.AlertEvent, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event.Type))
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(s.secret, body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// commandSink runs a local command with the event JSON on stdin and the main
+// event fields in MCPV_ALERT_* environment variables.
+type commandSink struct {
+	argv []string
+}
+
+func (s *commandSink) deliver(ctx context.Context, event domain.AlertEvent, body []byte) error {
+	cmd := exec.CommandContext(ctx, s.argv[0], s.argv[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"MCPV_ALERT_TYPE="+string(event.Type),
+		"MCPV_ALERT_SERVER="+event.Server,
+		"MCPV_ALERT_MESSAGE="+event.Message,
+	)
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	detail := strings.TrimSpace(string(output))
+	if len(detail) > maxCommandOutput {
+		detail = detail[:maxCommandOutput]
+	}
+	if detail == "" {
+		return err
+	}
+	return fmt.Errorf("%w: %s", err, detail)
+}
+
+// fileSink appends one JSON line per event.
+type fileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+func (s *fileSink) deliver(_ context.Context, _ domain.AlertEvent, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	line := make([]byte, 0, len(body)+1)
+	line = append(append(line, body...), '\n')
+	_, writeErr := file.Write(line)
+	return errors.Join(writeErr, file.Close())
+}
@@ -0,0 +1,89 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+)
+
+// spikeDetector counts governance rejections per server in fixed windows and
+// reports a spike once per window when the count reaches the threshold.
+type spikeDetector struct {
+	threshold int
+	window    time.Duration
+
+	mu      sync.Mutex
+	servers map[string]*spikeWindow
+}
+
+type spikeWindow struct {
+	start time.Time
+	count int
+}
+
+func newSpikeDetector(cfg domain.AlertRejectionSpikeConfig) *spikeDetector {
+	threshold := cfg.Threshold
+	if threshold <= 0 {
+		threshold = domain.DefaultAlertRejectionSpikeThreshold
+	}
+	windowSeconds := cfg.WindowSeconds
+	if windowSeconds <= 0 {
+		windowSeconds = domain.DefaultAlertRejectionSpikeWindowSeconds
+	}
+	return &spikeDetector{
+		threshold: threshold,
+		window:    time.Duration(windowSeconds) * time.Second,
+		servers:   make(map[string]*spikeWindow),
+	}
+}
+
+// record counts one rejection and reports whether it completes a spike.
+func (d *spikeDetector) record(server string, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	window, ok := d.servers[server]
+	if !ok || now.Sub(window.start) >= d.window {
+		window = &spikeWindow{start: now}
+		d.servers[server] = window
+	}
+	window.count++
+	return window.count == d.threshold
+}
+
+// ObserveExecution feeds governance rejections into the spike detector and
+// publishes a rejection spike event when a server crosses the threshold.
+func (n *Notifier) ObserveExecution(_ context.Context, execution governance.Execution) {
+	if n == nil {
+		return
+	}
+	rejection, rejected := execution.Rejected()
+	if !rejected {
+		return
+	}
+	server := execution.Request.Server
+	if !n.spikes.record(server, n.now()) {
+		return
+	}
+	target := server
+	if target == "" {
+		target = "unrouted calls"
+	}
+	n.PublishAlert(domain.AlertEvent{
+		Type:    domain.AlertEventRejectionSpike,
+		Server:  server,
+		Message: fmt.Sprintf("governance rejected %d calls to %s within %s", n.spikes.threshold, target, n.spikes.window),
+		Attributes: map[string]string{
+			"threshold":     strconv.Itoa(n.spikes.threshold),
+			"windowSeconds": strconv.Itoa(int(n.spikes.window / time.Second)),
+			"lastCategory":  string(rejection.Category),
+			"lastPlugin":    rejection.Plugin,
+			"lastCode":      rejection.Code,
+			"lastMessage":   rejection.Message,
+		},
+	})
+}
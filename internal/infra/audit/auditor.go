@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry"
+	"mcpv/internal/infra/telemetry/diagnostics"
+)
+
+// Options configures an Auditor.
+type Options struct {
+	Config domain.AuditConfig
+	Logger *zap.Logger
+	Now    func() time.Time
+}
+
+// Auditor is the built-in audit policy. It observes every governed execution
+// and appends one record per call to the hash-chained log.
+type Auditor struct {
+	log              *Log
+	includeArguments bool
+	redactor         diagnostics.KeyRedactor
+	logger           *zap.Logger
+}
+
+// NewAuditor opens the audit log described by the config.
+func NewAuditor(opts Options) (*Auditor, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	cfg := opts.Config
+	log, err := OpenLog(LogOptions{
+		Path:         cfg.Path,
+		MaxSizeBytes: int64(cfg.MaxSizeMB) * 1024 * 1024,
+		MaxAge:       time.Duration(cfg.MaxAgeHours) * time.Hour,
+		MaxBackups:   cfg.MaxBackups,
+		Now:          opts.Now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Auditor{
+		log:              log,
+		includeArguments: cfg.IncludeArguments,
+		redactor:         diagnostics.NewKeyRedactor(cfg.RedactKeys),
+		logger:           logger.Named("audit"),
+	}, nil
+}
+
+// ObserveExecution records a completed governed call. Write failures are logged
+// and never fail the call itself.
+func (a *Auditor) ObserveExecution(_ context.Context, execution governance.Execution) {
+	if a == nil {
+		return
+	}
+	record := a.buildRecord(execution)
+	if _, err := a.log.Append(record); err != nil {
+		a.logger.Warn("audit append failed",
+			zap.String("method", record.Method),
+			zap.String("caller", record.Caller),
+			zap.Error(err),
+		)
+	}
+}
+
+// Close closes the underlying log.
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+	return a.log.Close()
+}
+
+func (a *Auditor) buildRecord(execution governance.Execution) Record {
+	req := execution.Request
+	record := Record{
+		Time:      execution.StartedAt,
+		RequestID: req.Metadata[telemetry.FieldRequestID],
+		Caller:    req.Caller,
+		Server:    req.Server,
+		SpecKey:   execution.SpecKey,
+		Method:    req.Method,
+		Tool:      req.ToolName,
+		Resource:  req.ResourceURI,
+		Prompt:    req.PromptName,
+		LatencyMs: float64(execution.Duration.Microseconds()) / 1000,
+	}
+
+	if len(bytes.TrimSpace(req.RequestJSON)) > 0 {
+		decoded, canonical, ok := canonicalJSON(req.RequestJSON)
+		if ok {
+			record.ArgsDigest = diagnostics.HashBytes(canonical)
+			if a.includeArguments {
+				if redacted, err := json.Marshal(a.redactor.Redact(decoded)); err == nil {
+					record.Arguments = redacted
+				}
+			}
+		} else {
+			record.ArgsDigest = diagnostics.HashBytes(req.RequestJSON)
+		}
+	}
+
+	rejection, rejected := execution.Rejected()
+	switch {
+	case rejected:
+		record.Outcome = OutcomeRejected
+		record.Code = rejection.Code
+		record.Message = rejection.Message
+		record.Plugin = rejection.Plugin
+	case execution.Err != nil:
+		record.Outcome = OutcomeError
+		record.Message = execution.Err.Error()
+	case execution.ToolResultIsError():
+		record.Outcome = OutcomeError
+	default:
+		record.Outcome = OutcomeOK
+	}
+	return record
+}
+
+// canonicalJSON decodes raw and re-encodes it with sorted keys so equivalent
+// arguments produce the same digest.
+func canonicalJSON(raw json.RawMessage) (any, []byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, nil, false
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return nil, nil, false
+	}
+	return value, canonical, true
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry"
+)
+
+func TestAuditor_RecordsOutcomes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mcpv.jsonl")
+	auditor, err := NewAuditor(Options{Config: domain.AuditConfig{
+		Path:             path,
+		MaxSizeMB:        1,
+		IncludeArguments: true,
+		RedactKeys:       []string{"pass*"},
+	}})
+	require.NoError(t, err)
+
+	req := domain.GovernanceRequest{
+		Method:      "tools/call",
+		Caller:      "cli",
+		Server:      "github",
+		ToolName:    "create_issue",
+		RequestJSON: json.RawMessage(`{"title":"x","auth":{"password":"p","api_token":"t"}}`),
+		Metadata:    map[string]string{telemetry.FieldRequestID: "req-1"},
+	}
+	ctx := context.Background()
+	auditor.ObserveExecution(ctx, governance.Execution{Request: req, Response: json.RawMessage(`{"content":[]}`), Duration: 1500 * time.Microsecond})
+	auditor.ObserveExecution(ctx, governance.Execution{Request: req, Response: json.RawMessage(`{"isError":true}`)})
+	auditor.ObserveExecution(ctx, governance.Execution{Request: req, Rejection: &domain.GovernanceDecision{Plugin: "guard", RejectCode: "denied", RejectMessage: "no"}})
+	auditor.ObserveExecution(ctx, governance.Execution{Request: domain.GovernanceRequest{Method: "prompts/get"}, Err: domain.GovernanceRejection{Plugin: "guard", Code: "denied"}})
+	auditor.ObserveExecution(ctx, governance.Execution{Request: domain.GovernanceRequest{Method: "resources/read"}, Err: errors.New("boom")})
+	require.NoError(t, auditor.Close())
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 5)
+	records := make([]Record, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &records[i]))
+	}
+
+	first := records[0]
+	require.Equal(t, OutcomeOK, first.Outcome)
+	require.Equal(t, "req-1", first.RequestID)
+	require.Equal(t, "github", first.Server)
+	require.Equal(t, "create_issue", first.Tool)
+	require.Equal(t, 1.5, first.LatencyMs)
+	require.NotEmpty(t, first.ArgsDigest)
+	require.JSONEq(t, `{"title":"x","auth":{"password":"***","api_token":"***"}}`, string(first.Arguments))
+
+	require.Equal(t, OutcomeError, records[1].Outcome)
+	require.Equal(t, OutcomeRejected, records[2].Outcome)
+	require.Equal(t, "guard", records[2].Plugin)
+	require.Equal(t, OutcomeRejected, records[3].Outcome)
+	require.Equal(t, "denied", records[3].Code)
+	require.Equal(t, OutcomeError, records[4].Outcome)
+	require.Equal(t, "boom", records[4].Message)
+
+	report, err := Verify(path)
+	require.NoError(t, err)
+	require.True(t, report.OK())
+}
+
+func TestAuditor_DigestIsKeyOrderIndependent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mcpv.jsonl")
+	auditor, err := NewAuditor(Options{Config: domain.AuditConfig{Path: path}})
+	require.NoError(t, err)
+
+	auditor.ObserveExecution(context.Background(), governance.Execution{Request: domain.GovernanceRequest{Method: "tools/call", RequestJSON: json.RawMessage(`{"a":1,"b":2}`)}})
+	auditor.ObserveExecution(context.Background(), governance.Execution{Request: domain.GovernanceRequest{Method: "tools/call", RequestJSON: json.RawMessage(`{ "b": 2, "a": 1 }`)}})
+	require.NoError(t, auditor.Close())
+
+	lines := readLines(t, path)
+	var first, second Record
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	require.Equal(t, first.ArgsDigest, second.ArgsDigest)
+	require.Empty(t, first.Arguments)
+}
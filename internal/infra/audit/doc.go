@@ -0,0 +1,4 @@
+package audit
+
+// Package audit writes governed requests to an append-only, hash-chained JSONL log
+// and verifies existing logs for tampering.
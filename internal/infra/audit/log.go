@@ -0,0 +1,398 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	logFileMode os.FileMode = 0o600
+	logDirMode  os.FileMode = 0o700
+
+	tailChunkSize = 64 * 1024
+)
+
+// LogOptions configures an audit log.
+type LogOptions struct {
+	// Path is the active segment. Rotated segments are written next to it as
+	// <name>-<first seq>.<ext>.
+	Path         string
+	MaxSizeBytes int64
+	MaxAge       time.Duration
+	// MaxBackups limits retained rotated segments; zero keeps all of them.
+	MaxBackups int
+	Now        func() time.Time
+}
+
+// Log is an append-only, hash-chained JSONL writer with size and age rotation.
+// The chain continues across rotated segments.
+type Log struct {
+	mu   sync.Mutex
+	opts LogOptions
+
+	file         *os.File
+	size         int64
+	segmentSeq   uint64
+	segmentStart time.Time
+	lastSeq      uint64
+	lastHash     string
+}
+
+// OpenLog opens or creates the audit log and resumes the chain from the last record.
+func OpenLog(opts LogOptions) (*Log, error) {
+	if strings.TrimSpace(opts.Path) == "" {
+		return nil, errors.New("audit log path is required")
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.Path), logDirMode); err != nil {
+		return nil, fmt.Errorf("create audit dir: %w", err)
+	}
+
+	l := &Log{opts: opts}
+	if err := l.resume(); err != nil {
+		return nil, err
+	}
+	if err := l.openActive(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Append assigns the sequence number and chain hashes, then writes the record.
+func (l *Log) Append(record Record) (Record, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return Record{}, errors.New("audit log closed")
+	}
+
+	record.Seq = l.lastSeq + 1
+	record.PrevHash = l.lastHash
+	if record.Time.IsZero() {
+		record.Time = l.opts.Now()
+	}
+	record.Time = record.Time.UTC()
+	hash, err := computeHash(record)
+	if err != nil {
+		return Record{}, fmt.Errorf("hash audit record: %w", err)
+	}
+	record.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return Record{}, fmt.Errorf("encode audit record: %w", err)
+	}
+	line = append(line, '\n')
+
+	if l.shouldRotate(int64(len(line))) {
+		if err := l.rotate(); err != nil {
+			return Record{}, err
+		}
+	}
+
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return Record{}, fmt.Errorf("write audit record: %w", err)
+	}
+	if l.segmentSeq == 0 {
+		l.segmentSeq = record.Seq
+		l.segmentStart = record.Time
+	}
+	l.lastSeq = record.Seq
+	l.lastHash = record.Hash
+	return record, nil
+}
+
+// Close flushes and closes the active segment.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	syncErr := l.file.Sync()
+	closeErr := l.file.Close()
+	l.file = nil
+	return errors.Join(syncErr, closeErr)
+}
+
+func (l *Log) shouldRotate(next int64) bool {
+	if l.size == 0 {
+		return false
+	}
+	if l.opts.MaxSizeBytes > 0 && l.size+next > l.opts.MaxSizeBytes {
+		return true
+	}
+	if l.opts.MaxAge > 0 && !l.segmentStart.IsZero() && l.opts.Now().Sub(l.segmentStart) >= l.opts.MaxAge {
+		return true
+	}
+	return false
+}
+
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("close audit segment: %w", err)
+	}
+	l.file = nil
+	if err := os.Rename(l.opts.Path, segmentPath(l.opts.Path, l.segmentSeq)); err != nil {
+		return fmt.Errorf("rotate audit segment: %w", err)
+	}
+	l.size = 0
+	l.segmentSeq = 0
+	l.segmentStart = time.Time{}
+	if err := l.openActive(); err != nil {
+		return err
+	}
+	return l.prune()
+}
+
+func (l *Log) prune() error {
+	if l.opts.MaxBackups <= 0 {
+		return nil
+	}
+	backups, err := rotatedSegments(l.opts.Path)
+	if err != nil {
+		return err
+	}
+	if len(backups) <= l.opts.MaxBackups {
+		return nil
+	}
+	var errs []error
+	for _, path := range backups[:len(backups)-l.opts.MaxBackups] {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (l *Log) openActive() error {
+	file, err := os.OpenFile(l.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFileMode)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+// resume restores the chain head and current segment bounds from disk.
+func (l *Log) resume() error {
+	first, last, err := segmentBounds(l.opts.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if first != nil {
+		l.segmentSeq = first.Seq
+		l.segmentStart = first.Time
+	}
+	if last != nil {
+		l.lastSeq = last.Seq
+		l.lastHash = last.Hash
+		return l.terminatePartialLine()
+	}
+
+	backups, err := rotatedSegments(l.opts.Path)
+	if err != nil {
+		return err
+	}
+	if len(backups) == 0 {
+		return nil
+	}
+	_, last, err = segmentBounds(backups[len(backups)-1])
+	if err != nil {
+		return err
+	}
+	if last != nil {
+		l.lastSeq = last.Seq
+		l.lastHash = last.Hash
+	}
+	return nil
+}
+
+// terminatePartialLine appends a newline when the previous process died mid-write,
+// so the torn record is reported by Verify instead of corrupting the next one.
+func (l *Log) terminatePartialLine() error {
+	file, err := os.OpenFile(l.opts.Path, os.O_RDWR, logFileMode)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil || info.Size() == 0 {
+		return err
+	}
+	buf := make([]byte, 1)
+	if _, err := file.ReadAt(buf, info.Size()-1); err != nil {
+		return fmt.Errorf("read audit log: %w", err)
+	}
+	if buf[0] == '\n' {
+		return nil
+	}
+	if _, err := file.WriteAt([]byte{'\n'}, info.Size()); err != nil {
+		return fmt.Errorf("terminate audit log: %w", err)
+	}
+	return nil
+}
+
+// segmentBounds returns the first and last parseable records of a segment.
+func segmentBounds(path string) (*Record, *Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	firstLine, err := bufio.NewReader(file).ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("read audit log: %w", err)
+	}
+	if len(bytes.TrimSpace(firstLine)) == 0 {
+		return nil, nil, nil
+	}
+	first := parseRecordLine(firstLine)
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("stat audit log: %w", err)
+	}
+	lastLine, err := readLastLine(file, info.Size())
+	if err != nil {
+		return nil, nil, err
+	}
+	last := parseRecordLine(lastLine)
+	if last == nil {
+		// Torn trailing write: fall back to the last complete record.
+		last, err = scanLastRecord(file)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if last == nil {
+		return nil, nil, fmt.Errorf("audit log %s has no readable records", path)
+	}
+	return first, last, nil
+}
+
+func scanLastRecord(file *os.File) (*Record, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	reader := bufio.NewReader(file)
+	var last *Record
+	for {
+		line, err := reader.ReadBytes('\n')
+		if record := parseRecordLine(line); record != nil {
+			last = record
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return last, nil
+			}
+			return nil, fmt.Errorf("read audit log: %w", err)
+		}
+	}
+}
+
+func readLastLine(file *os.File, size int64) ([]byte, error) {
+	var tail []byte
+	offset := size
+	for offset > 0 {
+		chunk := int64(tailChunkSize)
+		if chunk > offset {
+			chunk = offset
+		}
+		offset -= chunk
+		buf := make([]byte, chunk)
+		if _, err := file.ReadAt(buf, offset); err != nil {
+			return nil, fmt.Errorf("read audit log: %w", err)
+		}
+		tail = append(buf, tail...)
+		trimmed := bytes.TrimRight(tail, "\n")
+		if idx := bytes.LastIndexByte(trimmed, '\n'); idx >= 0 {
+			return trimmed[idx+1:], nil
+		}
+	}
+	return bytes.TrimRight(tail, "\n"), nil
+}
+
+func parseRecordLine(line []byte) *Record {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+	var record Record
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil
+	}
+	return &record
+}
+
+func segmentPath(path string, firstSeq uint64) string {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	return fmt.Sprintf("%s-%012d%s", base, firstSeq, ext)
+}
+
+// rotatedSegments lists rotated segments for path ordered from oldest to newest.
+func rotatedSegments(path string) ([]string, error) {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(filepath.Base(path), ext)
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(base) + `-(\d{12,})` + regexp.QuoteMeta(ext) + "$")
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list audit segments: %w", err)
+	}
+
+	type segment struct {
+		path string
+		seq  uint64
+	}
+	segments := make([]segment, 0)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := pattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		seq, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, segment{path: filepath.Join(filepath.Dir(path), entry.Name()), seq: seq})
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].seq < segments[j].seq })
+
+	out := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		out = append(out, seg.path)
+	}
+	return out, nil
+}
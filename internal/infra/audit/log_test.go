@@ -0,0 +1,178 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_ChainsAndResumes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "mcpv.jsonl")
+
+	log, err := OpenLog(LogOptions{Path: path})
+	require.NoError(t, err)
+	first, err := log.Append(Record{Method: "tools/call", Tool: "a", Outcome: OutcomeOK})
+	require.NoError(t, err)
+	second, err := log.Append(Record{Method: "tools/call", Tool: "b", Outcome: OutcomeOK})
+	require.NoError(t, err)
+	require.NoError(t, log.Close())
+
+	require.Equal(t, uint64(1), first.Seq)
+	require.Empty(t, first.PrevHash)
+	require.Equal(t, first.Hash, second.PrevHash)
+
+	log, err = OpenLog(LogOptions{Path: path})
+	require.NoError(t, err)
+	third, err := log.Append(Record{Method: "tools/call", Tool: "c", Outcome: OutcomeOK})
+	require.NoError(t, err)
+	require.NoError(t, log.Close())
+	require.Equal(t, uint64(3), third.Seq)
+	require.Equal(t, second.Hash, third.PrevHash)
+
+	report, err := Verify(path)
+	require.NoError(t, err)
+	require.True(t, report.OK(), "%+v", report.Problems)
+	require.Equal(t, 3, report.Records)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, logFileMode, info.Mode().Perm())
+}
+
+func TestLog_RotatesBySizeAndAge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mcpv.jsonl")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	log, err := OpenLog(LogOptions{
+		Path:         path,
+		MaxSizeBytes: 800,
+		MaxAge:       time.Hour,
+		Now:          func() time.Time { return now },
+	})
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err := log.Append(Record{Method: "tools/call", Tool: strings.Repeat("x", 100), Outcome: OutcomeOK})
+		require.NoError(t, err)
+	}
+	now = now.Add(2 * time.Hour)
+	_, err = log.Append(Record{Method: "tools/call", Tool: "late", Outcome: OutcomeOK})
+	require.NoError(t, err)
+	require.NoError(t, log.Close())
+
+	backups, err := rotatedSegments(path)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	require.Equal(t, filepath.Join(dir, "mcpv-000000000001.jsonl"), backups[0])
+	require.Equal(t, filepath.Join(dir, "mcpv-000000000003.jsonl"), backups[1])
+
+	report, err := Verify(path)
+	require.NoError(t, err)
+	require.True(t, report.OK(), "%+v", report.Problems)
+	require.Equal(t, 5, report.Records)
+	require.Equal(t, uint64(5), report.LastSeq)
+}
+
+func TestLog_PrunesBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mcpv.jsonl")
+	log, err := OpenLog(LogOptions{Path: path, MaxSizeBytes: 1, MaxBackups: 2})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err := log.Append(Record{Method: "tools/call", Outcome: OutcomeOK})
+		require.NoError(t, err)
+	}
+	require.NoError(t, log.Close())
+
+	backups, err := rotatedSegments(path)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+
+	report, err := Verify(path)
+	require.NoError(t, err)
+	require.True(t, report.OK(), "%+v", report.Problems)
+	require.Equal(t, uint64(3), report.FirstSeq)
+}
+
+func TestVerify_DetectsTampering(t *testing.T) {
+	path := writeLog(t, 4)
+	lines := readLines(t, path)
+
+	t.Run("modified", func(t *testing.T) {
+		tampered := append([]string(nil), lines...)
+		tampered[1] = strings.Replace(tampered[1], `"tool":"t"`, `"tool":"evil"`, 1)
+		writeLines(t, path, tampered)
+
+		report, err := Verify(path)
+		require.NoError(t, err)
+		require.False(t, report.OK())
+		require.Equal(t, 2, report.Problems[0].Line)
+		require.Contains(t, report.Problems[0].Reason, "hash mismatch")
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		writeLines(t, path, append(append([]string(nil), lines[:1]...), lines[2:]...))
+
+		report, err := Verify(path)
+		require.NoError(t, err)
+		require.False(t, report.OK())
+		require.Contains(t, report.Problems[0].Reason, "prevHash")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		torn := append([]string(nil), lines...)
+		torn[3] = torn[3][:20]
+		require.NoError(t, os.WriteFile(path, []byte(strings.Join(torn, "\n")), 0o600))
+
+		report, err := Verify(path)
+		require.NoError(t, err)
+		require.Len(t, report.Problems, 1)
+		require.Equal(t, "truncated record", report.Problems[0].Reason)
+	})
+}
+
+func TestOpenLog_ResumesAfterTornWrite(t *testing.T) {
+	path := writeLog(t, 2)
+	lines := readLines(t, path)
+	require.NoError(t, os.WriteFile(path, []byte(lines[0]+"\n"+lines[1]+"\n"+`{"seq":3,`), 0o600))
+
+	log, err := OpenLog(LogOptions{Path: path})
+	require.NoError(t, err)
+	record, err := log.Append(Record{Method: "tools/call", Outcome: OutcomeOK})
+	require.NoError(t, err)
+	require.NoError(t, log.Close())
+	require.Equal(t, uint64(3), record.Seq)
+
+	report, err := Verify(path)
+	require.NoError(t, err)
+	require.Len(t, report.Problems, 1)
+	require.Equal(t, 3, report.Problems[0].Line)
+}
+
+func writeLog(t *testing.T, count int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "mcpv.jsonl")
+	log, err := OpenLog(LogOptions{Path: path})
+	require.NoError(t, err)
+	for i := 0; i < count; i++ {
+		_, err := log.Append(Record{Method: "tools/call", Tool: "t", Outcome: OutcomeOK})
+		require.NoError(t, err)
+	}
+	require.NoError(t, log.Close())
+	return path
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+}
+
+func writeLines(t *testing.T, path string, lines []string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Outcome classifies how a governed call finished.
+type Outcome string
+
+const (
+	// OutcomeOK indicates the call completed successfully.
+	OutcomeOK Outcome = "ok"
+	// OutcomeError indicates the call failed or the tool reported an error result.
+	OutcomeError Outcome = "error"
+	// OutcomeRejected indicates a governance plugin rejected the call.
+	OutcomeRejected Outcome = "rejected"
+)
+
+// Record is a single audit log line. Each record stores the hash of its
+// predecessor, so editing, dropping or reordering lines breaks the chain.
+type Record struct {
+	Seq        uint64          `json:"seq"`
+	Time       time.Time       `json:"time"`
+	RequestID  string          `json:"requestId,omitempty"`
+	Caller     string          `json:"caller,omitempty"`
+	Server     string          `json:"server,omitempty"`
+	SpecKey    string          `json:"specKey,omitempty"`
+	Method     string          `json:"method"`
+	Tool       string          `json:"tool,omitempty"`
+	Resource   string          `json:"resource,omitempty"`
+	Prompt     string          `json:"prompt,omitempty"`
+	ArgsDigest string          `json:"argsDigest,omitempty"`
+	Arguments  json.RawMessage `json:"arguments,omitempty"`
+	Outcome    Outcome         `json:"outcome"`
+	Code       string          `json:"code,omitempty"`
+	Message    string          `json:"message,omitempty"`
+	Plugin     string          `json:"plugin,omitempty"`
+	LatencyMs  float64         `json:"latencyMs"`
+	PrevHash   string          `json:"prevHash"`
+	Hash       string          `json:"hash"`
+}
+
+// computeHash returns the chain hash for the record, ignoring its Hash field.
+func computeHash(record Record) (string, error) {
+	record.Hash = ""
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
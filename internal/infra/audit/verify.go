@@ -0,0 +1,139 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Problem describes a single integrity violation found by Verify.
+type Problem struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Seq    uint64 `json:"seq,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Report summarizes an audit chain verification.
+type Report struct {
+	Files    []string  `json:"files"`
+	Records  int       `json:"records"`
+	FirstSeq uint64    `json:"firstSeq,omitempty"`
+	LastSeq  uint64    `json:"lastSeq,omitempty"`
+	Problems []Problem `json:"problems,omitempty"`
+}
+
+// OK reports whether the chain verified without problems.
+func (r Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// Verify checks every segment of the audit log at path, oldest first. The first
+// record found is trusted as the chain anchor, so pruned segments are not
+// reported; every later record must hash correctly and link to its predecessor.
+func Verify(path string) (Report, error) {
+	segments, err := rotatedSegments(path)
+	if err != nil {
+		return Report{}, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		segments = append(segments, path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return Report{}, fmt.Errorf("stat audit log: %w", err)
+	}
+	if len(segments) == 0 {
+		return Report{}, fmt.Errorf("audit log %s: %w", path, os.ErrNotExist)
+	}
+
+	v := &verifier{report: Report{Files: segments}}
+	for _, segment := range segments {
+		if err := v.verifySegment(segment); err != nil {
+			return Report{}, err
+		}
+	}
+	return v.report, nil
+}
+
+type verifier struct {
+	report   Report
+	started  bool
+	lastSeq  uint64
+	lastHash string
+}
+
+func (v *verifier) verifySegment(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open audit segment: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	lineNo := 0
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			lineNo++
+			v.verifyLine(path, lineNo, line, readErr == nil)
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("read audit segment: %w", readErr)
+		}
+	}
+}
+
+func (v *verifier) verifyLine(path string, lineNo int, line []byte, terminated bool) {
+	trimmed := bytes.TrimSpace(line)
+	if len(trimmed) == 0 {
+		return
+	}
+	var record Record
+	if err := json.Unmarshal(trimmed, &record); err != nil {
+		reason := "malformed record: " + err.Error()
+		if !terminated {
+			reason = "truncated record"
+		}
+		v.addProblem(path, lineNo, 0, reason)
+		return
+	}
+
+	v.report.Records++
+	if v.report.FirstSeq == 0 {
+		v.report.FirstSeq = record.Seq
+	}
+	v.report.LastSeq = record.Seq
+
+	expected, err := computeHash(record)
+	if err != nil {
+		v.addProblem(path, lineNo, record.Seq, "hash record: "+err.Error())
+	} else if expected != record.Hash {
+		v.addProblem(path, lineNo, record.Seq, "record hash mismatch (content modified)")
+	}
+	if v.started {
+		if record.PrevHash != v.lastHash {
+			v.addProblem(path, lineNo, record.Seq, "prevHash does not match previous record (record removed or reordered)")
+		}
+		if record.Seq != v.lastSeq+1 {
+			v.addProblem(path, lineNo, record.Seq, fmt.Sprintf("sequence gap: expected %d", v.lastSeq+1))
+		}
+	}
+	v.started = true
+	v.lastSeq = record.Seq
+	v.lastHash = record.Hash
+}
+
+func (v *verifier) addProblem(path string, lineNo int, seq uint64, reason string) {
+	v.report.Problems = append(v.report.Problems, Problem{
+		File:   path,
+		Line:   lineNo,
+		Seq:    seq,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,4 @@
+package callhistory
+
+// Package callhistory journals governed tool calls to a bbolt database and
+// answers filtered, paginated history queries.
@@ -0,0 +1,200 @@
+package callhistory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry/diagnostics"
+)
+
+const (
+	recordQueueSize = 1024
+	maxWriteBatch   = 128
+)
+
+// Options configures a Recorder.
+type Options struct {
+	Config domain.CallHistoryConfig
+	Logger *zap.Logger
+	Now    func() time.Time
+}
+
+// Recorder journals every governed tool call. Records are written by a
+// background goroutine so storage latency never slows calls down; when the
+// queue is full new records are dropped.
+type Recorder struct {
+	store           *Store
+	includePayloads bool
+	maxPayloadBytes int
+	redactor        diagnostics.KeyRedactor
+	logger          *zap.Logger
+
+	queue     chan domain.CallRecord
+	done      chan struct{}
+	closeOnce sync.Once
+	dropMu    sync.Mutex
+	dropped   uint64
+}
+
+// NewRecorder opens the call history store described by the config.
+func NewRecorder(opts Options) (*Recorder, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	cfg := opts.Config
+	store, err := OpenStore(StoreOptions{
+		Path:       cfg.Path,
+		MaxAge:     time.Duration(cfg.MaxAgeHours) * time.Hour,
+		MaxEntries: cfg.MaxEntries,
+		Now:        opts.Now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{
+		store:           store,
+		includePayloads: cfg.IncludePayloads,
+		maxPayloadBytes: cfg.MaxPayloadBytes,
+		redactor:        diagnostics.NewKeyRedactor(cfg.RedactKeys),
+		logger:          logger.Named("call_history"),
+		queue:           make(chan domain.CallRecord, recordQueueSize),
+		done:            make(chan struct{}),
+	}
+	go r.writeLoop()
+	return r, nil
+}
+
+// ObserveExecution queues a record for a completed tool call.
+func (r *Recorder) ObserveExecution(_ context.Context, execution governance.Execution) {
+	if r == nil || execution.Request.Method != "tools/call" {
+		return
+	}
+	record := r.buildRecord(execution)
+	select {
+	case r.queue <- record:
+	default:
+		r.dropMu.Lock()
+		r.dropped++
+		dropped := r.dropped
+		r.dropMu.Unlock()
+		if dropped == 1 || dropped%1000 == 0 {
+			r.logger.Warn("call history queue full; dropping records", zap.Uint64("dropped", dropped))
+		}
+	}
+}
+
+// QueryCallHistory returns recorded calls, newest first.
+func (r *Recorder) QueryCallHistory(_ context.Context, query domain.CallHistoryQuery) (domain.CallHistoryPage, error) {
+	if r == nil {
+		return domain.CallHistoryPage{}, nil
+	}
+	return r.store.Query(query)
+}
+
+// Close flushes queued records and closes the store.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.closeOnce.Do(func() {
+		close(r.queue)
+		<-r.done
+	})
+	return r.store.Close()
+}
+
+func (r *Recorder) writeLoop() {
+	defer close(r.done)
+	batch := make([]domain.CallRecord, 0, maxWriteBatch)
+	for record := range r.queue {
+		batch = append(batch[:0], record)
+	drain:
+		for len(batch) < maxWriteBatch {
+			select {
+			case next, ok := <-r.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+		if err := r.store.Append(batch...); err != nil {
+			r.logger.Warn("call history append failed", zap.Int("records", len(batch)), zap.Error(err))
+		}
+	}
+}
+
+func (r *Recorder) buildRecord(execution governance.Execution) domain.CallRecord {
+	req := execution.Request
+	record := domain.CallRecord{
+		Time:          execution.StartedAt,
+		Caller:        req.Caller,
+		Server:        req.Server,
+		Tool:          req.ToolName,
+		Duration:      execution.Duration,
+		ArgumentBytes: len(req.RequestJSON),
+		ResultBytes:   len(execution.Response),
+	}
+
+	rejection, rejected := execution.Rejected()
+	switch {
+	case rejected:
+		record.Status = domain.CallStatusRejected
+		record.Reason = rejectionReason(rejection.Code, rejection.Message)
+	case execution.Err != nil:
+		record.Status = domain.CallStatusError
+		record.Reason = execution.Err.Error()
+	case execution.ToolResultIsError():
+		record.Status = domain.CallStatusError
+		record.Reason = "tool returned isError"
+	default:
+		record.Status = domain.CallStatusOK
+	}
+
+	if r.includePayloads {
+		record.Arguments = r.payload(req.RequestJSON)
+		record.Result = r.payload(execution.Response)
+	}
+	return record
+}
+
+// payload returns raw with sensitive keys masked, or nil when it is empty,
+// not JSON, or larger than the configured limit.
+func (r *Recorder) payload(raw json.RawMessage) json.RawMessage {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || (r.maxPayloadBytes > 0 && len(raw) > r.maxPayloadBytes) {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(r.redactor.Redact(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func rejectionReason(code, message string) string {
+	switch {
+	case code == "":
+		return message
+	case message == "":
+		return code
+	default:
+		return code + ": " + message
+	}
+}
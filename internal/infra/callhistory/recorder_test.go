@@ -0,0 +1,65 @@
+package callhistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+)
+
+func TestRecorder_RecordsToolCalls(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "calls.db")
+	recorder, err := NewRecorder(Options{Config: domain.CallHistoryConfig{
+		Path:            path,
+		IncludePayloads: true,
+		MaxPayloadBytes: 64,
+		RedactKeys:      []string{"pass*"},
+	}})
+	require.NoError(t, err)
+
+	started := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	req := domain.GovernanceRequest{
+		Method:      "tools/call",
+		Caller:      "cli",
+		Server:      "github",
+		ToolName:    "github.create_issue",
+		RequestJSON: json.RawMessage(`{"title":"x","password":"p"}`),
+	}
+	ctx := context.Background()
+	recorder.ObserveExecution(ctx, governance.Execution{Request: req, Response: json.RawMessage(`{"content":[]}`), StartedAt: started, Duration: time.Second})
+	recorder.ObserveExecution(ctx, governance.Execution{Request: req, Response: json.RawMessage(`{"isError":true}`), StartedAt: started.Add(time.Second)})
+	recorder.ObserveExecution(ctx, governance.Execution{Request: req, Rejection: &domain.GovernanceDecision{RejectCode: "rate_limited", RejectMessage: "slow down"}, StartedAt: started.Add(2 * time.Second)})
+	recorder.ObserveExecution(ctx, governance.Execution{Request: req, Err: errors.New("boom"), StartedAt: started.Add(3 * time.Second)})
+	recorder.ObserveExecution(ctx, governance.Execution{Request: domain.GovernanceRequest{Method: "prompts/get"}, StartedAt: started.Add(4 * time.Second)})
+	require.NoError(t, recorder.Close())
+
+	store, err := OpenStore(StoreOptions{Path: path})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	page, err := store.Query(domain.CallHistoryQuery{})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 4)
+
+	require.Equal(t, domain.CallStatusError, page.Records[0].Status)
+	require.Equal(t, "boom", page.Records[0].Reason)
+	require.Equal(t, domain.CallStatusRejected, page.Records[1].Status)
+	require.Equal(t, "rate_limited: slow down", page.Records[1].Reason)
+	require.Equal(t, domain.CallStatusError, page.Records[2].Status)
+
+	ok := page.Records[3]
+	require.Equal(t, domain.CallStatusOK, ok.Status)
+	require.Equal(t, "cli", ok.Caller)
+	require.Equal(t, "github", ok.Server)
+	require.Equal(t, "github.create_issue", ok.Tool)
+	require.Equal(t, time.Second, ok.Duration)
+	require.Equal(t, len(req.RequestJSON), ok.ArgumentBytes)
+	require.JSONEq(t, `{"title":"x","password":"***"}`, string(ok.Arguments))
+	require.JSONEq(t, `{"content":[]}`, string(ok.Result))
+}
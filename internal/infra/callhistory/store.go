@@ -0,0 +1,289 @@
+package callhistory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	storeFileMode os.FileMode = 0o600
+	storeDirMode  os.FileMode = 0o700
+
+	keySize = 16
+
+	// DefaultQueryLimit is the page size used when a query sets no limit.
+	DefaultQueryLimit = 50
+	// MaxQueryLimit caps the page size of a single query.
+	MaxQueryLimit = 1000
+)
+
+var callsBucket = []byte("calls")
+
+// StoreOptions configures a call history store.
+type StoreOptions struct {
+	Path string
+	// MaxAge prunes records older than this; zero keeps records forever.
+	MaxAge time.Duration
+	// MaxEntries prunes the oldest records beyond this count; zero is unlimited.
+	MaxEntries int
+	Now        func() time.Time
+}
+
+// Store is an append-only bbolt journal of calls. Keys are the call start
+// time followed by a sequence number, so records iterate in time order and
+// the key doubles as the record ID.
+type Store struct {
+	db   *bolt.DB
+	opts StoreOptions
+
+	mu    sync.Mutex
+	count int
+}
+
+type storedRecord struct {
+	Time          time.Time         `json:"time"`
+	Caller        string            `json:"caller,omitempty"`
+	Server        string            `json:"server,omitempty"`
+	Tool          string            `json:"tool"`
+	DurationNs    int64             `json:"durationNs"`
+	Status        domain.CallStatus `json:"status"`
+	Reason        string            `json:"reason,omitempty"`
+	ArgumentBytes int               `json:"argumentBytes"`
+	ResultBytes   int               `json:"resultBytes"`
+	Arguments     json.RawMessage   `json:"arguments,omitempty"`
+	Result        json.RawMessage   `json:"result,omitempty"`
+}
+
+// OpenStore opens or creates the call history database.
+func OpenStore(opts StoreOptions) (*Store, error) {
+	path := strings.TrimSpace(opts.Path)
+	if path == "" {
+		return nil, errors.New("call history path is required")
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if err := os.MkdirAll(filepath.Dir(path), storeDirMode); err != nil {
+		return nil, fmt.Errorf("create call history dir: %w", err)
+	}
+	db, err := bolt.Open(path, storeFileMode, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open call history: %w", err)
+	}
+
+	s := &Store{db: db, opts: opts}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(callsBucket)
+		if err != nil {
+			return err
+		}
+		s.count = bucket.Stats().KeyN
+		return s.prune(bucket)
+	}); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("init call history: %w", err)
+	}
+	return s, nil
+}
+
+// Append writes records in one transaction and applies retention.
+func (s *Store) Append(records ...domain.CallRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := s.count
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(callsBucket)
+		for _, record := range records {
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			value, err := json.Marshal(toStoredRecord(record))
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(recordKey(record.Time, seq), value); err != nil {
+				return err
+			}
+			s.count++
+		}
+		return s.prune(bucket)
+	})
+	if err != nil {
+		s.count = count
+		return fmt.Errorf("append call history: %w", err)
+	}
+	return nil
+}
+
+// Query returns matching records, newest first.
+func (s *Store) Query(query domain.CallHistoryQuery) (domain.CallHistoryPage, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+
+	var upper []byte
+	if query.Cursor != "" {
+		cursor, err := hex.DecodeString(query.Cursor)
+		if err != nil || len(cursor) != keySize {
+			return domain.CallHistoryPage{}, domain.ErrInvalidCursor
+		}
+		upper = cursor
+	}
+	if !query.Until.IsZero() {
+		untilKey := recordKey(query.Until.Add(time.Nanosecond), 0)
+		if upper == nil || bytes.Compare(untilKey, upper) < 0 {
+			upper = untilKey
+		}
+	}
+	var lower []byte
+	if !query.Since.IsZero() {
+		lower = recordKey(query.Since, 0)
+	}
+
+	var page domain.CallHistoryPage
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(callsBucket).Cursor()
+		var k, v []byte
+		if upper == nil {
+			k, v = c.Last()
+		} else if k, _ = c.Seek(upper); k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			if lower != nil && bytes.Compare(k, lower) < 0 {
+				break
+			}
+			var stored storedRecord
+			if err := json.Unmarshal(v, &stored); err != nil {
+				return fmt.Errorf("decode call record %x: %w", k, err)
+			}
+			if !matches(stored, query) {
+				continue
+			}
+			if len(page.Records) == limit {
+				page.NextCursor = page.Records[limit-1].ID
+				break
+			}
+			page.Records = append(page.Records, fromStoredRecord(hex.EncodeToString(k), stored))
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.CallHistoryPage{}, err
+	}
+	return page, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+// prune drops the oldest records beyond the entry limit and records older
+// than the retention age. Callers hold s.mu or have exclusive access.
+func (s *Store) prune(bucket *bolt.Bucket) error {
+	var cutoff []byte
+	if s.opts.MaxAge > 0 {
+		cutoff = recordKey(s.opts.Now().Add(-s.opts.MaxAge), 0)
+	}
+	c := bucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		overLimit := s.opts.MaxEntries > 0 && s.count > s.opts.MaxEntries
+		expired := cutoff != nil && bytes.Compare(k, cutoff) < 0
+		if !overLimit && !expired {
+			return nil
+		}
+		if err := c.Delete(); err != nil {
+			return err
+		}
+		s.count--
+	}
+	return nil
+}
+
+func recordKey(at time.Time, seq uint64) []byte {
+	key := make([]byte, keySize)
+	nanos := at.UnixNano()
+	if nanos < 0 {
+		nanos = 0
+	}
+	binary.BigEndian.PutUint64(key[:8], uint64(nanos))
+	binary.BigEndian.PutUint64(key[8:], seq)
+	return key
+}
+
+func matches(record storedRecord, query domain.CallHistoryQuery) bool {
+	if query.Caller != "" && record.Caller != query.Caller {
+		return false
+	}
+	if query.Server != "" && record.Server != query.Server {
+		return false
+	}
+	if query.Tool != "" && record.Tool != query.Tool {
+		return false
+	}
+	if query.Status != "" && record.Status != query.Status {
+		return false
+	}
+	return true
+}
+
+func toStoredRecord(record domain.CallRecord) storedRecord {
+	return storedRecord{
+		Time:          record.Time.UTC(),
+		Caller:        record.Caller,
+		Server:        record.Server,
+		Tool:          record.Tool,
+		DurationNs:    record.Duration.Nanoseconds(),
+		Status:        record.Status,
+		Reason:        record.Reason,
+		ArgumentBytes: record.ArgumentBytes,
+		ResultBytes:   record.ResultBytes,
+		Arguments:     record.Arguments,
+		Result:        record.Result,
+	}
+}
+
+func fromStoredRecord(id string, stored storedRecord) domain.CallRecord {
+	return domain.CallRecord{
+		ID:            id,
+		Time:          stored.Time,
+		Caller:        stored.Caller,
+		Server:        stored.Server,
+		Tool:          stored.Tool,
+		Duration:      time.Duration(stored.DurationNs),
+		Status:        stored.Status,
+		Reason:        stored.Reason,
+		ArgumentBytes: stored.ArgumentBytes,
+		ResultBytes:   stored.ResultBytes,
+		Arguments:     stored.Arguments,
+		Result:        stored.Result,
+	}
+}
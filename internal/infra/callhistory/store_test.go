@@ -0,0 +1,97 @@
+package callhistory
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+)
+
+func TestStore_QueryFiltersAndPaginates(t *testing.T) {
+	store, err := OpenStore(StoreOptions{Path: filepath.Join(t.TempDir(), "calls.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var records []domain.CallRecord
+	for i := range 5 {
+		status := domain.CallStatusOK
+		if i%2 == 1 {
+			status = domain.CallStatusError
+		}
+		records = append(records, domain.CallRecord{
+			Time:     base.Add(time.Duration(i) * time.Minute),
+			Caller:   "cli",
+			Server:   "github",
+			Tool:     "github.search",
+			Duration: 20 * time.Millisecond,
+			Status:   status,
+		})
+	}
+	records = append(records, domain.CallRecord{Time: base.Add(10 * time.Minute), Caller: "ide", Tool: "fs.read", Status: domain.CallStatusOK})
+	require.NoError(t, store.Append(records...))
+
+	page, err := store.Query(domain.CallHistoryQuery{Caller: "cli", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2)
+	require.Equal(t, base.Add(4*time.Minute), page.Records[0].Time)
+	require.Equal(t, base.Add(3*time.Minute), page.Records[1].Time)
+	require.Equal(t, 20*time.Millisecond, page.Records[0].Duration)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = store.Query(domain.CallHistoryQuery{Caller: "cli", Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2)
+	require.Equal(t, base.Add(2*time.Minute), page.Records[0].Time)
+
+	page, err = store.Query(domain.CallHistoryQuery{Status: domain.CallStatusError})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 2)
+	require.Empty(t, page.NextCursor)
+
+	page, err = store.Query(domain.CallHistoryQuery{Since: base.Add(time.Minute), Until: base.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 3)
+	require.Equal(t, base.Add(3*time.Minute), page.Records[0].Time)
+	require.Equal(t, base.Add(time.Minute), page.Records[2].Time)
+
+	_, err = store.Query(domain.CallHistoryQuery{Cursor: "zz"})
+	require.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestStore_Retention(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "calls.db")
+	store, err := OpenStore(StoreOptions{
+		Path:       path,
+		MaxAge:     time.Hour,
+		MaxEntries: 3,
+		Now:        func() time.Time { return now },
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Append(domain.CallRecord{Time: now.Add(-2 * time.Hour), Tool: "old"}))
+	for i := range 4 {
+		require.NoError(t, store.Append(domain.CallRecord{Time: now.Add(time.Duration(i) * time.Second), Tool: "new"}))
+	}
+
+	page, err := store.Query(domain.CallHistoryQuery{})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 3)
+	for _, record := range page.Records {
+		require.Equal(t, "new", record.Tool)
+	}
+	require.Equal(t, now.Add(time.Second), page.Records[2].Time)
+	require.NoError(t, store.Close())
+
+	now = now.Add(2 * time.Hour)
+	store, err = OpenStore(StoreOptions{Path: path, MaxAge: time.Hour, Now: func() time.Time { return now }})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	page, err = store.Query(domain.CallHistoryQuery{})
+	require.NoError(t, err)
+	require.Empty(t, page.Records)
+}
@@ -436,8 +436,32 @@ func normalizeEditorPluginSpec(spec domain.PluginSpec) (domain.PluginSpec, error
 		}
 		cmd = append(cmd, trimmed)
 	}
-	if len(cmd) == 0 {
-		return domain.PluginSpec{}, fmt.Errorf("plugin cmd is required")
+	runtime, ok := domain.NormalizePluginRuntime(string(spec.Runtime))
+	if !ok {
+		return domain.PluginSpec{}, fmt.Errorf("plugin runtime must be process or wasm")
+	}
+	module := strings.TrimSpace(spec.Module)
+	switch runtime {
+	case domain.PluginRuntimeWasm:
+		if module == "" {
+			return domain.PluginSpec{}, fmt.Errorf("plugin module is required for wasm runtime")
+		}
+		if len(cmd) > 0 {
+			return domain.PluginSpec{}, fmt.Errorf("plugin cmd must be empty for wasm runtime")
+		}
+	default:
+		if len(cmd) == 0 {
+			return domain.PluginSpec{}, fmt.Errorf("plugin cmd is required")
+		}
+		if module != "" {
+			return domain.PluginSpec{}, fmt.Errorf("plugin module is only supported for wasm runtime")
+		}
+	}
+	if spec.MemoryLimitMB < 0 {
+		return domain.PluginSpec{}, fmt.Errorf("plugin memoryLimitMb must be >= 0")
+	}
+	if spec.FuelLimit < 0 {
+		return domain.PluginSpec{}, fmt.Errorf("plugin fuelLimit must be >= 0")
 	}
 
 	flowStrings := make([]string, 0, len(spec.Flows))
@@ -473,7 +497,9 @@ func normalizeEditorPluginSpec(spec domain.PluginSpec) (domain.PluginSpec, error
 
 	spec.Name = name
 	spec.Category = category
+	spec.Runtime = runtime
 	spec.Cmd = cmd
+	spec.Module = module
 	spec.Env = normalizer.NormalizeEnvMap(spec.Env)
 	spec.Cwd = strings.TrimSpace(spec.Cwd)
 	spec.CommitHash = strings.TrimSpace(spec.CommitHash)
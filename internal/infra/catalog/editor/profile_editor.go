@@ -23,23 +23,34 @@ var ErrPluginExists = errors.New("plugin already exists")
 var ErrPluginNotFound = errors.New("plugin not found")
 
 type serverSpecYAML struct {
-	Name                string              `yaml:"name"`
-	Transport           string              `yaml:"transport,omitempty"`
-	Cmd                 []string            `yaml:"cmd"`
-	Env                 map[string]string   `yaml:"env,omitempty"`
-	Cwd                 string              `yaml:"cwd,omitempty"`
-	Tags                []string            `yaml:"tags,omitempty"`
-	IdleSeconds         int                 `yaml:"idleSeconds"`
-	MaxConcurrent       int                 `yaml:"maxConcurrent"`
-	Strategy            string              `yaml:"strategy,omitempty"`
-	SessionTTLSeconds   int                 `yaml:"sessionTTLSeconds,omitempty"`
-	Disabled            bool                `yaml:"disabled,omitempty"`
-	MinReady            int                 `yaml:"minReady"`
-	ActivationMode      string              `yaml:"activationMode,omitempty"`
-	DrainTimeoutSeconds int                 `yaml:"drainTimeoutSeconds"`
-	ProtocolVersion     string              `yaml:"protocolVersion"`
-	ExposeTools         []string            `yaml:"exposeTools,omitempty"`
-	HTTP                *streamableHTTPYAML `yaml:"http,omitempty"`
+	Name                string                      `yaml:"name"`
+	Transport           string                      `yaml:"transport,omitempty"`
+	Cmd                 []string                    `yaml:"cmd"`
+	Env                 map[string]string           `yaml:"env,omitempty"`
+	Cwd                 string                      `yaml:"cwd,omitempty"`
+	Tags                []string                    `yaml:"tags,omitempty"`
+	IdleSeconds         int                         `yaml:"idleSeconds"`
+	MaxConcurrent       int                         `yaml:"maxConcurrent"`
+	Strategy            string                      `yaml:"strategy,omitempty"`
+	SessionTTLSeconds   int                         `yaml:"sessionTTLSeconds,omitempty"`
+	Disabled            bool                        `yaml:"disabled,omitempty"`
+	MinReady            int                         `yaml:"minReady"`
+	ActivationMode      string                      `yaml:"activationMode,omitempty"`
+	DrainTimeoutSeconds int                         `yaml:"drainTimeoutSeconds"`
+	ProtocolVersion     string                      `yaml:"protocolVersion"`
+	ExposeTools         []string                    `yaml:"exposeTools,omitempty"`
+	Tools               map[string]toolOverrideYAML `yaml:"tools,omitempty"`
+	HTTP                *streamableHTTPYAML         `yaml:"http,omitempty"`
+}
+
+type toolOverrideYAML struct {
+	Name              string         `yaml:"name,omitempty"`
+	Aliases           []string       `yaml:"aliases,omitempty"`
+	Description       string         `yaml:"description,omitempty"`
+	AppendDescription string         `yaml:"appendDescription,omitempty"`
+	Title             string         `yaml:"title,omitempty"`
+	AppendTitle       string         `yaml:"appendTitle,omitempty"`
+	FixedArgs         map[string]any `yaml:"fixedArgs,omitempty"`
 }
 
 type streamableHTTPYAML struct {
@@ -60,7 +71,11 @@ type pluginSpecYAML struct {
 	Category           string            `yaml:"category"`
 	Required           bool              `yaml:"required"`
 	Disabled           bool              `yaml:"disabled,omitempty"`
-	Cmd                []string          `yaml:"cmd"`
+	Runtime            string            `yaml:"runtime,omitempty"`
+	Cmd                []string          `yaml:"cmd,omitempty"`
+	Module             string            `yaml:"module,omitempty"`
+	MemoryLimitMB      int               `yaml:"memoryLimitMb,omitempty"`
+	FuelLimit          int64             `yaml:"fuelLimit,omitempty"`
 	Env                map[string]string `yaml:"env,omitempty"`
 	Cwd                string            `yaml:"cwd,omitempty"`
 	CommitHash         string            `yaml:"commitHash,omitempty"`
@@ -161,7 +176,12 @@ func UpdateServer(path string, server domain.ServerSpec) (ProfileUpdate, error)
 	found := false
 	for i := range servers {
 		if strings.TrimSpace(servers[i].Name) == serverName {
-			servers[i] = toServerSpecYAML(server)
+			updated := toServerSpecYAML(server)
+			// Editors that do not manage tool overrides leave them in place.
+			if updated.Tools == nil {
+				updated.Tools = servers[i].Tools
+			}
+			servers[i] = updated
 			found = true
 			break
 		}
@@ -536,10 +556,30 @@ func toServerSpecYAML(spec domain.ServerSpec) serverSpecYAML {
 		DrainTimeoutSeconds: spec.DrainTimeoutSeconds,
 		ProtocolVersion:     spec.ProtocolVersion,
 		ExposeTools:         exposeTools,
+		Tools:               toToolOverridesYAML(spec.Tools),
 		HTTP:                httpCfg,
 	}
 }
 
+func toToolOverridesYAML(overrides map[string]domain.ToolOverride) map[string]toolOverrideYAML {
+	if len(overrides) == 0 {
+		return nil
+	}
+	out := make(map[string]toolOverrideYAML, len(overrides))
+	for name, override := range overrides {
+		out[name] = toolOverrideYAML{
+			Name:              override.Name,
+			Aliases:           append([]string(nil), override.Aliases...),
+			Description:       override.Description,
+			AppendDescription: override.AppendDescription,
+			Title:             override.Title,
+			AppendTitle:       override.AppendTitle,
+			FixedArgs:         override.FixedArgs,
+		}
+	}
+	return out
+}
+
 func toPluginSpecYAML(spec domain.PluginSpec) pluginSpecYAML {
 	env := spec.Env
 	if len(env) == 0 {
@@ -570,7 +610,11 @@ func toPluginSpecYAML(spec domain.PluginSpec) pluginSpecYAML {
 		Category:           string(spec.Category),
 		Required:           spec.Required,
 		Disabled:           spec.Disabled,
+		Runtime:            pluginRuntimeYAML(spec.Runtime),
 		Cmd:                append([]string(nil), spec.Cmd...),
+		Module:             spec.Module,
+		MemoryLimitMB:      spec.MemoryLimitMB,
+		FuelLimit:          spec.FuelLimit,
 		Env:                env,
 		Cwd:                spec.Cwd,
 		CommitHash:         spec.CommitHash,
@@ -581,6 +625,13 @@ func toPluginSpecYAML(spec domain.PluginSpec) pluginSpecYAML {
 	}
 }
 
+func pluginRuntimeYAML(runtime domain.PluginRuntime) string {
+	if runtime == domain.PluginRuntimeProcess {
+		return ""
+	}
+	return string(runtime)
+}
+
 func loadProfileDocument(path string) (map[string]any, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -197,6 +197,41 @@ servers:
 	require.Equal(t, 2, servers[0].MaxConcurrent)
 }
 
+func TestUpdateServer_PreservesToolOverrides(t *testing.T) {
+	profilePath := filepath.Join(t.TempDir(), "default.yaml")
+	content := `
+servers:
+  - name: existing
+    cmd: ["./a"]
+    protocolVersion: "2025-11-25"
+    tools:
+      createIssue:
+        name: file_bug
+        fixedArgs:
+          repoOwner: acme
+`
+	require.NoError(t, os.WriteFile(profilePath, []byte(content), fsutil.DefaultFileMode))
+
+	update, err := UpdateServer(profilePath, domain.ServerSpec{
+		Name:            "existing",
+		Cmd:             []string{"./updated"},
+		MaxConcurrent:   1,
+		Strategy:        domain.StrategyStateless,
+		ProtocolVersion: domain.DefaultProtocolVersion,
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		Servers []serverSpecYAML `yaml:"servers"`
+	}
+	require.NoError(t, yaml.Unmarshal(update.Data, &doc))
+	require.Len(t, doc.Servers, 1)
+	require.Equal(t, []string{"./updated"}, doc.Servers[0].Cmd)
+	require.Equal(t, map[string]toolOverrideYAML{
+		"createIssue": {Name: "file_bug", FixedArgs: map[string]any{"repoOwner": "acme"}},
+	}, doc.Servers[0].Tools)
+}
+
 func TestUpdateServer_Missing(t *testing.T) {
 	profilePath := filepath.Join(t.TempDir(), "default.yaml")
 	content := `
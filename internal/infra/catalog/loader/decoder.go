@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	"gopkg.in/yaml.v3"
+
 	"mcpv/internal/infra/catalog/normalizer"
 )
 
@@ -16,6 +18,9 @@ func decodeRuntimeConfig(expanded string) (normalizer.RawRuntimeConfig, error) {
 	if err := v.Unmarshal(&cfg); err != nil {
 		return normalizer.RawRuntimeConfig{}, fmt.Errorf("decode config: %w", err)
 	}
+	if err := decodeCaseSensitive(expanded, nil, &cfg); err != nil {
+		return normalizer.RawRuntimeConfig{}, err
+	}
 	return cfg, nil
 }
 
@@ -28,5 +33,31 @@ func decodeCatalog(expanded string) (normalizer.RawCatalog, error) {
 	if err := v.Unmarshal(&cfg); err != nil {
 		return normalizer.RawCatalog{}, fmt.Errorf("decode config: %w", err)
 	}
+	if err := decodeCaseSensitive(expanded, cfg.Servers, &cfg.RawRuntimeConfig); err != nil {
+		return normalizer.RawCatalog{}, err
+	}
 	return cfg, nil
 }
+
+// decodeCaseSensitive re-reads sections that carry tool names, schema
+// properties or argument names without viper, which lowercases map keys.
+func decodeCaseSensitive(expanded string, servers []normalizer.RawServerSpec, runtime *normalizer.RawRuntimeConfig) error {
+	var doc struct {
+		Servers []struct {
+			Tools map[string]normalizer.RawToolOverride `yaml:"tools"`
+		} `yaml:"servers"`
+		VirtualTools []normalizer.RawVirtualTool `yaml:"virtualTools"`
+	}
+	if err := yaml.Unmarshal([]byte(expanded), &doc); err != nil {
+		return fmt.Errorf("decode config: %w", err)
+	}
+	for i := range servers {
+		if i < len(doc.Servers) {
+			servers[i].Tools = doc.Servers[i].Tools
+		}
+	}
+	if runtime != nil {
+		runtime.VirtualTools = doc.VirtualTools
+	}
+	return nil
+}
@@ -412,6 +412,267 @@ servers:
 	require.Contains(t, err.Error(), "schema validation failed")
 }
 
+func TestLoader_WasmPlugin(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+plugins:
+  - name: policy
+    category: authorization
+    runtime: wasm
+    module: ./policy.wasm
+    memoryLimitMb: 32
+    fuelLimit: 5000
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Len(t, catalog.Plugins, 1)
+
+	got := catalog.Plugins[0]
+	require.Equal(t, domain.PluginRuntimeWasm, got.Runtime)
+	require.Equal(t, "./policy.wasm", got.Module)
+	require.Equal(t, 32, got.MemoryLimitMB)
+	require.Equal(t, int64(5000), got.FuelLimit)
+	require.Empty(t, got.Cmd)
+}
+
+func TestLoader_WasmPluginInvalid(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+plugins:
+  - name: policy
+    category: authorization
+    runtime: wasm
+    cmd: ["./policy"]
+  - name: proc
+    category: audit
+    cmd: ["./audit"]
+    module: ./audit.wasm
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "module is required for wasm runtime")
+	require.Contains(t, err.Error(), "cmd must be empty for wasm runtime")
+	require.Contains(t, err.Error(), "module is only supported for wasm runtime")
+}
+
+func TestLoader_AuditConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+audit:
+  enabled: true
+  path: ./audit/mcpv.jsonl
+  maxAgeHours: 24
+  includeArguments: true
+  redactKeys: ["Password", " "]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+
+	got := catalog.Runtime.Audit
+	require.True(t, got.Enabled)
+	require.Equal(t, "./audit/mcpv.jsonl", got.Path)
+	require.Equal(t, domain.DefaultAuditMaxSizeMB, got.MaxSizeMB)
+	require.Equal(t, 24, got.MaxAgeHours)
+	require.True(t, got.IncludeArguments)
+	require.Equal(t, []string{"password"}, got.RedactKeys)
+}
+
+func TestLoader_AuditConfigRequiresPath(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+audit:
+  enabled: true
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "audit.path is required")
+}
+
+func TestLoader_RedactionDefaults(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+redaction:
+  enabled: true
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+
+	rules := catalog.Runtime.Redaction.Rules
+	require.Len(t, rules, 4)
+	require.Equal(t, "secret", rules[0].Name)
+	require.Equal(t, domain.RedactionActionMask, rules[0].Action)
+}
+
+func TestLoader_RedactionInvalidRules(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+redaction:
+  enabled: true
+  rules:
+    - detector: regex
+    - name: bad
+      detector: regex
+      pattern: "("
+    - name: bad
+      detector: email
+      action: reject
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "pattern is required for regex detector")
+	require.Contains(t, err.Error(), "invalid pattern")
+	require.Contains(t, err.Error(), `duplicate rule name "bad"`)
+}
+
+func TestLoader_RateLimitConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+rateLimits:
+  enabled: true
+  statePath: /tmp/mcpv-quota.json
+  rules:
+    - name: cursor-github
+      caller: cursor*
+      server: github
+      rate: 60
+      per: minute
+    - tag: Batch
+      dailyQuota: 1000
+      scope: shared
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+
+	cfg := catalog.Runtime.RateLimits
+	require.True(t, cfg.Enabled)
+	require.Len(t, cfg.Rules, 2)
+	require.Equal(t, domain.RateLimitRule{
+		Name:          "cursor-github",
+		Caller:        "cursor*",
+		Server:        "github",
+		Rate:          60,
+		PeriodSeconds: 60,
+		Burst:         60,
+		Scope:         domain.RateLimitScopeCaller,
+	}, cfg.Rules[0])
+	require.Equal(t, "rule-2", cfg.Rules[1].Name)
+	require.Equal(t, "batch", cfg.Rules[1].Tag)
+	require.Equal(t, domain.RateLimitScopeShared, cfg.Rules[1].Scope)
+}
+
+func TestLoader_RateLimitInvalidRules(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+rateLimits:
+  enabled: true
+  rules:
+    - name: empty
+    - name: quota
+      tool: "[bad"
+      dailyQuota: 10
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rate or dailyQuota is required")
+	require.Contains(t, err.Error(), `invalid tool pattern "[bad"`)
+	require.Contains(t, err.Error(), "statePath is required when dailyQuota is set")
+}
+
+func TestLoader_ResponseCacheConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+responseCache:
+  enabled: true
+  resources: false
+  tools:
+    - server: github
+      tool: list_*
+      ttlSeconds: 300
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+
+	cfg := catalog.Runtime.ResponseCache
+	require.True(t, cfg.Enabled)
+	require.True(t, cfg.ReadOnlyTools)
+	require.False(t, cfg.Resources)
+	require.Equal(t, domain.DefaultResponseCacheTTLSeconds, cfg.TTLSeconds)
+	require.Equal(t, domain.DefaultResponseCacheMaxEntries, cfg.MaxEntries)
+	require.Equal(t, []domain.ResponseCacheToolRule{{Server: "github", Tool: "list_*", TTLSeconds: 300}}, cfg.Tools)
+}
+
+func TestLoader_ResponseCacheInvalidRules(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+responseCache:
+  enabled: true
+  maxBytes: 10
+  maxEntryBytes: 20
+  tools:
+    - server: "[bad"
+      tool: x
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maxEntryBytes must not exceed maxBytes")
+	require.Contains(t, err.Error(), `invalid server pattern "[bad"`)
+}
+
+func TestLoader_GovernanceForwardMetadata(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: ok
+    cmd: ["./a"]
+governance:
+  forwardMetadata: ["user.id", " tenant ", "user.id", ""]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Equal(t, []string{"user.id", "tenant"}, catalog.Runtime.Governance.ForwardMetadata)
+}
+
 func writeTempConfig(t *testing.T, content string) string {
 	t.Helper()
 
@@ -423,3 +684,488 @@ func writeTempConfig(t *testing.T, content string) string {
 	}
 	return path
 }
+
+func TestLoader_ToolOverrides(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: github
+    cmd: ["./gh"]
+    tools:
+      createIssue:
+        name: fileBug
+        aliases: ["open_issue"]
+        appendDescription: "Use for bug reports only."
+        fixedArgs:
+          repoOwner: acme
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	override, ok := catalog.Specs["github"].ToolOverrideFor("createIssue")
+	require.True(t, ok)
+	require.Equal(t, "fileBug", override.Name)
+	require.Equal(t, []string{"open_issue"}, override.Aliases)
+	require.Equal(t, "Use for bug reports only.", override.AppendDescription)
+	require.Equal(t, map[string]any{"repoOwner": "acme"}, override.FixedArgs)
+}
+
+func TestLoader_ToolOverridesRejectCollisions(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: github
+    cmd: ["./gh"]
+    tools:
+      create_issue:
+        name: issue
+      update_issue:
+        aliases: ["issue"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `tools.update_issue exposes "issue" which is already used by tools.create_issue`)
+}
+
+func TestLoader_VirtualTools(t *testing.T) {
+	file := writeTempConfig(t, `
+virtualTools:
+  - name: triageIssue
+    description: Fetch and label an issue.
+    steps:
+      - tool: github.get_issue
+        args:
+          issueNumber: "{{ inputs.number }}"
+      - id: label
+        tool: github.add_label
+    result:
+      step: step1
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Len(t, catalog.Runtime.VirtualTools, 1)
+	tool := catalog.Runtime.VirtualTools[0]
+	require.Equal(t, "triageIssue", tool.Name)
+	require.Equal(t, map[string]any{"type": "object"}, tool.InputSchema)
+	require.Equal(t, "step1", tool.Steps[0].ID)
+	require.Equal(t, map[string]any{"issueNumber": "{{ inputs.number }}"}, tool.Steps[0].Args)
+	require.Equal(t, "label", tool.Steps[1].ID)
+	require.Equal(t, "step1", tool.Result.Step)
+}
+
+func TestLoader_VirtualToolsRejectInvalid(t *testing.T) {
+	file := writeTempConfig(t, `
+virtualTools:
+  - name: outer
+    steps:
+      - tool: inner
+    result:
+      step: missing
+      aggregate: true
+  - name: inner
+    steps:
+      - id: a.b
+        tool: echo
+servers:
+  - name: echo
+    cmd: ["./echo"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `virtualTools[0].steps[0]: tool "inner" is a virtual tool`)
+	require.Contains(t, err.Error(), `virtualTools[0]: result.step "missing" does not match any step id`)
+	require.Contains(t, err.Error(), `virtualTools[0]: result accepts only one of step, aggregate or template`)
+	require.Contains(t, err.Error(), `virtualTools[1].steps[0]: id "a.b" must not contain '.'`)
+}
+
+func TestLoader_ClientProfiles(t *testing.T) {
+	file := writeTempConfig(t, `
+clients:
+  - name: cursor-repo-x
+    callers: ["cursor*"]
+    tags: ["Repo-X"]
+    includeServers: ["github", "fs"]
+    includeTools: ["get_*", "read_*", "search_*"]
+    toolNamespaceStrategy: flat
+    subAgent: false
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Len(t, catalog.Runtime.Clients, 1)
+	profile := catalog.Runtime.Clients[0]
+	require.Equal(t, "cursor-repo-x", profile.Name)
+	require.Equal(t, []string{"cursor*"}, profile.Callers)
+	require.Equal(t, []string{"repo-x"}, profile.Tags)
+	require.Equal(t, []string{"github", "fs"}, profile.IncludeServers)
+	require.Equal(t, domain.ToolNamespaceStrategyFlat, profile.ToolNamespaceStrategy)
+	require.NotNil(t, profile.SubAgent)
+	require.False(t, *profile.SubAgent)
+}
+
+func TestLoader_ClientProfilesRejectInvalid(t *testing.T) {
+	file := writeTempConfig(t, `
+clients:
+  - name: empty
+  - name: empty
+    callers: ["[bad"]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "clients[0]: callers or tags is required")
+	require.Contains(t, err.Error(), `clients[1]: duplicate profile name "empty"`)
+	require.Contains(t, err.Error(), `clients[1].callers: invalid pattern "[bad"`)
+}
+
+func TestLoader_AdminTools(t *testing.T) {
+	file := writeTempConfig(t, `
+adminTools:
+  enabled: true
+  callers: ["ops-*"]
+  tags: ["Admin"]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	admin := catalog.Runtime.AdminTools
+	require.True(t, admin.Enabled)
+	require.Equal(t, []string{"ops-*"}, admin.Callers)
+	require.Equal(t, []string{"admin"}, admin.Tags)
+}
+
+func TestLoader_AdminToolsRequireSelector(t *testing.T) {
+	file := writeTempConfig(t, `
+adminTools:
+  enabled: true
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "adminTools: callers or tags is required when enabled")
+}
+
+func TestLoader_TracingConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+observability:
+  tracing:
+    enabled: true
+    exporter: otlp-http
+    endpoint: http://collector:4318
+    samplingRatio: 0.25
+    headers:
+      x-api-key: secret
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	tracing := catalog.Runtime.Observability.Tracing
+	require.True(t, tracing.Enabled)
+	require.Equal(t, domain.TracingExporterOTLPHTTP, tracing.Exporter)
+	require.Equal(t, "http://collector:4318", tracing.Endpoint)
+	require.InDelta(t, 0.25, tracing.SamplingRatio, 1e-9)
+	require.Equal(t, map[string]string{"x-api-key": "secret"}, tracing.Headers)
+	require.Equal(t, domain.DefaultTracingServiceName, tracing.ServiceName)
+}
+
+func TestLoader_TracingConfigRejectsInvalidRatio(t *testing.T) {
+	file := writeTempConfig(t, `
+observability:
+  tracing:
+    enabled: true
+    samplingRatio: 2
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "samplingRatio")
+}
+
+func TestLoader_CallHistoryDefaults(t *testing.T) {
+	file := writeTempConfig(t, `
+callHistory:
+  enabled: true
+  path: ./history/calls.db
+  redactKeys: [" Password "]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	history := catalog.Runtime.CallHistory
+	require.True(t, history.Enabled)
+	require.Equal(t, "./history/calls.db", history.Path)
+	require.Equal(t, domain.DefaultCallHistoryMaxAgeHours, history.MaxAgeHours)
+	require.Equal(t, domain.DefaultCallHistoryMaxEntries, history.MaxEntries)
+	require.Equal(t, domain.DefaultCallHistoryMaxPayloadBytes, history.MaxPayloadBytes)
+	require.Equal(t, []string{"password"}, history.RedactKeys)
+}
+
+func TestLoader_CallHistoryZeroLimitsDisablePruning(t *testing.T) {
+	file := writeTempConfig(t, `
+callHistory:
+  enabled: true
+  path: ./history/calls.db
+  maxAgeHours: 0
+  maxEntries: 0
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	history := catalog.Runtime.CallHistory
+	require.Zero(t, history.MaxAgeHours)
+	require.Zero(t, history.MaxEntries)
+}
+
+func TestLoader_CallHistoryRequiresPath(t *testing.T) {
+	file := writeTempConfig(t, `
+callHistory:
+  enabled: true
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "callHistory.path is required")
+}
+
+func TestLoader_LogStoreDefaults(t *testing.T) {
+	file := writeTempConfig(t, `
+logStore:
+  enabled: true
+  dir: ./logs
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	store := catalog.Runtime.LogStore
+	require.True(t, store.Enabled)
+	require.Equal(t, "./logs", store.Dir)
+	require.Equal(t, domain.DefaultLogStoreMaxSizeMB, store.MaxSizeMB)
+	require.Equal(t, domain.DefaultLogStoreMaxAgeHours, store.MaxAgeHours)
+	require.Equal(t, domain.DefaultLogStoreMaxBackups, store.MaxBackups)
+	require.Equal(t, domain.DefaultLogStoreMinLevel, store.MinLevel)
+}
+
+func TestLoader_LogStoreRequiresDir(t *testing.T) {
+	file := writeTempConfig(t, `
+logStore:
+  enabled: true
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "logStore.dir is required")
+}
+
+func TestLoader_ToolStatsConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+observability:
+  toolStats:
+    slos:
+      - server: github
+        tool: search
+        latencyTargetMs: 500
+        errorBudget: 0.01
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	stats := catalog.Runtime.Observability.ToolStats
+	require.Equal(t, domain.DefaultToolStatsMaxToolsPerServer, stats.MaxToolsPerServer)
+	require.Equal(t, []domain.ToolSLOConfig{{
+		Name:            "slo-1",
+		Server:          "github",
+		Tool:            "search",
+		LatencyTargetMs: 500,
+		ErrorBudget:     0.01,
+		WindowMinutes:   domain.DefaultToolSLOWindowMinutes,
+	}}, stats.SLOs)
+}
+
+func TestLoader_ToolStatsRejectsDuplicateSLONames(t *testing.T) {
+	file := writeTempConfig(t, `
+observability:
+  toolStats:
+    slos:
+      - name: search
+        errorBudget: 0.01
+      - name: search
+        errorBudget: 0.05
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `observability.toolStats.slos[1]: duplicate slo name "search"`)
+}
+
+func TestLoader_ReadinessConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReadinessConfig{
+		Bootstrap:       true,
+		RequiredPlugins: true,
+		RPCListener:     true,
+		Servers:         []string{},
+	}, catalog.Runtime.Observability.Readiness)
+
+	file = writeTempConfig(t, `
+observability:
+  readiness:
+    requiredPlugins: false
+    servers: [" github ", "github", ""]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	catalog, err = loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReadinessConfig{
+		Bootstrap:   true,
+		RPCListener: true,
+		Servers:     []string{"github"},
+	}, catalog.Runtime.Observability.Readiness)
+}
+
+func TestLoader_AlertsConfig(t *testing.T) {
+	file := writeTempConfig(t, `
+alerts:
+  enabled: true
+  sinks:
+    - name: ops
+      type: webhook
+      url: https://hooks.example.com/mcpv
+      secretEnvVar: MCPV_ALERT_SECRET
+      maxRetries: 0
+    - name: journal
+      type: file
+      path: ./alerts.jsonl
+  rules:
+    - events: [server_init_failed, reload_rollback]
+      servers: ["git*"]
+      sinks: [ops, journal]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	catalog, err := loader.Load(context.Background(), file)
+	require.NoError(t, err)
+	alerts := catalog.Runtime.Alerts
+	require.True(t, alerts.Enabled)
+	require.Len(t, alerts.Sinks, 2)
+	require.Equal(t, domain.AlertSinkConfig{
+		Name:            "ops",
+		Type:            domain.AlertSinkWebhook,
+		URL:             "https://hooks.example.com/mcpv",
+		SecretEnvVar:    "MCPV_ALERT_SECRET",
+		TimeoutSeconds:  domain.DefaultAlertSinkTimeoutSeconds,
+		MaxRetries:      0,
+		RetryBackoffMs:  domain.DefaultAlertSinkRetryBackoffMs,
+		DebounceSeconds: domain.DefaultAlertSinkDebounceSeconds,
+	}, alerts.Sinks[0])
+	require.Equal(t, domain.DefaultAlertSinkMaxRetries, alerts.Sinks[1].MaxRetries)
+	require.Equal(t, []domain.AlertRuleConfig{{
+		Name:    "rule-1",
+		Events:  []domain.AlertEventType{domain.AlertEventServerInitFailed, domain.AlertEventReloadRollback},
+		Servers: []string{"git*"},
+		Sinks:   []string{"ops", "journal"},
+	}}, alerts.Rules)
+	require.Equal(t, domain.AlertRejectionSpikeConfig{
+		Threshold:     domain.DefaultAlertRejectionSpikeThreshold,
+		WindowSeconds: domain.DefaultAlertRejectionSpikeWindowSeconds,
+	}, alerts.RejectionSpike)
+}
+
+func TestLoader_AlertsRejectsInvalidSinks(t *testing.T) {
+	file := writeTempConfig(t, `
+alerts:
+  enabled: true
+  sinks:
+    - name: ops
+      type: webhook
+      url: ftp://hooks.example.com
+    - name: ops
+      type: file
+  rules:
+    - sinks: [pager]
+servers:
+  - name: github
+    cmd: ["./gh"]
+`)
+
+	loader := NewLoader(zap.NewNop())
+	_, err := loader.Load(context.Background(), file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "alerts.sinks[0]: url must be an http or https URL")
+	require.Contains(t, err.Error(), "alerts.sinks[1]: path is required")
+	require.Contains(t, err.Error(), `alerts.sinks[1]: duplicate sink name "ops"`)
+	require.Contains(t, err.Error(), `alerts.rules[0]: unknown sink "pager"`)
+}
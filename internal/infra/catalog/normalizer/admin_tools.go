@@ -0,0 +1,16 @@
+package normalizer
+
+import "mcpv/internal/domain"
+
+func normalizeAdminToolsConfig(raw RawAdminToolsConfig) (domain.AdminToolsConfig, []string) {
+	var errs []string
+	cfg := domain.AdminToolsConfig{
+		Enabled: raw.Enabled,
+		Callers: normalizePatterns("adminTools.callers", raw.Callers, &errs),
+		Tags:    NormalizeTags(raw.Tags),
+	}
+	if cfg.Enabled && len(cfg.Callers) == 0 && len(cfg.Tags) == 0 {
+		errs = append(errs, "adminTools: callers or tags is required when enabled")
+	}
+	return cfg, errs
+}
@@ -0,0 +1,170 @@
+package normalizer
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeAlertsConfig(raw RawAlertsConfig) (domain.AlertsConfig, []string) {
+	var errs []string
+
+	cfg := domain.AlertsConfig{Enabled: raw.Enabled}
+
+	sinkNames := make(map[string]struct{}, len(raw.Sinks))
+	for i, rawSink := range raw.Sinks {
+		sink, sinkErrs := normalizeAlertSink(fmt.Sprintf("alerts.sinks[%d]", i), rawSink)
+		errs = append(errs, sinkErrs...)
+		if sink.Name != "" {
+			if _, ok := sinkNames[sink.Name]; ok {
+				errs = append(errs, fmt.Sprintf("alerts.sinks[%d]: duplicate sink name %q", i, sink.Name))
+			}
+			sinkNames[sink.Name] = struct{}{}
+		}
+		cfg.Sinks = append(cfg.Sinks, sink)
+	}
+
+	known := make(map[domain.AlertEventType]struct{}, len(domain.AlertEventTypes))
+	for _, eventType := range domain.AlertEventTypes {
+		known[eventType] = struct{}{}
+	}
+	for i, rawRule := range raw.Rules {
+		prefix := fmt.Sprintf("alerts.rules[%d]", i)
+		name := strings.TrimSpace(rawRule.Name)
+		if name == "" {
+			name = fmt.Sprintf("rule-%d", i+1)
+		}
+		rule := domain.AlertRuleConfig{Name: name}
+		for _, event := range rawRule.Events {
+			eventType := domain.AlertEventType(strings.ToLower(strings.TrimSpace(event)))
+			if _, ok := known[eventType]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: unknown event %q", prefix, event))
+				continue
+			}
+			rule.Events = append(rule.Events, eventType)
+		}
+		for _, server := range rawRule.Servers {
+			server = strings.TrimSpace(server)
+			if server == "" {
+				continue
+			}
+			if _, err := path.Match(server, ""); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: invalid server pattern %q", prefix, server))
+			}
+			rule.Servers = append(rule.Servers, server)
+		}
+		for _, sink := range rawRule.Sinks {
+			sink = strings.TrimSpace(sink)
+			if _, ok := sinkNames[sink]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: unknown sink %q", prefix, sink))
+				continue
+			}
+			rule.Sinks = append(rule.Sinks, sink)
+		}
+		if len(rawRule.Sinks) == 0 {
+			errs = append(errs, prefix+": sinks is required")
+		}
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	spike := raw.RejectionSpike
+	if spike.Threshold < 0 {
+		errs = append(errs, "alerts.rejectionSpike.threshold must be >= 0")
+	}
+	if spike.WindowSeconds < 0 {
+		errs = append(errs, "alerts.rejectionSpike.windowSeconds must be >= 0")
+	}
+	cfg.RejectionSpike = domain.AlertRejectionSpikeConfig{
+		Threshold:     spike.Threshold,
+		WindowSeconds: spike.WindowSeconds,
+	}
+	if cfg.RejectionSpike.Threshold <= 0 {
+		cfg.RejectionSpike.Threshold = domain.DefaultAlertRejectionSpikeThreshold
+	}
+	if cfg.RejectionSpike.WindowSeconds <= 0 {
+		cfg.RejectionSpike.WindowSeconds = domain.DefaultAlertRejectionSpikeWindowSeconds
+	}
+
+	return cfg, errs
+}
+
+func normalizeAlertSink(prefix string, raw RawAlertSinkConfig) (domain.AlertSinkConfig, []string) {
+	var errs []string
+
+	sink := domain.AlertSinkConfig{
+		Name:           strings.TrimSpace(raw.Name),
+		Type:           domain.AlertSinkType(strings.ToLower(strings.TrimSpace(raw.Type))),
+		Secret:         raw.Secret,
+		SecretEnvVar:   strings.TrimSpace(raw.SecretEnvVar),
+		Path:           strings.TrimSpace(raw.Path),
+		TimeoutSeconds: raw.TimeoutSeconds,
+		RetryBackoffMs: raw.RetryBackoffMs,
+	}
+	if sink.Name == "" {
+		errs = append(errs, prefix+": name is required")
+	}
+
+	switch sink.Type {
+	case domain.AlertSinkWebhook:
+		endpoint := strings.TrimSpace(raw.URL)
+		parsed, err := url.Parse(endpoint)
+		if endpoint == "" || err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			errs = append(errs, prefix+": url must be an http or https URL")
+		}
+		sink.URL = endpoint
+		for key, value := range raw.Headers {
+			key = strings.TrimSpace(key)
+			if key == "" {
+				errs = append(errs, prefix+": header name is required")
+				continue
+			}
+			if sink.Headers == nil {
+				sink.Headers = make(map[string]string, len(raw.Headers))
+			}
+			sink.Headers[key] = value
+		}
+	case domain.AlertSinkCommand:
+		sink.Command = append([]string(nil), raw.Command...)
+		if len(sink.Command) == 0 || strings.TrimSpace(sink.Command[0]) == "" {
+			errs = append(errs, prefix+": command is required")
+		}
+	case domain.AlertSinkFile:
+		if sink.Path == "" {
+			errs = append(errs, prefix+": path is required")
+		}
+	default:
+		errs = append(errs, prefix+": type must be webhook, command or file")
+	}
+
+	if raw.TimeoutSeconds < 0 {
+		errs = append(errs, prefix+": timeoutSeconds must be >= 0")
+	}
+	if sink.TimeoutSeconds <= 0 {
+		sink.TimeoutSeconds = domain.DefaultAlertSinkTimeoutSeconds
+	}
+	sink.MaxRetries = domain.DefaultAlertSinkMaxRetries
+	if raw.MaxRetries != nil {
+		sink.MaxRetries = *raw.MaxRetries
+		if sink.MaxRetries < 0 {
+			errs = append(errs, prefix+": maxRetries must be >= 0")
+		}
+	}
+	if raw.RetryBackoffMs < 0 {
+		errs = append(errs, prefix+": retryBackoffMs must be >= 0")
+	}
+	if sink.RetryBackoffMs <= 0 {
+		sink.RetryBackoffMs = domain.DefaultAlertSinkRetryBackoffMs
+	}
+	sink.DebounceSeconds = domain.DefaultAlertSinkDebounceSeconds
+	if raw.DebounceSeconds != nil {
+		sink.DebounceSeconds = *raw.DebounceSeconds
+		if sink.DebounceSeconds < 0 {
+			errs = append(errs, prefix+": debounceSeconds must be >= 0")
+		}
+	}
+
+	return sink, errs
+}
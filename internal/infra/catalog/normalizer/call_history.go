@@ -0,0 +1,49 @@
+package normalizer
+
+import (
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeCallHistoryConfig(cfg RawCallHistoryConfig) (domain.CallHistoryConfig, []string) {
+	var errs []string
+
+	path := strings.TrimSpace(cfg.Path)
+	if cfg.Enabled && path == "" {
+		errs = append(errs, "callHistory.path is required when callHistory.enabled is true")
+	}
+	if cfg.MaxAgeHours != nil && *cfg.MaxAgeHours < 0 {
+		errs = append(errs, "callHistory.maxAgeHours must be >= 0")
+	}
+	if cfg.MaxEntries != nil && *cfg.MaxEntries < 0 {
+		errs = append(errs, "callHistory.maxEntries must be >= 0")
+	}
+	if cfg.MaxPayloadBytes < 0 {
+		errs = append(errs, "callHistory.maxPayloadBytes must be >= 0")
+	}
+
+	// An unset limit takes the default; an explicit zero disables it.
+	maxAge := domain.DefaultCallHistoryMaxAgeHours
+	if cfg.MaxAgeHours != nil {
+		maxAge = *cfg.MaxAgeHours
+	}
+	maxEntries := domain.DefaultCallHistoryMaxEntries
+	if cfg.MaxEntries != nil {
+		maxEntries = *cfg.MaxEntries
+	}
+	maxPayload := cfg.MaxPayloadBytes
+	if maxPayload <= 0 {
+		maxPayload = domain.DefaultCallHistoryMaxPayloadBytes
+	}
+
+	return domain.CallHistoryConfig{
+		Enabled:         cfg.Enabled,
+		Path:            path,
+		MaxAgeHours:     maxAge,
+		MaxEntries:      maxEntries,
+		IncludePayloads: cfg.IncludePayloads,
+		MaxPayloadBytes: maxPayload,
+		RedactKeys:      normalizeRedactKeys(cfg.RedactKeys),
+	}, errs
+}
@@ -0,0 +1,71 @@
+package normalizer
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeClientProfiles(raw []RawClientProfile) ([]domain.ClientProfile, []string) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var errs []string
+	seen := make(map[string]struct{}, len(raw))
+	profiles := make([]domain.ClientProfile, 0, len(raw))
+	for i, rawProfile := range raw {
+		prefix := fmt.Sprintf("clients[%d]", i)
+
+		name := strings.TrimSpace(rawProfile.Name)
+		if name == "" {
+			errs = append(errs, prefix+": name is required")
+		} else if _, ok := seen[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s: duplicate profile name %q", prefix, name))
+		}
+		seen[name] = struct{}{}
+
+		profile := domain.ClientProfile{
+			Name:           name,
+			Callers:        normalizePatterns(prefix+".callers", rawProfile.Callers, &errs),
+			Tags:           NormalizeTags(rawProfile.Tags),
+			IncludeServers: normalizePatterns(prefix+".includeServers", rawProfile.IncludeServers, &errs),
+			ExcludeServers: normalizePatterns(prefix+".excludeServers", rawProfile.ExcludeServers, &errs),
+			IncludeTools:   normalizePatterns(prefix+".includeTools", rawProfile.IncludeTools, &errs),
+			ExcludeTools:   normalizePatterns(prefix+".excludeTools", rawProfile.ExcludeTools, &errs),
+			SubAgent:       rawProfile.SubAgent,
+		}
+		if len(profile.Callers) == 0 && len(profile.Tags) == 0 {
+			errs = append(errs, prefix+": callers or tags is required")
+		}
+
+		strategy := strings.ToLower(strings.TrimSpace(rawProfile.ToolNamespaceStrategy))
+		switch domain.ToolNamespaceStrategy(strategy) {
+		case "", domain.ToolNamespaceStrategyPrefix, domain.ToolNamespaceStrategyFlat:
+			profile.ToolNamespaceStrategy = domain.ToolNamespaceStrategy(strategy)
+		default:
+			errs = append(errs, prefix+": toolNamespaceStrategy must be prefix or flat")
+		}
+
+		profiles = append(profiles, profile)
+	}
+	return profiles, errs
+}
+
+func normalizePatterns(field string, raw []string, errs *[]string) []string {
+	var patterns []string
+	for _, pattern := range raw {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			*errs = append(*errs, fmt.Sprintf("%s: invalid pattern %q", field, pattern))
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
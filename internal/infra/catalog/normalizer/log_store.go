@@ -0,0 +1,65 @@
+package normalizer
+
+import (
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+var allowedLogLevels = map[domain.LogLevel]struct{}{
+	domain.LogLevelDebug:     {},
+	domain.LogLevelInfo:      {},
+	domain.LogLevelNotice:    {},
+	domain.LogLevelWarning:   {},
+	domain.LogLevelError:     {},
+	domain.LogLevelCritical:  {},
+	domain.LogLevelAlert:     {},
+	domain.LogLevelEmergency: {},
+}
+
+func normalizeLogStoreConfig(cfg RawLogStoreConfig) (domain.LogStoreConfig, []string) {
+	var errs []string
+
+	dir := strings.TrimSpace(cfg.Dir)
+	if cfg.Enabled && dir == "" {
+		errs = append(errs, "logStore.dir is required when logStore.enabled is true")
+	}
+	if cfg.MaxSizeMB < 0 {
+		errs = append(errs, "logStore.maxSizeMb must be >= 0")
+	}
+	if cfg.MaxAgeHours < 0 {
+		errs = append(errs, "logStore.maxAgeHours must be >= 0")
+	}
+	if cfg.MaxBackups < 0 {
+		errs = append(errs, "logStore.maxBackups must be >= 0")
+	}
+
+	minLevel := domain.LogLevel(strings.ToLower(strings.TrimSpace(cfg.MinLevel)))
+	if minLevel == "" {
+		minLevel = domain.DefaultLogStoreMinLevel
+	} else if _, ok := allowedLogLevels[minLevel]; !ok {
+		errs = append(errs, "logStore.minLevel must be one of: debug, info, notice, warning, error, critical, alert, emergency")
+	}
+
+	maxSize := cfg.MaxSizeMB
+	if maxSize <= 0 {
+		maxSize = domain.DefaultLogStoreMaxSizeMB
+	}
+	maxAge := cfg.MaxAgeHours
+	if maxAge <= 0 {
+		maxAge = domain.DefaultLogStoreMaxAgeHours
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = domain.DefaultLogStoreMaxBackups
+	}
+
+	return domain.LogStoreConfig{
+		Enabled:     cfg.Enabled,
+		Dir:         dir,
+		MaxSizeMB:   maxSize,
+		MaxAgeHours: maxAge,
+		MaxBackups:  maxBackups,
+		MinLevel:    minLevel,
+	}, errs
+}
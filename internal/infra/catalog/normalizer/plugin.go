@@ -75,9 +75,44 @@ func normalizePluginSpec(raw RawPluginSpec, index int) (domain.PluginSpec, []str
 		errs = append(errs, fmt.Sprintf("plugins[%d]: handshakeTimeoutMs must be >= 0", index))
 	}
 
+	runtime, ok := domain.NormalizePluginRuntime(raw.Runtime)
+	if !ok {
+		errs = append(errs, fmt.Sprintf("plugins[%d]: runtime must be process or wasm", index))
+	}
+
 	cmd := raw.Cmd
-	if len(cmd) == 0 {
-		errs = append(errs, fmt.Sprintf("plugins[%d]: cmd is required", index))
+	module := strings.TrimSpace(raw.Module)
+	switch runtime {
+	case domain.PluginRuntimeWasm:
+		if module == "" {
+			errs = append(errs, fmt.Sprintf("plugins[%d]: module is required for wasm runtime", index))
+		}
+		if len(cmd) > 0 {
+			errs = append(errs, fmt.Sprintf("plugins[%d]: cmd must be empty for wasm runtime", index))
+		}
+	case domain.PluginRuntimeProcess:
+		if len(cmd) == 0 {
+			errs = append(errs, fmt.Sprintf("plugins[%d]: cmd is required", index))
+		}
+		if module != "" {
+			errs = append(errs, fmt.Sprintf("plugins[%d]: module is only supported for wasm runtime", index))
+		}
+	}
+
+	memoryLimitMB := 0
+	if raw.MemoryLimitMB != nil {
+		memoryLimitMB = *raw.MemoryLimitMB
+	}
+	if memoryLimitMB < 0 {
+		errs = append(errs, fmt.Sprintf("plugins[%d]: memoryLimitMb must be >= 0", index))
+	}
+
+	var fuelLimit int64
+	if raw.FuelLimit != nil {
+		fuelLimit = *raw.FuelLimit
+	}
+	if fuelLimit < 0 {
+		errs = append(errs, fmt.Sprintf("plugins[%d]: fuelLimit must be >= 0", index))
 	}
 
 	var configJSON json.RawMessage
@@ -99,7 +134,11 @@ func normalizePluginSpec(raw RawPluginSpec, index int) (domain.PluginSpec, []str
 		Category:           category,
 		Required:           required,
 		Disabled:           raw.Disabled,
+		Runtime:            runtime,
 		Cmd:                cmd,
+		Module:             module,
+		MemoryLimitMB:      memoryLimitMB,
+		FuelLimit:          fuelLimit,
 		Env:                NormalizeEnvMap(raw.Env),
 		Cwd:                strings.TrimSpace(raw.Cwd),
 		CommitHash:         strings.TrimSpace(raw.CommitHash),
@@ -0,0 +1,113 @@
+package normalizer
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+var rateLimitPeriods = map[string]int{
+	"second": 1,
+	"minute": 60,
+	"hour":   3600,
+}
+
+func normalizeRateLimitConfig(raw RawRateLimitConfig) (domain.RateLimitConfig, []string) {
+	if !raw.Enabled && len(raw.Rules) == 0 {
+		return domain.RateLimitConfig{}, nil
+	}
+
+	var errs []string
+	statePath := strings.TrimSpace(raw.StatePath)
+	seen := make(map[string]struct{}, len(raw.Rules))
+	rules := make([]domain.RateLimitRule, 0, len(raw.Rules))
+	for i, rawRule := range raw.Rules {
+		prefix := fmt.Sprintf("rateLimits.rules[%d]", i)
+
+		name := strings.TrimSpace(rawRule.Name)
+		if name == "" {
+			name = fmt.Sprintf("rule-%d", i+1)
+		}
+		if _, ok := seen[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s: duplicate rule name %q", prefix, name))
+		}
+		seen[name] = struct{}{}
+
+		caller := strings.TrimSpace(rawRule.Caller)
+		server := strings.TrimSpace(rawRule.Server)
+		tool := strings.TrimSpace(rawRule.Tool)
+		for _, selector := range []struct{ field, pattern string }{
+			{"caller", caller},
+			{"server", server},
+			{"tool", tool},
+		} {
+			if selector.pattern == "" {
+				continue
+			}
+			if _, err := path.Match(selector.pattern, ""); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: invalid %s pattern %q", prefix, selector.field, selector.pattern))
+			}
+		}
+
+		if rawRule.Rate < 0 {
+			errs = append(errs, prefix+": rate must be >= 0")
+		}
+		if rawRule.Burst < 0 {
+			errs = append(errs, prefix+": burst must be >= 0")
+		}
+		if rawRule.DailyQuota < 0 {
+			errs = append(errs, prefix+": dailyQuota must be >= 0")
+		}
+		if rawRule.Rate <= 0 && rawRule.DailyQuota <= 0 {
+			errs = append(errs, prefix+": rate or dailyQuota is required")
+		}
+		if rawRule.DailyQuota > 0 && statePath == "" {
+			errs = append(errs, prefix+": rateLimits.statePath is required when dailyQuota is set")
+		}
+
+		per := strings.ToLower(strings.TrimSpace(rawRule.Per))
+		if per == "" {
+			per = "minute"
+		}
+		period, ok := rateLimitPeriods[per]
+		if !ok {
+			errs = append(errs, prefix+": per must be second, minute or hour")
+		}
+		burst := rawRule.Burst
+		if burst == 0 {
+			burst = rawRule.Rate
+		}
+
+		scope := strings.ToLower(strings.TrimSpace(rawRule.Scope))
+		if scope == "" {
+			scope = string(domain.RateLimitScopeCaller)
+		}
+		if scope != string(domain.RateLimitScopeCaller) && scope != string(domain.RateLimitScopeShared) {
+			errs = append(errs, prefix+": scope must be caller or shared")
+		}
+
+		rule := domain.RateLimitRule{
+			Name:       name,
+			Caller:     caller,
+			Tag:        strings.ToLower(strings.TrimSpace(rawRule.Tag)),
+			Server:     server,
+			Tool:       tool,
+			DailyQuota: rawRule.DailyQuota,
+			Scope:      domain.RateLimitScope(scope),
+		}
+		if rawRule.Rate > 0 {
+			rule.Rate = rawRule.Rate
+			rule.PeriodSeconds = period
+			rule.Burst = burst
+		}
+		rules = append(rules, rule)
+	}
+
+	return domain.RateLimitConfig{
+		Enabled:   raw.Enabled,
+		StatePath: statePath,
+		Rules:     rules,
+	}, errs
+}
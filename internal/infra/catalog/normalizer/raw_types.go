@@ -7,23 +7,36 @@ type RawCatalog struct {
 }
 
 type RawServerSpec struct {
-	Name                string                  `mapstructure:"name"`
-	Transport           string                  `mapstructure:"transport"`
-	Cmd                 []string                `mapstructure:"cmd"`
-	Env                 map[string]string       `mapstructure:"env"`
-	Cwd                 string                  `mapstructure:"cwd"`
-	Tags                []string                `mapstructure:"tags"`
-	IdleSeconds         int                     `mapstructure:"idleSeconds"`
-	MaxConcurrent       int                     `mapstructure:"maxConcurrent"`
-	Strategy            string                  `mapstructure:"strategy"`
-	SessionTTLSeconds   *int                    `mapstructure:"sessionTTLSeconds"`
-	Disabled            bool                    `mapstructure:"disabled"`
-	MinReady            int                     `mapstructure:"minReady"`
-	ActivationMode      string                  `mapstructure:"activationMode"`
-	DrainTimeoutSeconds int                     `mapstructure:"drainTimeoutSeconds"`
-	ProtocolVersion     string                  `mapstructure:"protocolVersion"`
-	ExposeTools         []string                `mapstructure:"exposeTools"`
-	HTTP                RawStreamableHTTPConfig `mapstructure:"http"`
+	Name                string                     `mapstructure:"name"`
+	Transport           string                     `mapstructure:"transport"`
+	Cmd                 []string                   `mapstructure:"cmd"`
+	Env                 map[string]string          `mapstructure:"env"`
+	Cwd                 string                     `mapstructure:"cwd"`
+	Tags                []string                   `mapstructure:"tags"`
+	IdleSeconds         int                        `mapstructure:"idleSeconds"`
+	MaxConcurrent       int                        `mapstructure:"maxConcurrent"`
+	Strategy            string                     `mapstructure:"strategy"`
+	SessionTTLSeconds   *int                       `mapstructure:"sessionTTLSeconds"`
+	Disabled            bool                       `mapstructure:"disabled"`
+	MinReady            int                        `mapstructure:"minReady"`
+	ActivationMode      string                     `mapstructure:"activationMode"`
+	DrainTimeoutSeconds int                        `mapstructure:"drainTimeoutSeconds"`
+	ProtocolVersion     string                     `mapstructure:"protocolVersion"`
+	ExposeTools         []string                   `mapstructure:"exposeTools"`
+	Tools               map[string]RawToolOverride `mapstructure:"tools"`
+	HTTP                RawStreamableHTTPConfig    `mapstructure:"http"`
+}
+
+// RawToolOverride is also decoded with YAML tags because tool and argument
+// names are case-sensitive and viper lowercases map keys.
+type RawToolOverride struct {
+	Name              string         `mapstructure:"name" yaml:"name"`
+	Aliases           []string       `mapstructure:"aliases" yaml:"aliases"`
+	Description       string         `mapstructure:"description" yaml:"description"`
+	AppendDescription string         `mapstructure:"appendDescription" yaml:"appendDescription"`
+	Title             string         `mapstructure:"title" yaml:"title"`
+	AppendTitle       string         `mapstructure:"appendTitle" yaml:"appendTitle"`
+	FixedArgs         map[string]any `mapstructure:"fixedArgs" yaml:"fixedArgs"`
 }
 
 type RawPluginSpec struct {
@@ -31,7 +44,11 @@ type RawPluginSpec struct {
 	Category           string            `mapstructure:"category"`
 	Required           *bool             `mapstructure:"required"`
 	Disabled           bool              `mapstructure:"disabled"`
+	Runtime            string            `mapstructure:"runtime"`
 	Cmd                []string          `mapstructure:"cmd"`
+	Module             string            `mapstructure:"module"`
+	MemoryLimitMB      *int              `mapstructure:"memoryLimitMb"`
+	FuelLimit          *int64            `mapstructure:"fuelLimit"`
 	Env                map[string]string `mapstructure:"env"`
 	Cwd                string            `mapstructure:"cwd"`
 	CommitHash         string            `mapstructure:"commitHash"`
@@ -67,8 +84,178 @@ type RawRuntimeConfig struct {
 	ToolNamespaceStrategy      string                 `mapstructure:"toolNamespaceStrategy"`
 	Proxy                      RawProxyConfig         `mapstructure:"proxy"`
 	Observability              RawObservabilityConfig `mapstructure:"observability"`
+	LogStore                   RawLogStoreConfig      `mapstructure:"logStore"`
 	RPC                        RawRPCConfig           `mapstructure:"rpc"`
 	SubAgent                   RawSubAgentConfig      `mapstructure:"subAgent"`
+	Audit                      RawAuditConfig         `mapstructure:"audit"`
+	CallHistory                RawCallHistoryConfig   `mapstructure:"callHistory"`
+	Redaction                  RawRedactionConfig     `mapstructure:"redaction"`
+	RateLimits                 RawRateLimitConfig     `mapstructure:"rateLimits"`
+	ResponseCache              RawResponseCacheConfig `mapstructure:"responseCache"`
+	Alerts                     RawAlertsConfig        `mapstructure:"alerts"`
+	Governance                 RawGovernanceConfig    `mapstructure:"governance"`
+	VirtualTools               []RawVirtualTool       `mapstructure:"virtualTools"`
+	Clients                    []RawClientProfile     `mapstructure:"clients"`
+	AdminTools                 RawAdminToolsConfig    `mapstructure:"adminTools"`
+}
+
+type RawAdminToolsConfig struct {
+	Enabled bool     `mapstructure:"enabled"`
+	Callers []string `mapstructure:"callers"`
+	Tags    []string `mapstructure:"tags"`
+}
+
+type RawClientProfile struct {
+	Name                  string   `mapstructure:"name"`
+	Callers               []string `mapstructure:"callers"`
+	Tags                  []string `mapstructure:"tags"`
+	IncludeServers        []string `mapstructure:"includeServers"`
+	ExcludeServers        []string `mapstructure:"excludeServers"`
+	IncludeTools          []string `mapstructure:"includeTools"`
+	ExcludeTools          []string `mapstructure:"excludeTools"`
+	ToolNamespaceStrategy string   `mapstructure:"toolNamespaceStrategy"`
+	SubAgent              *bool    `mapstructure:"subAgent"`
+}
+
+// RawVirtualTool is also decoded with YAML tags so schema properties and
+// argument names keep their case.
+type RawVirtualTool struct {
+	Name        string               `mapstructure:"name" yaml:"name"`
+	Title       string               `mapstructure:"title" yaml:"title"`
+	Description string               `mapstructure:"description" yaml:"description"`
+	InputSchema map[string]any       `mapstructure:"inputSchema" yaml:"inputSchema"`
+	Steps       []RawVirtualToolStep `mapstructure:"steps" yaml:"steps"`
+	Result      RawVirtualToolResult `mapstructure:"result" yaml:"result"`
+}
+
+type RawVirtualToolStep struct {
+	ID   string         `mapstructure:"id" yaml:"id"`
+	Tool string         `mapstructure:"tool" yaml:"tool"`
+	Args map[string]any `mapstructure:"args" yaml:"args"`
+}
+
+type RawVirtualToolResult struct {
+	Step      string `mapstructure:"step" yaml:"step"`
+	Aggregate bool   `mapstructure:"aggregate" yaml:"aggregate"`
+	Template  any    `mapstructure:"template" yaml:"template"`
+}
+
+type RawGovernanceConfig struct {
+	ForwardMetadata []string `mapstructure:"forwardMetadata"`
+}
+
+type RawAlertsConfig struct {
+	Enabled        bool                         `mapstructure:"enabled"`
+	Sinks          []RawAlertSinkConfig         `mapstructure:"sinks"`
+	Rules          []RawAlertRuleConfig         `mapstructure:"rules"`
+	RejectionSpike RawAlertRejectionSpikeConfig `mapstructure:"rejectionSpike"`
+}
+
+type RawAlertSinkConfig struct {
+	Name            string            `mapstructure:"name"`
+	Type            string            `mapstructure:"type"`
+	URL             string            `mapstructure:"url"`
+	Headers         map[string]string `mapstructure:"headers"`
+	Secret          string            `mapstructure:"secret"`
+	SecretEnvVar    string            `mapstructure:"secretEnvVar"`
+	Command         []string          `mapstructure:"command"`
+	Path            string            `mapstructure:"path"`
+	TimeoutSeconds  int               `mapstructure:"timeoutSeconds"`
+	MaxRetries      *int              `mapstructure:"maxRetries"`
+	RetryBackoffMs  int               `mapstructure:"retryBackoffMs"`
+	DebounceSeconds *int              `mapstructure:"debounceSeconds"`
+}
+
+type RawAlertRuleConfig struct {
+	Name    string   `mapstructure:"name"`
+	Events  []string `mapstructure:"events"`
+	Servers []string `mapstructure:"servers"`
+	Sinks   []string `mapstructure:"sinks"`
+}
+
+type RawAlertRejectionSpikeConfig struct {
+	Threshold     int `mapstructure:"threshold"`
+	WindowSeconds int `mapstructure:"windowSeconds"`
+}
+
+type RawResponseCacheConfig struct {
+	Enabled       bool                       `mapstructure:"enabled"`
+	TTLSeconds    int                        `mapstructure:"ttlSeconds"`
+	MaxEntries    int                        `mapstructure:"maxEntries"`
+	MaxBytes      int                        `mapstructure:"maxBytes"`
+	MaxEntryBytes int                        `mapstructure:"maxEntryBytes"`
+	ReadOnlyTools *bool                      `mapstructure:"readOnlyTools"`
+	Resources     *bool                      `mapstructure:"resources"`
+	Tools         []RawResponseCacheToolRule `mapstructure:"tools"`
+}
+
+type RawResponseCacheToolRule struct {
+	Server     string `mapstructure:"server"`
+	Tool       string `mapstructure:"tool"`
+	TTLSeconds int    `mapstructure:"ttlSeconds"`
+}
+
+type RawRateLimitConfig struct {
+	Enabled   bool               `mapstructure:"enabled"`
+	StatePath string             `mapstructure:"statePath"`
+	Rules     []RawRateLimitRule `mapstructure:"rules"`
+}
+
+type RawRateLimitRule struct {
+	Name       string `mapstructure:"name"`
+	Caller     string `mapstructure:"caller"`
+	Tag        string `mapstructure:"tag"`
+	Server     string `mapstructure:"server"`
+	Tool       string `mapstructure:"tool"`
+	Rate       int    `mapstructure:"rate"`
+	Per        string `mapstructure:"per"`
+	Burst      int    `mapstructure:"burst"`
+	DailyQuota int    `mapstructure:"dailyQuota"`
+	Scope      string `mapstructure:"scope"`
+}
+
+type RawRedactionConfig struct {
+	Enabled bool               `mapstructure:"enabled"`
+	Rules   []RawRedactionRule `mapstructure:"rules"`
+}
+
+type RawRedactionRule struct {
+	Name        string  `mapstructure:"name"`
+	Detector    string  `mapstructure:"detector"`
+	Pattern     string  `mapstructure:"pattern"`
+	Action      string  `mapstructure:"action"`
+	Replacement string  `mapstructure:"replacement"`
+	MinLength   int     `mapstructure:"minLength"`
+	MinEntropy  float64 `mapstructure:"minEntropy"`
+}
+
+type RawAuditConfig struct {
+	Enabled          bool     `mapstructure:"enabled"`
+	Path             string   `mapstructure:"path"`
+	MaxSizeMB        int      `mapstructure:"maxSizeMb"`
+	MaxAgeHours      int      `mapstructure:"maxAgeHours"`
+	MaxBackups       int      `mapstructure:"maxBackups"`
+	IncludeArguments bool     `mapstructure:"includeArguments"`
+	RedactKeys       []string `mapstructure:"redactKeys"`
+}
+
+type RawLogStoreConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Dir         string `mapstructure:"dir"`
+	MaxSizeMB   int    `mapstructure:"maxSizeMb"`
+	MaxAgeHours int    `mapstructure:"maxAgeHours"`
+	MaxBackups  int    `mapstructure:"maxBackups"`
+	MinLevel    string `mapstructure:"minLevel"`
+}
+
+type RawCallHistoryConfig struct {
+	Enabled         bool     `mapstructure:"enabled"`
+	Path            string   `mapstructure:"path"`
+	MaxAgeHours     *int     `mapstructure:"maxAgeHours"`
+	MaxEntries      *int     `mapstructure:"maxEntries"`
+	IncludePayloads bool     `mapstructure:"includePayloads"`
+	MaxPayloadBytes int      `mapstructure:"maxPayloadBytes"`
+	RedactKeys      []string `mapstructure:"redactKeys"`
 }
 
 type RawSubAgentConfig struct {
@@ -84,9 +271,52 @@ type RawSubAgentConfig struct {
 }
 
 type RawObservabilityConfig struct {
-	ListenAddress  string `mapstructure:"listenAddress"`
-	MetricsEnabled *bool  `mapstructure:"metricsEnabled"`
-	HealthzEnabled *bool  `mapstructure:"healthzEnabled"`
+	ListenAddress  string                      `mapstructure:"listenAddress"`
+	MetricsEnabled *bool                       `mapstructure:"metricsEnabled"`
+	HealthzEnabled *bool                       `mapstructure:"healthzEnabled"`
+	Tracing        RawTracingConfig            `mapstructure:"tracing"`
+	ToolStats      RawToolStatsConfig          `mapstructure:"toolStats"`
+	Debug          RawObservabilityDebugConfig `mapstructure:"debug"`
+	Readiness      RawReadinessConfig          `mapstructure:"readiness"`
+}
+
+type RawReadinessConfig struct {
+	Bootstrap       *bool    `mapstructure:"bootstrap"`
+	RequiredPlugins *bool    `mapstructure:"requiredPlugins"`
+	RPCListener     *bool    `mapstructure:"rpcListener"`
+	Servers         []string `mapstructure:"servers"`
+}
+
+type RawObservabilityDebugConfig struct {
+	PprofEnabled           bool   `mapstructure:"pprofEnabled"`
+	StateEnabled           bool   `mapstructure:"stateEnabled"`
+	DeepDiagnosticsEnabled bool   `mapstructure:"deepDiagnosticsEnabled"`
+	Token                  string `mapstructure:"token"`
+	TokenEnv               string `mapstructure:"tokenEnv"`
+}
+
+type RawToolStatsConfig struct {
+	MaxToolsPerServer int                `mapstructure:"maxToolsPerServer"`
+	SLOs              []RawToolSLOConfig `mapstructure:"slos"`
+}
+
+type RawToolSLOConfig struct {
+	Name            string  `mapstructure:"name"`
+	Server          string  `mapstructure:"server"`
+	Tool            string  `mapstructure:"tool"`
+	LatencyTargetMs int     `mapstructure:"latencyTargetMs"`
+	ErrorBudget     float64 `mapstructure:"errorBudget"`
+	WindowMinutes   int     `mapstructure:"windowMinutes"`
+}
+
+type RawTracingConfig struct {
+	Enabled       bool              `mapstructure:"enabled"`
+	Exporter      string            `mapstructure:"exporter"`
+	Endpoint      string            `mapstructure:"endpoint"`
+	Insecure      bool              `mapstructure:"insecure"`
+	Headers       map[string]string `mapstructure:"headers"`
+	SamplingRatio *float64          `mapstructure:"samplingRatio"`
+	ServiceName   string            `mapstructure:"serviceName"`
 }
 
 type RawRPCConfig struct {
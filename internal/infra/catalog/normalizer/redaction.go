@@ -0,0 +1,95 @@
+package normalizer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+var allowedRedactionDetectors = map[string]struct{}{
+	"secret":      {},
+	"email":       {},
+	"phone":       {},
+	"credit_card": {},
+	"entropy":     {},
+	"regex":       {},
+}
+
+// defaultRedactionDetectors apply when redaction is enabled without rules.
+var defaultRedactionDetectors = []string{"secret", "email", "phone", "credit_card"}
+
+func normalizeRedactionConfig(raw RawRedactionConfig) (domain.RedactionConfig, []string) {
+	if !raw.Enabled && len(raw.Rules) == 0 {
+		return domain.RedactionConfig{}, nil
+	}
+
+	var errs []string
+	rawRules := raw.Rules
+	if len(rawRules) == 0 {
+		rawRules = make([]RawRedactionRule, 0, len(defaultRedactionDetectors))
+		for _, detector := range defaultRedactionDetectors {
+			rawRules = append(rawRules, RawRedactionRule{Detector: detector})
+		}
+	}
+
+	seen := make(map[string]struct{}, len(rawRules))
+	rules := make([]domain.RedactionRule, 0, len(rawRules))
+	for i, rawRule := range rawRules {
+		prefix := fmt.Sprintf("redaction.rules[%d]", i)
+		detector := strings.ToLower(strings.TrimSpace(rawRule.Detector))
+		if _, ok := allowedRedactionDetectors[detector]; !ok {
+			errs = append(errs, prefix+": detector must be one of: secret, email, phone, credit_card, entropy, regex")
+		}
+
+		name := strings.TrimSpace(rawRule.Name)
+		if name == "" {
+			name = detector
+		}
+		if _, ok := seen[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s: duplicate rule name %q", prefix, name))
+		}
+		seen[name] = struct{}{}
+
+		pattern := strings.TrimSpace(rawRule.Pattern)
+		if detector == "regex" {
+			if pattern == "" {
+				errs = append(errs, prefix+": pattern is required for regex detector")
+			} else if _, err := regexp.Compile(pattern); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: invalid pattern: %v", prefix, err))
+			}
+		} else if pattern != "" {
+			errs = append(errs, prefix+": pattern is only supported for regex detector")
+		}
+
+		action := strings.ToLower(strings.TrimSpace(rawRule.Action))
+		if action == "" {
+			action = string(domain.RedactionActionMask)
+		}
+		if action != string(domain.RedactionActionMask) && action != string(domain.RedactionActionReject) {
+			errs = append(errs, prefix+": action must be mask or reject")
+		}
+		if rawRule.MinLength < 0 {
+			errs = append(errs, prefix+": minLength must be >= 0")
+		}
+		if rawRule.MinEntropy < 0 {
+			errs = append(errs, prefix+": minEntropy must be >= 0")
+		}
+
+		rules = append(rules, domain.RedactionRule{
+			Name:        name,
+			Detector:    detector,
+			Pattern:     pattern,
+			Action:      domain.RedactionAction(action),
+			Replacement: rawRule.Replacement,
+			MinLength:   rawRule.MinLength,
+			MinEntropy:  rawRule.MinEntropy,
+		})
+	}
+
+	return domain.RedactionConfig{
+		Enabled: raw.Enabled,
+		Rules:   rules,
+	}, errs
+}
@@ -0,0 +1,84 @@
+package normalizer
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeResponseCacheConfig(raw RawResponseCacheConfig) (domain.ResponseCacheConfig, []string) {
+	if !raw.Enabled && len(raw.Tools) == 0 {
+		return domain.ResponseCacheConfig{}, nil
+	}
+
+	var errs []string
+	for _, field := range []struct {
+		name  string
+		value int
+	}{
+		{"ttlSeconds", raw.TTLSeconds},
+		{"maxEntries", raw.MaxEntries},
+		{"maxBytes", raw.MaxBytes},
+		{"maxEntryBytes", raw.MaxEntryBytes},
+	} {
+		if field.value < 0 {
+			errs = append(errs, fmt.Sprintf("responseCache.%s must be >= 0", field.name))
+		}
+	}
+
+	cfg := domain.ResponseCacheConfig{
+		Enabled:       raw.Enabled,
+		TTLSeconds:    raw.TTLSeconds,
+		MaxEntries:    raw.MaxEntries,
+		MaxBytes:      raw.MaxBytes,
+		MaxEntryBytes: raw.MaxEntryBytes,
+		ReadOnlyTools: raw.ReadOnlyTools == nil || *raw.ReadOnlyTools,
+		Resources:     raw.Resources == nil || *raw.Resources,
+	}
+	if cfg.TTLSeconds <= 0 {
+		cfg.TTLSeconds = domain.DefaultResponseCacheTTLSeconds
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = domain.DefaultResponseCacheMaxEntries
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = domain.DefaultResponseCacheMaxBytes
+	}
+	if cfg.MaxEntryBytes <= 0 {
+		cfg.MaxEntryBytes = domain.DefaultResponseCacheMaxEntryBytes
+	}
+	if cfg.MaxEntryBytes > cfg.MaxBytes {
+		errs = append(errs, "responseCache.maxEntryBytes must not exceed maxBytes")
+	}
+
+	for i, rawRule := range raw.Tools {
+		prefix := fmt.Sprintf("responseCache.tools[%d]", i)
+		server := strings.TrimSpace(rawRule.Server)
+		tool := strings.TrimSpace(rawRule.Tool)
+		if tool == "" {
+			errs = append(errs, prefix+": tool is required")
+		}
+		for _, selector := range []struct{ field, pattern string }{
+			{"server", server},
+			{"tool", tool},
+		} {
+			if selector.pattern == "" {
+				continue
+			}
+			if _, err := path.Match(selector.pattern, ""); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: invalid %s pattern %q", prefix, selector.field, selector.pattern))
+			}
+		}
+		if rawRule.TTLSeconds < 0 {
+			errs = append(errs, prefix+": ttlSeconds must be >= 0")
+		}
+		cfg.Tools = append(cfg.Tools, domain.ResponseCacheToolRule{
+			Server:     server,
+			Tool:       tool,
+			TTLSeconds: rawRule.TTLSeconds,
+		})
+	}
+	return cfg, errs
+}
@@ -108,6 +108,36 @@ func NormalizeRuntimeConfig(cfg RawRuntimeConfig) (domain.RuntimeConfig, []strin
 	proxyCfg, proxyErrs := normalizeRuntimeProxyConfig(cfg.Proxy)
 	errs = append(errs, proxyErrs...)
 
+	auditCfg, auditErrs := normalizeAuditConfig(cfg.Audit)
+	errs = append(errs, auditErrs...)
+
+	callHistoryCfg, callHistoryErrs := normalizeCallHistoryConfig(cfg.CallHistory)
+	errs = append(errs, callHistoryErrs...)
+
+	logStoreCfg, logStoreErrs := normalizeLogStoreConfig(cfg.LogStore)
+	errs = append(errs, logStoreErrs...)
+
+	redactionCfg, redactionErrs := normalizeRedactionConfig(cfg.Redaction)
+	errs = append(errs, redactionErrs...)
+
+	rateLimitCfg, rateLimitErrs := normalizeRateLimitConfig(cfg.RateLimits)
+	errs = append(errs, rateLimitErrs...)
+
+	responseCacheCfg, responseCacheErrs := normalizeResponseCacheConfig(cfg.ResponseCache)
+	errs = append(errs, responseCacheErrs...)
+
+	alertsCfg, alertsErrs := normalizeAlertsConfig(cfg.Alerts)
+	errs = append(errs, alertsErrs...)
+
+	virtualTools, virtualToolErrs := normalizeVirtualTools(cfg.VirtualTools)
+	errs = append(errs, virtualToolErrs...)
+
+	clientProfiles, clientProfileErrs := normalizeClientProfiles(cfg.Clients)
+	errs = append(errs, clientProfileErrs...)
+
+	adminTools, adminToolErrs := normalizeAdminToolsConfig(cfg.AdminTools)
+	errs = append(errs, adminToolErrs...)
+
 	enabledTags := NormalizeTags(cfg.SubAgent.EnabledTags)
 	enabled := false
 	if cfg.SubAgent.Enabled != nil {
@@ -134,7 +164,18 @@ func NormalizeRuntimeConfig(cfg RawRuntimeConfig) (domain.RuntimeConfig, []strin
 		ToolNamespaceStrategy:      domain.ToolNamespaceStrategy(strategy),
 		Proxy:                      proxyCfg,
 		Observability:              observabilityCfg,
+		LogStore:                   logStoreCfg,
 		RPC:                        rpcCfg,
+		Audit:                      auditCfg,
+		CallHistory:                callHistoryCfg,
+		Redaction:                  redactionCfg,
+		RateLimits:                 rateLimitCfg,
+		ResponseCache:              responseCacheCfg,
+		Alerts:                     alertsCfg,
+		Governance:                 normalizeGovernanceConfig(cfg.Governance),
+		VirtualTools:               virtualTools,
+		Clients:                    clientProfiles,
+		AdminTools:                 adminTools,
 		SubAgent: domain.SubAgentConfig{
 			Enabled:            enabled,
 			EnabledTags:        enabledTags,
@@ -149,16 +190,137 @@ func NormalizeRuntimeConfig(cfg RawRuntimeConfig) (domain.RuntimeConfig, []strin
 	}, errs
 }
 
+func normalizeGovernanceConfig(cfg RawGovernanceConfig) domain.GovernanceConfig {
+	var keys []string
+	seen := make(map[string]struct{}, len(cfg.ForwardMetadata))
+	for _, key := range cfg.ForwardMetadata {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return domain.GovernanceConfig{ForwardMetadata: keys}
+}
+
 func normalizeObservabilityConfig(cfg RawObservabilityConfig) (domain.ObservabilityConfig, []string) {
 	addr := strings.TrimSpace(cfg.ListenAddress)
 	if addr == "" {
 		addr = domain.DefaultObservabilityListenAddress
 	}
+	tracing, errs := normalizeTracingConfig(cfg.Tracing)
+	toolStats, toolStatsErrs := normalizeToolStatsConfig(cfg.ToolStats)
+	errs = append(errs, toolStatsErrs...)
+	debug, debugErrs := normalizeObservabilityDebugConfig(cfg.Debug)
+	errs = append(errs, debugErrs...)
 	return domain.ObservabilityConfig{
 		ListenAddress:  addr,
 		MetricsEnabled: cfg.MetricsEnabled,
 		HealthzEnabled: cfg.HealthzEnabled,
-	}, nil
+		Tracing:        tracing,
+		ToolStats:      toolStats,
+		Debug:          debug,
+		Readiness:      normalizeReadinessConfig(cfg.Readiness),
+	}, errs
+}
+
+// normalizeReadinessConfig enables every built-in criterion unless it is
+// turned off explicitly.
+func normalizeReadinessConfig(cfg RawReadinessConfig) domain.ReadinessConfig {
+	enabled := func(value *bool) bool {
+		return value == nil || *value
+	}
+	servers := make([]string, 0, len(cfg.Servers))
+	seen := make(map[string]struct{}, len(cfg.Servers))
+	for _, name := range cfg.Servers {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		servers = append(servers, name)
+	}
+	return domain.ReadinessConfig{
+		Bootstrap:       enabled(cfg.Bootstrap),
+		RequiredPlugins: enabled(cfg.RequiredPlugins),
+		RPCListener:     enabled(cfg.RPCListener),
+		Servers:         servers,
+	}
+}
+
+func normalizeObservabilityDebugConfig(cfg RawObservabilityDebugConfig) (domain.ObservabilityDebugConfig, []string) {
+	var errs []string
+
+	token := strings.TrimSpace(cfg.Token)
+	tokenEnv := strings.TrimSpace(cfg.TokenEnv)
+	if token != "" && tokenEnv != "" {
+		errs = append(errs, "observability.debug.token and observability.debug.tokenEnv are mutually exclusive")
+	}
+	if (cfg.PprofEnabled || cfg.StateEnabled) && token == "" && tokenEnv == "" {
+		errs = append(errs, "observability.debug.token or observability.debug.tokenEnv is required when debug endpoints are enabled")
+	}
+	return domain.ObservabilityDebugConfig{
+		PprofEnabled:           cfg.PprofEnabled,
+		StateEnabled:           cfg.StateEnabled,
+		DeepDiagnosticsEnabled: cfg.DeepDiagnosticsEnabled,
+		Token:                  token,
+		TokenEnv:               tokenEnv,
+	}, errs
+}
+
+func normalizeAuditConfig(cfg RawAuditConfig) (domain.AuditConfig, []string) {
+	var errs []string
+
+	path := strings.TrimSpace(cfg.Path)
+	if cfg.Enabled && path == "" {
+		errs = append(errs, "audit.path is required when audit.enabled is true")
+	}
+	if cfg.MaxSizeMB < 0 {
+		errs = append(errs, "audit.maxSizeMb must be >= 0")
+	}
+	if cfg.MaxAgeHours < 0 {
+		errs = append(errs, "audit.maxAgeHours must be >= 0")
+	}
+	if cfg.MaxBackups < 0 {
+		errs = append(errs, "audit.maxBackups must be >= 0")
+	}
+
+	maxSize := cfg.MaxSizeMB
+	if maxSize <= 0 {
+		maxSize = domain.DefaultAuditMaxSizeMB
+	}
+	return domain.AuditConfig{
+		Enabled:          cfg.Enabled,
+		Path:             path,
+		MaxSizeMB:        maxSize,
+		MaxAgeHours:      cfg.MaxAgeHours,
+		MaxBackups:       cfg.MaxBackups,
+		IncludeArguments: cfg.IncludeArguments,
+		RedactKeys:       normalizeRedactKeys(cfg.RedactKeys),
+	}, errs
+}
+
+// normalizeRedactKeys lowercases key patterns and drops blanks.
+func normalizeRedactKeys(keys []string) []string {
+	redactKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		redactKeys = append(redactKeys, key)
+	}
+	if len(redactKeys) == 0 {
+		return nil
+	}
+	return redactKeys
 }
 
 func normalizeRPCConfig(cfg RawRPCConfig) (domain.RPCConfig, []string) {
@@ -40,6 +40,7 @@ func NormalizeServerSpec(raw RawServerSpec) (domain.ServerSpec, bool) {
 		DrainTimeoutSeconds: raw.DrainTimeoutSeconds,
 		ProtocolVersion:     raw.ProtocolVersion,
 		ExposeTools:         raw.ExposeTools,
+		Tools:               normalizeToolOverrides(raw.Tools),
 		HTTP:                httpConfig,
 	}
 	if raw.SessionTTLSeconds != nil {
@@ -64,6 +65,33 @@ func NormalizeServerSpec(raw RawServerSpec) (domain.ServerSpec, bool) {
 	return spec, implicitHTTP
 }
 
+func normalizeToolOverrides(raw map[string]RawToolOverride) map[string]domain.ToolOverride {
+	if len(raw) == 0 {
+		return nil
+	}
+	overrides := make(map[string]domain.ToolOverride, len(raw))
+	for upstream, item := range raw {
+		override := domain.ToolOverride{
+			Name:              strings.TrimSpace(item.Name),
+			Description:       item.Description,
+			AppendDescription: item.AppendDescription,
+			Title:             item.Title,
+			AppendTitle:       item.AppendTitle,
+		}
+		for _, alias := range item.Aliases {
+			override.Aliases = append(override.Aliases, strings.TrimSpace(alias))
+		}
+		if len(item.FixedArgs) > 0 {
+			override.FixedArgs = make(map[string]any, len(item.FixedArgs))
+			for key, value := range item.FixedArgs {
+				override.FixedArgs[strings.TrimSpace(key)] = value
+			}
+		}
+		overrides[strings.TrimSpace(upstream)] = override
+	}
+	return overrides
+}
+
 func normalizeStreamableHTTPConfig(raw RawStreamableHTTPConfig, transport domain.TransportKind) *domain.StreamableHTTPConfig {
 	if domain.NormalizeTransport(transport) != domain.TransportStreamableHTTP {
 		return nil
@@ -0,0 +1,63 @@
+package normalizer
+
+import (
+	"fmt"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeToolStatsConfig(raw RawToolStatsConfig) (domain.ToolStatsConfig, []string) {
+	var errs []string
+
+	maxTools := raw.MaxToolsPerServer
+	if maxTools < 0 {
+		errs = append(errs, "observability.toolStats.maxToolsPerServer must be >= 0")
+	}
+	if maxTools <= 0 {
+		maxTools = domain.DefaultToolStatsMaxToolsPerServer
+	}
+
+	var slos []domain.ToolSLOConfig
+	seen := make(map[string]struct{}, len(raw.SLOs))
+	for i, rawSLO := range raw.SLOs {
+		prefix := fmt.Sprintf("observability.toolStats.slos[%d]", i)
+
+		name := strings.TrimSpace(rawSLO.Name)
+		if name == "" {
+			name = fmt.Sprintf("slo-%d", i+1)
+		}
+		if _, ok := seen[name]; ok {
+			errs = append(errs, fmt.Sprintf("%s: duplicate slo name %q", prefix, name))
+		}
+		seen[name] = struct{}{}
+
+		if rawSLO.LatencyTargetMs < 0 {
+			errs = append(errs, prefix+": latencyTargetMs must be >= 0")
+		}
+		if rawSLO.ErrorBudget <= 0 || rawSLO.ErrorBudget >= 1 {
+			errs = append(errs, prefix+": errorBudget must be between 0 and 1 (exclusive)")
+		}
+		if rawSLO.WindowMinutes < 0 {
+			errs = append(errs, prefix+": windowMinutes must be >= 0")
+		}
+		window := rawSLO.WindowMinutes
+		if window <= 0 {
+			window = domain.DefaultToolSLOWindowMinutes
+		}
+
+		slos = append(slos, domain.ToolSLOConfig{
+			Name:            name,
+			Server:          strings.TrimSpace(rawSLO.Server),
+			Tool:            strings.TrimSpace(rawSLO.Tool),
+			LatencyTargetMs: rawSLO.LatencyTargetMs,
+			ErrorBudget:     rawSLO.ErrorBudget,
+			WindowMinutes:   window,
+		})
+	}
+
+	return domain.ToolStatsConfig{
+		MaxToolsPerServer: maxTools,
+		SLOs:              slos,
+	}, errs
+}
@@ -0,0 +1,56 @@
+package normalizer
+
+import (
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeTracingConfig(raw RawTracingConfig) (domain.TracingConfig, []string) {
+	var errs []string
+
+	exporter := domain.TracingExporter(strings.ToLower(strings.TrimSpace(raw.Exporter)))
+	switch exporter {
+	case "":
+		exporter = domain.DefaultTracingExporter
+	case domain.TracingExporterOTLPGRPC, domain.TracingExporterOTLPHTTP:
+	default:
+		errs = append(errs, "observability.tracing.exporter must be otlp-grpc or otlp-http")
+	}
+
+	ratio := domain.DefaultTracingSamplingRatio
+	if raw.SamplingRatio != nil {
+		ratio = *raw.SamplingRatio
+		if ratio < 0 || ratio > 1 {
+			errs = append(errs, "observability.tracing.samplingRatio must be between 0 and 1")
+		}
+	}
+
+	serviceName := strings.TrimSpace(raw.ServiceName)
+	if serviceName == "" {
+		serviceName = domain.DefaultTracingServiceName
+	}
+
+	var headers map[string]string
+	for key, value := range raw.Headers {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			errs = append(errs, "observability.tracing.headers: header name is required")
+			continue
+		}
+		if headers == nil {
+			headers = make(map[string]string, len(raw.Headers))
+		}
+		headers[key] = value
+	}
+
+	return domain.TracingConfig{
+		Enabled:       raw.Enabled,
+		Exporter:      exporter,
+		Endpoint:      strings.TrimSpace(raw.Endpoint),
+		Insecure:      raw.Insecure,
+		Headers:       headers,
+		SamplingRatio: ratio,
+		ServiceName:   serviceName,
+	}, errs
+}
@@ -0,0 +1,105 @@
+package normalizer
+
+import (
+	"fmt"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+func normalizeVirtualTools(raw []RawVirtualTool) ([]domain.VirtualToolConfig, []string) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var errs []string
+	names := make(map[string]struct{}, len(raw))
+	for _, rawTool := range raw {
+		names[strings.TrimSpace(rawTool.Name)] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(raw))
+	tools := make([]domain.VirtualToolConfig, 0, len(raw))
+	for i, rawTool := range raw {
+		prefix := fmt.Sprintf("virtualTools[%d]", i)
+
+		name := strings.TrimSpace(rawTool.Name)
+		switch {
+		case name == "":
+			errs = append(errs, fmt.Sprintf("%s: name is required", prefix))
+		case strings.ContainsAny(name, " \t\n"):
+			errs = append(errs, fmt.Sprintf("%s: name %q must not contain whitespace", prefix, name))
+		}
+		if _, ok := seen[name]; ok && name != "" {
+			errs = append(errs, fmt.Sprintf("%s: duplicate virtual tool name %q", prefix, name))
+		}
+		seen[name] = struct{}{}
+
+		schema := rawTool.InputSchema
+		if len(schema) == 0 {
+			schema = map[string]any{"type": "object"}
+		} else if schemaType, _ := schema["type"].(string); schemaType != "object" {
+			errs = append(errs, fmt.Sprintf("%s: inputSchema.type must be object", prefix))
+		}
+
+		if len(rawTool.Steps) == 0 {
+			errs = append(errs, fmt.Sprintf("%s: at least one step is required", prefix))
+		}
+		stepIDs := make(map[string]struct{}, len(rawTool.Steps))
+		steps := make([]domain.VirtualToolStep, 0, len(rawTool.Steps))
+		for j, rawStep := range rawTool.Steps {
+			stepPrefix := fmt.Sprintf("%s.steps[%d]", prefix, j)
+			id := strings.TrimSpace(rawStep.ID)
+			if id == "" {
+				id = fmt.Sprintf("step%d", j+1)
+			}
+			if strings.Contains(id, ".") {
+				errs = append(errs, fmt.Sprintf("%s: id %q must not contain '.'", stepPrefix, id))
+			}
+			if _, ok := stepIDs[id]; ok {
+				errs = append(errs, fmt.Sprintf("%s: duplicate step id %q", stepPrefix, id))
+			}
+			stepIDs[id] = struct{}{}
+
+			tool := strings.TrimSpace(rawStep.Tool)
+			if tool == "" {
+				errs = append(errs, fmt.Sprintf("%s: tool is required", stepPrefix))
+			} else if _, ok := names[tool]; ok {
+				errs = append(errs, fmt.Sprintf("%s: tool %q is a virtual tool; virtual tools cannot be nested", stepPrefix, tool))
+			}
+			steps = append(steps, domain.VirtualToolStep{ID: id, Tool: tool, Args: rawStep.Args})
+		}
+
+		result := domain.VirtualToolResult{
+			Step:      strings.TrimSpace(rawTool.Result.Step),
+			Aggregate: rawTool.Result.Aggregate,
+			Template:  rawTool.Result.Template,
+		}
+		modes := 0
+		if result.Step != "" {
+			modes++
+			if _, ok := stepIDs[result.Step]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: result.step %q does not match any step id", prefix, result.Step))
+			}
+		}
+		if result.Aggregate {
+			modes++
+		}
+		if result.Template != nil {
+			modes++
+		}
+		if modes > 1 {
+			errs = append(errs, fmt.Sprintf("%s: result accepts only one of step, aggregate or template", prefix))
+		}
+
+		tools = append(tools, domain.VirtualToolConfig{
+			Name:        name,
+			Title:       strings.TrimSpace(rawTool.Title),
+			Description: rawTool.Description,
+			InputSchema: schema,
+			Steps:       steps,
+			Result:      result,
+		})
+	}
+	return tools, errs
+}
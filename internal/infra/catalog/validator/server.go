@@ -80,6 +80,8 @@ func ValidateServerSpec(spec domain.ServerSpec, index int) []string {
 		}
 	}
 
+	errs = append(errs, validateToolOverrides(spec.Tools, index)...)
+
 	if transport == domain.TransportStreamableHTTP {
 		errs = append(errs, validateStreamableHTTPSpec(spec, index)...)
 	}
@@ -87,6 +89,36 @@ func ValidateServerSpec(spec domain.ServerSpec, index int) []string {
 	return errs
 }
 
+func validateToolOverrides(overrides map[string]domain.ToolOverride, index int) []string {
+	var errs []string
+	exposed := make(map[string]string, len(overrides))
+	for _, upstream := range domain.SortedToolOverrideNames(overrides) {
+		if upstream == "" {
+			errs = append(errs, fmt.Sprintf("servers[%d]: tools contains empty tool name", index))
+			continue
+		}
+		override := overrides[upstream]
+		for i, alias := range override.Aliases {
+			if alias == "" {
+				errs = append(errs, fmt.Sprintf("servers[%d]: tools.%s.aliases[%d] must not be empty", index, upstream, i))
+			}
+		}
+		for key := range override.FixedArgs {
+			if key == "" {
+				errs = append(errs, fmt.Sprintf("servers[%d]: tools.%s.fixedArgs contains empty property name", index, upstream))
+			}
+		}
+		for _, name := range override.ExposedToolNames(upstream) {
+			if owner, ok := exposed[name]; ok && owner != upstream {
+				errs = append(errs, fmt.Sprintf("servers[%d]: tools.%s exposes %q which is already used by tools.%s", index, upstream, name, owner))
+				continue
+			}
+			exposed[name] = upstream
+		}
+	}
+	return errs
+}
+
 func validateStreamableHTTPSpec(spec domain.ServerSpec, index int) []string {
 	var errs []string
 
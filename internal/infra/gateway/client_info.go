@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// clientRootsTimeout bounds a roots/list request to the downstream client.
+const clientRootsTimeout = 10 * time.Second
+
+// SetWorkspaceRoot sets the workspace root reported with the caller's client
+// info. It must be called before the gateway runs.
+func (g *Gateway) SetWorkspaceRoot(root string) {
+	g.workspaceRoot = root
+}
+
+// clientInfo returns the client info sent on registration, carrying the
+// identity the gateway acts for.
+func (g *Gateway) clientInfo() *controlv1.ClientInfo {
+	info := g.sessionInfo.Load()
+	if g.identity == "" {
+		if info == nil && g.workspaceRoot != "" {
+			info = &controlv1.ClientInfo{WorkspaceRoot: g.workspaceRoot}
+		}
+		return info
+	}
+	if info == nil {
+		return &controlv1.ClientInfo{WorkspaceRoot: g.workspaceRoot, Identity: g.identity}
+	}
+	out := proto.Clone(info).(*controlv1.ClientInfo)
+	out.Identity = g.identity
+	return out
+}
+
+// clientInitializedHandler records the downstream client's info once a session
+// initializes and registers again so the core sees it before the first call.
+// With several sessions, the most recent one wins.
+func (g *Gateway) clientInitializedHandler(ctx context.Context, req *mcp.InitializedRequest) {
+	if req == nil || req.Session == nil {
+		return
+	}
+	info := clientInfoFromParams(req.Session.InitializeParams(), g.workspaceRoot)
+	if prev := g.sessionInfo.Swap(info); prev == nil || !proto.Equal(prev, info) {
+		if err := g.registerCaller(ctx); err != nil {
+			g.logger.Debug("client info registration deferred to heartbeat", zap.Error(err))
+		}
+	}
+	if info.GetRoots() {
+		// The client answers on the same connection, so list its roots
+		// outside the notification handler.
+		go g.refreshClientRoots(context.WithoutCancel(ctx), req.Session)
+	}
+}
+
+// clientRootsListChangedHandler lists the client's roots again after it
+// announced a change.
+func (g *Gateway) clientRootsListChangedHandler(ctx context.Context, req *mcp.RootsListChangedRequest) {
+	if req == nil || req.Session == nil {
+		return
+	}
+	go g.refreshClientRoots(context.WithoutCancel(ctx), req.Session)
+}
+
+// refreshClientRoots fetches the session's roots and registers them with the
+// core when they changed.
+func (g *Gateway) refreshClientRoots(ctx context.Context, session *mcp.ServerSession) {
+	ctx, cancel := context.WithTimeout(ctx, clientRootsTimeout)
+	defer cancel()
+	res, err := session.ListRoots(ctx, nil)
+	if err != nil {
+		g.logger.Debug("list client roots failed", zap.Error(err))
+		return
+	}
+	if !g.setSessionRoots(rootsFromResult(res)) {
+		return
+	}
+	if err := g.registerCaller(ctx); err != nil {
+		g.logger.Debug("client roots registration deferred to heartbeat", zap.Error(err))
+	}
+}
+
+// setSessionRoots stores roots on the session info and reports whether they
+// changed.
+func (g *Gateway) setSessionRoots(roots []*controlv1.Root) bool {
+	for {
+		cur := g.sessionInfo.Load()
+		if cur == nil {
+			return false
+		}
+		next := proto.Clone(cur).(*controlv1.ClientInfo)
+		next.RootList = roots
+		if proto.Equal(cur, next) {
+			return false
+		}
+		if g.sessionInfo.CompareAndSwap(cur, next) {
+			return true
+		}
+	}
+}
+
+func rootsFromResult(res *mcp.ListRootsResult) []*controlv1.Root {
+	if res == nil {
+		return nil
+	}
+	roots := make([]*controlv1.Root, 0, len(res.Roots))
+	for _, root := range res.Roots {
+		if root == nil || root.URI == "" {
+			continue
+		}
+		roots = append(roots, &controlv1.Root{Uri: root.URI, Name: root.Name})
+	}
+	return roots
+}
+
+func clientInfoFromParams(params *mcp.InitializeParams, workspaceRoot string) *controlv1.ClientInfo {
+	info := &controlv1.ClientInfo{WorkspaceRoot: workspaceRoot}
+	if params == nil {
+		return info
+	}
+	if params.ClientInfo != nil {
+		info.Name = params.ClientInfo.Name
+		info.Version = params.ClientInfo.Version
+	}
+	if caps := params.Capabilities; caps != nil {
+		info.Sampling = caps.Sampling != nil
+		info.Elicitation = caps.Elicitation != nil
+		// The server decodes initialize so that RootsV2 is set whenever the
+		// client sent a roots object, even an empty one.
+		info.Roots = caps.RootsV2 != nil
+	}
+	return info
+}
@@ -0,0 +1,52 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func TestClientInfoFromParams(t *testing.T) {
+	params := &mcp.InitializeParams{
+		ClientInfo: &mcp.Implementation{Name: "cursor", Version: "1.2.0"},
+		Capabilities: &mcp.ClientCapabilities{
+			Sampling:    &mcp.SamplingCapabilities{},
+			Elicitation: &mcp.ElicitationCapabilities{},
+			RootsV2:     &mcp.RootCapabilities{},
+		},
+	}
+
+	info := clientInfoFromParams(params, "/work/app")
+	require.Equal(t, "cursor", info.GetName())
+	require.Equal(t, "1.2.0", info.GetVersion())
+	require.Equal(t, "/work/app", info.GetWorkspaceRoot())
+	require.True(t, info.GetRoots())
+	require.True(t, info.GetSampling())
+	require.True(t, info.GetElicitation())
+
+	info = clientInfoFromParams(&mcp.InitializeParams{Capabilities: &mcp.ClientCapabilities{}}, "")
+	require.False(t, info.GetRoots())
+	require.False(t, info.GetSampling())
+	require.False(t, info.GetElicitation())
+}
+
+func TestSetSessionRootsReportsChanges(t *testing.T) {
+	g := &Gateway{}
+	roots := rootsFromResult(&mcp.ListRootsResult{Roots: []*mcp.Root{
+		{URI: "file:///work/app", Name: "app"},
+		{URI: ""},
+		nil,
+	}})
+	require.Len(t, roots, 1)
+	require.False(t, g.setSessionRoots(roots))
+
+	g.sessionInfo.Store(&controlv1.ClientInfo{Name: "cursor", Roots: true})
+	require.True(t, g.setSessionRoots(roots))
+	require.False(t, g.setSessionRoots(rootsFromResult(&mcp.ListRootsResult{Roots: []*mcp.Root{{URI: "file:///work/app", Name: "app"}}})))
+	require.Equal(t, "file:///work/app", g.clientInfo().GetRootList()[0].GetUri())
+	require.True(t, g.setSessionRoots(nil))
+	require.Empty(t, g.clientInfo().GetRootList())
+}
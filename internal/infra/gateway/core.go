@@ -30,6 +30,11 @@ type Gateway struct {
 	resources         *resourceRegistry
 	prompts           *promptRegistry
 	callerPID         int64
+	pinTools          bool
+	pins              *toolPins
+	workspaceRoot     string
+	identity          string
+	sessionInfo       atomic.Pointer[controlv1.ClientInfo]
 	registered        atomic.Bool
 	subAgentEnabled   atomic.Bool
 	toolsReadyCh      chan struct{}
@@ -83,9 +88,11 @@ func (g *Gateway) run(ctx context.Context, runner func(context.Context) error) e
 		Name:    "mcpv-mcp",
 		Version: buildinfo.Version,
 	}, &mcp.ServerOptions{
-		HasTools:     true,
-		HasResources: true,
-		HasPrompts:   true,
+		HasTools:                true,
+		HasResources:            true,
+		HasPrompts:              true,
+		InitializedHandler:      g.clientInitializedHandler,
+		RootsListChangedHandler: g.clientRootsListChangedHandler,
 	})
 	if g.serverReadyCh != nil {
 		close(g.serverReadyCh)
@@ -94,6 +101,9 @@ func (g *Gateway) run(ctx context.Context, runner func(context.Context) error) e
 
 	g.clients = newClientManager(g.cfg, g.logger)
 	g.registry = newToolRegistry(g.server, g.toolHandler, g.logger)
+	if g.pinTools {
+		g.setupToolPinning()
+	}
 	g.resources = newResourceRegistry(g.server, g.resourceHandler, g.logger)
 	g.prompts = newPromptRegistry(g.server, g.promptHandler, g.logger)
 
@@ -216,6 +226,12 @@ func (g *Gateway) StopRuntime(ctx context.Context) error {
 	}
 }
 
+// SetToolPinning freezes each session's tool list at its first tools/list.
+// It must be called before the gateway runs.
+func (g *Gateway) SetToolPinning(enabled bool) {
+	g.pinTools = enabled
+}
+
 func (g *Gateway) Server() *mcp.Server {
 	return g.server
 }
@@ -257,13 +273,16 @@ func (g *Gateway) registerCaller(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	resp, err := client.Control().RegisterCaller(ctx, &controlv1.RegisterCallerRequest{
-		Caller: g.caller,
-		Pid:    g.callerPID,
-		Tags:   append([]string(nil), g.tags...),
-		Server: g.serverName,
+	suppressed := g.pins.takeSuppressed()
+	resp, err := client.Control().RegisterCaller(rpc.WithSuppressedToolListChanges(ctx, suppressed), &controlv1.RegisterCallerRequest{
+		Caller:     g.caller,
+		Pid:        g.callerPID,
+		Tags:       append([]string(nil), g.tags...),
+		Server:     g.serverName,
+		ClientInfo: g.clientInfo(),
 	})
 	if err != nil {
+		g.pins.restoreSuppressed(suppressed)
 		if status.Code(err) == codes.Unavailable {
 			g.clients.reset()
 		}
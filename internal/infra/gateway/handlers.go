@@ -9,6 +9,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/rpc"
+	"mcpv/internal/infra/telemetry"
 	controlv1 "mcpv/pkg/api/control/v1"
 )
 
@@ -17,8 +20,17 @@ func (g *Gateway) toolHandler(name string) mcp.ToolHandler {
 		var args json.RawMessage
 		if req != nil && req.Params != nil {
 			args = json.RawMessage(req.Params.Arguments)
+			if domain.CacheBypassRequested(req.Params.GetMeta()) {
+				ctx = rpc.WithCacheBypassHeader(ctx)
+			}
+			ctx = telemetry.ContextWithTraceMeta(ctx, req.Params.GetMeta())
 		}
+		ctx, span := telemetry.StartSpan(ctx, "gateway.tool_call",
+			telemetry.AttrTool.String(name),
+			telemetry.AttrCaller.String(g.caller),
+		)
 		resp, err := g.callTool(ctx, name, args)
+		telemetry.EndSpan(span, err)
 		if err != nil {
 			return nil, err
 		}
@@ -55,8 +67,13 @@ func (g *Gateway) promptHandler(name string) mcp.PromptHandler {
 func (g *Gateway) resourceHandler(uri string) mcp.ResourceHandler {
 	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
 		targetURI := uri
-		if req != nil && req.Params != nil && req.Params.URI != "" {
-			targetURI = req.Params.URI
+		if req != nil && req.Params != nil {
+			if req.Params.URI != "" {
+				targetURI = req.Params.URI
+			}
+			if domain.CacheBypassRequested(req.Params.GetMeta()) {
+				ctx = rpc.WithCacheBypassHeader(ctx)
+			}
 		}
 		resp, err := g.readResource(ctx, targetURI)
 		if err != nil {
@@ -0,0 +1,142 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/fsutil"
+)
+
+const (
+	unixAddrPrefix = "unix://"
+	fdAddrPrefix   = "fd://"
+
+	// listenFDsStart is the first descriptor passed by socket activation.
+	listenFDsStart = 3
+)
+
+// listenHTTP opens the gateway listener for addr. Besides host:port it
+// accepts unix:///path for a Unix domain socket and fd://[name] for a socket
+// inherited through LISTEN_FDS socket activation. The returned cleanup removes
+// any socket file created here.
+func listenHTTP(ctx context.Context, addr, socketMode string) (net.Listener, func(), error) {
+	switch {
+	case strings.HasPrefix(addr, unixAddrPrefix):
+		return listenUnixSocket(ctx, strings.TrimPrefix(addr, unixAddrPrefix), socketMode)
+	case strings.HasPrefix(addr, fdAddrPrefix):
+		lis, err := inheritedListener(strings.TrimPrefix(addr, fdAddrPrefix))
+		if err != nil {
+			return nil, nil, err
+		}
+		return lis, func() {}, nil
+	default:
+		listenerConfig := net.ListenConfig{}
+		lis, err := listenerConfig.Listen(ctx, "tcp", strings.TrimPrefix(addr, "tcp://"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("listen http: %w", err)
+		}
+		return lis, func() {}, nil
+	}
+}
+
+func listenUnixSocket(ctx context.Context, path, socketMode string) (net.Listener, func(), error) {
+	if path == "" {
+		return nil, nil, errors.New("http unix socket path is empty")
+	}
+	var mode os.FileMode
+	if strings.TrimSpace(socketMode) != "" {
+		parsed, err := domain.ParseSocketMode(socketMode)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid http socket mode %q: must be an octal file mode like 0660", socketMode)
+		}
+		mode = os.FileMode(parsed)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), fsutil.DefaultDirMode); err != nil {
+		return nil, nil, fmt.Errorf("create http socket dir: %w", err)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("remove http socket: %w", err)
+	}
+	listenerConfig := net.ListenConfig{}
+	lis, err := listenerConfig.Listen(ctx, "unix", path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen http: %w", err)
+	}
+	if mode != 0 {
+		if err := os.Chmod(path, mode); err != nil {
+			_ = lis.Close()
+			return nil, nil, fmt.Errorf("chmod http socket: %w", err)
+		}
+	}
+	cleanup := func() {
+		_ = os.Remove(path)
+	}
+	return lis, cleanup, nil
+}
+
+// inheritedListener wraps a socket passed by the service manager. An empty
+// name selects the first descriptor; otherwise the name must match an entry
+// of LISTEN_FDNAMES.
+func inheritedListener(name string) (net.Listener, error) {
+	index, err := inheritedFDIndex(os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDS"), os.Getenv("LISTEN_FDNAMES"), os.Getpid(), name)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		_ = os.Unsetenv(key)
+	}
+	file := os.NewFile(uintptr(listenFDsStart+index), "listen-fd-"+strconv.Itoa(index))
+	if file == nil {
+		return nil, fmt.Errorf("inherited socket %d is invalid", index)
+	}
+	defer file.Close()
+	lis, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("use inherited socket: %w", err)
+	}
+	return lis, nil
+}
+
+func inheritedFDIndex(listenPID, listenFDs, listenNames string, pid int, name string) (int, error) {
+	if strings.TrimSpace(listenPID) == "" {
+		return 0, errors.New("no inherited sockets: LISTEN_PID is not set")
+	}
+	owner, err := strconv.Atoi(strings.TrimSpace(listenPID))
+	if err != nil || owner != pid {
+		return 0, errors.New("no inherited sockets: LISTEN_PID does not match this process")
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(listenFDs))
+	if err != nil || count <= 0 {
+		return 0, errors.New("no inherited sockets: LISTEN_FDS is empty")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, nil
+	}
+	names := strings.Split(listenNames, ":")
+	for i := 0; i < count && i < len(names); i++ {
+		if names[i] == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no inherited socket named %q", name)
+}
+
+// isLoopbackListener reports whether a listener only accepts local peers.
+func isLoopbackListener(lis net.Listener) bool {
+	switch addr := lis.Addr().(type) {
+	case *net.UnixAddr:
+		return true
+	case *net.TCPAddr:
+		return addr.IP.IsLoopback()
+	default:
+		return false
+	}
+}
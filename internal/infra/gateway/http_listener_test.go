@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenHTTP_UnixSocketAppliesModeAndPeerCredentials(t *testing.T) {
+	if goruntime.GOOS == "windows" {
+		t.Skip("unix sockets are not used on windows")
+	}
+	dir, err := os.MkdirTemp("", "mcpv-http")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "gw.sock")
+
+	lis, cleanup, err := listenHTTP(context.Background(), "unix://"+path, "0600")
+	require.NoError(t, err)
+	defer cleanup()
+	defer lis.Close()
+	require.True(t, isLoopbackListener(lis))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+		close(accepted)
+	}()
+	client, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer client.Close()
+	server, ok := <-accepted
+	require.True(t, ok)
+	defer server.Close()
+
+	creds, ok := peerCredentialsFrom(withConnPeerCredentials(context.Background(), server))
+	if goruntime.GOOS != "linux" {
+		require.False(t, ok)
+		return
+	}
+	require.True(t, ok)
+	require.Equal(t, int64(os.Getpid()), creds.PID)
+	require.Equal(t, uint32(os.Getuid()), creds.UID)
+	require.True(t, peerAlive(creds.PID))
+	require.True(t, listenerHasPeerCredentials(lis))
+}
+
+func TestListenHTTP_RejectsInvalidSocketMode(t *testing.T) {
+	_, _, err := listenHTTP(context.Background(), "unix://"+filepath.Join(t.TempDir(), "gw.sock"), "rw")
+	require.ErrorContains(t, err, "invalid http socket mode")
+}
+
+func TestInheritedFDIndex(t *testing.T) {
+	index, err := inheritedFDIndex("42", "2", "rpc:http", 42, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, index)
+
+	index, err = inheritedFDIndex("42", "2", "rpc:http", 42, "http")
+	require.NoError(t, err)
+	require.Equal(t, 1, index)
+
+	_, err = inheritedFDIndex("42", "2", "rpc:http", 42, "admin")
+	require.ErrorContains(t, err, `no inherited socket named "admin"`)
+
+	_, err = inheritedFDIndex("41", "2", "", 42, "")
+	require.ErrorContains(t, err, "does not match")
+
+	_, err = inheritedFDIndex("", "", "", 42, "")
+	require.ErrorContains(t, err, "LISTEN_PID is not set")
+
+	_, err = inheritedFDIndex("42", "0", "", 42, "")
+	require.ErrorContains(t, err, "LISTEN_FDS is empty")
+}
+
+func TestAllowsPeerUID(t *testing.T) {
+	require.True(t, allowsPeerUID(nil, 1000))
+	require.True(t, allowsPeerUID([]int{0, 1000}, 1000))
+	require.False(t, allowsPeerUID([]int{0}, 1000))
+}
+
+func TestAuthorizePeer_FailsClosedWithoutCredentials(t *testing.T) {
+	pid, ok := authorizePeer(context.Background(), nil)
+	require.True(t, ok)
+	require.Zero(t, pid)
+	_, ok = authorizePeer(context.Background(), []int{1000})
+	require.False(t, ok)
+
+	ctx := context.WithValue(context.Background(), peerCredentialsKey{}, PeerCredentials{PID: 42, UID: 1000})
+	pid, ok = authorizePeer(ctx, []int{1000})
+	require.True(t, ok)
+	require.Equal(t, int64(42), pid)
+	_, ok = authorizePeer(ctx, []int{0})
+	require.False(t, ok)
+}
+
+func TestListenerHasPeerCredentials_TCP(t *testing.T) {
+	lis, cleanup, err := listenHTTP(context.Background(), "127.0.0.1:0", "")
+	require.NoError(t, err)
+	defer cleanup()
+	defer lis.Close()
+	require.False(t, listenerHasPeerCredentials(lis))
+}
@@ -24,7 +24,10 @@ const (
 type HTTPOptions struct {
 	Addr               string
 	Path               string
+	SocketMode         string
+	AllowedPeerUIDs    []int
 	Token              string
+	TokenFile          string
 	AllowedOrigins     []string
 	JSONResponse       bool
 	SessionTimeout     time.Duration
@@ -50,8 +53,34 @@ func (g *Gateway) RunStreamableHTTP(ctx context.Context, opts HTTPOptions) error
 	runCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	pool := newGatewayPool(runCtx, g.cfg, g.caller, g.logger, PoolOptions{})
-	handler := g.buildStreamableHTTPHandler(normalized, pool)
+	var identities *identityStore
+	if normalized.TokenFile != "" {
+		identities, err = newIdentityStore(normalized.TokenFile, g.logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	listener, cleanup, err := listenHTTP(runCtx, normalized.Addr, normalized.SocketMode)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(normalized.AllowedPeerUIDs) > 0 && !listenerHasPeerCredentials(listener) {
+		_ = listener.Close()
+		return errors.New("http allowed uids require a unix socket listener with peer credentials")
+	}
+	if normalized.Token == "" && identities == nil && !isLoopbackListener(listener) {
+		_ = listener.Close()
+		return errors.New("http token or token file is required when listening on a non-loopback address")
+	}
+
+	pool := newGatewayPool(runCtx, g.cfg, g.caller, g.logger, PoolOptions{PeerAlive: peerAlive, PinTools: g.pinTools})
+	if identities != nil {
+		identities.OnRevoke(pool.EvictCallers)
+		go identities.Watch(runCtx)
+	}
+	handler := g.buildStreamableHTTPHandler(normalized, pool, identities)
 	mux := http.NewServeMux()
 	mux.Handle(normalized.Path, handler)
 	if normalized.Path != "/" && !strings.HasSuffix(normalized.Path, "/") {
@@ -62,8 +91,8 @@ func (g *Gateway) RunStreamableHTTP(ctx context.Context, opts HTTPOptions) error
 	})
 
 	server := &http.Server{
-		Addr:              normalized.Addr,
 		Handler:           mux,
+		ConnContext:       withConnPeerCredentials,
 		ReadHeaderTimeout: normalized.ReadHeaderTimeout,
 		ReadTimeout:       normalized.ReadTimeout,
 		IdleTimeout:       normalized.IdleTimeout,
@@ -73,13 +102,14 @@ func (g *Gateway) RunStreamableHTTP(ctx context.Context, opts HTTPOptions) error
 	go func() {
 		g.logger.Info("gateway starting (streamable http transport)",
 			zap.String("addr", normalized.Addr),
+			zap.String("listener", listener.Addr().String()),
 			zap.String("path", normalized.Path),
 		)
 		var listenErr error
 		if normalized.TLSEnabled {
-			listenErr = server.ListenAndServeTLS(normalized.TLSCertFile, normalized.TLSKeyFile)
+			listenErr = server.ServeTLS(listener, normalized.TLSCertFile, normalized.TLSKeyFile)
 		} else {
-			listenErr = server.ListenAndServe()
+			listenErr = server.Serve(listener)
 		}
 		if listenErr != nil && !errors.Is(listenErr, http.ErrServerClosed) {
 			errCh <- listenErr
@@ -118,6 +148,10 @@ func normalizeHTTPOptions(opts HTTPOptions) (HTTPOptions, error) {
 		path = strings.TrimRight(path, "/")
 	}
 	opts.Path = path
+	opts.Addr = strings.TrimSpace(opts.Addr)
+	opts.SocketMode = strings.TrimSpace(opts.SocketMode)
+	opts.Token = strings.TrimSpace(opts.Token)
+	opts.TokenFile = strings.TrimSpace(opts.TokenFile)
 
 	if opts.ReadHeaderTimeout <= 0 {
 		opts.ReadHeaderTimeout = defaultHTTPReadHeaderTimeout
@@ -138,7 +172,7 @@ func normalizeHTTPOptions(opts HTTPOptions) (HTTPOptions, error) {
 
 type selectorServerKey struct{}
 
-func (g *Gateway) buildStreamableHTTPHandler(opts HTTPOptions, pool *gatewayPool) http.Handler {
+func (g *Gateway) buildStreamableHTTPHandler(opts HTTPOptions, pool *gatewayPool, identities *identityStore) http.Handler {
 	streamable := mcp.NewStreamableHTTPHandler(func(r *http.Request) *mcp.Server {
 		if r == nil {
 			return nil
@@ -168,7 +202,22 @@ func (g *Gateway) buildStreamableHTTPHandler(opts HTTPOptions, pool *gatewayPool
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		server, err := pool.Get(r.Context(), selector)
+		pid, ok := authorizePeer(r.Context(), opts.AllowedPeerUIDs)
+		if !ok {
+			http.Error(w, "peer not allowed", http.StatusForbidden)
+			return
+		}
+		caller := ""
+		var tags []string
+		if identity, ok := httpIdentityFrom(r.Context()); ok {
+			if !identity.AllowsSelector(selector.normalized()) {
+				http.Error(w, "selector not allowed for this token", http.StatusForbidden)
+				return
+			}
+			caller = identity.Caller
+			tags = identity.Tags
+		}
+		server, err := pool.GetAs(r.Context(), caller, tags, pid, selector)
 		if err != nil {
 			http.Error(w, "gateway selector unavailable", http.StatusServiceUnavailable)
 			return
@@ -177,15 +226,27 @@ func (g *Gateway) buildStreamableHTTPHandler(opts HTTPOptions, pool *gatewayPool
 		streamable.ServeHTTP(w, r.WithContext(ctx))
 	})
 
-	if opts.Token != "" {
+	if opts.Token != "" || identities != nil {
 		handler = withTokenHeader(handler)
 		handler = auth.RequireBearerToken(func(_ context.Context, token string, _ *http.Request) (*auth.TokenInfo, error) {
-			if subtle.ConstantTimeCompare([]byte(token), []byte(opts.Token)) != 1 {
+			if opts.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(opts.Token)) == 1 {
+				return &auth.TokenInfo{
+					Expiration: time.Now().Add(staticTokenLifetime),
+					UserID:     token,
+				}, nil
+			}
+			if identities == nil {
+				return nil, auth.ErrInvalidToken
+			}
+			identity, expiry, err := identities.Resolve(token)
+			if err != nil {
+				g.logger.Debug("bearer token rejected", zap.Error(err))
 				return nil, auth.ErrInvalidToken
 			}
 			return &auth.TokenInfo{
-				Expiration: time.Now().Add(24 * 365 * 10 * time.Hour),
-				UserID:     token,
+				Expiration: expiry,
+				UserID:     identity.Caller,
+				Extra:      map[string]any{identityExtraKey: identity},
 			}, nil
 		}, nil)(handler)
 	}
@@ -196,6 +257,29 @@ func (g *Gateway) buildStreamableHTTPHandler(opts HTTPOptions, pool *gatewayPool
 	return handler
 }
 
+// authorizePeer checks the connection peer against the allowed user IDs and
+// returns its pid. With an allow list set, requests without peer credentials
+// are rejected.
+func authorizePeer(ctx context.Context, allowed []int) (int64, bool) {
+	peer, ok := peerCredentialsFrom(ctx)
+	if !ok {
+		return 0, len(allowed) == 0
+	}
+	return peer.PID, allowsPeerUID(allowed, peer.UID)
+}
+
+func allowsPeerUID(allowed []int, uid uint32) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, candidate := range allowed {
+		if candidate >= 0 && uint32(candidate) == uid {
+			return true
+		}
+	}
+	return false
+}
+
 func buildEventStore(opts HTTPOptions) mcp.EventStore {
 	if !opts.EventStoreEnabled {
 		return nil
@@ -0,0 +1,408 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultJWTCallerClaim  = "sub"
+	defaultJWTServersClaim = "mcpv_servers"
+	defaultJWTTagsClaim    = "mcpv_tags"
+	identityReloadDebounce = 200 * time.Millisecond
+	staticTokenLifetime    = 24 * 365 * 10 * time.Hour
+)
+
+// HTTPIdentity is the caller identity bound to a bearer token.
+type HTTPIdentity struct {
+	Caller string
+	// Servers are glob patterns for server selectors the caller may use.
+	Servers []string
+	// Tags are the tags the caller may combine in tag selectors.
+	Tags []string
+}
+
+// AllowsSelector reports whether the identity may use a selector. An identity
+// without servers or tags may use any selector.
+func (i HTTPIdentity) AllowsSelector(sel Selector) bool {
+	if len(i.Servers) == 0 && len(i.Tags) == 0 {
+		return true
+	}
+	if sel.Server != "" {
+		for _, pattern := range i.Servers {
+			if ok, err := path.Match(pattern, sel.Server); err == nil && ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(sel.Tags) == 0 {
+		return false
+	}
+	allowed := make(map[string]struct{}, len(i.Tags))
+	for _, tag := range i.Tags {
+		allowed[tag] = struct{}{}
+	}
+	for _, tag := range sel.Tags {
+		if _, ok := allowed[tag]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// identityFile is the on-disk token table. JSON files are accepted as YAML.
+type identityFile struct {
+	Tokens []identityFileToken `yaml:"tokens"`
+	JWT    *identityFileJWT    `yaml:"jwt"`
+}
+
+type identityFileToken struct {
+	Token       string   `yaml:"token"`
+	TokenSHA256 string   `yaml:"tokenSha256"`
+	Caller      string   `yaml:"caller"`
+	Servers     []string `yaml:"servers"`
+	Tags        []string `yaml:"tags"`
+}
+
+type identityFileJWT struct {
+	JWKSFile      string `yaml:"jwksFile"`
+	Issuer        string `yaml:"issuer"`
+	Audience      string `yaml:"audience"`
+	CallerClaim   string `yaml:"callerClaim"`
+	ServersClaim  string `yaml:"serversClaim"`
+	TagsClaim     string `yaml:"tagsClaim"`
+	LeewaySeconds int    `yaml:"leewaySeconds"`
+}
+
+// identityTable resolves bearer tokens to identities. Static tokens are kept
+// as SHA-256 digests so the table never holds plaintext secrets.
+type identityTable struct {
+	tokens   map[string]HTTPIdentity
+	jwt      *identityFileJWT
+	jwksPath string
+	jwks     []jwksKey
+}
+
+func loadIdentityTable(file string) (*identityTable, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read token file: %w", err)
+	}
+	var raw identityFile
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("decode token file: %w", err)
+	}
+
+	table := &identityTable{tokens: make(map[string]HTTPIdentity, len(raw.Tokens))}
+	for i, entry := range raw.Tokens {
+		digest, err := tokenDigest(entry)
+		if err != nil {
+			return nil, fmt.Errorf("tokens[%d]: %w", i, err)
+		}
+		caller := strings.TrimSpace(entry.Caller)
+		if caller == "" {
+			return nil, fmt.Errorf("tokens[%d]: caller is required", i)
+		}
+		if _, exists := table.tokens[digest]; exists {
+			return nil, fmt.Errorf("tokens[%d]: duplicate token", i)
+		}
+		identity, err := newHTTPIdentity(caller, entry.Servers, entry.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("tokens[%d]: %w", i, err)
+		}
+		table.tokens[digest] = identity
+	}
+
+	if raw.JWT != nil {
+		cfg := *raw.JWT
+		if strings.TrimSpace(cfg.JWKSFile) == "" {
+			return nil, errors.New("jwt: jwksFile is required")
+		}
+		if cfg.CallerClaim == "" {
+			cfg.CallerClaim = defaultJWTCallerClaim
+		}
+		if cfg.ServersClaim == "" {
+			cfg.ServersClaim = defaultJWTServersClaim
+		}
+		if cfg.TagsClaim == "" {
+			cfg.TagsClaim = defaultJWTTagsClaim
+		}
+		table.jwksPath = cfg.JWKSFile
+		if !filepath.IsAbs(table.jwksPath) {
+			table.jwksPath = filepath.Join(filepath.Dir(file), table.jwksPath)
+		}
+		keys, err := loadJWKSFile(table.jwksPath)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: %w", err)
+		}
+		table.jwt = &cfg
+		table.jwks = keys
+	}
+
+	if len(table.tokens) == 0 && table.jwt == nil {
+		return nil, errors.New("token file defines no tokens or jwt")
+	}
+	return table, nil
+}
+
+func tokenDigest(entry identityFileToken) (string, error) {
+	token := strings.TrimSpace(entry.Token)
+	hashed := strings.ToLower(strings.TrimSpace(entry.TokenSHA256))
+	switch {
+	case token != "" && hashed != "":
+		return "", errors.New("token and tokenSha256 are mutually exclusive")
+	case token != "":
+		return hashToken(token), nil
+	case hashed != "":
+		if decoded, err := hex.DecodeString(hashed); err != nil || len(decoded) != sha256.Size {
+			return "", errors.New("tokenSha256 must be a hex sha256 digest")
+		}
+		return hashed, nil
+	default:
+		return "", errors.New("token or tokenSha256 is required")
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newHTTPIdentity(caller string, servers, tags []string) (HTTPIdentity, error) {
+	for _, pattern := range servers {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return HTTPIdentity{}, fmt.Errorf("invalid server pattern %q", pattern)
+		}
+	}
+	return HTTPIdentity{
+		Caller:  caller,
+		Servers: append([]string(nil), servers...),
+		Tags:    normalizeTags(tags),
+	}, nil
+}
+
+// resolve maps a bearer token to an identity and its expiry.
+func (t *identityTable) resolve(token string, now time.Time) (HTTPIdentity, time.Time, error) {
+	if identity, ok := t.tokens[hashToken(token)]; ok {
+		return identity, now.Add(staticTokenLifetime), nil
+	}
+	if t.jwt == nil || strings.Count(token, ".") != 2 {
+		return HTTPIdentity{}, time.Time{}, errors.New("unknown token")
+	}
+	cfg := t.jwt
+	claims, err := verifyJWT(token, t.jwks, now, time.Duration(cfg.LeewaySeconds)*time.Second)
+	if err != nil {
+		return HTTPIdentity{}, time.Time{}, err
+	}
+	if cfg.Issuer != "" && stringClaim(claims, "iss") != cfg.Issuer {
+		return HTTPIdentity{}, time.Time{}, errors.New("jwt issuer mismatch")
+	}
+	if cfg.Audience != "" && !containsString(stringListClaim(claims, "aud"), cfg.Audience) {
+		return HTTPIdentity{}, time.Time{}, errors.New("jwt audience mismatch")
+	}
+	caller := stringClaim(claims, cfg.CallerClaim)
+	if caller == "" {
+		return HTTPIdentity{}, time.Time{}, fmt.Errorf("jwt claim %q is required", cfg.CallerClaim)
+	}
+	identity, err := newHTTPIdentity(caller, stringListClaim(claims, cfg.ServersClaim), stringListClaim(claims, cfg.TagsClaim))
+	if err != nil {
+		return HTTPIdentity{}, time.Time{}, err
+	}
+	expiry, ok := numericClaim(claims, "exp")
+	if !ok {
+		return HTTPIdentity{}, time.Time{}, errors.New("jwt exp claim is required")
+	}
+	return identity, expiry, nil
+}
+
+func (t *identityTable) callers() map[string]struct{} {
+	out := make(map[string]struct{}, len(t.tokens))
+	for _, identity := range t.tokens {
+		out[identity.Caller] = struct{}{}
+	}
+	return out
+}
+
+func containsString(values []string, want string) bool {
+	for _, value := range values {
+		if value == want {
+			return true
+		}
+	}
+	return false
+}
+
+// identityStore holds the current token table and reloads it when the token
+// file or its JWKS file changes. A failed reload keeps the previous table.
+type identityStore struct {
+	path   string
+	logger *zap.Logger
+
+	mu       sync.RWMutex
+	table    *identityTable
+	onRevoke func(callers []string)
+}
+
+func newIdentityStore(file string, logger *zap.Logger) (*identityStore, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	table, err := loadIdentityTable(file)
+	if err != nil {
+		return nil, err
+	}
+	return &identityStore{
+		path:   file,
+		logger: logger.Named("identity"),
+		table:  table,
+	}, nil
+}
+
+// Resolve maps a bearer token to an identity using the current table.
+func (s *identityStore) Resolve(token string) (HTTPIdentity, time.Time, error) {
+	s.mu.RLock()
+	table := s.table
+	s.mu.RUnlock()
+	return table.resolve(token, time.Now())
+}
+
+// OnRevoke registers a callback for static-token callers removed by a reload.
+func (s *identityStore) OnRevoke(fn func(callers []string)) {
+	s.mu.Lock()
+	s.onRevoke = fn
+	s.mu.Unlock()
+}
+
+func (s *identityStore) reload() error {
+	table, err := loadIdentityTable(s.path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	previous := s.table
+	s.table = table
+	onRevoke := s.onRevoke
+	s.mu.Unlock()
+
+	current := table.callers()
+	var revoked []string
+	for caller := range previous.callers() {
+		if _, ok := current[caller]; !ok {
+			revoked = append(revoked, caller)
+		}
+	}
+	s.logger.Info("token file reloaded",
+		zap.Int("tokens", len(table.tokens)),
+		zap.Bool("jwt", table.jwt != nil),
+		zap.Strings("revoked", revoked),
+	)
+	if onRevoke != nil && len(revoked) > 0 {
+		onRevoke(revoked)
+	}
+	return nil
+}
+
+func (s *identityStore) watchedFiles() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	files := []string{filepath.Clean(s.path)}
+	if s.table.jwksPath != "" {
+		files = append(files, filepath.Clean(s.table.jwksPath))
+	}
+	return files
+}
+
+// Watch reloads the table on changes until ctx is done. Parent directories are
+// watched so atomic replaces are picked up.
+func (s *identityStore) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		s.logger.Warn("token file watcher failed", zap.Error(err))
+		return
+	}
+	defer watcher.Close()
+
+	watchedDirs := make(map[string]struct{})
+	addDirs := func() {
+		for _, file := range s.watchedFiles() {
+			dir := filepath.Dir(file)
+			if _, ok := watchedDirs[dir]; ok {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				s.logger.Warn("token file watcher add failed", zap.String("path", dir), zap.Error(err))
+				continue
+			}
+			watchedDirs[dir] = struct{}{}
+		}
+	}
+	addDirs()
+
+	var timer *time.Timer
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-watcher.Errors:
+			if err != nil {
+				s.logger.Warn("token file watcher error", zap.Error(err))
+			}
+		case event := <-watcher.Events:
+			if !containsString(s.watchedFiles(), filepath.Clean(event.Name)) {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(identityReloadDebounce)
+				continue
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(identityReloadDebounce)
+		case <-identityTimerChan(timer):
+			timer = nil
+			if err := s.reload(); err != nil {
+				s.logger.Warn("token file reload failed", zap.Error(err))
+				continue
+			}
+			addDirs()
+		}
+	}
+}
+
+func identityTimerChan(timer *time.Timer) <-chan time.Time {
+	if timer == nil {
+		return nil
+	}
+	return timer.C
+}
+
+const identityExtraKey = "mcpv.identity"
+
+// httpIdentityFrom returns the identity attached by the bearer token verifier.
+func httpIdentityFrom(ctx context.Context) (HTTPIdentity, bool) {
+	info := auth.TokenInfoFromContext(ctx)
+	if info == nil {
+		return HTTPIdentity{}, false
+	}
+	identity, ok := info.Extra[identityExtraKey].(HTTPIdentity)
+	return identity, ok
+}
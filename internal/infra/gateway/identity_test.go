@@ -0,0 +1,153 @@
+package gateway
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writeIdentityFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+func signTestJWT(t *testing.T, key ed25519.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": "EdDSA", "kid": "k1"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(key, []byte(signed)))
+}
+
+func TestIdentityTable_ResolvesStaticTokens(t *testing.T) {
+	dir := t.TempDir()
+	file := writeIdentityFile(t, dir, "tokens.yaml", `
+tokens:
+  - token: alice-secret
+    caller: alice
+    servers: ["context7", "git-*"]
+  - tokenSha256: `+hashToken("bob-secret")+`
+    caller: bob
+    tags: [db]
+`)
+
+	table, err := loadIdentityTable(file)
+	require.NoError(t, err)
+
+	alice, _, err := table.resolve("alice-secret", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "alice", alice.Caller)
+	require.True(t, alice.AllowsSelector(Selector{Server: "git-local"}))
+	require.False(t, alice.AllowsSelector(Selector{Server: "weather"}))
+	require.False(t, alice.AllowsSelector(Selector{Tags: []string{"db"}}))
+
+	bob, _, err := table.resolve("bob-secret", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "bob", bob.Caller)
+	require.True(t, bob.AllowsSelector(Selector{Tags: []string{"db"}}))
+	require.False(t, bob.AllowsSelector(Selector{Tags: []string{"db", "git"}}))
+
+	_, _, err = table.resolve("unknown", time.Now())
+	require.Error(t, err)
+}
+
+func TestIdentityTable_RejectsInvalidEntries(t *testing.T) {
+	dir := t.TempDir()
+	missingCaller := writeIdentityFile(t, dir, "missing.yaml", "tokens:\n  - token: x\n")
+	_, err := loadIdentityTable(missingCaller)
+	require.ErrorContains(t, err, "caller is required")
+
+	duplicate := writeIdentityFile(t, dir, "dup.yaml", "tokens:\n  - token: x\n    caller: a\n  - token: x\n    caller: b\n")
+	_, err = loadIdentityTable(duplicate)
+	require.ErrorContains(t, err, "duplicate token")
+
+	empty := writeIdentityFile(t, dir, "empty.yaml", "tokens: []\n")
+	_, err = loadIdentityTable(empty)
+	require.Error(t, err)
+}
+
+func TestIdentityTable_ResolvesJWT(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	writeIdentityFile(t, dir, "jwks.json", `{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"k1","x":"`+
+		base64.RawURLEncoding.EncodeToString(pub)+`"}]}`)
+	file := writeIdentityFile(t, dir, "tokens.yaml", `
+jwt:
+  jwksFile: jwks.json
+  issuer: https://issuer.example
+  audience: mcpv
+`)
+
+	table, err := loadIdentityTable(file)
+	require.NoError(t, err)
+
+	now := time.Now()
+	exp := now.Add(time.Hour).Unix()
+	token := signTestJWT(t, priv, map[string]any{
+		"iss":         "https://issuer.example",
+		"aud":         []string{"mcpv"},
+		"sub":         "carol",
+		"exp":         exp,
+		"mcpv_tags":   "db git",
+		"mcpv_extras": true,
+	})
+	identity, expiry, err := table.resolve(token, now)
+	require.NoError(t, err)
+	require.Equal(t, "carol", identity.Caller)
+	require.Equal(t, []string{"db", "git"}, identity.Tags)
+	require.Equal(t, exp, expiry.Unix())
+
+	expired := signTestJWT(t, priv, map[string]any{"iss": "https://issuer.example", "aud": "mcpv", "sub": "carol", "exp": now.Add(-time.Hour).Unix()})
+	_, _, err = table.resolve(expired, now)
+	require.ErrorContains(t, err, "expired")
+
+	noExpiry := signTestJWT(t, priv, map[string]any{"iss": "https://issuer.example", "aud": "mcpv", "sub": "carol"})
+	_, _, err = table.resolve(noExpiry, now)
+	require.ErrorContains(t, err, "exp claim is required")
+
+	wrongAudience := signTestJWT(t, priv, map[string]any{"iss": "https://issuer.example", "aud": "other", "sub": "carol"})
+	_, _, err = table.resolve(wrongAudience, now)
+	require.ErrorContains(t, err, "audience")
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	forged := signTestJWT(t, otherKey, map[string]any{"iss": "https://issuer.example", "aud": "mcpv", "sub": "mallory"})
+	_, _, err = table.resolve(forged, now)
+	require.ErrorContains(t, err, "signature")
+}
+
+func TestIdentityStore_ReloadRevokesRemovedCallers(t *testing.T) {
+	dir := t.TempDir()
+	file := writeIdentityFile(t, dir, "tokens.yaml", "tokens:\n  - token: a\n    caller: alice\n  - token: b\n    caller: bob\n")
+
+	store, err := newIdentityStore(file, zap.NewNop())
+	require.NoError(t, err)
+	var revoked []string
+	store.OnRevoke(func(callers []string) { revoked = callers })
+
+	writeIdentityFile(t, dir, "tokens.yaml", "tokens:\n  - token: a\n    caller: alice\n")
+	require.NoError(t, store.reload())
+	require.Equal(t, []string{"bob"}, revoked)
+
+	_, _, err = store.Resolve("b")
+	require.Error(t, err)
+
+	writeIdentityFile(t, dir, "tokens.yaml", "tokens: [")
+	require.Error(t, store.reload())
+	identity, _, err := store.Resolve("a")
+	require.NoError(t, err)
+	require.Equal(t, "alice", identity.Caller)
+}
@@ -0,0 +1,303 @@
+package gateway
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// jwksKey is a public key loaded from a JWKS document.
+type jwksKey struct {
+	id  string
+	alg string
+	key crypto.PublicKey
+}
+
+type rawJWKS struct {
+	Keys []rawJWK `json:"keys"`
+}
+
+type rawJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func loadJWKSFile(path string) ([]jwksKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks: %w", err)
+	}
+	return parseJWKS(data)
+}
+
+func parseJWKS(data []byte) ([]jwksKey, error) {
+	var doc rawJWKS
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+	keys := make([]jwksKey, 0, len(doc.Keys))
+	for i, raw := range doc.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+		key, err := parseJWK(raw)
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %d: %w", i, err)
+		}
+		keys = append(keys, jwksKey{id: raw.Kid, alg: raw.Alg, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no signing keys")
+	}
+	return keys, nil
+}
+
+func parseJWK(raw rawJWK) (crypto.PublicKey, error) {
+	switch raw.Kty {
+	case "RSA":
+		n, err := decodeBigInt(raw.N)
+		if err != nil {
+			return nil, fmt.Errorf("modulus: %w", err)
+		}
+		e, err := decodeBigInt(raw.E)
+		if err != nil {
+			return nil, fmt.Errorf("exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, size, err := jwkCurve(raw.Crv)
+		if err != nil {
+			return nil, err
+		}
+		x, err := decodeFixed(raw.X, size)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		y, err := decodeFixed(raw.Y, size)
+		if err != nil {
+			return nil, fmt.Errorf("y: %w", err)
+		}
+		point := append([]byte{4}, append(x, y...)...)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	case "OKP":
+		if raw.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", raw.Crv)
+		}
+		x, err := decodeFixed(raw.X, ed25519.PublicKeySize)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", raw.Kty)
+	}
+}
+
+func jwkCurve(name string) (elliptic.Curve, int, error) {
+	switch name {
+	case "P-256":
+		return elliptic.P256(), 32, nil
+	case "P-384":
+		return elliptic.P384(), 48, nil
+	case "P-521":
+		return elliptic.P521(), 66, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported curve %q", name)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func decodeFixed(value string, size int) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != size {
+		return nil, fmt.Errorf("expected %d bytes, got %d", size, len(data))
+	}
+	return data, nil
+}
+
+// jwtHeader is the subset of the JOSE header used for verification.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verifyJWT checks the signature of a compact JWS against the key set and
+// returns its claims. Registered time claims are checked against now.
+func verifyJWT(token string, keys []jwksKey, now time.Time, leeway time.Duration) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed jwt")
+	}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed jwt header")
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, errors.New("malformed jwt header")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed jwt signature")
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+
+	verified := false
+	for _, key := range keys {
+		if header.Kid != "" && key.id != "" && key.id != header.Kid {
+			continue
+		}
+		if key.alg != "" && key.alg != header.Alg {
+			continue
+		}
+		if verifyJWS(header.Alg, key.key, signed, signature) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("jwt signature invalid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed jwt payload")
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New("malformed jwt payload")
+	}
+	if exp, ok := numericClaim(claims, "exp"); ok && now.After(exp.Add(leeway)) {
+		return nil, errors.New("jwt expired")
+	}
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(leeway).Before(nbf) {
+		return nil, errors.New("jwt not yet valid")
+	}
+	return claims, nil
+}
+
+func verifyJWS(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	switch alg {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		hash := jwsHash(alg[2:])
+		digest := hashSum(hash, signed)
+		if strings.HasPrefix(alg, "PS") {
+			return rsa.VerifyPSS(pub, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+		return rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+	case "ES256", "ES384", "ES512":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, hashSum(jwsHash(alg[2:]), signed), r, s) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return errors.New("key type mismatch")
+		}
+		if !ed25519.Verify(pub, signed, signature) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+}
+
+func jwsHash(bits string) crypto.Hash {
+	switch bits {
+	case "384":
+		return crypto.SHA384
+	case "512":
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+func hashSum(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	_, _ = h.Write(data)
+	return h.Sum(nil)
+}
+
+func numericClaim(claims map[string]any, name string) (time.Time, bool) {
+	value, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(value), 0), true
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	value, _ := claims[name].(string)
+	return strings.TrimSpace(value)
+}
+
+// stringListClaim accepts a JSON array of strings or a space or comma
+// separated string.
+func stringListClaim(claims map[string]any, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		out := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+)
+
+// PeerCredentials identifies the process on the other end of a Unix socket.
+type PeerCredentials struct {
+	PID int64
+	UID uint32
+	GID uint32
+}
+
+type peerCredentialsKey struct{}
+
+// withConnPeerCredentials attaches the peer credentials of conn, when the
+// platform exposes them, to the connection context.
+func withConnPeerCredentials(ctx context.Context, conn net.Conn) context.Context {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+	creds, ok := readPeerCredentials(conn)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, peerCredentialsKey{}, creds)
+}
+
+func peerCredentialsFrom(ctx context.Context) (PeerCredentials, bool) {
+	if ctx == nil {
+		return PeerCredentials{}, false
+	}
+	creds, ok := ctx.Value(peerCredentialsKey{}).(PeerCredentials)
+	return creds, ok
+}
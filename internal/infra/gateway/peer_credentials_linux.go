@@ -0,0 +1,42 @@
+//go:build linux
+
+package gateway
+
+import (
+	"net"
+	"syscall"
+)
+
+func readPeerCredentials(conn net.Conn) (PeerCredentials, bool) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return PeerCredentials{}, false
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return PeerCredentials{}, false
+	}
+	var cred *syscall.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil || credErr != nil || cred == nil {
+		return PeerCredentials{}, false
+	}
+	return PeerCredentials{PID: int64(cred.Pid), UID: cred.Uid, GID: cred.Gid}, true
+}
+
+// listenerHasPeerCredentials reports whether connections accepted by lis carry
+// peer credentials, which only Unix sockets provide.
+func listenerHasPeerCredentials(lis net.Listener) bool {
+	_, ok := lis.Addr().(*net.UnixAddr)
+	return ok
+}
+
+func peerAlive(pid int64) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(int(pid), 0)
+	return err == nil || err == syscall.EPERM
+}
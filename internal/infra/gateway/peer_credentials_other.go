@@ -0,0 +1,17 @@
+//go:build !linux
+
+package gateway
+
+import "net"
+
+func readPeerCredentials(net.Conn) (PeerCredentials, bool) {
+	return PeerCredentials{}, false
+}
+
+func listenerHasPeerCredentials(net.Listener) bool {
+	return false
+}
+
+func peerAlive(pid int64) bool {
+	return pid > 0
+}
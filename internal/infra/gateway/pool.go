@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -19,12 +20,19 @@ type runtime interface {
 	Server() *mcp.Server
 }
 
-type runtimeFactory func(sel Selector, caller string) runtime
+// runtimeFactory builds a runtime registering as caller. A non-empty identity
+// is reported with the client info so the core keys governance on it.
+type runtimeFactory func(sel Selector, caller, identity string, pid int64) runtime
 
 type PoolOptions struct {
 	IdleTimeout    time.Duration
 	MaxInstances   int
 	RuntimeFactory runtimeFactory
+	// PeerAlive reports whether a peer process is still running. Runtimes
+	// bound to a peer PID are evicted once it exits.
+	PeerAlive func(pid int64) bool
+	// PinTools enables per-session tool list pinning on pooled runtimes.
+	PinTools bool
 }
 
 type gatewayPool struct {
@@ -40,6 +48,8 @@ type gatewayPool struct {
 }
 
 type pooledRuntime struct {
+	base     string
+	pid      int64
 	runtime  runtime
 	server   *mcp.Server
 	lastUsed time.Time
@@ -53,8 +63,14 @@ func newGatewayPool(ctx context.Context, cfg rpc.ClientConfig, baseCaller string
 		ctx = context.Background()
 	}
 	if opts.RuntimeFactory == nil {
-		opts.RuntimeFactory = func(sel Selector, caller string) runtime {
-			return NewGateway(cfg, caller, sel.Tags, sel.Server, logger)
+		opts.RuntimeFactory = func(sel Selector, caller, identity string, pid int64) runtime {
+			gw := NewGateway(cfg, caller, sel.Tags, sel.Server, logger)
+			if pid > 0 {
+				gw.callerPID = pid
+			}
+			gw.identity = identity
+			gw.pinTools = opts.PinTools
+			return gw
 		}
 	}
 	pool := &gatewayPool{
@@ -65,13 +81,25 @@ func newGatewayPool(ctx context.Context, cfg rpc.ClientConfig, baseCaller string
 		runtimes:   make(map[string]*pooledRuntime),
 		stopCh:     make(chan struct{}),
 	}
-	if pool.options.IdleTimeout > 0 {
+	if pool.options.IdleTimeout > 0 || pool.options.PeerAlive != nil {
 		pool.startSweeper()
 	}
 	return pool
 }
 
 func (p *gatewayPool) Get(ctx context.Context, sel Selector) (*mcp.Server, error) {
+	return p.GetAs(ctx, p.baseCaller, nil, 0, sel)
+}
+
+// GetAs returns the server for a selector on behalf of a caller identity.
+// Runtimes are keyed by the derived caller so each identity registers with
+// the core under its own name, and report the bare identity so governance,
+// rate limits and profiles match it whatever the selector. A positive pid binds the runtime to a peer
+// process, which is then reported to the core instead of the gateway's own
+// parent. Identity tags are registered with server selectors so the core
+// applies tag visibility and tag-matched profiles to the caller; tag selectors
+// already carry a subset of them.
+func (p *gatewayPool) GetAs(ctx context.Context, identity string, identityTags []string, pid int64, sel Selector) (*mcp.Server, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -79,10 +107,21 @@ func (p *gatewayPool) Get(ctx context.Context, sel Selector) (*mcp.Server, error
 	if sel.empty() || (sel.Server != "" && len(sel.Tags) > 0) {
 		return nil, errors.New("invalid selector")
 	}
-	key := SelectorKey(sel)
-	if key == "" {
+	selectorKey := SelectorKey(sel)
+	if selectorKey == "" {
 		return nil, errors.New("invalid selector")
 	}
+	identity = strings.TrimSpace(identity)
+	base := identity
+	if base == "" {
+		base = p.baseCaller
+	}
+	callerBase := base
+	if pid > 0 {
+		callerBase = fmt.Sprintf("%s:pid-%d", base, pid)
+	}
+	caller := deriveSelectorCaller(callerBase, selectorKey)
+	key := caller
 	now := time.Now()
 
 	p.mu.Lock()
@@ -105,8 +144,11 @@ func (p *gatewayPool) Get(ctx context.Context, sel Selector) (*mcp.Server, error
 	}
 	p.mu.Unlock()
 
-	caller := deriveSelectorCaller(p.baseCaller, key)
-	runtime := p.options.RuntimeFactory(sel, caller)
+	registration := sel
+	if sel.Server != "" {
+		registration.Tags = normalizeTags(identityTags)
+	}
+	runtime := p.options.RuntimeFactory(registration, caller, identity, pid)
 	if runtime == nil {
 		return nil, errors.New("gateway runtime factory returned nil")
 	}
@@ -135,6 +177,8 @@ func (p *gatewayPool) Get(ctx context.Context, sel Selector) (*mcp.Server, error
 		return existing.server, nil
 	}
 	p.runtimes[key] = &pooledRuntime{
+		base:     base,
+		pid:      pid,
 		runtime:  runtime,
 		server:   server,
 		lastUsed: now,
@@ -191,13 +235,17 @@ func (p *gatewayPool) startSweeper() {
 }
 
 func (p *gatewayPool) evictIdle(now time.Time) {
-	if p.options.IdleTimeout <= 0 {
+	idleTimeout := p.options.IdleTimeout
+	peerAlive := p.options.PeerAlive
+	if idleTimeout <= 0 && peerAlive == nil {
 		return
 	}
 	var expired []*pooledRuntime
 	p.mu.Lock()
 	for key, runtime := range p.runtimes {
-		if now.Sub(runtime.lastUsed) > p.options.IdleTimeout {
+		idle := idleTimeout > 0 && now.Sub(runtime.lastUsed) > idleTimeout
+		gone := peerAlive != nil && runtime.pid > 0 && !peerAlive(runtime.pid)
+		if idle || gone {
 			delete(p.runtimes, key)
 			expired = append(expired, runtime)
 		}
@@ -209,6 +257,30 @@ func (p *gatewayPool) evictIdle(now time.Time) {
 	}
 }
 
+// EvictCallers stops runtimes owned by the given base callers.
+func (p *gatewayPool) EvictCallers(bases []string) {
+	if len(bases) == 0 {
+		return
+	}
+	drop := make(map[string]struct{}, len(bases))
+	for _, base := range bases {
+		drop[base] = struct{}{}
+	}
+	var evicted []*pooledRuntime
+	p.mu.Lock()
+	for key, runtime := range p.runtimes {
+		if _, ok := drop[runtime.base]; ok {
+			delete(p.runtimes, key)
+			evicted = append(evicted, runtime)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, runtime := range evicted {
+		_ = runtime.runtime.StopRuntime(context.Background())
+	}
+}
+
 func deriveSelectorCaller(baseCaller, key string) string {
 	base := strings.TrimSpace(baseCaller)
 	if base == "" {
@@ -35,7 +35,7 @@ func (f *fakeRuntime) Server() *mcp.Server {
 
 func TestGatewayPool_ReusesRuntime(t *testing.T) {
 	created := atomic.Int32{}
-	factory := func(_ Selector, _ string) runtime {
+	factory := func(_ Selector, _, _ string, _ int64) runtime {
 		created.Add(1)
 		return &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
 	}
@@ -54,7 +54,7 @@ func TestGatewayPool_ReusesRuntime(t *testing.T) {
 func TestGatewayPool_EvictsIdle(t *testing.T) {
 	created := atomic.Int32{}
 	fake := &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
-	factory := func(_ Selector, _ string) runtime {
+	factory := func(_ Selector, _, _ string, _ int64) runtime {
 		created.Add(1)
 		return fake
 	}
@@ -68,7 +68,7 @@ func TestGatewayPool_EvictsIdle(t *testing.T) {
 	_, err := pool.Get(context.Background(), sel)
 	require.NoError(t, err)
 
-	key := SelectorKey(sel)
+	key := deriveSelectorCaller("base", SelectorKey(sel))
 	pool.mu.Lock()
 	if runtime, ok := pool.runtimes[key]; ok {
 		runtime.lastUsed = time.Now().Add(-time.Minute)
@@ -84,7 +84,7 @@ func TestGatewayPool_EvictsIdle(t *testing.T) {
 }
 
 func TestGatewayPool_MaxInstances(t *testing.T) {
-	factory := func(_ Selector, _ string) runtime {
+	factory := func(_ Selector, _, _ string, _ int64) runtime {
 		return &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
 	}
 
@@ -98,3 +98,105 @@ func TestGatewayPool_MaxInstances(t *testing.T) {
 	_, err = pool.Get(context.Background(), Selector{Server: "b"})
 	require.Error(t, err)
 }
+
+func TestGatewayPool_SeparatesCallerIdentities(t *testing.T) {
+	var callers []string
+	fakes := map[string]*fakeRuntime{}
+	factory := func(_ Selector, caller, _ string, _ int64) runtime {
+		callers = append(callers, caller)
+		fake := &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
+		fakes[caller] = fake
+		return fake
+	}
+
+	pool := newGatewayPool(context.Background(), rpc.ClientConfig{}, "base", zap.NewNop(), PoolOptions{RuntimeFactory: factory})
+	sel := Selector{Server: "context7"}
+
+	serverA, err := pool.GetAs(context.Background(), "alice", nil, 0, sel)
+	require.NoError(t, err)
+	serverB, err := pool.GetAs(context.Background(), "bob", nil, 0, sel)
+	require.NoError(t, err)
+	require.NotSame(t, serverA, serverB)
+	require.Equal(t, []string{
+		deriveSelectorCaller("alice", SelectorKey(sel)),
+		deriveSelectorCaller("bob", SelectorKey(sel)),
+	}, callers)
+
+	pool.EvictCallers([]string{"bob"})
+	require.Equal(t, int32(0), fakes[callers[0]].stopCount.Load())
+	require.Equal(t, int32(1), fakes[callers[1]].stopCount.Load())
+}
+
+func TestGatewayPool_ReportsBareIdentity(t *testing.T) {
+	identities := map[string]string{}
+	factory := func(_ Selector, caller, identity string, _ int64) runtime {
+		identities[caller] = identity
+		return &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
+	}
+	pool := newGatewayPool(context.Background(), rpc.ClientConfig{}, "base", zap.NewNop(), PoolOptions{RuntimeFactory: factory})
+
+	serverSel := Selector{Server: "context7"}
+	tagSel := Selector{Tags: []string{"git"}}
+	_, err := pool.GetAs(context.Background(), "alice", nil, 0, serverSel)
+	require.NoError(t, err)
+	_, err = pool.GetAs(context.Background(), "alice", nil, 0, tagSel)
+	require.NoError(t, err)
+	_, err = pool.GetAs(context.Background(), "", nil, 0, serverSel)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		deriveSelectorCaller("alice", SelectorKey(serverSel)): "alice",
+		deriveSelectorCaller("alice", SelectorKey(tagSel)):    "alice",
+		deriveSelectorCaller("base", SelectorKey(serverSel)):  "",
+	}, identities)
+}
+
+func TestGatewayPool_RegistersIdentityTagsWithServerSelector(t *testing.T) {
+	var registered []Selector
+	factory := func(sel Selector, _, _ string, _ int64) runtime {
+		registered = append(registered, sel)
+		return &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
+	}
+	pool := newGatewayPool(context.Background(), rpc.ClientConfig{}, "base", zap.NewNop(), PoolOptions{RuntimeFactory: factory})
+
+	_, err := pool.GetAs(context.Background(), "alice", []string{"Git", "db"}, 0, Selector{Server: "context7"})
+	require.NoError(t, err)
+	_, err = pool.GetAs(context.Background(), "alice", []string{"git", "db"}, 0, Selector{Tags: []string{"git"}})
+	require.NoError(t, err)
+	require.Equal(t, []Selector{
+		{Server: "context7", Tags: []string{"db", "git"}},
+		{Tags: []string{"git"}},
+	}, registered)
+}
+
+func TestGatewayPool_BindsRuntimesToPeerPID(t *testing.T) {
+	pids := map[string]int64{}
+	fakes := map[string]*fakeRuntime{}
+	factory := func(_ Selector, caller, _ string, pid int64) runtime {
+		pids[caller] = pid
+		fake := &fakeRuntime{server: mcp.NewServer(&mcp.Implementation{Name: "fake", Version: "test"}, nil)}
+		fakes[caller] = fake
+		return fake
+	}
+	var exited atomic.Int64
+	pool := newGatewayPool(context.Background(), rpc.ClientConfig{}, "base", zap.NewNop(), PoolOptions{
+		RuntimeFactory: factory,
+		PeerAlive:      func(pid int64) bool { return pid != exited.Load() },
+	})
+	defer pool.Close(context.Background())
+	sel := Selector{Server: "context7"}
+
+	serverA, err := pool.GetAs(context.Background(), "", nil, 100, sel)
+	require.NoError(t, err)
+	serverB, err := pool.GetAs(context.Background(), "", nil, 200, sel)
+	require.NoError(t, err)
+	require.NotSame(t, serverA, serverB)
+
+	callerA := deriveSelectorCaller("base:pid-100", SelectorKey(sel))
+	callerB := deriveSelectorCaller("base:pid-200", SelectorKey(sel))
+	require.Equal(t, map[string]int64{callerA: 100, callerB: 200}, pids)
+
+	exited.Store(200)
+	pool.evictIdle(time.Now())
+	require.Equal(t, int32(0), fakes[callerA].stopCount.Load())
+	require.Equal(t, int32(1), fakes[callerB].stopCount.Load())
+}
@@ -0,0 +1,250 @@
+package gateway
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// refreshToolsName is the gateway tool that delivers queued tool list
+	// changes to a session with a pinned tool list.
+	refreshToolsName = "mcpv_refresh_tools"
+
+	methodListTools              = "tools/list"
+	notificationToolsListChanged = "notifications/tools/list_changed"
+)
+
+// toolPins freezes the tool list of each downstream session at its first
+// tools/list so upstream changes do not invalidate client prompt caches.
+// Changes are queued and delivered when the session calls refreshToolsName;
+// new sessions always start from the current list.
+type toolPins struct {
+	mu         sync.Mutex
+	sessions   map[*mcp.ServerSession]*toolPin
+	suppressed int64
+}
+
+type toolPin struct {
+	tools []*mcp.Tool
+	// stale is set when the live tool list changed after pinning.
+	stale bool
+	// deliver lets the next list_changed notification reach the session.
+	deliver bool
+}
+
+func newToolPins() *toolPins {
+	return &toolPins{sessions: make(map[*mcp.ServerSession]*toolPin)}
+}
+
+func refreshTool() mcp.Tool {
+	return mcp.Tool{
+		Name:        refreshToolsName,
+		Description: "Apply tool list changes that mcpv queued for this session. Call it when you need tools added since the session started.",
+		InputSchema: map[string]any{"type": "object", "properties": map[string]any{}},
+		Annotations: &mcp.ToolAnnotations{
+			Title:          "Refresh mcpv tools",
+			ReadOnlyHint:   true,
+			IdempotentHint: true,
+		},
+	}
+}
+
+// receivingMiddleware answers tools/list from the session's pin, pinning the
+// live list on first use.
+func (p *toolPins) receivingMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodListTools {
+				return next(ctx, method, req)
+			}
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok || session == nil {
+				return next(ctx, method, req)
+			}
+			if tools, ok := p.pinned(session); ok {
+				return &mcp.ListToolsResult{Tools: tools}, nil
+			}
+			tools, err := listAllTools(ctx, next, session)
+			if err != nil {
+				return nil, err
+			}
+			p.pin(session, tools)
+			return &mcp.ListToolsResult{Tools: slices.Clone(tools)}, nil
+		}
+	}
+}
+
+// sendingMiddleware drops tool list change notifications for pinned sessions
+// unless a refresh asked for one.
+func (p *toolPins) sendingMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != notificationToolsListChanged {
+				return next(ctx, method, req)
+			}
+			session, ok := req.GetSession().(*mcp.ServerSession)
+			if !ok || !p.holdNotification(session) {
+				return next(ctx, method, req)
+			}
+			return nil, nil
+		}
+	}
+}
+
+func listAllTools(ctx context.Context, next mcp.MethodHandler, session *mcp.ServerSession) ([]*mcp.Tool, error) {
+	var tools []*mcp.Tool
+	cursor := ""
+	for {
+		res, err := next(ctx, methodListTools, &mcp.ListToolsRequest{
+			Session: session,
+			Params:  &mcp.ListToolsParams{Cursor: cursor},
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, ok := res.(*mcp.ListToolsResult)
+		if !ok || page == nil {
+			break
+		}
+		tools = append(tools, page.Tools...)
+		if page.NextCursor == "" || page.NextCursor == cursor {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	slices.SortFunc(tools, func(a, b *mcp.Tool) int { return strings.Compare(a.Name, b.Name) })
+	return tools, nil
+}
+
+func (p *toolPins) pinned(session *mcp.ServerSession) ([]*mcp.Tool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pin, ok := p.sessions[session]
+	if !ok || pin.tools == nil {
+		return nil, false
+	}
+	return slices.Clone(pin.tools), true
+}
+
+func (p *toolPins) pin(session *mcp.ServerSession, tools []*mcp.Tool) {
+	if tools == nil {
+		tools = []*mcp.Tool{}
+	}
+	p.mu.Lock()
+	pin, ok := p.sessions[session]
+	if !ok {
+		pin = &toolPin{}
+		p.sessions[session] = pin
+	}
+	pin.tools = tools
+	pin.stale = false
+	p.mu.Unlock()
+	if !ok {
+		go func() {
+			_ = session.Wait()
+			p.drop(session)
+		}()
+	}
+}
+
+func (p *toolPins) drop(session *mcp.ServerSession) {
+	p.mu.Lock()
+	delete(p.sessions, session)
+	p.mu.Unlock()
+}
+
+func (p *toolPins) holdNotification(session *mcp.ServerSession) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pin, ok := p.sessions[session]
+	if !ok {
+		return false
+	}
+	if pin.deliver {
+		pin.deliver = false
+		return false
+	}
+	return true
+}
+
+// markChanged records a live tool list change against every pinned session.
+func (p *toolPins) markChanged() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pin := range p.sessions {
+		if pin.tools == nil {
+			continue
+		}
+		pin.stale = true
+		p.suppressed++
+	}
+}
+
+// refresh unpins a session with queued changes so its next tools/list sees the
+// live list, and reports whether a list_changed notification should be sent.
+func (p *toolPins) refresh(session *mcp.ServerSession) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pin, ok := p.sessions[session]
+	if !ok || !pin.stale {
+		return false
+	}
+	pin.tools = nil
+	pin.stale = false
+	pin.deliver = true
+	return true
+}
+
+// takeSuppressed returns and resets the count not yet reported to the core.
+func (p *toolPins) takeSuppressed() int64 {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := p.suppressed
+	p.suppressed = 0
+	return count
+}
+
+// restoreSuppressed re-queues a count whose report failed.
+func (p *toolPins) restoreSuppressed(count int64) {
+	if p == nil || count <= 0 {
+		return
+	}
+	p.mu.Lock()
+	p.suppressed += count
+	p.mu.Unlock()
+}
+
+// setupToolPinning installs pinning middleware and the refresh tool on the
+// gateway server.
+func (g *Gateway) setupToolPinning() {
+	g.pins = newToolPins()
+	g.server.AddReceivingMiddleware(g.pins.receivingMiddleware())
+	g.server.AddSendingMiddleware(g.pins.sendingMiddleware())
+	g.registry.onChange = g.pins.markChanged
+	tool := refreshTool()
+	g.server.AddTool(&tool, g.refreshToolsHandler())
+}
+
+func (g *Gateway) refreshToolsHandler() mcp.ToolHandler {
+	return func(_ context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !g.pins.refresh(req.Session) {
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{&mcp.TextContent{Text: "The tool list is up to date."}},
+			}, nil
+		}
+		// Re-adding the tool makes the server announce a list change; the
+		// sending middleware lets it through only for this session.
+		tool := refreshTool()
+		g.server.AddTool(&tool, g.refreshToolsHandler())
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "Queued tool list changes were applied. List tools again to see them."}},
+		}, nil
+	}
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/buildinfo"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+func TestToolPinning_HoldsChangesUntilRefresh(t *testing.T) {
+	ctx := context.Background()
+	g := &Gateway{
+		logger: zap.NewNop(),
+		server: mcp.NewServer(&mcp.Implementation{Name: "gateway", Version: buildinfo.Version}, &mcp.ServerOptions{HasTools: true}),
+	}
+	g.registry = newToolRegistry(g.server, func(string) mcp.ToolHandler {
+		return func(context.Context, *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return &mcp.CallToolResult{}, nil
+		}
+	}, zap.NewNop())
+	g.setupToolPinning()
+	g.registry.ApplySnapshot(pinningSnapshot(t, "v1", "b.search", "a.read"))
+
+	changed := make(chan struct{}, 4)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: buildinfo.Version}, &mcp.ClientOptions{
+		ToolListChangedHandler: func(context.Context, *mcp.ToolListChangedRequest) {
+			changed <- struct{}{}
+		},
+	})
+	ct, st := mcp.NewInMemoryTransports()
+	_, err := g.server.Connect(ctx, st, nil)
+	require.NoError(t, err)
+	session, err := client.Connect(ctx, ct, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	require.Equal(t, []string{"a.read", "b.search", refreshToolsName}, listToolNames(ctx, t, session))
+
+	g.registry.ApplySnapshot(pinningSnapshot(t, "v2", "b.search", "a.read", "c.write"))
+	select {
+	case <-changed:
+		t.Fatal("pinned session received tools/list_changed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.Equal(t, []string{"a.read", "b.search", refreshToolsName}, listToolNames(ctx, t, session))
+	require.Equal(t, int64(1), g.pins.takeSuppressed())
+
+	_, other := connectClient(ctx, t, g.server)
+	defer other.Close()
+	require.Equal(t, []string{"a.read", "b.search", "c.write", refreshToolsName}, listToolNames(ctx, t, other))
+
+	_, err = session.CallTool(ctx, &mcp.CallToolParams{Name: refreshToolsName})
+	require.NoError(t, err)
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("refresh did not deliver tools/list_changed")
+	}
+	require.Equal(t, []string{"a.read", "b.search", "c.write", refreshToolsName}, listToolNames(ctx, t, session))
+}
+
+func pinningSnapshot(t *testing.T, etag string, names ...string) *controlv1.ToolsSnapshot {
+	t.Helper()
+	snapshot := &controlv1.ToolsSnapshot{Etag: etag}
+	for _, name := range names {
+		raw, err := json.Marshal(&mcp.Tool{Name: name, InputSchema: map[string]any{"type": "object"}})
+		require.NoError(t, err)
+		snapshot.Tools = append(snapshot.Tools, &controlv1.ToolDefinition{Name: name, ToolJson: raw})
+	}
+	return snapshot
+}
+
+func listToolNames(ctx context.Context, t *testing.T, session *mcp.ClientSession) []string {
+	t.Helper()
+	res, err := session.ListTools(ctx, &mcp.ListToolsParams{})
+	require.NoError(t, err)
+	names := make([]string, 0, len(res.Tools))
+	for _, tool := range res.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
@@ -18,6 +18,8 @@ type toolRegistry struct {
 	mu         sync.Mutex
 	etag       string
 	registered map[string]struct{}
+	// onChange runs after a snapshot changed the registered tools.
+	onChange func()
 }
 
 func newToolRegistry(server *mcp.Server, handler func(name string) mcp.ToolHandler, logger *zap.Logger) *toolRegistry {
@@ -62,7 +64,7 @@ func (r *toolRegistry) ApplySnapshot(snapshot *controlv1.ToolsSnapshot) {
 		if tool.Name == "" {
 			tool.Name = def.GetName()
 		}
-		if tool.Name == "" {
+		if tool.Name == "" || tool.Name == refreshToolsName {
 			continue
 		}
 		if tool.Name != def.GetName() && def.GetName() != "" {
@@ -98,4 +100,7 @@ func (r *toolRegistry) ApplySnapshot(snapshot *controlv1.ToolsSnapshot) {
 
 	r.registered = next
 	r.etag = snapshot.GetEtag()
+	if r.onChange != nil {
+		r.onChange()
+	}
 }
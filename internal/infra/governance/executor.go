@@ -3,16 +3,64 @@ package governance
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/mcpcodec"
 	"mcpv/internal/infra/pipeline"
 )
 
 type Executor struct {
-	chain *Chain
+	chain       *Chain
+	observers   []Observer
+	forwardKeys []string
+}
+
+// Execution describes a completed governed call.
+type Execution struct {
+	// Request is the caller's original request; Server and SpecKey are filled
+	// from the route when the call reached an upstream server.
+	Request   domain.GovernanceRequest
+	SpecKey   string
+	Response  json.RawMessage
+	Rejection *domain.GovernanceDecision
+	Err       error
+	StartedAt time.Time
+	Duration  time.Duration
+}
+
+// Rejected returns the governance rejection that stopped the call, whether a
+// policy returned it as a decision or it surfaced as an error.
+func (e Execution) Rejected() (domain.GovernanceRejection, bool) {
+	if e.Rejection != nil {
+		return domain.GovernanceRejection{
+			Category:   e.Rejection.Category,
+			Plugin:     e.Rejection.Plugin,
+			Code:       e.Rejection.RejectCode,
+			Message:    e.Rejection.RejectMessage,
+			RetryAfter: e.Rejection.RetryAfter,
+		}, true
+	}
+	var rejection domain.GovernanceRejection
+	if errors.As(e.Err, &rejection) {
+		return rejection, true
+	}
+	return domain.GovernanceRejection{}, false
+}
+
+// ToolResultIsError reports whether a tools/call completed with a result that
+// sets isError.
+func (e Execution) ToolResultIsError() bool {
+	return e.Request.Method == "tools/call" && mcpcodec.ToolResultIsError(e.Response)
+}
+
+// Observer receives every execution that passes through Execute.
+type Observer interface {
+	ObserveExecution(ctx context.Context, execution Execution)
 }
 
 func NewExecutor(pipe *pipeline.Engine) *Executor {
@@ -26,6 +74,25 @@ func NewExecutorWithPolicies(policies ...Policy) *Executor {
 	return &Executor{chain: NewChain(policies...)}
 }
 
+// AddObserver registers an execution observer. It must be called before the
+// executor starts serving requests.
+func (e *Executor) AddObserver(observer Observer) {
+	if e == nil || observer == nil {
+		return
+	}
+	e.observers = append(e.observers, observer)
+}
+
+// ForwardMetadata selects governance metadata keys that are injected into the
+// upstream request's params._meta. It must be called before the executor starts
+// serving requests.
+func (e *Executor) ForwardMetadata(keys []string) {
+	if e == nil {
+		return
+	}
+	e.forwardKeys = append([]string(nil), keys...)
+}
+
 func (e *Executor) Request(ctx context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -50,10 +117,56 @@ func (e *Executor) Execute(ctx context.Context, req domain.GovernanceRequest, ne
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	if e.chain == nil {
-		return next(ctx, req)
+	if len(e.forwardKeys) > 0 {
+		next = e.forwardingNext(next)
+	}
+	if len(e.observers) == 0 {
+		if e.chain == nil {
+			return next(ctx, req)
+		}
+		return e.chain.Execute(ctx, req, next)
+	}
+
+	started := time.Now()
+	ctx, trace := domain.WithRouteTrace(ctx)
+	resp, rejection, err := e.chain.execute(ctx, req, next)
+
+	execution := Execution{
+		Request:   req,
+		Response:  resp,
+		Rejection: rejection,
+		Err:       err,
+		StartedAt: started,
+		Duration:  time.Since(started),
+	}
+	serverType, specKey := trace.Target()
+	if execution.Request.Server == "" {
+		execution.Request.Server = serverType
+	}
+	execution.SpecKey = specKey
+	for _, observer := range e.observers {
+		observer.ObserveExecution(ctx, execution)
+	}
+	return resp, err
+}
+
+// forwardingNext attaches the selected keys of the governed request metadata to
+// the context so the router can inject them upstream.
+func (e *Executor) forwardingNext(next func(context.Context, domain.GovernanceRequest) (json.RawMessage, error)) func(context.Context, domain.GovernanceRequest) (json.RawMessage, error) {
+	return func(ctx context.Context, req domain.GovernanceRequest) (json.RawMessage, error) {
+		var meta map[string]string
+		for _, key := range e.forwardKeys {
+			value, ok := req.Metadata[key]
+			if !ok {
+				continue
+			}
+			if meta == nil {
+				meta = make(map[string]string, len(e.forwardKeys))
+			}
+			meta[key] = value
+		}
+		return next(domain.WithUpstreamMeta(ctx, meta), req)
 	}
-	return e.chain.Execute(ctx, req, next)
 }
 
 func handleRejection(req domain.GovernanceRequest, decision domain.GovernanceDecision) (json.RawMessage, error) {
@@ -61,10 +174,11 @@ func handleRejection(req domain.GovernanceRequest, decision domain.GovernanceDec
 		return buildToolRejection(decision)
 	}
 	return nil, domain.GovernanceRejection{
-		Category: decision.Category,
-		Plugin:   decision.Plugin,
-		Code:     decision.RejectCode,
-		Message:  decision.RejectMessage,
+		Category:   decision.Category,
+		Plugin:     decision.Plugin,
+		Code:       decision.RejectCode,
+		Message:    decision.RejectMessage,
+		RetryAfter: decision.RetryAfter,
 	}
 }
 
@@ -78,6 +192,9 @@ func buildToolRejection(decision domain.GovernanceDecision) (json.RawMessage, er
 		"code":    decision.RejectCode,
 		"message": message,
 	}
+	if decision.RetryAfter > 0 {
+		structured["retryAfterMs"] = decision.RetryAfter.Milliseconds()
+	}
 	result := mcp.CallToolResult{
 		IsError: true,
 		Content: []mcp.Content{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -224,3 +225,118 @@ func TestExecutor_NilContext(t *testing.T) {
 	_, err = executor.Execute(context.Background(), req, next)
 	require.NoError(t, err)
 }
+
+type recordingObserver struct {
+	executions []Execution
+}
+
+func (r *recordingObserver) ObserveExecution(_ context.Context, execution Execution) {
+	r.executions = append(r.executions, execution)
+}
+
+// TestExecutor_ObserverReceivesOutcome verifies observers see rejections and routed servers.
+func TestExecutor_ObserverReceivesOutcome(t *testing.T) {
+	policy := &mockPolicy{
+		requestFunc: func(_ context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+			if req.ToolName == "blocked" {
+				return domain.GovernanceDecision{Continue: false, Plugin: "guard", RejectCode: "denied"}, nil
+			}
+			return domain.GovernanceDecision{Continue: true}, nil
+		},
+	}
+	executor := NewExecutorWithPolicies(policy)
+	observer := &recordingObserver{}
+	executor.AddObserver(observer)
+
+	next := func(ctx context.Context, _ domain.GovernanceRequest) (json.RawMessage, error) {
+		domain.RecordRouteTarget(ctx, "github", "spec-1")
+		return json.RawMessage(`{"content":[]}`), nil
+	}
+
+	_, err := executor.Execute(context.Background(), domain.GovernanceRequest{Method: "tools/call", ToolName: "list"}, next)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), domain.GovernanceRequest{Method: "tools/call", ToolName: "blocked"}, next)
+	require.NoError(t, err)
+
+	require.Len(t, observer.executions, 2)
+	allowed := observer.executions[0]
+	assert.Nil(t, allowed.Rejection)
+	assert.Equal(t, "github", allowed.Request.Server)
+	assert.Equal(t, "spec-1", allowed.SpecKey)
+	assert.JSONEq(t, `{"content":[]}`, string(allowed.Response))
+
+	rejected := observer.executions[1]
+	require.NotNil(t, rejected.Rejection)
+	assert.Equal(t, "denied", rejected.Rejection.RejectCode)
+	assert.Empty(t, rejected.Request.Server)
+}
+
+// TestExecutor_MetadataMutationsAndForwarding verifies plugin metadata reaches
+// later policies, the response phase and, for selected keys, the upstream call.
+func TestExecutor_MetadataMutationsAndForwarding(t *testing.T) {
+	authn := &mockPolicy{
+		requestFunc: func(_ context.Context, _ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+			return domain.GovernanceDecision{
+				Continue:       true,
+				Metadata:       map[string]string{"user.id": "alice", "internal": "secret"},
+				RemoveMetadata: []string{"authorization"},
+			}, nil
+		},
+	}
+	var responseMetadata map[string]string
+	audit := &mockPolicy{
+		requestFunc: func(_ context.Context, _ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+			return domain.GovernanceDecision{Continue: true}, nil
+		},
+		responseFunc: func(_ context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+			responseMetadata = req.Metadata
+			return domain.GovernanceDecision{Continue: true}, nil
+		},
+	}
+	executor := NewExecutorWithPolicies(authn, audit)
+	executor.ForwardMetadata([]string{"user.id", "tenant"})
+
+	var upstream map[string]string
+	next := func(ctx context.Context, req domain.GovernanceRequest) (json.RawMessage, error) {
+		upstream = domain.UpstreamMetaFromContext(ctx)
+		assert.NotContains(t, req.Metadata, "authorization")
+		return json.RawMessage(`{"content":[]}`), nil
+	}
+
+	_, err := executor.Execute(context.Background(), domain.GovernanceRequest{
+		Method:   "tools/call",
+		Metadata: map[string]string{"authorization": "Bearer t"},
+	}, next)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"user.id": "alice"}, upstream)
+	assert.Equal(t, map[string]string{"user.id": "alice", "internal": "secret"}, responseMetadata)
+}
+
+func TestExecution_Rejected(t *testing.T) {
+	decided := Execution{Rejection: &domain.GovernanceDecision{Plugin: "quota", RejectCode: "rate_limited", RejectMessage: "slow down"}}
+	rejection, ok := decided.Rejected()
+	require.True(t, ok)
+	assert.Equal(t, domain.GovernanceRejection{Plugin: "quota", Code: "rate_limited", Message: "slow down"}, rejection)
+
+	wrapped := Execution{Err: fmt.Errorf("call: %w", domain.GovernanceRejection{Code: "forbidden"})}
+	rejection, ok = wrapped.Rejected()
+	require.True(t, ok)
+	assert.Equal(t, "forbidden", rejection.Code)
+
+	_, ok = Execution{Err: errors.New("boom")}.Rejected()
+	assert.False(t, ok)
+}
+
+func TestExecution_ToolResultIsError(t *testing.T) {
+	execution := Execution{
+		Request:  domain.GovernanceRequest{Method: "tools/call"},
+		Response: json.RawMessage(`{"isError":true}`),
+	}
+	assert.True(t, execution.ToolResultIsError())
+
+	execution.Request.Method = "prompts/get"
+	assert.False(t, execution.ToolResultIsError())
+
+	execution = Execution{Request: domain.GovernanceRequest{Method: "tools/call"}, Response: json.RawMessage(`not json`)}
+	assert.False(t, execution.ToolResultIsError())
+}
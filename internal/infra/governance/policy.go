@@ -49,6 +49,7 @@ func (c *Chain) Request(ctx context.Context, req domain.GovernanceRequest) (doma
 		if len(nextDecision.RequestJSON) > 0 {
 			working.RequestJSON = nextDecision.RequestJSON
 		}
+		working.Metadata = nextDecision.ApplyMetadata(working.Metadata)
 	}
 	return decision, nil
 }
@@ -74,53 +75,65 @@ func (c *Chain) Response(ctx context.Context, req domain.GovernanceRequest) (dom
 		if len(nextDecision.ResponseJSON) > 0 {
 			working.ResponseJSON = nextDecision.ResponseJSON
 		}
+		working.Metadata = nextDecision.ApplyMetadata(working.Metadata)
 	}
 	return decision, nil
 }
 
 func (c *Chain) Execute(ctx context.Context, req domain.GovernanceRequest, next func(context.Context, domain.GovernanceRequest) (json.RawMessage, error)) (json.RawMessage, error) {
+	resp, _, err := c.execute(ctx, req, next)
+	return resp, err
+}
+
+// execute runs the chain and additionally returns the rejecting decision, if any.
+func (c *Chain) execute(ctx context.Context, req domain.GovernanceRequest, next func(context.Context, domain.GovernanceRequest) (json.RawMessage, error)) (json.RawMessage, *domain.GovernanceDecision, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	if c == nil || len(c.policies) == 0 {
-		return next(ctx, req)
+		resp, err := next(ctx, req)
+		return resp, nil, err
 	}
 
 	working := req
 	for _, policy := range c.policies {
 		decision, err := policy.Request(ctx, working)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if !decision.Continue {
-			return handleRejection(req, decision)
+			resp, err := handleRejection(req, decision)
+			return resp, &decision, err
 		}
 		if len(decision.RequestJSON) > 0 {
 			working.RequestJSON = decision.RequestJSON
 		}
+		working.Metadata = decision.ApplyMetadata(working.Metadata)
 	}
 
 	resp, err := next(ctx, working)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	working.ResponseJSON = resp
 	for i := len(c.policies) - 1; i >= 0; i-- {
 		decision, err := c.policies[i].Response(ctx, working)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if !decision.Continue {
-			return handleRejection(req, decision)
+			resp, err := handleRejection(req, decision)
+			return resp, &decision, err
 		}
 		if len(decision.ResponseJSON) > 0 {
 			resp = decision.ResponseJSON
 			working.ResponseJSON = decision.ResponseJSON
 		}
+		working.Metadata = decision.ApplyMetadata(working.Metadata)
 	}
 
-	return resp, nil
+	return resp, nil, nil
 }
 
 type PipelinePolicy struct {
@@ -3,6 +3,7 @@ package governance
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -87,3 +88,27 @@ func TestBuildToolRejection_EmptyMessage(t *testing.T) {
 	content := parsed["content"].([]any)[0].(map[string]any)
 	assert.Equal(t, "request rejected", content["text"])
 }
+
+// TestBuildToolRejection_RetryAfter verifies the retry hint is exposed to callers.
+func TestBuildToolRejection_RetryAfter(t *testing.T) {
+	decision := domain.GovernanceDecision{
+		Continue:      false,
+		RejectCode:    "rate_limited",
+		RejectMessage: "rate limit exceeded",
+		RetryAfter:    1500 * time.Millisecond,
+	}
+
+	result, err := buildToolRejection(decision)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal(result, &parsed))
+	structured := parsed["structuredContent"].(map[string]any)
+	assert.Equal(t, "rate_limited", structured["code"])
+	assert.InDelta(t, 1500, structured["retryAfterMs"], 0)
+
+	_, err = handleRejection(domain.GovernanceRequest{Method: "prompts/get"}, decision)
+	var govErr domain.GovernanceRejection
+	require.ErrorAs(t, err, &govErr)
+	assert.Equal(t, 1500*time.Millisecond, govErr.RetryAfter)
+}
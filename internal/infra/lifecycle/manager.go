@@ -55,6 +55,7 @@ type Manager struct {
 
 	samplingHandler    domain.SamplingHandler
 	elicitationHandler domain.ElicitationHandler
+	rootsProvider      domain.RootsProvider
 }
 
 const (
@@ -99,6 +100,11 @@ func (m *Manager) SetElicitationHandler(handler domain.ElicitationHandler) {
 	m.elicitationHandler = handler
 }
 
+// SetRootsProvider configures the roots source for client capabilities.
+func (m *Manager) SetRootsProvider(provider domain.RootsProvider) {
+	m.rootsProvider = provider
+}
+
 func (m *Manager) StartInstance(ctx context.Context, specKey string, spec domain.ServerSpec) (*domain.Instance, error) {
 	baseCtx := m.ctx
 	if baseCtx == nil {
@@ -222,6 +228,7 @@ func (m *Manager) StartInstance(ctx context.Context, specKey string, spec domain
 		SpecKey:    specKey,
 		State:      domain.InstanceStateInitializing,
 		Conn:       conn,
+		PID:        streams.PID,
 		SpawnedAt:  spawnedAt,
 		LastActive: time.Now(),
 	})
@@ -349,6 +356,9 @@ func (m *Manager) initialize(ctx context.Context, conn domain.Conn, specKey stri
 	if m.elicitationHandler != nil {
 		initParams.Capabilities.Elicitation = &mcp.ElicitationCapabilities{}
 	}
+	if m.rootsProvider != nil {
+		initParams.Capabilities.Roots.ListChanged = true
+	}
 
 	attrs := map[string]string{
 		"attempt":         strconv.Itoa(attempt),
@@ -0,0 +1,33 @@
+package mcpcodec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergeParamsMeta merges meta into the _meta object of encoded request params.
+// Values in meta overwrite keys already present; other entries are kept.
+func MergeParamsMeta(raw json.RawMessage, meta map[string]string) (json.RawMessage, error) {
+	params := make(map[string]json.RawMessage)
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null")) {
+		if err := json.Unmarshal(trimmed, &params); err != nil {
+			return nil, fmt.Errorf("decode params: %w", err)
+		}
+	}
+	metaObject := make(map[string]any)
+	if existing, ok := params["_meta"]; ok && !bytes.Equal(bytes.TrimSpace(existing), []byte("null")) {
+		if err := json.Unmarshal(existing, &metaObject); err != nil {
+			return nil, fmt.Errorf("decode params._meta: %w", err)
+		}
+	}
+	for key, value := range meta {
+		metaObject[key] = value
+	}
+	encodedMeta, err := json.Marshal(metaObject)
+	if err != nil {
+		return nil, err
+	}
+	params["_meta"] = encodedMeta
+	return json.Marshal(params)
+}
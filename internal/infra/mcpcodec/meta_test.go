@@ -0,0 +1,21 @@
+package mcpcodec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeParamsMeta(t *testing.T) {
+	merged, err := MergeParamsMeta(json.RawMessage(`{"name":"echo","_meta":{"tenant":"acme","trace":"old"}}`), map[string]string{"trace": "new"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"echo","_meta":{"tenant":"acme","trace":"new"}}`, string(merged))
+
+	merged, err = MergeParamsMeta(nil, map[string]string{"trace": "new"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"_meta":{"trace":"new"}}`, string(merged))
+
+	_, err = MergeParamsMeta(json.RawMessage(`[1]`), map[string]string{"trace": "new"})
+	require.ErrorContains(t, err, "decode params")
+}
@@ -0,0 +1,18 @@
+package mcpcodec
+
+import "encoding/json"
+
+// ToolResultIsError reports whether an encoded tools/call result sets isError.
+// Empty or undecodable results are not errors.
+func ToolResultIsError(raw json.RawMessage) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	var result struct {
+		IsError bool `json:"isError"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return false
+	}
+	return result.IsError
+}
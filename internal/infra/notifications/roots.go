@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"mcpv/internal/domain"
+)
+
+const defaultRootsChangeBuffer = 16
+
+// RootsHub keeps the roots reported by each caller and tells subscribers
+// which callers' roots changed.
+type RootsHub struct {
+	mu    sync.RWMutex
+	roots map[string][]domain.Root
+	subs  map[chan string]struct{}
+}
+
+func NewRootsHub() *RootsHub {
+	return &RootsHub{
+		roots: make(map[string][]domain.Root),
+		subs:  make(map[chan string]struct{}),
+	}
+}
+
+// SetCallerRoots replaces a caller's roots. Subscribers are notified only when
+// the roots differ from the previous ones.
+func (h *RootsHub) SetCallerRoots(caller string, roots []domain.Root) {
+	if h == nil || caller == "" {
+		return
+	}
+	h.mu.Lock()
+	prev := h.roots[caller]
+	if slices.Equal(prev, roots) {
+		h.mu.Unlock()
+		return
+	}
+	if len(roots) == 0 {
+		delete(h.roots, caller)
+	} else {
+		h.roots[caller] = slices.Clone(roots)
+	}
+	h.mu.Unlock()
+	h.emit(caller)
+}
+
+// RemoveCaller drops the roots of a caller that went away.
+func (h *RootsHub) RemoveCaller(caller string) {
+	h.SetCallerRoots(caller, nil)
+}
+
+// CallerRoots returns the roots a caller reported.
+func (h *RootsHub) CallerRoots(caller string) []domain.Root {
+	if h == nil {
+		return nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Clone(h.roots[caller])
+}
+
+// SubscribeRootsChanges streams the names of callers whose roots changed until
+// ctx is done.
+func (h *RootsHub) SubscribeRootsChanges(ctx context.Context) <-chan string {
+	ch := make(chan string, defaultRootsChangeBuffer)
+	if h == nil {
+		close(ch)
+		return ch
+	}
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		delete(h.subs, ch)
+		close(ch)
+		h.mu.Unlock()
+	}()
+
+	return ch
+}
+
+func (h *RootsHub) emit(caller string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subs {
+		select {
+		case ch <- caller:
+		default:
+		}
+	}
+}
+
+var (
+	_ domain.RootsStore    = (*RootsHub)(nil)
+	_ domain.RootsProvider = (*RootsHub)(nil)
+)
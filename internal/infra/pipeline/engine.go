@@ -1,15 +1,18 @@
 package pipeline
 
 import (
+	"bytes"
 	"context"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
 )
 
 var categoryOrder = []domain.PluginCategory{
@@ -29,13 +32,27 @@ type Engine struct {
 
 	mu         sync.RWMutex
 	plugins    []domain.PluginSpec
-	byCategory map[domain.PluginCategory][]domain.PluginSpec
+	builtins   []Builtin
+	byCategory map[domain.PluginCategory][]stage
 }
 
 type Handler interface {
 	Handle(ctx context.Context, spec domain.PluginSpec, req domain.GovernanceRequest) (domain.GovernanceDecision, error)
 }
 
+// Builtin is a native policy that runs ahead of the configured plugins in the
+// slot of Spec.Category, with the same ordering, flow filtering and metrics.
+type Builtin struct {
+	Spec    domain.PluginSpec
+	Handler Handler
+}
+
+// stage is a plugin or builtin together with the handler that runs it.
+type stage struct {
+	spec    domain.PluginSpec
+	handler Handler
+}
+
 func NewEngine(handler Handler, logger *zap.Logger, metrics domain.Metrics) *Engine {
 	if logger == nil {
 		logger = zap.NewNop()
@@ -45,29 +62,48 @@ func NewEngine(handler Handler, logger *zap.Logger, metrics domain.Metrics) *Eng
 		logger:     logger.Named("pipeline"),
 		metrics:    metrics,
 		plugins:    nil,
-		byCategory: make(map[domain.PluginCategory][]domain.PluginSpec),
+		byCategory: make(map[domain.PluginCategory][]stage),
 	}
 }
 
 func (e *Engine) Update(specs []domain.PluginSpec) {
-	byCategory := make(map[domain.PluginCategory][]domain.PluginSpec)
 	copied := append([]domain.PluginSpec(nil), specs...)
-	for _, spec := range copied {
+
+	e.mu.Lock()
+	e.plugins = copied
+	e.byCategory = e.buildStages(copied, e.builtins)
+	e.mu.Unlock()
+}
+
+// SetBuiltins replaces the native policies run ahead of each category's plugins.
+func (e *Engine) SetBuiltins(builtins ...Builtin) {
+	copied := append([]Builtin(nil), builtins...)
+
+	e.mu.Lock()
+	e.builtins = copied
+	e.byCategory = e.buildStages(e.plugins, copied)
+	e.mu.Unlock()
+}
+
+func (e *Engine) buildStages(specs []domain.PluginSpec, builtins []Builtin) map[domain.PluginCategory][]stage {
+	byCategory := make(map[domain.PluginCategory][]stage)
+	for _, spec := range specs {
 		if spec.Disabled {
 			continue
 		}
-		byCategory[spec.Category] = append(byCategory[spec.Category], spec)
+		byCategory[spec.Category] = append(byCategory[spec.Category], stage{spec: spec, handler: e.handler})
 	}
 	for _, list := range byCategory {
 		sort.Slice(list, func(i, j int) bool {
-			return list[i].Name < list[j].Name
+			return list[i].spec.Name < list[j].spec.Name
 		})
 	}
-
-	e.mu.Lock()
-	e.plugins = copied
-	e.byCategory = byCategory
-	e.mu.Unlock()
+	for i := len(builtins) - 1; i >= 0; i-- {
+		builtin := builtins[i]
+		category := builtin.Spec.Category
+		byCategory[category] = append([]stage{{spec: builtin.Spec, handler: builtin.Handler}}, byCategory[category]...)
+	}
+	return byCategory
 }
 
 func (e *Engine) Handle(ctx context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
@@ -81,20 +117,31 @@ func (e *Engine) Handle(ctx context.Context, req domain.GovernanceRequest) (doma
 
 	decision := domain.GovernanceDecision{Continue: true}
 	request := req
+	metadata := newMetadataState(req.Metadata, req.Flow)
 
 	for _, category := range categoryOrder {
 		plugins := byCategory[category]
 		if len(plugins) == 0 {
 			continue
 		}
+		spanCtx, span := telemetry.StartSpan(ctx, "governance."+string(category),
+			attribute.String("mcpv.governance.flow", string(request.Flow)),
+			attribute.Int("mcpv.governance.plugins", len(plugins)),
+		)
 		if category == domain.PluginCategoryObservability {
-			if err := e.runObservability(ctx, plugins, request, request.Flow); err != nil {
+			err := e.runObservability(spanCtx, plugins, request, request.Flow)
+			telemetry.EndSpan(span, err)
+			if err != nil {
 				return decision, err
 			}
 			continue
 		}
 		var err error
-		request, decision, err = e.runSequential(ctx, category, plugins, request, request.Flow)
+		request, decision, err = e.runSequential(spanCtx, category, plugins, request, request.Flow, metadata)
+		if err == nil && !decision.Continue {
+			span.SetAttributes(attribute.String("mcpv.governance.reject_code", decision.RejectCode))
+		}
+		telemetry.EndSpan(span, err)
 		if err != nil {
 			return decision, err
 		}
@@ -103,23 +150,37 @@ func (e *Engine) Handle(ctx context.Context, req domain.GovernanceRequest) (doma
 		}
 	}
 
-	return decision, nil
+	return accumulatedDecision(req, request, metadata), nil
 }
 
-func (e *Engine) runObservability(ctx context.Context, plugins []domain.PluginSpec, req domain.GovernanceRequest, flow domain.PluginFlow) error {
+// accumulatedDecision reports the net mutations of all plugins so callers
+// outside the engine see the same request later plugins saw.
+func accumulatedDecision(original, current domain.GovernanceRequest, metadata *metadataState) domain.GovernanceDecision {
+	decision := domain.GovernanceDecision{Continue: true}
+	if !bytes.Equal(original.RequestJSON, current.RequestJSON) {
+		decision.RequestJSON = current.RequestJSON
+	}
+	if !bytes.Equal(original.ResponseJSON, current.ResponseJSON) {
+		decision.ResponseJSON = current.ResponseJSON
+	}
+	decision.Metadata, decision.RemoveMetadata = metadata.mutations()
+	return decision
+}
+
+func (e *Engine) runObservability(ctx context.Context, plugins []stage, req domain.GovernanceRequest, flow domain.PluginFlow) error {
 	var wg sync.WaitGroup
 	errCh := make(chan error, len(plugins))
 
-	for _, spec := range plugins {
+	for _, plugin := range plugins {
+		spec := plugin.spec
 		if !flowAllowed(spec, flow) {
 			continue
 		}
 		wg.Add(1)
-		spec := spec
 		go func() {
 			defer wg.Done()
 			start := time.Now()
-			decision, err := e.handler.Handle(ctx, spec, req)
+			decision, err := plugin.handler.Handle(ctx, spec, req)
 			duration := time.Since(start)
 			if err != nil {
 				e.recordOutcome(spec, flow, domain.GovernanceOutcomePluginError, duration)
@@ -130,6 +191,9 @@ func (e *Engine) runObservability(ctx context.Context, plugins []domain.PluginSp
 				e.logger.Debug("observability plugin error ignored", zap.String("plugin", spec.Name), zap.Error(err))
 				return
 			}
+			if decision.HasMetadataMutations() {
+				e.logger.Warn("observability plugin metadata ignored", zap.String("plugin", spec.Name))
+			}
 			if !decision.Continue {
 				code := defaultRejectCode(decision.RejectCode, spec.Category)
 				decision.RejectCode = code
@@ -160,16 +224,17 @@ func (e *Engine) runObservability(ctx context.Context, plugins []domain.PluginSp
 	return nil
 }
 
-func (e *Engine) runSequential(ctx context.Context, category domain.PluginCategory, plugins []domain.PluginSpec, req domain.GovernanceRequest, flow domain.PluginFlow) (domain.GovernanceRequest, domain.GovernanceDecision, error) {
+func (e *Engine) runSequential(ctx context.Context, category domain.PluginCategory, plugins []stage, req domain.GovernanceRequest, flow domain.PluginFlow, metadata *metadataState) (domain.GovernanceRequest, domain.GovernanceDecision, error) {
 	current := req
 	decision := domain.GovernanceDecision{Continue: true}
 
-	for _, spec := range plugins {
+	for _, plugin := range plugins {
+		spec := plugin.spec
 		if !flowAllowed(spec, flow) {
 			continue
 		}
 		start := time.Now()
-		resp, err := e.handler.Handle(ctx, spec, current)
+		resp, err := plugin.handler.Handle(ctx, spec, current)
 		duration := time.Since(start)
 		if err != nil {
 			e.recordOutcome(spec, flow, domain.GovernanceOutcomePluginError, duration)
@@ -202,6 +267,16 @@ func (e *Engine) runSequential(ctx context.Context, category domain.PluginCatego
 		} else if len(resp.RequestJSON) > 0 || len(resp.ResponseJSON) > 0 {
 			e.logger.Warn("non-content plugin returned mutations", zap.String("plugin", spec.Name), zap.String("category", string(category)))
 		}
+		if resp.HasMetadataMutations() {
+			if conflicts := metadata.apply(category, resp); len(conflicts) > 0 {
+				e.logger.Warn("plugin metadata conflicts ignored",
+					zap.String("plugin", spec.Name),
+					zap.String("category", string(category)),
+					zap.Strings("keys", conflicts),
+				)
+			}
+			current.Metadata = metadata.snapshot()
+		}
 	}
 
 	return current, decision, nil
@@ -125,3 +125,167 @@ func TestEngine_SkipsDisabledPlugins(t *testing.T) {
 	require.False(t, disabledSeen)
 	require.True(t, enabledSeen)
 }
+
+func TestEngine_MetadataFlowsInCategoryOrder(t *testing.T) {
+	handler := &fakeHandler{
+		responses: map[string]func(domain.GovernanceRequest) (domain.GovernanceDecision, error){
+			"authn": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				return domain.GovernanceDecision{
+					Continue:       true,
+					Metadata:       map[string]string{"user.id": "alice", "x-role": "admin"},
+					RemoveMetadata: []string{"authorization"},
+				}, nil
+			},
+			"authn-z": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				// Same category runs later in name order and may refine the value.
+				return domain.GovernanceDecision{Continue: true, Metadata: map[string]string{"x-role": "user"}}, nil
+			},
+			"authz": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				// Later categories cannot overwrite or remove keys owned by authentication.
+				return domain.GovernanceDecision{
+					Continue:       true,
+					Metadata:       map[string]string{"user.id": "mallory", "decision": "allow"},
+					RemoveMetadata: []string{"x-role"},
+				}, nil
+			},
+		},
+	}
+
+	engine := NewEngine(handler, nil, nil)
+	engine.Update([]domain.PluginSpec{
+		{Name: "authn", Category: domain.PluginCategoryAuthentication, Required: true},
+		{Name: "authn-z", Category: domain.PluginCategoryAuthentication, Required: true},
+		{Name: "authz", Category: domain.PluginCategoryAuthorization, Required: true},
+		{Name: "audit", Category: domain.PluginCategoryAudit, Required: true},
+	})
+
+	decision, err := engine.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		Metadata: map[string]string{"authorization": "Bearer t", "user.id": "spoofed", "trace": "1"},
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+
+	want := map[string]string{"user.id": "alice", "x-role": "user", "decision": "allow", "trace": "1"}
+	handler.mu.Lock()
+	audit := handler.seen["audit"]
+	authz := handler.seen["authz"]
+	handler.mu.Unlock()
+	require.Len(t, audit, 1)
+	require.Equal(t, want, audit[0].Metadata)
+	require.Equal(t, "admin", handler.seen["authn-z"][0].Metadata["x-role"])
+	require.Equal(t, "user", authz[0].Metadata["x-role"])
+
+	require.Equal(t, map[string]string{"user.id": "alice", "x-role": "user", "decision": "allow"}, decision.Metadata)
+	require.Equal(t, []string{"authorization"}, decision.RemoveMetadata)
+}
+
+func TestEngine_ResponseFlowMetadataIsAppendOnly(t *testing.T) {
+	handler := &fakeHandler{
+		responses: map[string]func(domain.GovernanceRequest) (domain.GovernanceDecision, error){
+			"content": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				return domain.GovernanceDecision{
+					Continue:       true,
+					Metadata:       map[string]string{"user.id": "mallory", "redacted": "true"},
+					RemoveMetadata: []string{"trace"},
+				}, nil
+			},
+			"obs": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				return domain.GovernanceDecision{Continue: true, Metadata: map[string]string{"ignored": "true"}}, nil
+			},
+		},
+	}
+
+	engine := NewEngine(handler, nil, nil)
+	engine.Update([]domain.PluginSpec{
+		{Name: "obs", Category: domain.PluginCategoryObservability},
+		{Name: "content", Category: domain.PluginCategoryContent, Required: true},
+		{Name: "audit", Category: domain.PluginCategoryAudit, Required: true},
+	})
+
+	decision, err := engine.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowResponse,
+		Method:   "tools/call",
+		Metadata: map[string]string{"user.id": "alice", "trace": "1"},
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+	require.Equal(t, map[string]string{"redacted": "true"}, decision.Metadata)
+	require.Empty(t, decision.RemoveMetadata)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	require.Equal(t, map[string]string{"user.id": "alice", "trace": "1", "redacted": "true"}, handler.seen["audit"][0].Metadata)
+}
+
+func TestEngine_ReturnsAccumulatedContentMutations(t *testing.T) {
+	handler := &fakeHandler{
+		responses: map[string]func(domain.GovernanceRequest) (domain.GovernanceDecision, error){
+			"content": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				return domain.GovernanceDecision{Continue: true, RequestJSON: json.RawMessage(`{"foo":"bar"}`)}, nil
+			},
+		},
+	}
+
+	engine := NewEngine(handler, nil, nil)
+	engine.Update([]domain.PluginSpec{
+		{Name: "content", Category: domain.PluginCategoryContent, Required: true},
+		{Name: "audit", Category: domain.PluginCategoryAudit, Required: true},
+	})
+
+	decision, err := engine.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:        domain.PluginFlowRequest,
+		Method:      "tools/call",
+		RequestJSON: json.RawMessage(`{"foo":"old"}`),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"foo":"bar"}`, string(decision.RequestJSON))
+	require.Nil(t, decision.Metadata)
+}
+
+func TestEngine_BuiltinRunsFirstInItsCategory(t *testing.T) {
+	handler := &fakeHandler{}
+	builtin := &fakeHandler{
+		responses: map[string]func(domain.GovernanceRequest) (domain.GovernanceDecision, error){
+			"builtin": func(_ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+				return domain.GovernanceDecision{Continue: true, ResponseJSON: json.RawMessage(`{"masked":true}`)}, nil
+			},
+		},
+	}
+
+	engine := NewEngine(handler, nil, nil)
+	engine.Update([]domain.PluginSpec{
+		{Name: "a-content", Category: domain.PluginCategoryContent, Required: true},
+		{Name: "audit", Category: domain.PluginCategoryAudit, Required: true},
+	})
+	engine.SetBuiltins(Builtin{
+		Spec: domain.PluginSpec{
+			Name:     "builtin",
+			Category: domain.PluginCategoryContent,
+			Required: true,
+			Flows:    []domain.PluginFlow{domain.PluginFlowResponse},
+		},
+		Handler: builtin,
+	})
+	// Builtins survive plugin reloads.
+	engine.Update([]domain.PluginSpec{
+		{Name: "a-content", Category: domain.PluginCategoryContent, Required: true},
+		{Name: "audit", Category: domain.PluginCategoryAudit, Required: true},
+	})
+
+	decision, err := engine.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:         domain.PluginFlowResponse,
+		Method:       "tools/call",
+		ResponseJSON: json.RawMessage(`{"secret":"x"}`),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"masked":true}`, string(decision.ResponseJSON))
+	require.Len(t, builtin.seen["builtin"], 1)
+	require.JSONEq(t, `{"masked":true}`, string(handler.seen["a-content"][0].ResponseJSON))
+	require.JSONEq(t, `{"masked":true}`, string(handler.seen["audit"][0].ResponseJSON))
+
+	_, err = engine.Handle(context.Background(), domain.GovernanceRequest{Flow: domain.PluginFlowRequest, Method: "tools/call"})
+	require.NoError(t, err)
+	require.Len(t, builtin.seen["builtin"], 1, "builtin is limited to its flows")
+}
@@ -0,0 +1,108 @@
+package pipeline
+
+import (
+	"sort"
+
+	"mcpv/internal/domain"
+)
+
+// metadataState merges plugin metadata mutations within one flow.
+//
+// A key written or removed by a plugin belongs to that plugin's category:
+// plugins in the same category may change it again (last in name order wins),
+// later categories may not. Keys supplied with the request can be replaced in
+// the request flow but are read-only in the response flow.
+type metadataState struct {
+	initial  map[string]string
+	values   map[string]string
+	owners   map[string]domain.PluginCategory
+	readOnly bool
+}
+
+func newMetadataState(initial map[string]string, flow domain.PluginFlow) *metadataState {
+	values := make(map[string]string, len(initial))
+	for key, value := range initial {
+		values[key] = value
+	}
+	return &metadataState{
+		initial:  initial,
+		values:   values,
+		owners:   make(map[string]domain.PluginCategory),
+		readOnly: flow == domain.PluginFlowResponse,
+	}
+}
+
+// apply merges the decision's mutations and returns the keys it was not
+// allowed to change.
+func (m *metadataState) apply(category domain.PluginCategory, decision domain.GovernanceDecision) []string {
+	var conflicts []string
+	for _, key := range decision.RemoveMetadata {
+		if !m.writable(category, key) {
+			conflicts = append(conflicts, key)
+			continue
+		}
+		delete(m.values, key)
+		m.owners[key] = category
+	}
+	keys := make([]string, 0, len(decision.Metadata))
+	for key := range decision.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !m.writable(category, key) {
+			conflicts = append(conflicts, key)
+			continue
+		}
+		m.values[key] = decision.Metadata[key]
+		m.owners[key] = category
+	}
+	return conflicts
+}
+
+func (m *metadataState) writable(category domain.PluginCategory, key string) bool {
+	if owner, ok := m.owners[key]; ok {
+		return owner == category
+	}
+	if _, ok := m.initial[key]; ok && m.readOnly {
+		return false
+	}
+	return true
+}
+
+// snapshot returns the merged metadata, or the initial map when nothing changed.
+func (m *metadataState) snapshot() map[string]string {
+	if len(m.owners) == 0 {
+		return m.initial
+	}
+	values := make(map[string]string, len(m.values))
+	for key, value := range m.values {
+		values[key] = value
+	}
+	return values
+}
+
+// mutations returns the net changes relative to the initial metadata.
+func (m *metadataState) mutations() (map[string]string, []string) {
+	if len(m.owners) == 0 {
+		return nil, nil
+	}
+	var set map[string]string
+	for key, value := range m.values {
+		if prev, ok := m.initial[key]; ok && prev == value {
+			continue
+		}
+		if set == nil {
+			set = make(map[string]string)
+		}
+		set[key] = value
+	}
+	var removed []string
+	for key := range m.initial {
+		if _, ok := m.values[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return set, removed
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/plugin/wasm"
 	pluginv1 "mcpv/pkg/api/plugin/v1"
 )
 
@@ -20,5 +21,6 @@ type Instance struct {
 	Conn       *grpc.ClientConn
 	Client     pluginv1.PluginServiceClient
 	Metadata   *pluginv1.PluginMetadata
+	Wasm       *wasm.Module
 	Stop       StopFunc
 }
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"mcpv/internal/infra/plugin/handshake"
 	"mcpv/internal/infra/plugin/instance"
 	"mcpv/internal/infra/plugin/socket"
+	"mcpv/internal/infra/plugin/wasm"
 	"mcpv/internal/infra/process"
 	"mcpv/internal/infra/telemetry"
 	pluginv1 "mcpv/pkg/api/plugin/v1"
@@ -31,12 +33,15 @@ type Manager struct {
 	mu        sync.RWMutex
 	instances map[string]*instance.Instance
 	metrics   domain.Metrics
+	alerts    domain.AlertPublisher
 }
 
 type Options struct {
 	RootDir string
 	Logger  *zap.Logger
 	Metrics domain.Metrics
+	// Alerts is notified when a plugin fails to start.
+	Alerts domain.AlertPublisher
 }
 
 func NewManager(opts Options) (*Manager, error) {
@@ -70,6 +75,7 @@ func NewManager(opts Options) (*Manager, error) {
 		rootDir:   rootDir,
 		instances: make(map[string]*instance.Instance),
 		metrics:   metrics,
+		alerts:    opts.Alerts,
 	}, nil
 }
 
@@ -81,7 +87,9 @@ func (m *Manager) RootDir() string {
 type Status struct {
 	Name    string `json:"name"`
 	Running bool   `json:"running"`
-	Error   string `json:"error,omitempty"`
+	// Required is set for enabled plugins that must be running.
+	Required bool   `json:"required,omitempty"`
+	Error    string `json:"error,omitempty"`
 }
 
 // GetStatus returns the runtime status of all configured plugins.
@@ -104,8 +112,9 @@ func (m *Manager) GetStatus(configuredSpecs []domain.PluginSpec) []Status {
 			running = true
 		}
 		s := Status{
-			Name:    spec.Name,
-			Running: running,
+			Name:     spec.Name,
+			Running:  running,
+			Required: spec.Required,
 		}
 		if !running {
 			s.Error = "Plugin failed to start or is not running"
@@ -157,11 +166,12 @@ func (m *Manager) Apply(ctx context.Context, specs []domain.PluginSpec) error {
 	// Start or restart updated plugins.
 	for name, spec := range desired {
 		inst, ok := existing[name]
-		if ok && reflect.DeepEqual(inst.Spec, spec) {
+		if ok && m.instanceCurrent(inst, spec) {
 			continue
 		}
 		newInst, err := m.startInstance(ctx, spec)
 		if err != nil {
+			m.publishStartFailure(spec, err)
 			if spec.Required {
 				applyErrs = append(applyErrs, fmt.Sprintf("plugin %q start failed: %v", name, err))
 				continue
@@ -235,6 +245,11 @@ func (m *Manager) Handle(ctx context.Context, spec domain.PluginSpec, req domain
 	callCtx, cancel := context.WithTimeout(ctx, deadline)
 	defer cancel()
 
+	if inst.Wasm != nil {
+		req.Flow = flow
+		return inst.Wasm.Handle(callCtx, req)
+	}
+
 	grpcReq := &pluginv1.PluginHandleRequest{
 		Flow:         string(flow),
 		Method:       req.Method,
@@ -264,15 +279,20 @@ func (m *Manager) Handle(ctx context.Context, spec domain.PluginSpec, req domain
 	}
 
 	return domain.GovernanceDecision{
-		Continue:      resp.GetContinue(),
-		RequestJSON:   resp.GetRequestJson(),
-		ResponseJSON:  resp.GetResponseJson(),
-		RejectCode:    resp.GetRejectCode(),
-		RejectMessage: resp.GetRejectMessage(),
+		Continue:       resp.GetContinue(),
+		RequestJSON:    resp.GetRequestJson(),
+		ResponseJSON:   resp.GetResponseJson(),
+		RejectCode:     resp.GetRejectCode(),
+		RejectMessage:  resp.GetRejectMessage(),
+		Metadata:       resp.GetMetadata(),
+		RemoveMetadata: resp.GetRemoveMetadata(),
 	}, nil
 }
 
 func (m *Manager) startInstance(ctx context.Context, spec domain.PluginSpec) (*instance.Instance, error) {
+	if spec.Runtime == domain.PluginRuntimeWasm {
+		return m.loadWasmInstance(ctx, spec)
+	}
 	startTime := time.Now()
 	socketDir, socketPath, err := socket.Prepare(m.rootDir, spec.Name)
 	if err != nil {
@@ -352,6 +372,45 @@ func (m *Manager) startInstance(ctx context.Context, spec domain.PluginSpec) (*i
 		Stop:       stopFn,
 	}, nil
 }
+
+func (m *Manager) loadWasmInstance(ctx context.Context, spec domain.PluginSpec) (*instance.Instance, error) {
+	startTime := time.Now()
+	logger := m.logger.With(
+		zap.String("plugin", spec.Name),
+		zap.String("category", string(spec.Category)),
+	)
+	module, err := wasm.Load(ctx, spec, logger)
+	m.recordPluginStart(spec, time.Since(startTime), err == nil)
+	if err != nil {
+		return nil, fmt.Errorf("plugin wasm load: %w", err)
+	}
+	if m.alerts != nil {
+		module.SetAlertPublisher(m.alerts)
+	}
+	return &instance.Instance{
+		Spec: spec,
+		Wasm: module,
+		Stop: module.Close,
+	}, nil
+}
+
+// instanceCurrent reports whether inst already runs spec. A wasm module is
+// also compared by content so a module rebuilt at the same path is reloaded.
+func (m *Manager) instanceCurrent(inst *instance.Instance, spec domain.PluginSpec) bool {
+	if !reflect.DeepEqual(inst.Spec, spec) {
+		return false
+	}
+	if inst.Wasm == nil {
+		return true
+	}
+	digest, err := wasm.FileDigest(spec)
+	if err != nil {
+		m.logger.Warn("wasm module digest failed", zap.String("plugin", spec.Name), zap.Error(err))
+		return true
+	}
+	return digest == inst.Wasm.Digest()
+}
+
 func (m *Manager) cleanupInstance(inst *instance.Instance) {
 	if inst == nil {
 		return
@@ -426,6 +485,22 @@ func (m *Manager) recordPluginStart(spec domain.PluginSpec, duration time.Durati
 	})
 }
 
+func (m *Manager) publishStartFailure(spec domain.PluginSpec, err error) {
+	if m.alerts == nil {
+		return
+	}
+	m.alerts.PublishAlert(domain.AlertEvent{
+		Type:    domain.AlertEventPluginStartFailed,
+		Time:    time.Now(),
+		Message: fmt.Sprintf("plugin %s failed to start: %v", spec.Name, err),
+		Attributes: map[string]string{
+			"plugin":   spec.Name,
+			"category": string(spec.Category),
+			"required": strconv.FormatBool(spec.Required),
+		},
+	})
+}
+
 func (m *Manager) recordPluginHandshake(spec domain.PluginSpec, duration time.Duration, succeeded bool) {
 	if m.metrics == nil || spec.Name == "" {
 		return
@@ -0,0 +1,114 @@
+//go:build wasip1
+
+// Command wasmplugin is a reactor module used by the wasm plugin runtime tests.
+// Build with: GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared.
+package main
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+type request struct {
+	Flow     string `json:"flow"`
+	Method   string `json:"method"`
+	ToolName string `json:"toolName"`
+}
+
+type decision struct {
+	Continue      bool            `json:"continue"`
+	ResponseJSON  json.RawMessage `json:"responseJson,omitempty"`
+	RejectCode    string          `json:"rejectCode,omitempty"`
+	RejectMessage string          `json:"rejectMessage,omitempty"`
+}
+
+var (
+	buffers   = map[uint32][]byte{}
+	blockTool string
+)
+
+func main() {}
+
+//go:wasmexport alloc
+func alloc(size uint32) uint32 {
+	buf := make([]byte, size+1)
+	ptr := uint32(uintptr(unsafe.Pointer(&buf[0])))
+	buffers[ptr] = buf
+	return ptr
+}
+
+//go:wasmexport dealloc
+func dealloc(ptr, _ uint32) {
+	delete(buffers, ptr)
+}
+
+//go:wasmexport configure
+func configure(ptr, size uint32) int32 {
+	var cfg struct {
+		BlockTool string `json:"blockTool"`
+	}
+	if err := json.Unmarshal(read(ptr, size), &cfg); err != nil {
+		return 1
+	}
+	blockTool = cfg.BlockTool
+	return 0
+}
+
+//go:wasmexport handle_request
+func handleRequest(ptr, size uint32) uint64 {
+	var req request
+	if err := json.Unmarshal(read(ptr, size), &req); err != nil {
+		return reply(decision{Continue: false, RejectCode: "bad_request", RejectMessage: err.Error()})
+	}
+	switch req.ToolName {
+	case blockTool:
+		return reply(decision{Continue: false, RejectCode: "blocked", RejectMessage: "tool blocked"})
+	case "burn":
+		burn(1 << 20)
+	case "spin":
+		spinResult = spin(1 << 30)
+	case "hoard":
+		hoard = append(hoard, make([]byte, 256<<20))
+	}
+	return reply(decision{Continue: true})
+}
+
+//go:wasmexport handle_response
+func handleResponse(_, _ uint32) uint64 {
+	return reply(decision{Continue: true, ResponseJSON: json.RawMessage(`{"wasm":true}`)})
+}
+
+//go:noinline
+func burn(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return burn(n-1) + 1
+}
+
+var spinResult uint64
+
+// hoard keeps allocations reachable so "hoard" runs into the memory limit.
+var hoard [][]byte
+
+// spin is a call-free loop, metered only at its loop header.
+//
+//go:noinline
+func spin(n uint64) uint64 {
+	x := uint64(1)
+	for i := uint64(0); i < n; i++ {
+		x = x*6364136223846793005 + i
+	}
+	return x
+}
+
+func read(ptr, size uint32) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(uintptr(ptr))), size)
+}
+
+func reply(d decision) uint64 {
+	data, _ := json.Marshal(d)
+	ptr := alloc(uint32(len(data)))
+	copy(buffers[ptr], data)
+	return uint64(ptr)<<32 | uint64(len(data))
+}
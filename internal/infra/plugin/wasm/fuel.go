@@ -0,0 +1,535 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Fuel is metered inside the guest: instrumentFuel rewrites the module so
+// every function entry and every loop iteration decrements an exported i64
+// global and traps once it drops below zero. Straight-line code between those
+// points is not charged, so a budget bounds both call depth and CPU-bound
+// loops without a host call per unit.
+//
+// Every memory.grow is followed by a check that raises an exported i32 flag
+// when the grow fails, so the host can tell a guest that trapped after
+// hitting the memory limit from other traps.
+
+const (
+	// fuelExport names the global the host loads with the per-call budget.
+	fuelExport = "__mcpv_fuel"
+	// growFailedExport names the global set to 1 when a memory.grow fails.
+	growFailedExport = "__mcpv_grow_failed"
+)
+
+const (
+	sectionCustom    = 0
+	sectionImport    = 2
+	sectionGlobal    = 6
+	sectionExport    = 7
+	sectionCode      = 10
+	externGlobal     = 3
+	opLoop           = 0x03
+	opMemoryGrow     = 0x40
+	opUnreachable    = 0x00
+	opBlockTypeEmpty = 0x40
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+type wasmSection struct {
+	id      byte
+	content []byte
+}
+
+// instrumentModule returns a copy of module with fuel metering and memory.grow
+// checks injected, exporting their globals as fuelExport and growFailedExport.
+func instrumentModule(module []byte) ([]byte, error) {
+	if len(module) < len(wasmHeader) || !bytes.Equal(module[:len(wasmHeader)], wasmHeader) {
+		return nil, errors.New("not a wasm binary module")
+	}
+	sections, err := readSections(module[len(wasmHeader):])
+	if err != nil {
+		return nil, err
+	}
+
+	importedGlobals, definedGlobals := uint32(0), uint32(0)
+	for _, section := range sections {
+		switch section.id {
+		case sectionImport:
+			if importedGlobals, err = countImportedGlobals(section.content); err != nil {
+				return nil, fmt.Errorf("import section: %w", err)
+			}
+		case sectionGlobal:
+			r := &wasmReader{buf: section.content}
+			if definedGlobals, err = r.u32(); err != nil {
+				return nil, fmt.Errorf("global section: %w", err)
+			}
+		}
+	}
+	fuelGlobal := importedGlobals + definedGlobals
+	growResultGlobal := fuelGlobal + 1
+	growFailedGlobal := fuelGlobal + 2
+	charge := fuelCharge(fuelGlobal)
+	growCheck := memoryGrowCheck(growResultGlobal, growFailedGlobal)
+
+	// Start with the counter at its maximum so instantiation and _initialize
+	// run unmetered; Handle loads the real budget before each call.
+	global := []byte{0x7e, 0x01, 0x42}
+	global = appendS64(global, math.MaxInt64)
+	global = append(global, 0x0b)
+	i32Global := []byte{0x7f, 0x01, 0x41, 0x00, 0x0b}
+	export := appendName(nil, fuelExport)
+	export = append(export, externGlobal)
+	export = appendU32(export, fuelGlobal)
+	growExport := appendName(nil, growFailedExport)
+	growExport = append(growExport, externGlobal)
+	growExport = appendU32(growExport, growFailedGlobal)
+
+	sections = upsertSection(sections, sectionGlobal, global)
+	sections = upsertSection(sections, sectionGlobal, i32Global)
+	sections = upsertSection(sections, sectionGlobal, i32Global)
+	sections = upsertSection(sections, sectionExport, export)
+	sections = upsertSection(sections, sectionExport, growExport)
+	for i := range sections {
+		if sections[i].id != sectionCode {
+			continue
+		}
+		if sections[i].content, err = instrumentCode(sections[i].content, charge, growCheck); err != nil {
+			return nil, fmt.Errorf("code section: %w", err)
+		}
+	}
+
+	out := append([]byte(nil), wasmHeader...)
+	for _, section := range sections {
+		out = append(out, section.id)
+		out = appendU32(out, uint32(len(section.content)))
+		out = append(out, section.content...)
+	}
+	return out, nil
+}
+
+// fuelCharge is the sequence injected at every metering point:
+//
+//	global.set $fuel (i64.sub (global.get $fuel) (i64.const 1))
+//	if (i64.lt_s (global.get $fuel) (i64.const 0)) unreachable
+func fuelCharge(global uint32) []byte {
+	get := appendU32([]byte{0x23}, global)
+	set := appendU32([]byte{0x24}, global)
+	out := append([]byte(nil), get...)
+	out = append(out, 0x42, 0x01, 0x7d)
+	out = append(out, set...)
+	out = append(out, get...)
+	out = append(out, 0x42, 0x00, 0x53, 0x04, opBlockTypeEmpty, opUnreachable, 0x0b)
+	return out
+}
+
+// memoryGrowCheck is the sequence injected after every memory.grow; it keeps
+// the grow result on the stack:
+//
+//	global.set $result
+//	if (i32.eq (global.get $result) (i32.const -1)) global.set $failed (i32.const 1)
+//	global.get $result
+func memoryGrowCheck(result, failed uint32) []byte {
+	get := appendU32([]byte{0x23}, result)
+	out := appendU32([]byte{0x24}, result)
+	out = append(out, get...)
+	out = append(out, 0x41, 0x7f, 0x46, 0x04, opBlockTypeEmpty, 0x41, 0x01)
+	out = appendU32(append(out, 0x24), failed)
+	out = append(out, 0x0b)
+	return append(out, get...)
+}
+
+func readSections(buf []byte) ([]wasmSection, error) {
+	r := &wasmReader{buf: buf}
+	var sections []wasmSection
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", id, err)
+		}
+		sections = append(sections, wasmSection{id: id, content: content})
+	}
+	return sections, nil
+}
+
+// upsertSection appends entry to the vector section id, creating the section
+// in its canonical position when the module has none.
+func upsertSection(sections []wasmSection, id byte, entry []byte) []wasmSection {
+	for i := range sections {
+		if sections[i].id != id {
+			continue
+		}
+		r := &wasmReader{buf: sections[i].content}
+		count, _ := r.u32()
+		content := appendU32(nil, count+1)
+		content = append(content, r.buf[r.pos:]...)
+		sections[i].content = append(content, entry...)
+		return sections
+	}
+	section := wasmSection{id: id, content: append(appendU32(nil, 1), entry...)}
+	at := len(sections)
+	for i, existing := range sections {
+		if existing.id != sectionCustom && sectionOrder(existing.id) > sectionOrder(id) {
+			at = i
+			break
+		}
+	}
+	sections = append(sections, wasmSection{})
+	copy(sections[at+1:], sections[at:])
+	sections[at] = section
+	return sections
+}
+
+// sectionOrder maps section ids to their required position; the data count
+// (12) and tag (13) sections are numbered out of order.
+func sectionOrder(id byte) int {
+	switch id {
+	case 12:
+		return 95
+	case 13:
+		return 55
+	default:
+		return int(id) * 10
+	}
+}
+
+func countImportedGlobals(content []byte) (uint32, error) {
+	r := &wasmReader{buf: content}
+	count, err := r.u32()
+	if err != nil {
+		return 0, err
+	}
+	var globals uint32
+	for range count {
+		for range 2 {
+			size, err := r.u32()
+			if err != nil {
+				return 0, err
+			}
+			if _, err := r.bytes(int(size)); err != nil {
+				return 0, err
+			}
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		switch kind {
+		case 0x00: // func: type index
+			_, err = r.u32()
+		case 0x01: // table: reftype, limits
+			if _, err = r.byte(); err == nil {
+				err = r.skipLimits()
+			}
+		case 0x02: // memory: limits
+			err = r.skipLimits()
+		case externGlobal: // global: valtype, mutability
+			globals++
+			_, err = r.bytes(2)
+		case 0x04: // tag: attribute, type index
+			if _, err = r.byte(); err == nil {
+				_, err = r.u32()
+			}
+		default:
+			err = fmt.Errorf("unknown import kind 0x%02x", kind)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return globals, nil
+}
+
+func instrumentCode(content []byte, charge, growCheck []byte) ([]byte, error) {
+	r := &wasmReader{buf: content}
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	out := appendU32(make([]byte, 0, len(content)+int(count)*len(charge)*2), count)
+	for i := range count {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+		body, err = instrumentBody(body, charge, growCheck)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", i, err)
+		}
+		out = appendU32(out, uint32(len(body)))
+		out = append(out, body...)
+	}
+	return out, nil
+}
+
+// instrumentBody charges fuel on function entry and at the head of every loop,
+// and checks the result of every memory.grow.
+func instrumentBody(body []byte, charge, growCheck []byte) ([]byte, error) {
+	r := &wasmReader{buf: body}
+	groups, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	for range groups {
+		if _, err := r.u32(); err != nil {
+			return nil, err
+		}
+		if _, err := r.byte(); err != nil {
+			return nil, err
+		}
+	}
+	out := make([]byte, 0, len(body)+len(charge)*4)
+	out = append(out, body[:r.pos]...)
+	out = append(out, charge...)
+	copied := r.pos
+	for !r.done() {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.skipImmediates(op); err != nil {
+			return nil, fmt.Errorf("opcode 0x%02x at %d: %w", op, r.pos, err)
+		}
+		switch op {
+		case opLoop:
+			out = append(out, body[copied:r.pos]...)
+			out = append(out, charge...)
+			copied = r.pos
+		case opMemoryGrow:
+			out = append(out, body[copied:r.pos]...)
+			out = append(out, growCheck...)
+			copied = r.pos
+		}
+	}
+	return append(out, body[copied:]...), nil
+}
+
+type wasmReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *wasmReader) done() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *wasmReader) byte() (byte, error) {
+	if r.done() {
+		return 0, errors.New("unexpected end of module")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *wasmReader) bytes(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.buf) {
+		return nil, errors.New("unexpected end of module")
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *wasmReader) u32() (uint32, error) {
+	value, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 || value > math.MaxUint32 {
+		return 0, errors.New("malformed varint")
+	}
+	r.pos += n
+	return uint32(value), nil
+}
+
+// skipLEB skips a signed or 64-bit LEB128 value.
+func (r *wasmReader) skipLEB() error {
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+}
+
+func (r *wasmReader) skipLimits() error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if err := r.skipLEB(); err != nil {
+		return err
+	}
+	if flags&0x01 != 0 {
+		return r.skipLEB()
+	}
+	return nil
+}
+
+func (r *wasmReader) skipU32s(n int) error {
+	for range n {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *wasmReader) skipBlockType() error {
+	b, err := r.byte()
+	if err != nil {
+		return err
+	}
+	switch b {
+	case opBlockTypeEmpty, 0x7f, 0x7e, 0x7d, 0x7c, 0x7b, 0x70, 0x6f:
+		return nil
+	}
+	r.pos--
+	return r.skipLEB()
+}
+
+func (r *wasmReader) skipImmediates(op byte) error {
+	switch {
+	case op == 0x02 || op == opLoop || op == 0x04:
+		return r.skipBlockType()
+	case op == 0x0c || op == 0x0d || op == 0x10 || op == 0x12 || op == 0xd2:
+		return r.skipU32s(1)
+	case op == 0x0e:
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		return r.skipU32s(int(n) + 1)
+	case op == 0x11 || op == 0x13:
+		return r.skipU32s(2)
+	case op == 0x1c:
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		_, err = r.bytes(int(n))
+		return err
+	case op >= 0x20 && op <= 0x26:
+		return r.skipU32s(1)
+	case op >= 0x28 && op <= 0x3e:
+		return r.skipU32s(2)
+	case op == 0x3f || op == opMemoryGrow:
+		return r.skipU32s(1)
+	case op == 0x41 || op == 0x42:
+		return r.skipLEB()
+	case op == 0x43:
+		_, err := r.bytes(4)
+		return err
+	case op == 0x44:
+		_, err := r.bytes(8)
+		return err
+	case op == 0xd0:
+		_, err := r.byte()
+		return err
+	case op == 0xfc:
+		return r.skipMiscImmediates()
+	case op == 0xfd:
+		return r.skipVectorImmediates()
+	case op <= 0x01 || op == 0x05 || op == 0x0b || op == 0x0f || op == 0x1a || op == 0x1b ||
+		(op >= 0x45 && op <= 0xc4) || op == 0xd1:
+		return nil
+	default:
+		return errors.New("unsupported opcode")
+	}
+}
+
+// skipMiscImmediates handles the 0xfc prefix: saturating truncation, bulk
+// memory and table instructions.
+func (r *wasmReader) skipMiscImmediates() error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case sub <= 7:
+		return nil
+	case sub == 8:
+		if err := r.skipU32s(1); err != nil {
+			return err
+		}
+		_, err = r.byte()
+		return err
+	case sub == 9 || sub == 13 || (sub >= 15 && sub <= 17):
+		return r.skipU32s(1)
+	case sub == 10:
+		_, err = r.bytes(2)
+		return err
+	case sub == 11:
+		_, err = r.byte()
+		return err
+	case sub == 12 || sub == 14:
+		return r.skipU32s(2)
+	default:
+		return fmt.Errorf("unsupported 0xfc opcode %d", sub)
+	}
+}
+
+// skipVectorImmediates handles the 0xfd prefix (SIMD).
+func (r *wasmReader) skipVectorImmediates() error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case sub <= 11 || sub == 92 || sub == 93:
+		return r.skipU32s(2)
+	case sub == 12 || sub == 13:
+		_, err = r.bytes(16)
+		return err
+	case sub >= 21 && sub <= 34:
+		_, err = r.byte()
+		return err
+	case sub >= 84 && sub <= 91:
+		if err := r.skipU32s(2); err != nil {
+			return err
+		}
+		_, err = r.byte()
+		return err
+	case sub <= 255:
+		return nil
+	default:
+		return fmt.Errorf("unsupported 0xfd opcode %d", sub)
+	}
+}
+
+func appendU32(buf []byte, value uint32) []byte {
+	return binary.AppendUvarint(buf, uint64(value))
+}
+
+func appendS64(buf []byte, value int64) []byte {
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if (value == 0 && b&0x40 == 0) || (value == -1 && b&0x40 != 0) {
+			return append(buf, b)
+		}
+		buf = append(buf, b|0x80)
+	}
+}
+
+func appendName(buf []byte, name string) []byte {
+	buf = appendU32(buf, uint32(len(name)))
+	return append(buf, name...)
+}
@@ -0,0 +1,459 @@
+// Package wasm runs governance plugins as in-process WebAssembly modules.
+//
+// A module must export:
+//
+//	alloc(size i32) i32
+//	handle_request(ptr i32, len i32) i64
+//	handle_response(ptr i32, len i32) i64
+//
+// The handle functions receive a JSON encoded request (see Request) and return
+// the location of a JSON encoded Decision packed as (ptr << 32 | len).
+// Optional exports are configure(ptr i32, len i32) i32, which receives the
+// plugin config JSON and returns zero on success, and dealloc(ptr i32, len i32),
+// which is called for every buffer the host is done with. WASI preview1 is
+// available so modules produced by Go, TinyGo or Rust toolchains load as-is;
+// reactor modules have their _initialize export invoked on instantiation.
+//
+// FuelLimit bounds each call by the number of guest function entries plus loop
+// iterations, so CPU-bound loops without calls are stopped as well. A call that
+// traps after a memory.grow failed at MemoryLimitMB fails with
+// ErrMemoryExhausted and raises an instance_oom alert.
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
+)
+
+const (
+	exportAlloc          = "alloc"
+	exportDealloc        = "dealloc"
+	exportConfigure      = "configure"
+	exportHandleRequest  = "handle_request"
+	exportHandleResponse = "handle_response"
+
+	pagesPerMB = 16
+)
+
+var (
+	// ErrFuelExhausted is returned when a call exceeds the plugin fuel budget.
+	ErrFuelExhausted = errors.New("wasm plugin fuel exhausted")
+	// ErrMemoryExhausted is returned when a call traps after the module failed
+	// to grow its memory past the plugin memory limit.
+	ErrMemoryExhausted = errors.New("wasm plugin memory exhausted")
+)
+
+// Request is the JSON document passed to handle_request and handle_response.
+type Request struct {
+	Flow         string            `json:"flow"`
+	Method       string            `json:"method"`
+	Caller       string            `json:"caller,omitempty"`
+	Server       string            `json:"server,omitempty"`
+	ToolName     string            `json:"toolName,omitempty"`
+	ResourceURI  string            `json:"resourceUri,omitempty"`
+	PromptName   string            `json:"promptName,omitempty"`
+	RoutingKey   string            `json:"routingKey,omitempty"`
+	RequestJSON  json.RawMessage   `json:"requestJson,omitempty"`
+	ResponseJSON json.RawMessage   `json:"responseJson,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+// Decision is the JSON document returned by handle_request and handle_response.
+type Decision struct {
+	Continue      bool            `json:"continue"`
+	RequestJSON   json.RawMessage `json:"requestJson,omitempty"`
+	ResponseJSON  json.RawMessage `json:"responseJson,omitempty"`
+	RejectCode    string          `json:"rejectCode,omitempty"`
+	RejectMessage string          `json:"rejectMessage,omitempty"`
+	// Metadata and RemoveMetadata mutate request metadata for later plugins
+	// and the upstream call.
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	RemoveMetadata []string          `json:"removeMetadata,omitempty"`
+}
+
+// Module is a loaded wasm plugin. Calls are serialized; the instance is
+// recreated after a trap, timeout or fuel exhaustion.
+type Module struct {
+	spec          domain.PluginSpec
+	logger        *zap.Logger
+	runtime       wazero.Runtime
+	compiled      wazero.CompiledModule
+	fuel          int64
+	memoryLimitMB int
+	output        *logWriter
+	digest        string
+
+	mu       sync.Mutex
+	instance api.Module
+	alerts   domain.AlertPublisher
+}
+
+// Load compiles and instantiates the module referenced by spec.
+func Load(ctx context.Context, spec domain.PluginSpec, logger *zap.Logger) (*Module, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	path := ModulePath(spec)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read wasm module: %w", err)
+	}
+	digest := contentDigest(data)
+
+	memoryLimitMB := spec.MemoryLimitMB
+	if memoryLimitMB <= 0 {
+		memoryLimitMB = domain.DefaultPluginWasmMemoryLimitMB
+	}
+	fuel := spec.FuelLimit
+	if fuel <= 0 {
+		fuel = domain.DefaultPluginWasmFuelLimit
+	}
+
+	cfg := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(uint32(memoryLimitMB * pagesPerMB)).
+		WithCloseOnContextDone(true)
+	rt := wazero.NewRuntimeWithConfig(ctx, cfg)
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+		_ = rt.Close(ctx)
+		return nil, fmt.Errorf("instantiate wasi: %w", err)
+	}
+
+	data, err = instrumentModule(data)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return nil, fmt.Errorf("instrument wasm module: %w", err)
+	}
+	compiled, err := rt.CompileModule(ctx, data)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return nil, fmt.Errorf("compile wasm module: %w", err)
+	}
+	if err := validateExports(spec, compiled); err != nil {
+		_ = rt.Close(ctx)
+		return nil, err
+	}
+
+	m := &Module{
+		spec:          spec,
+		logger:        logger,
+		runtime:       rt,
+		compiled:      compiled,
+		fuel:          fuel,
+		memoryLimitMB: memoryLimitMB,
+		digest:        digest,
+		output: &logWriter{logger: logger.With(
+			zap.String(telemetry.FieldLogSource, telemetry.LogSourceDownstream),
+			zap.String(telemetry.FieldLogStream, "stderr"),
+		)},
+	}
+
+	m.mu.Lock()
+	_, err = m.ensureInstance(ctx)
+	m.mu.Unlock()
+	if err != nil {
+		_ = rt.Close(ctx)
+		return nil, err
+	}
+	return m, nil
+}
+
+// ModulePath resolves the module path, relative paths being anchored at spec.Cwd.
+func ModulePath(spec domain.PluginSpec) string {
+	path := strings.TrimSpace(spec.Module)
+	if path == "" || filepath.IsAbs(path) || spec.Cwd == "" {
+		return path
+	}
+	return filepath.Join(spec.Cwd, path)
+}
+
+// Digest returns the SHA-256 of the module file as it was loaded.
+func (m *Module) Digest() string {
+	return m.digest
+}
+
+// FileDigest returns the SHA-256 of the module file spec currently points at,
+// so callers can tell a rebuilt module from the loaded one.
+func FileDigest(spec domain.PluginSpec) (string, error) {
+	data, err := os.ReadFile(ModulePath(spec))
+	if err != nil {
+		return "", fmt.Errorf("read wasm module: %w", err)
+	}
+	return contentDigest(data), nil
+}
+
+func contentDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Handle runs the governance request through the module.
+func (m *Module) Handle(ctx context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	flow := req.Flow
+	if flow == "" {
+		flow = domain.PluginFlowRequest
+	}
+	export := exportHandleRequest
+	if flow == domain.PluginFlowResponse {
+		export = exportHandleResponse
+	}
+
+	payload, err := json.Marshal(Request{
+		Flow:         string(flow),
+		Method:       req.Method,
+		Caller:       req.Caller,
+		Server:       req.Server,
+		ToolName:     req.ToolName,
+		ResourceURI:  req.ResourceURI,
+		PromptName:   req.PromptName,
+		RoutingKey:   req.RoutingKey,
+		RequestJSON:  req.RequestJSON,
+		ResponseJSON: req.ResponseJSON,
+		Metadata:     req.Metadata,
+	})
+	if err != nil {
+		return domain.GovernanceDecision{}, fmt.Errorf("encode wasm request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	inst, err := m.ensureInstance(ctx)
+	if err != nil {
+		return domain.GovernanceDecision{}, err
+	}
+
+	fuel, ok := inst.ExportedGlobal(fuelExport).(api.MutableGlobal)
+	if !ok {
+		return domain.GovernanceDecision{}, errors.New("wasm module fuel global missing")
+	}
+	growFailed, ok := inst.ExportedGlobal(growFailedExport).(api.MutableGlobal)
+	if !ok {
+		return domain.GovernanceDecision{}, errors.New("wasm module memory global missing")
+	}
+	fuel.Set(uint64(m.fuel))
+	growFailed.Set(0)
+
+	out, err := call(ctx, inst, export, payload)
+	if err != nil {
+		exhausted := int64(fuel.Get()) < 0
+		outOfMemory := growFailed.Get() != 0
+		m.discardInstance(ctx)
+		if exhausted {
+			return domain.GovernanceDecision{}, fmt.Errorf("%w after %d units", ErrFuelExhausted, m.fuel)
+		}
+		if outOfMemory {
+			err = fmt.Errorf("%w at %d MB: %w", ErrMemoryExhausted, m.memoryLimitMB, err)
+			m.publishOutOfMemory(err)
+		}
+		return domain.GovernanceDecision{}, err
+	}
+
+	var decision Decision
+	if err := json.Unmarshal(out, &decision); err != nil {
+		return domain.GovernanceDecision{}, fmt.Errorf("decode wasm decision: %w", err)
+	}
+	return domain.GovernanceDecision{
+		Continue:       decision.Continue,
+		RequestJSON:    decision.RequestJSON,
+		ResponseJSON:   decision.ResponseJSON,
+		RejectCode:     decision.RejectCode,
+		RejectMessage:  decision.RejectMessage,
+		Metadata:       decision.Metadata,
+		RemoveMetadata: decision.RemoveMetadata,
+	}, nil
+}
+
+// SetAlertPublisher attaches the publisher notified when a call runs out of
+// memory; nil detaches it.
+func (m *Module) SetAlertPublisher(alerts domain.AlertPublisher) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alerts = alerts
+}
+
+// publishOutOfMemory raises an instance_oom alert. Callers hold m.mu.
+func (m *Module) publishOutOfMemory(err error) {
+	if m.alerts == nil {
+		return
+	}
+	m.alerts.PublishAlert(domain.AlertEvent{
+		Type:    domain.AlertEventInstanceOOM,
+		Time:    time.Now(),
+		Message: fmt.Sprintf("plugin %s ran out of memory: %v", m.spec.Name, err),
+		Attributes: map[string]string{
+			"plugin":        m.spec.Name,
+			"category":      string(m.spec.Category),
+			"runtime":       string(domain.PluginRuntimeWasm),
+			"memoryLimitMb": strconv.Itoa(m.memoryLimitMB),
+		},
+	})
+}
+
+// Close releases the runtime and all compiled code.
+func (m *Module) Close(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	m.mu.Lock()
+	m.instance = nil
+	m.mu.Unlock()
+	return m.runtime.Close(ctx)
+}
+
+func (m *Module) ensureInstance(ctx context.Context) (api.Module, error) {
+	if m.instance != nil && !m.instance.IsClosed() {
+		return m.instance, nil
+	}
+	cfg := wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize").
+		WithStdout(m.output).
+		WithStderr(m.output)
+	inst, err := m.runtime.InstantiateModule(ctx, m.compiled, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate wasm module: %w", err)
+	}
+	if err := configure(ctx, inst, m.spec.ConfigJSON); err != nil {
+		_ = inst.Close(ctx)
+		return nil, err
+	}
+	m.instance = inst
+	return inst, nil
+}
+
+func (m *Module) discardInstance(ctx context.Context) {
+	if m.instance == nil {
+		return
+	}
+	_ = m.instance.Close(context.WithoutCancel(ctx))
+	m.instance = nil
+}
+
+func validateExports(spec domain.PluginSpec, compiled wazero.CompiledModule) error {
+	exports := compiled.ExportedFunctions()
+	required := []string{exportAlloc}
+	for _, flow := range spec.Flows {
+		switch flow {
+		case domain.PluginFlowRequest:
+			required = append(required, exportHandleRequest)
+		case domain.PluginFlowResponse:
+			required = append(required, exportHandleResponse)
+		}
+	}
+	if len(spec.Flows) == 0 {
+		required = append(required, exportHandleRequest, exportHandleResponse)
+	}
+	for _, name := range required {
+		if _, ok := exports[name]; !ok {
+			return fmt.Errorf("wasm module missing export %q", name)
+		}
+	}
+	return nil
+}
+
+func configure(ctx context.Context, inst api.Module, config json.RawMessage) error {
+	fn := inst.ExportedFunction(exportConfigure)
+	if fn == nil {
+		return nil
+	}
+	if len(config) == 0 {
+		config = json.RawMessage(`{}`)
+	}
+	ptr, err := writeBuffer(ctx, inst, config)
+	if err != nil {
+		return err
+	}
+	defer release(ctx, inst, ptr, uint32(len(config)))
+	results, err := fn.Call(ctx, uint64(ptr), uint64(len(config)))
+	if err != nil {
+		return fmt.Errorf("wasm configure: %w", err)
+	}
+	if len(results) > 0 && api.DecodeI32(results[0]) != 0 {
+		return fmt.Errorf("wasm configure returned %d", api.DecodeI32(results[0]))
+	}
+	return nil
+}
+
+func call(ctx context.Context, inst api.Module, export string, payload []byte) ([]byte, error) {
+	fn := inst.ExportedFunction(export)
+	if fn == nil {
+		return nil, fmt.Errorf("wasm module missing export %q", export)
+	}
+	ptr, err := writeBuffer(ctx, inst, payload)
+	if err != nil {
+		return nil, err
+	}
+	results, err := fn.Call(ctx, uint64(ptr), uint64(len(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("wasm %s: %w", export, err)
+	}
+	release(ctx, inst, ptr, uint32(len(payload)))
+	if len(results) == 0 {
+		return nil, fmt.Errorf("wasm %s returned no result", export)
+	}
+
+	outPtr := uint32(results[0] >> 32)
+	outLen := uint32(results[0])
+	view, ok := inst.Memory().Read(outPtr, outLen)
+	if !ok {
+		return nil, fmt.Errorf("wasm %s returned out of range buffer", export)
+	}
+	out := append([]byte(nil), view...)
+	release(ctx, inst, outPtr, outLen)
+	return out, nil
+}
+
+func writeBuffer(ctx context.Context, inst api.Module, data []byte) (uint32, error) {
+	results, err := inst.ExportedFunction(exportAlloc).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("wasm alloc: %w", err)
+	}
+	ptr := api.DecodeU32(results[0])
+	if !inst.Memory().Write(ptr, data) {
+		return 0, errors.New("wasm alloc returned out of range buffer")
+	}
+	return ptr, nil
+}
+
+func release(ctx context.Context, inst api.Module, ptr, size uint32) {
+	fn := inst.ExportedFunction(exportDealloc)
+	if fn == nil {
+		return
+	}
+	_, _ = fn.Call(ctx, uint64(ptr), uint64(size))
+}
+
+type logWriter struct {
+	logger *zap.Logger
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\r\n"), "\n") {
+		if line = strings.TrimRight(line, "\r"); line != "" {
+			w.logger.Info(line)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,218 @@
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+)
+
+func TestModule_HandleRequestAndResponse(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{
+		ConfigJSON: json.RawMessage(`{"blockTool":"delete_repo"}`),
+	})
+
+	decision, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "list_repos",
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+
+	decision, err = module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "delete_repo",
+	})
+	require.NoError(t, err)
+	require.False(t, decision.Continue)
+	require.Equal(t, "blocked", decision.RejectCode)
+	require.Equal(t, "tool blocked", decision.RejectMessage)
+
+	decision, err = module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:         domain.PluginFlowResponse,
+		Method:       "tools/call",
+		ResponseJSON: json.RawMessage(`{"content":[]}`),
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+	require.JSONEq(t, `{"wasm":true}`, string(decision.ResponseJSON))
+}
+
+func TestModule_FuelExhaustionRecreatesInstance(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{
+		FuelLimit:  100_000,
+		ConfigJSON: json.RawMessage(`{"blockTool":"delete_repo"}`),
+	})
+
+	_, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "burn",
+	})
+	require.ErrorIs(t, err, ErrFuelExhausted)
+
+	decision, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "delete_repo",
+	})
+	require.NoError(t, err)
+	require.False(t, decision.Continue, "configuration must be reapplied to the new instance")
+}
+
+func TestModule_FuelBoundsLoopsWithoutCalls(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{FuelLimit: 1_000_000})
+
+	start := time.Now()
+	_, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "spin",
+	})
+	require.ErrorIs(t, err, ErrFuelExhausted)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	decision, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "list_repos",
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+}
+
+func TestModule_MemoryExhaustionPublishesAlert(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{MemoryLimitMB: 64})
+	alerts := &recordingAlerts{}
+	module.SetAlertPublisher(alerts)
+
+	_, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "hoard",
+	})
+	require.ErrorIs(t, err, ErrMemoryExhausted)
+	require.Len(t, alerts.events, 1)
+	require.Equal(t, domain.AlertEventInstanceOOM, alerts.events[0].Type)
+	require.Equal(t, "test-wasm", alerts.events[0].Attributes["plugin"])
+	require.Equal(t, "64", alerts.events[0].Attributes["memoryLimitMb"])
+
+	decision, err := module.Handle(context.Background(), domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "list_repos",
+	})
+	require.NoError(t, err)
+	require.True(t, decision.Continue)
+}
+
+func TestInstrumentModule_RejectsNonWasm(t *testing.T) {
+	_, err := instrumentModule([]byte("not wasm"))
+	require.Error(t, err)
+}
+
+func TestModule_TimeoutStopsExecution(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{FuelLimit: 1 << 40})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := module.Handle(ctx, domain.GovernanceRequest{
+		Flow:     domain.PluginFlowRequest,
+		Method:   "tools/call",
+		ToolName: "burn",
+	})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrFuelExhausted)
+}
+
+func TestLoad_MemoryLimit(t *testing.T) {
+	path := buildTestModule(t)
+	_, err := Load(context.Background(), domain.PluginSpec{
+		Name:          "tiny",
+		Runtime:       domain.PluginRuntimeWasm,
+		Module:        path,
+		MemoryLimitMB: 1,
+	}, zap.NewNop())
+	require.Error(t, err)
+}
+
+func TestLoad_MissingExports(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.wasm")
+	// Minimal valid module: magic + version, no sections.
+	require.NoError(t, os.WriteFile(path, []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, 0o600))
+
+	_, err := Load(context.Background(), domain.PluginSpec{
+		Name:    "empty",
+		Runtime: domain.PluginRuntimeWasm,
+		Module:  path,
+	}, zap.NewNop())
+	require.ErrorContains(t, err, `missing export "alloc"`)
+}
+
+func TestFileDigest_TracksModuleContent(t *testing.T) {
+	module := loadTestModule(t, domain.PluginSpec{})
+
+	digest, err := FileDigest(module.spec)
+	require.NoError(t, err)
+	require.Equal(t, module.Digest(), digest)
+
+	f, err := os.OpenFile(ModulePath(module.spec), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x00})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	digest, err = FileDigest(module.spec)
+	require.NoError(t, err)
+	require.NotEqual(t, module.Digest(), digest)
+}
+
+func TestModulePath(t *testing.T) {
+	require.Equal(t, "/abs/p.wasm", ModulePath(domain.PluginSpec{Module: "/abs/p.wasm", Cwd: "/work"}))
+	require.Equal(t, filepath.Join("/work", "p.wasm"), ModulePath(domain.PluginSpec{Module: "p.wasm", Cwd: "/work"}))
+	require.Equal(t, "p.wasm", ModulePath(domain.PluginSpec{Module: "p.wasm"}))
+}
+
+func loadTestModule(t *testing.T, spec domain.PluginSpec) *Module {
+	t.Helper()
+	spec.Name = "test-wasm"
+	spec.Category = domain.PluginCategoryAuthorization
+	spec.Runtime = domain.PluginRuntimeWasm
+	spec.Module = buildTestModule(t)
+	module, err := Load(context.Background(), spec, zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = module.Close(context.Background()) })
+	return module
+}
+
+func buildTestModule(t *testing.T) string {
+	t.Helper()
+	binPath := filepath.Join(t.TempDir(), "plugin.wasm")
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "go", "build", "-buildmode=c-shared", "-o", binPath, "./internal/infra/plugin/testdata/wasmplugin")
+	cmd.Dir = filepath.Join("..", "..", "..", "..")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	output, err := cmd.CombinedOutput()
+	require.NoErrorf(t, err, "build wasm plugin: %s", string(output))
+	return binPath
+}
+
+type recordingAlerts struct {
+	events []domain.AlertEvent
+}
+
+func (r *recordingAlerts) PublishAlert(event domain.AlertEvent) {
+	r.events = append(r.events, event)
+}
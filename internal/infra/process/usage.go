@@ -0,0 +1,18 @@
+package process
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUsageUnsupported reports that resource sampling is not available on this platform.
+var ErrUsageUnsupported = errors.New("process usage sampling is not supported on this platform")
+
+// Usage is the summed resource footprint of the processes in one process group.
+type Usage struct {
+	Processes int
+	CPUTime   time.Duration
+	RSSBytes  int64
+	OpenFDs   int
+	Threads   int
+}
@@ -0,0 +1,116 @@
+//go:build linux
+
+package process
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicks is USER_HZ, the unit of the CPU times in /proc/<pid>/stat.
+// It is 100 on every Linux architecture Go supports.
+const clockTicks = 100
+
+const procRoot = "/proc"
+
+// GroupUsage samples the resource usage of the given process groups from
+// /proc in a single pass. Groups without live processes are left out of the
+// result. Descriptors of processes owned by other users are not counted.
+func GroupUsage(pgids []int) (map[int]Usage, error) {
+	if len(pgids) == 0 {
+		return map[int]Usage{}, nil
+	}
+	wanted := make(map[int]struct{}, len(pgids))
+	for _, pgid := range pgids {
+		if pgid > 0 {
+			wanted[pgid] = struct{}{}
+		}
+	}
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", procRoot, err)
+	}
+	pageSize := int64(os.Getpagesize())
+	result := make(map[int]Usage, len(wanted))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		dir := filepath.Join(procRoot, entry.Name())
+		data, err := os.ReadFile(filepath.Join(dir, "stat"))
+		if err != nil {
+			// The process exited between listing and reading.
+			continue
+		}
+		stat, err := parseStat(string(data))
+		if err != nil {
+			continue
+		}
+		if _, ok := wanted[stat.pgrp]; !ok {
+			continue
+		}
+		usage := result[stat.pgrp]
+		usage.Processes++
+		usage.CPUTime += ticksToDuration(stat.utime + stat.stime)
+		usage.RSSBytes += stat.rssPages * pageSize
+		usage.Threads += stat.threads
+		if fds, err := os.ReadDir(filepath.Join(dir, "fd")); err == nil {
+			usage.OpenFDs += len(fds)
+		}
+		result[stat.pgrp] = usage
+	}
+	return result, nil
+}
+
+type procStat struct {
+	pgrp     int
+	utime    int64
+	stime    int64
+	threads  int
+	rssPages int64
+}
+
+// parseStat parses the fields of /proc/<pid>/stat used for usage sampling.
+// The command name may contain spaces and parentheses, so fields are counted
+// from the last closing parenthesis.
+func parseStat(line string) (procStat, error) {
+	end := strings.LastIndexByte(line, ')')
+	if end < 0 {
+		return procStat{}, errors.New("malformed stat: missing command")
+	}
+	// fields[0] is the state, field 3 in proc(5).
+	fields := strings.Fields(line[end+1:])
+	if len(fields) < 22 {
+		return procStat{}, fmt.Errorf("malformed stat: %d fields", len(fields))
+	}
+	var stat procStat
+	var err error
+	if stat.pgrp, err = strconv.Atoi(fields[2]); err != nil {
+		return procStat{}, fmt.Errorf("parse pgrp: %w", err)
+	}
+	if stat.utime, err = strconv.ParseInt(fields[11], 10, 64); err != nil {
+		return procStat{}, fmt.Errorf("parse utime: %w", err)
+	}
+	if stat.stime, err = strconv.ParseInt(fields[12], 10, 64); err != nil {
+		return procStat{}, fmt.Errorf("parse stime: %w", err)
+	}
+	if stat.threads, err = strconv.Atoi(fields[17]); err != nil {
+		return procStat{}, fmt.Errorf("parse num_threads: %w", err)
+	}
+	if stat.rssPages, err = strconv.ParseInt(fields[21], 10, 64); err != nil {
+		return procStat{}, fmt.Errorf("parse rss: %w", err)
+	}
+	return stat, nil
+}
+
+func ticksToDuration(ticks int64) time.Duration {
+	return time.Duration(ticks) * time.Second / clockTicks
+}
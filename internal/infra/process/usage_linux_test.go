@@ -0,0 +1,43 @@
+//go:build linux
+
+package process
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStat(t *testing.T) {
+	line := "4242 (node (worker) x) S 1 4242 4242 0 -1 4194560 1500 0 0 0 250 50 0 0 20 0 7 0 123456 987654321 2048 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 3 0 0 0 0 0"
+
+	stat, err := parseStat(line)
+	require.NoError(t, err)
+	assert.Equal(t, 4242, stat.pgrp)
+	assert.Equal(t, int64(250), stat.utime)
+	assert.Equal(t, int64(50), stat.stime)
+	assert.Equal(t, 7, stat.threads)
+	assert.Equal(t, int64(2048), stat.rssPages)
+	assert.Equal(t, 3*time.Second, ticksToDuration(stat.utime+stat.stime))
+
+	_, err = parseStat("4242 node S 1")
+	assert.Error(t, err)
+}
+
+func TestGroupUsage_CurrentGroup(t *testing.T) {
+	pgid, err := syscall.Getpgid(os.Getpid())
+	require.NoError(t, err)
+
+	usage, err := GroupUsage([]int{pgid, -1})
+	require.NoError(t, err)
+	require.Contains(t, usage, pgid)
+	got := usage[pgid]
+	assert.GreaterOrEqual(t, got.Processes, 1)
+	assert.Positive(t, got.RSSBytes)
+	assert.Positive(t, got.OpenFDs)
+	assert.Positive(t, got.Threads)
+}
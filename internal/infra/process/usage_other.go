@@ -0,0 +1,8 @@
+//go:build !linux
+
+package process
+
+// GroupUsage samples the resource usage of the given process groups.
+func GroupUsage(_ []int) (map[int]Usage, error) {
+	return nil, ErrUsageUnsupported
+}
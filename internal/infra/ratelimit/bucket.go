@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+
+	"mcpv/internal/domain"
+)
+
+// bucket is a token bucket refilled continuously at the rule's rate.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func newBucket(rule domain.RateLimitRule, now time.Time) *bucket {
+	return &bucket{tokens: float64(rule.Burst), updated: now}
+}
+
+func refillRate(rule domain.RateLimitRule) float64 {
+	if rule.PeriodSeconds <= 0 {
+		return 0
+	}
+	return float64(rule.Rate) / float64(rule.PeriodSeconds)
+}
+
+// refill adds the tokens accrued since the last update.
+func (b *bucket) refill(rule domain.RateLimitRule, now time.Time) {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(rule.Burst), b.tokens+elapsed*refillRate(rule))
+	}
+	b.updated = now
+}
+
+// wait reports how long until one token is available; zero means a token can be taken now.
+func (b *bucket) wait(rule domain.RateLimitRule) time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	rate := refillRate(rule)
+	if rate <= 0 {
+		return time.Duration(rule.PeriodSeconds) * time.Second
+	}
+	return time.Duration((1 - b.tokens) / rate * float64(time.Second))
+}
@@ -0,0 +1,4 @@
+package ratelimit
+
+// Package ratelimit implements the built-in governance policy that enforces
+// per-caller token bucket rate limits and persisted daily quotas on tool calls.
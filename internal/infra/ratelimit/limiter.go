@@ -0,0 +1,495 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+)
+
+// PluginName identifies the built-in policy in decisions and metrics.
+const PluginName = "builtin-ratelimit"
+
+const (
+	// RejectCodeRateLimited is returned when a token bucket is empty.
+	RejectCodeRateLimited = "rate_limited"
+	// RejectCodeQuotaExceeded is returned when a daily quota is used up.
+	RejectCodeQuotaExceeded = "quota_exceeded"
+)
+
+const (
+	anonymousKey         = "anonymous"
+	defaultFlushInterval = 10 * time.Second
+	sweepInterval        = time.Minute
+	// maxReportedKeysPerRule caps the keys of one rule exported as metric
+	// series. Keys beyond it are enforced but not reported.
+	maxReportedKeysPerRule = 100
+)
+
+// TagResolver returns the tags of a registered caller.
+type TagResolver func(caller string) ([]string, error)
+
+// ToolResolver maps a public tool name to its server and upstream tool name.
+type ToolResolver func(name string) (server, tool string, ok bool)
+
+// Options configures a Limiter.
+type Options struct {
+	Config        domain.RateLimitConfig
+	Tags          TagResolver
+	Tools         ToolResolver
+	Metrics       domain.Metrics
+	Logger        *zap.Logger
+	Now           func() time.Time
+	FlushInterval time.Duration
+}
+
+type bucketKey struct {
+	rule string
+	key  string
+}
+
+// Limiter enforces rate limit rules on tool calls. It implements governance.Policy.
+type Limiter struct {
+	rules         []domain.RateLimitRule
+	needsTags     bool
+	tags          TagResolver
+	tools         ToolResolver
+	metrics       domain.Metrics
+	logger        *zap.Logger
+	now           func() time.Time
+	flushInterval time.Duration
+
+	mu       sync.Mutex
+	buckets  map[bucketKey]*bucket
+	quotas   *quotaStore
+	reported map[string]map[string]struct{}
+}
+
+// NewLimiter loads persisted quota usage and prepares the configured rules.
+func NewLimiter(opts Options) (*Limiter, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	flushInterval := opts.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	quotas, err := loadQuotaStore(opts.Config.StatePath, now())
+	if err != nil {
+		return nil, err
+	}
+	l := &Limiter{
+		rules:         append([]domain.RateLimitRule(nil), opts.Config.Rules...),
+		tags:          opts.Tags,
+		tools:         opts.Tools,
+		metrics:       opts.Metrics,
+		logger:        logger.Named("ratelimit"),
+		now:           now,
+		flushInterval: flushInterval,
+		buckets:       make(map[bucketKey]*bucket),
+		quotas:        quotas,
+		reported:      make(map[string]map[string]struct{}),
+	}
+	for _, rule := range l.rules {
+		if rule.Tag != "" {
+			l.needsTags = true
+		}
+	}
+	return l, nil
+}
+
+// Run periodically persists quota usage and sweeps idle keys until ctx is
+// done.
+func (l *Limiter) Run(ctx context.Context) {
+	if l == nil || len(l.rules) == 0 {
+		return
+	}
+	sweep := time.NewTicker(sweepInterval)
+	defer sweep.Stop()
+	var flush <-chan time.Time
+	if l.quotas.path != "" {
+		ticker := time.NewTicker(l.flushInterval)
+		defer ticker.Stop()
+		flush = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-flush:
+			if err := l.Flush(); err != nil {
+				l.logger.Warn("quota state flush failed", zap.Error(err))
+			}
+		case <-sweep.C:
+			l.sweep()
+		}
+	}
+}
+
+// sweep evicts buckets that refilled to their burst, which a new bucket
+// matches, clears quota usage of past days and drops the metric series of
+// keys that are no longer tracked.
+func (l *Limiter) sweep() {
+	rules := make(map[string]domain.RateLimitRule, len(l.rules))
+	for _, rule := range l.rules {
+		rules[rule.Name] = rule
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	l.quotas.rollover(now)
+	for id, b := range l.buckets {
+		rule := rules[id.rule]
+		b.refill(rule, now)
+		if b.tokens >= float64(rule.Burst) {
+			delete(l.buckets, id)
+		}
+	}
+	var stale []bucketKey
+	for rule, keys := range l.reported {
+		for key := range keys {
+			id := bucketKey{rule: rule, key: key}
+			if _, ok := l.buckets[id]; ok || l.quotas.get(rule, key) > 0 {
+				continue
+			}
+			delete(keys, key)
+			stale = append(stale, id)
+		}
+	}
+	l.mu.Unlock()
+
+	if l.metrics != nil {
+		for _, id := range stale {
+			l.metrics.DeleteRateLimitState(id.rule, id.key)
+		}
+	}
+}
+
+// reportLocked reports whether the key of a state may be exported, admitting
+// it while the rule has fewer than maxReportedKeysPerRule keys.
+func (l *Limiter) reportLocked(state domain.RateLimitStateMetric) bool {
+	keys, ok := l.reported[state.Rule]
+	if !ok {
+		keys = make(map[string]struct{})
+		l.reported[state.Rule] = keys
+	}
+	if _, ok := keys[state.Key]; ok {
+		return true
+	}
+	if len(keys) >= maxReportedKeysPerRule {
+		return false
+	}
+	keys[state.Key] = struct{}{}
+	return true
+}
+
+// Flush writes quota usage to the state file.
+func (l *Limiter) Flush() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.quotas.flush()
+}
+
+// Close persists quota usage.
+func (l *Limiter) Close() error {
+	return l.Flush()
+}
+
+// Request admits or rejects a tool call. All matching rules must admit the
+// call before any bucket or quota is charged.
+func (l *Limiter) Request(_ context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	if l == nil || len(l.rules) == 0 || req.Method != "tools/call" {
+		return domain.GovernanceDecision{Continue: true}, nil
+	}
+
+	call := l.describe(req)
+	matched := make([]domain.RateLimitRule, 0, len(l.rules))
+	for _, rule := range l.rules {
+		if call.matches(rule) {
+			matched = append(matched, rule)
+		}
+	}
+	if len(matched) == 0 {
+		return domain.GovernanceDecision{Continue: true}, nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	l.quotas.rollover(now)
+	for _, rule := range matched {
+		key := keyFor(rule, req.Caller)
+		if rule.DailyQuota > 0 && l.quotas.get(rule.Name, key) >= rule.DailyQuota {
+			l.mu.Unlock()
+			retry := nextReset(now).Sub(now)
+			return l.reject(rule, key, RejectCodeQuotaExceeded,
+				fmt.Sprintf("daily quota %q of %d calls exceeded", rule.Name, rule.DailyQuota), retry), nil
+		}
+		if rule.Rate > 0 {
+			b := l.bucketLocked(rule, key, now)
+			if wait := b.wait(rule); wait > 0 {
+				l.mu.Unlock()
+				return l.reject(rule, key, RejectCodeRateLimited,
+					fmt.Sprintf("rate limit %q of %d calls per %s exceeded", rule.Name, rule.Rate, periodName(rule.PeriodSeconds)), wait), nil
+			}
+		}
+	}
+
+	states := make([]domain.RateLimitStateMetric, 0, len(matched))
+	for _, rule := range matched {
+		key := keyFor(rule, req.Caller)
+		state := domain.RateLimitStateMetric{Rule: rule.Name, Key: key, QuotaLimit: rule.DailyQuota}
+		if rule.Rate > 0 {
+			b := l.bucketLocked(rule, key, now)
+			b.tokens--
+			state.Tokens = b.tokens
+		}
+		if rule.DailyQuota > 0 {
+			state.QuotaUsed = l.quotas.add(rule.Name, key)
+		}
+		if l.reportLocked(state) {
+			states = append(states, state)
+		}
+	}
+	l.mu.Unlock()
+
+	if l.metrics != nil {
+		for _, state := range states {
+			l.metrics.SetRateLimitState(state)
+		}
+	}
+	return domain.GovernanceDecision{Continue: true}, nil
+}
+
+// Response passes responses through unchanged.
+func (l *Limiter) Response(_ context.Context, _ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	return domain.GovernanceDecision{Continue: true}, nil
+}
+
+// GetQuotaStatus reports the state of every rule that applies to caller, or of
+// every tracked key when caller is empty.
+func (l *Limiter) GetQuotaStatus(_ context.Context, caller string) ([]domain.QuotaStatus, error) {
+	if l == nil {
+		return nil, nil
+	}
+	var call callInfo
+	if caller != "" {
+		call = callInfo{caller: caller, tags: l.resolveTags(caller)}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.quotas.rollover(now)
+
+	var statuses []domain.QuotaStatus
+	for _, rule := range l.rules {
+		var keys []string
+		switch {
+		case caller != "":
+			if !call.matchesCaller(rule) {
+				continue
+			}
+			keys = []string{keyFor(rule, caller)}
+		case rule.Scope == domain.RateLimitScopeShared:
+			keys = []string{""}
+		default:
+			keys = l.trackedKeysLocked(rule.Name)
+		}
+		for _, key := range keys {
+			statuses = append(statuses, l.statusLocked(rule, key, now))
+		}
+	}
+	return statuses, nil
+}
+
+func (l *Limiter) statusLocked(rule domain.RateLimitRule, key string, now time.Time) domain.QuotaStatus {
+	status := domain.QuotaStatus{
+		Rule:          rule.Name,
+		Key:           key,
+		Scope:         rule.Scope,
+		Rate:          rule.Rate,
+		PeriodSeconds: rule.PeriodSeconds,
+		Burst:         rule.Burst,
+		DailyQuota:    rule.DailyQuota,
+	}
+	if rule.Rate > 0 {
+		status.TokensAvailable = float64(rule.Burst)
+		if b, ok := l.buckets[bucketKey{rule: rule.Name, key: key}]; ok {
+			b.refill(rule, now)
+			status.TokensAvailable = b.tokens
+		}
+	}
+	if rule.DailyQuota > 0 {
+		status.QuotaUsed = l.quotas.get(rule.Name, key)
+		status.QuotaRemaining = max(rule.DailyQuota-status.QuotaUsed, 0)
+		status.ResetAt = nextReset(now)
+	}
+	return status
+}
+
+func (l *Limiter) trackedKeysLocked(rule string) []string {
+	seen := make(map[string]struct{})
+	for key := range l.buckets {
+		if key.rule == rule {
+			seen[key.key] = struct{}{}
+		}
+	}
+	for key := range l.quotas.used[rule] {
+		seen[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (l *Limiter) bucketLocked(rule domain.RateLimitRule, key string, now time.Time) *bucket {
+	id := bucketKey{rule: rule.Name, key: key}
+	b, ok := l.buckets[id]
+	if !ok {
+		b = newBucket(rule, now)
+		l.buckets[id] = b
+		return b
+	}
+	b.refill(rule, now)
+	return b
+}
+
+func (l *Limiter) reject(rule domain.RateLimitRule, key, code, message string, retry time.Duration) domain.GovernanceDecision {
+	retry = time.Duration(math.Ceil(retry.Seconds())) * time.Second
+	if l.metrics != nil {
+		l.metrics.RecordGovernanceRejection(domain.GovernanceRejectionMetric{
+			Category: domain.PluginCategoryRateLimiting,
+			Plugin:   PluginName,
+			Flow:     domain.PluginFlowRequest,
+			Code:     code,
+		})
+	}
+	l.logger.Debug("tool call rejected",
+		zap.String("rule", rule.Name),
+		zap.String("key", key),
+		zap.String("code", code),
+		zap.Duration("retryAfter", retry),
+	)
+	return domain.GovernanceDecision{
+		Category:      domain.PluginCategoryRateLimiting,
+		Plugin:        PluginName,
+		Continue:      false,
+		RejectCode:    code,
+		RejectMessage: fmt.Sprintf("%s; retry after %s", message, retry),
+		RetryAfter:    retry,
+	}
+}
+
+func (l *Limiter) describe(req domain.GovernanceRequest) callInfo {
+	call := callInfo{
+		caller: req.Caller,
+		server: req.Server,
+		tool:   req.ToolName,
+		public: req.ToolName,
+	}
+	if l.tools != nil {
+		if server, tool, ok := l.tools(req.ToolName); ok {
+			call.server = server
+			call.tool = tool
+		}
+	}
+	if l.needsTags {
+		call.tags = l.resolveTags(req.Caller)
+	}
+	return call
+}
+
+func (l *Limiter) resolveTags(caller string) []string {
+	if l.tags == nil || caller == "" {
+		return nil
+	}
+	tags, err := l.tags(caller)
+	if err != nil {
+		return nil
+	}
+	return tags
+}
+
+// callInfo holds the selector inputs for one call.
+type callInfo struct {
+	caller string
+	tags   []string
+	server string
+	tool   string
+	public string
+}
+
+func (c callInfo) matches(rule domain.RateLimitRule) bool {
+	if !c.matchesCaller(rule) {
+		return false
+	}
+	if rule.Server != "" && !matchPattern(rule.Server, c.server) {
+		return false
+	}
+	if rule.Tool != "" && !matchPattern(rule.Tool, c.tool) && !matchPattern(rule.Tool, c.public) {
+		return false
+	}
+	return true
+}
+
+func (c callInfo) matchesCaller(rule domain.RateLimitRule) bool {
+	if rule.Caller != "" && !matchPattern(rule.Caller, c.caller) {
+		return false
+	}
+	if rule.Tag != "" {
+		for _, tag := range c.tags {
+			if tag == rule.Tag {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchPattern(pattern, value string) bool {
+	if value == "" {
+		return false
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+func keyFor(rule domain.RateLimitRule, caller string) string {
+	if rule.Scope == domain.RateLimitScopeShared {
+		return ""
+	}
+	if caller == "" {
+		return anonymousKey
+	}
+	return caller
+}
+
+func periodName(seconds int) string {
+	switch seconds {
+	case 1:
+		return "second"
+	case 3600:
+		return "hour"
+	default:
+		return "minute"
+	}
+}
@@ -0,0 +1,224 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(t *testing.T, clock *fakeClock, cfg domain.RateLimitConfig) *Limiter {
+	t.Helper()
+	limiter, err := NewLimiter(Options{
+		Config: cfg,
+		Tags: func(caller string) ([]string, error) {
+			if caller == "batch-runner" {
+				return []string{"batch"}, nil
+			}
+			return nil, nil
+		},
+		Tools: func(name string) (string, string, bool) {
+			switch name {
+			case "github.create_issue":
+				return "github", "create_issue", true
+			case "fs.read":
+				return "fs", "read", true
+			}
+			return "", "", false
+		},
+		Now: clock.Now,
+	})
+	require.NoError(t, err)
+	return limiter
+}
+
+func callTool(t *testing.T, limiter *Limiter, caller, tool string) domain.GovernanceDecision {
+	t.Helper()
+	decision, err := limiter.Request(context.Background(), domain.GovernanceRequest{
+		Method:   "tools/call",
+		Caller:   caller,
+		ToolName: tool,
+	})
+	require.NoError(t, err)
+	return decision
+}
+
+func TestLimiter_TokenBucketPerCaller(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newTestLimiter(t, clock, domain.RateLimitConfig{
+		Enabled: true,
+		Rules: []domain.RateLimitRule{{
+			Name:          "cursor-github",
+			Caller:        "cursor*",
+			Server:        "github",
+			Rate:          2,
+			PeriodSeconds: 60,
+			Burst:         2,
+			Scope:         domain.RateLimitScopeCaller,
+		}},
+	})
+
+	assert.True(t, callTool(t, limiter, "cursor-1", "github.create_issue").Continue)
+	assert.True(t, callTool(t, limiter, "cursor-1", "github.create_issue").Continue)
+
+	rejected := callTool(t, limiter, "cursor-1", "github.create_issue")
+	assert.False(t, rejected.Continue)
+	assert.Equal(t, RejectCodeRateLimited, rejected.RejectCode)
+	assert.Equal(t, domain.PluginCategoryRateLimiting, rejected.Category)
+	assert.Equal(t, 30*time.Second, rejected.RetryAfter)
+
+	// Other callers, servers and unmatched callers have their own budget.
+	assert.True(t, callTool(t, limiter, "cursor-2", "github.create_issue").Continue)
+	assert.True(t, callTool(t, limiter, "cursor-1", "fs.read").Continue)
+	assert.True(t, callTool(t, limiter, "claude", "github.create_issue").Continue)
+
+	clock.Advance(30 * time.Second)
+	assert.True(t, callTool(t, limiter, "cursor-1", "github.create_issue").Continue)
+}
+
+func TestLimiter_RejectionDoesNotChargeOtherRules(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newTestLimiter(t, clock, domain.RateLimitConfig{
+		Enabled: true,
+		Rules: []domain.RateLimitRule{
+			{Name: "wide", Rate: 10, PeriodSeconds: 60, Burst: 10, Scope: domain.RateLimitScopeCaller},
+			{Name: "narrow", Tool: "create_issue", Rate: 1, PeriodSeconds: 60, Burst: 1, Scope: domain.RateLimitScopeCaller},
+		},
+	})
+
+	assert.True(t, callTool(t, limiter, "a", "github.create_issue").Continue)
+	assert.False(t, callTool(t, limiter, "a", "github.create_issue").Continue)
+
+	statuses, err := limiter.GetQuotaStatus(context.Background(), "a")
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "wide", statuses[0].Rule)
+	assert.InDelta(t, 9, statuses[0].TokensAvailable, 0.001)
+	assert.InDelta(t, 0, statuses[1].TokensAvailable, 0.001)
+}
+
+func TestLimiter_SweepEvictsIdleKeys(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)}
+	registry := prometheus.NewRegistry()
+	limiter, err := NewLimiter(Options{
+		Config: domain.RateLimitConfig{
+			Enabled: true,
+			Rules: []domain.RateLimitRule{
+				{Name: "burst", Rate: 1, PeriodSeconds: 60, Burst: 1, Scope: domain.RateLimitScopeCaller},
+				{Name: "daily", DailyQuota: 5, Scope: domain.RateLimitScopeCaller},
+			},
+		},
+		Metrics: telemetry.NewPrometheusMetrics(registry),
+		Now:     clock.Now,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, callTool(t, limiter, "a", "search").Continue)
+	assert.True(t, callTool(t, limiter, "b", "search").Continue)
+	require.Len(t, limiter.buckets, 2)
+	require.Equal(t, 4, testutil.CollectAndCount(registry, "mcpv_ratelimit_tokens"))
+
+	// Refilled buckets are dropped with their series; quota usage keeps the
+	// daily rule's series.
+	clock.Advance(time.Minute)
+	limiter.sweep()
+	require.Empty(t, limiter.buckets)
+	require.Equal(t, 2, testutil.CollectAndCount(registry, "mcpv_ratelimit_tokens"))
+	require.Equal(t, 2, testutil.CollectAndCount(registry, "mcpv_quota_used"))
+
+	// The next UTC day clears quota usage and the series with it.
+	clock.Advance(time.Hour)
+	limiter.sweep()
+	require.Zero(t, testutil.CollectAndCount(registry, "mcpv_ratelimit_tokens"))
+	require.Zero(t, testutil.CollectAndCount(registry, "mcpv_quota_used"))
+}
+
+func TestLimiter_CapsReportedKeysPerRule(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	registry := prometheus.NewRegistry()
+	limiter, err := NewLimiter(Options{
+		Config: domain.RateLimitConfig{
+			Enabled: true,
+			Rules:   []domain.RateLimitRule{{Name: "burst", Rate: 1, PeriodSeconds: 60, Burst: 1, Scope: domain.RateLimitScopeCaller}},
+		},
+		Metrics: telemetry.NewPrometheusMetrics(registry),
+		Now:     clock.Now,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i <= maxReportedKeysPerRule; i++ {
+		assert.True(t, callTool(t, limiter, fmt.Sprintf("caller-%d", i), "search").Continue)
+	}
+	require.Equal(t, maxReportedKeysPerRule, testutil.CollectAndCount(registry, "mcpv_ratelimit_tokens"))
+}
+
+func TestLimiter_DailyQuotaPersistsAcrossRestarts(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)}
+	cfg := domain.RateLimitConfig{
+		Enabled:   true,
+		StatePath: filepath.Join(t.TempDir(), "quota.json"),
+		Rules: []domain.RateLimitRule{{
+			Name:       "batch-daily",
+			Tag:        "batch",
+			DailyQuota: 2,
+			Scope:      domain.RateLimitScopeShared,
+		}},
+	}
+
+	limiter := newTestLimiter(t, clock, cfg)
+	assert.True(t, callTool(t, limiter, "batch-runner", "fs.read").Continue)
+	assert.True(t, callTool(t, limiter, "claude", "fs.read").Continue, "untagged caller is not limited")
+	require.NoError(t, limiter.Close())
+
+	restarted := newTestLimiter(t, clock, cfg)
+	assert.True(t, callTool(t, restarted, "batch-runner", "fs.read").Continue)
+	rejected := callTool(t, restarted, "batch-runner", "fs.read")
+	assert.False(t, rejected.Continue)
+	assert.Equal(t, RejectCodeQuotaExceeded, rejected.RejectCode)
+	assert.Equal(t, 2*time.Hour, rejected.RetryAfter)
+
+	statuses, err := restarted.GetQuotaStatus(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, 2, statuses[0].QuotaUsed)
+	assert.Equal(t, 0, statuses[0].QuotaRemaining)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), statuses[0].ResetAt)
+
+	clock.Advance(2 * time.Hour)
+	assert.True(t, callTool(t, restarted, "batch-runner", "fs.read").Continue)
+}
+
+func TestLimiter_IgnoresNonToolCalls(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	limiter := newTestLimiter(t, clock, domain.RateLimitConfig{
+		Enabled: true,
+		Rules:   []domain.RateLimitRule{{Name: "all", Rate: 1, PeriodSeconds: 60, Burst: 1}},
+	})
+
+	for range 3 {
+		decision, err := limiter.Request(context.Background(), domain.GovernanceRequest{Method: "tools/list", Caller: "a"})
+		require.NoError(t, err)
+		assert.True(t, decision.Continue)
+	}
+}
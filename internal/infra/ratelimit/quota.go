@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const quotaDayLayout = "2006-01-02"
+
+// quotaFile is the on-disk representation of daily quota usage.
+type quotaFile struct {
+	Day  string                    `json:"day"`
+	Used map[string]map[string]int `json:"used"`
+}
+
+// quotaStore counts calls per rule and key for the current UTC day.
+type quotaStore struct {
+	path  string
+	day   string
+	used  map[string]map[string]int
+	dirty bool
+}
+
+func loadQuotaStore(path string, now time.Time) (*quotaStore, error) {
+	store := &quotaStore{path: path, day: quotaDay(now), used: make(map[string]map[string]int)}
+	if path == "" {
+		return store, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read quota state: %w", err)
+	}
+	var file quotaFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decode quota state %s: %w", path, err)
+	}
+	if file.Day == store.day && file.Used != nil {
+		store.used = file.Used
+	}
+	return store, nil
+}
+
+func quotaDay(now time.Time) string {
+	return now.UTC().Format(quotaDayLayout)
+}
+
+// nextReset returns the start of the next UTC day.
+func nextReset(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
+
+// rollover clears usage when the UTC day has changed.
+func (s *quotaStore) rollover(now time.Time) {
+	if day := quotaDay(now); day != s.day {
+		s.day = day
+		s.used = make(map[string]map[string]int)
+		s.dirty = true
+	}
+}
+
+func (s *quotaStore) get(rule, key string) int {
+	return s.used[rule][key]
+}
+
+func (s *quotaStore) add(rule, key string) int {
+	keys, ok := s.used[rule]
+	if !ok {
+		keys = make(map[string]int)
+		s.used[rule] = keys
+	}
+	keys[key]++
+	s.dirty = true
+	return keys[key]
+}
+
+// flush writes usage to disk when it changed since the last flush.
+func (s *quotaStore) flush() error {
+	if s.path == "" || !s.dirty {
+		return nil
+	}
+	data, err := json.Marshal(quotaFile{Day: s.day, Used: s.used})
+	if err != nil {
+		return fmt.Errorf("encode quota state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create quota state dir: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write quota state: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("replace quota state: %w", err)
+	}
+	s.dirty = false
+	return nil
+}
@@ -0,0 +1,4 @@
+package redaction
+
+// Package redaction implements the built-in content policy that masks or rejects
+// PII and secrets in governed responses.
@@ -0,0 +1,248 @@
+package redaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/pipeline"
+	"mcpv/internal/infra/telemetry/diagnostics"
+)
+
+// PluginName identifies the built-in policy in decisions and metrics.
+const PluginName = "builtin-redaction"
+
+// RejectCode is returned when a reject rule matches a response.
+const RejectCode = "sensitive_content"
+
+type rule struct {
+	detector    diagnostics.Detector
+	action      domain.RedactionAction
+	replacement string
+}
+
+// Policy scans response text content and structured content with the
+// configured detectors. It implements governance.Policy and runs as a pipeline
+// builtin, see Builtin.
+type Policy struct {
+	rules     []rule
+	byName    map[string]rule
+	detectors []diagnostics.Detector
+	secret    *rule
+	metrics   domain.Metrics
+	logger    *zap.Logger
+}
+
+// NewPolicy compiles the configured rules.
+func NewPolicy(cfg domain.RedactionConfig, metrics domain.Metrics, logger *zap.Logger) (*Policy, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	p := &Policy{
+		byName:  make(map[string]rule, len(cfg.Rules)),
+		metrics: metrics,
+		logger:  logger.Named("redaction"),
+	}
+	for _, cfgRule := range cfg.Rules {
+		detector, err := diagnostics.NewDetector(diagnostics.DetectorOptions{
+			Name:       cfgRule.Name,
+			Kind:       diagnostics.DetectorKind(cfgRule.Detector),
+			Pattern:    cfgRule.Pattern,
+			MinLength:  cfgRule.MinLength,
+			MinEntropy: cfgRule.MinEntropy,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("redaction rule %q: %w", cfgRule.Name, err)
+		}
+		action := cfgRule.Action
+		if action == "" {
+			action = domain.RedactionActionMask
+		}
+		replacement := cfgRule.Replacement
+		if replacement == "" {
+			replacement = "[REDACTED:" + detector.Name + "]"
+		}
+		r := rule{detector: detector, action: action, replacement: replacement}
+		p.rules = append(p.rules, r)
+		p.byName[detector.Name] = r
+		p.detectors = append(p.detectors, detector)
+		if detector.Kind == diagnostics.DetectorSecret && p.secret == nil {
+			secret := r
+			p.secret = &secret
+		}
+	}
+	return p, nil
+}
+
+// Builtin registers the policy first in the content category slot of the
+// plugin pipeline, so responses are masked before content and audit plugins
+// see them. A policy error fails the call like a required plugin.
+func (p *Policy) Builtin() pipeline.Builtin {
+	return pipeline.Builtin{
+		Spec: domain.PluginSpec{
+			Name:     PluginName,
+			Category: domain.PluginCategoryContent,
+			Required: true,
+			Flows:    []domain.PluginFlow{domain.PluginFlowResponse},
+		},
+		Handler: p,
+	}
+}
+
+// Handle implements pipeline.Handler.
+func (p *Policy) Handle(ctx context.Context, _ domain.PluginSpec, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	if req.Flow == domain.PluginFlowResponse {
+		return p.Response(ctx, req)
+	}
+	return p.Request(ctx, req)
+}
+
+// Request passes requests through unchanged.
+func (p *Policy) Request(_ context.Context, _ domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	return domain.GovernanceDecision{Continue: true}, nil
+}
+
+// Response masks matches in text and structured content, or rejects the
+// response when a reject rule matches.
+func (p *Policy) Response(_ context.Context, req domain.GovernanceRequest) (domain.GovernanceDecision, error) {
+	if p == nil || len(p.rules) == 0 || len(bytes.TrimSpace(req.ResponseJSON)) == 0 {
+		return domain.GovernanceDecision{Continue: true}, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(req.ResponseJSON))
+	decoder.UseNumber()
+	var payload any
+	if err := decoder.Decode(&payload); err != nil {
+		p.logger.Debug("skip redaction for non-JSON response", zap.String("method", req.Method), zap.Error(err))
+		return domain.GovernanceDecision{Continue: true}, nil
+	}
+
+	s := &scan{policy: p, counts: make(map[string]int)}
+	rewritten := s.walk(payload, false)
+
+	for name, count := range s.counts {
+		p.recordMetric(name, count)
+	}
+	if s.rejectedBy != "" {
+		return domain.GovernanceDecision{
+			Category:      domain.PluginCategoryContent,
+			Plugin:        PluginName,
+			Continue:      false,
+			RejectCode:    RejectCode,
+			RejectMessage: fmt.Sprintf("response contains sensitive content (%s)", s.rejectedBy),
+		}, nil
+	}
+	if !s.masked {
+		return domain.GovernanceDecision{Continue: true}, nil
+	}
+
+	data, err := json.Marshal(rewritten)
+	if err != nil {
+		return domain.GovernanceDecision{}, fmt.Errorf("encode redacted response: %w", err)
+	}
+	return domain.GovernanceDecision{
+		Category:     domain.PluginCategoryContent,
+		Plugin:       PluginName,
+		Continue:     true,
+		ResponseJSON: data,
+	}, nil
+}
+
+func (p *Policy) recordMetric(name string, count int) {
+	if p.metrics == nil {
+		return
+	}
+	r, ok := p.byName[name]
+	if !ok {
+		return
+	}
+	p.metrics.RecordGovernanceRedaction(domain.GovernanceRedactionMetric{
+		Plugin: PluginName,
+		Rule:   name,
+		Action: string(r.action),
+		Count:  count,
+	})
+}
+
+type scan struct {
+	policy     *Policy
+	counts     map[string]int
+	masked     bool
+	rejectedBy string
+}
+
+// walk rewrites the payload. Only "text" fields (content blocks, resource
+// contents, prompt messages) and everything under "structuredContent" are
+// scanned, so identifiers such as URIs and MIME types stay intact.
+func (s *scan) walk(value any, scanStrings bool) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			switch {
+			case scanStrings && s.policy.secret != nil && diagnostics.ContainsSensitiveKey(key):
+				switch item.(type) {
+				case string, json.Number:
+					typed[key] = s.apply(*s.policy.secret)
+				default:
+					typed[key] = s.walk(item, true)
+				}
+			case key == "structuredContent":
+				typed[key] = s.walk(item, true)
+			case key == "text":
+				if text, ok := item.(string); ok {
+					typed[key] = s.scanText(text)
+					continue
+				}
+				typed[key] = s.walk(item, scanStrings)
+			default:
+				typed[key] = s.walk(item, scanStrings)
+			}
+		}
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = s.walk(item, scanStrings)
+		}
+		return typed
+	case string:
+		if scanStrings {
+			return s.scanText(typed)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+func (s *scan) scanText(text string) string {
+	matches := diagnostics.Scan(text, s.policy.detectors)
+	if len(matches) == 0 {
+		return text
+	}
+	for _, match := range matches {
+		r := s.policy.byName[match.Detector]
+		s.counts[match.Detector]++
+		if r.action == domain.RedactionActionReject && s.rejectedBy == "" {
+			s.rejectedBy = match.Detector
+		}
+	}
+	s.masked = true
+	return diagnostics.Mask(text, matches, func(match diagnostics.Match) string {
+		return s.policy.byName[match.Detector].replacement
+	})
+}
+
+// apply masks a whole value stored under a sensitive key.
+func (s *scan) apply(r rule) string {
+	name := r.detector.Name
+	s.counts[name]++
+	if r.action == domain.RedactionActionReject && s.rejectedBy == "" {
+		s.rejectedBy = name
+	}
+	s.masked = true
+	return r.replacement
+}
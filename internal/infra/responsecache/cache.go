@@ -0,0 +1,428 @@
+package responsecache
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	// KindTool labels cached tools/call results.
+	KindTool = "tool"
+	// KindResource labels cached resources/read results.
+	KindResource = "resource"
+)
+
+const (
+	// ResultHit is recorded when a lookup is served from the cache.
+	ResultHit = "hit"
+	// ResultMiss is recorded when a lookup falls through to the upstream server.
+	ResultMiss = "miss"
+	// ResultBypass is recorded when the caller asked to skip the cache.
+	ResultBypass = "bypass"
+)
+
+const (
+	defaultSweepInterval = 30 * time.Second
+	maxInlineArgsBytes   = 256
+)
+
+// ListChangeSubscriber delivers list change events used for invalidation.
+type ListChangeSubscriber interface {
+	Subscribe(ctx context.Context, kind domain.ListChangeKind) <-chan domain.ListChangeEvent
+}
+
+// Options configures a Cache.
+type Options struct {
+	Config      domain.ResponseCacheConfig
+	ListChanges ListChangeSubscriber
+	Metrics     domain.Metrics
+	Logger      *zap.Logger
+	Now         func() time.Time
+}
+
+// Key identifies a cached response. Args holds the canonical JSON encoding of
+// the call arguments and is empty for resource reads. Caller, RoutingKey and
+// Meta scope an entry to its request, so different callers, sticky routes and
+// forwarded governance metadata never share a response.
+type Key struct {
+	Kind       string
+	Server     string
+	Name       string
+	Args       string
+	Caller     string
+	RoutingKey string
+	Meta       string
+}
+
+type entry struct {
+	key     Key
+	value   json.RawMessage
+	expires time.Time
+}
+
+// Cache stores upstream responses in LRU order. A nil Cache caches nothing.
+type Cache struct {
+	cfg         domain.ResponseCacheConfig
+	ttl         time.Duration
+	listChanges ListChangeSubscriber
+	metrics     domain.Metrics
+	logger      *zap.Logger
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[Key]*list.Element
+	order   *list.List
+	bytes   int
+}
+
+// New constructs a cache, filling unset limits with defaults.
+func New(opts Options) *Cache {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	cfg := opts.Config
+	if cfg.TTLSeconds <= 0 {
+		cfg.TTLSeconds = domain.DefaultResponseCacheTTLSeconds
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = domain.DefaultResponseCacheMaxEntries
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = domain.DefaultResponseCacheMaxBytes
+	}
+	if cfg.MaxEntryBytes <= 0 {
+		cfg.MaxEntryBytes = domain.DefaultResponseCacheMaxEntryBytes
+	}
+	return &Cache{
+		cfg:         cfg,
+		ttl:         time.Duration(cfg.TTLSeconds) * time.Second,
+		listChanges: opts.ListChanges,
+		metrics:     opts.Metrics,
+		logger:      logger.Named("response_cache"),
+		now:         now,
+		entries:     make(map[Key]*list.Element),
+		order:       list.New(),
+	}
+}
+
+// ToolTTL reports whether results of an upstream tool may be cached and for
+// how long. Configured tool rules win over the readOnlyHint default.
+func (c *Cache) ToolTTL(server, tool string, readOnly bool) (time.Duration, bool) {
+	if c == nil {
+		return 0, false
+	}
+	for _, rule := range c.cfg.Tools {
+		if !globMatch(rule.Server, server) || !globMatch(rule.Tool, tool) {
+			continue
+		}
+		if rule.TTLSeconds > 0 {
+			return time.Duration(rule.TTLSeconds) * time.Second, true
+		}
+		return c.ttl, true
+	}
+	if readOnly && c.cfg.ReadOnlyTools {
+		return c.ttl, true
+	}
+	return 0, false
+}
+
+// ResourceTTL reports whether resource reads may be cached and for how long.
+func (c *Cache) ResourceTTL() (time.Duration, bool) {
+	if c == nil || !c.cfg.Resources {
+		return 0, false
+	}
+	return c.ttl, true
+}
+
+// ToolKey builds the key of a tool call from its canonicalized arguments and
+// the caller, routing key and upstream metadata carried by ctx.
+func ToolKey(ctx context.Context, server, tool string, args json.RawMessage, routingKey string) (Key, error) {
+	canonical, err := canonicalArgs(args)
+	if err != nil {
+		return Key{}, err
+	}
+	key := scopedKey(ctx, routingKey)
+	key.Kind = KindTool
+	key.Server = server
+	key.Name = tool
+	key.Args = canonical
+	return key, nil
+}
+
+// ResourceKey builds the key of a resource read for the caller and upstream
+// metadata carried by ctx.
+func ResourceKey(ctx context.Context, server, uri string) Key {
+	key := scopedKey(ctx, "")
+	key.Kind = KindResource
+	key.Server = server
+	key.Name = uri
+	return key
+}
+
+func scopedKey(ctx context.Context, routingKey string) Key {
+	key := Key{RoutingKey: routingKey}
+	if routeCtx, ok := domain.RouteContextFrom(ctx); ok {
+		key.Caller = routeCtx.Client
+	}
+	if meta := domain.UpstreamMetaFromContext(ctx); len(meta) > 0 {
+		// Maps encode with sorted keys, so equal metadata yields equal keys.
+		if encoded, err := json.Marshal(meta); err == nil {
+			key.Meta = string(encoded)
+		}
+	}
+	return key
+}
+
+// Fetch returns the cached response for key, or calls load and stores its
+// result when load reports it cacheable. A bypass request skips the lookup but
+// still refreshes the entry.
+func (c *Cache) Fetch(ctx context.Context, key Key, ttl time.Duration, load func(context.Context) (json.RawMessage, bool, error)) (json.RawMessage, error) {
+	if c == nil {
+		value, _, err := load(ctx)
+		return value, err
+	}
+	result := ResultMiss
+	if domain.CacheBypassFromContext(ctx) {
+		result = ResultBypass
+	} else if value, ok := c.get(key); ok {
+		c.recordLookup(key, ResultHit)
+		return value, nil
+	}
+	c.recordLookup(key, result)
+
+	value, cacheable, err := load(ctx)
+	if err != nil || !cacheable {
+		return value, err
+	}
+	c.put(key, value, ttl)
+	return value, nil
+}
+
+func (c *Cache) get(key Key) (json.RawMessage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cached := elem.Value.(*entry)
+	if !c.now().Before(cached.expires) {
+		c.removeLocked(elem)
+		c.reportSizeLocked()
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return cloneRaw(cached.value), true
+}
+
+func (c *Cache) put(key Key, value json.RawMessage, ttl time.Duration) {
+	if len(value) == 0 || len(value) > c.cfg.MaxEntryBytes || ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeLocked(elem)
+	}
+	c.entries[key] = c.order.PushFront(&entry{
+		key:     key,
+		value:   cloneRaw(value),
+		expires: c.now().Add(ttl),
+	})
+	c.bytes += len(value)
+	for c.order.Len() > c.cfg.MaxEntries || c.bytes > c.cfg.MaxBytes {
+		c.removeLocked(c.order.Back())
+	}
+	c.reportSizeLocked()
+}
+
+// InvalidateServer drops every entry of one kind cached for a server.
+func (c *Cache) InvalidateServer(kind, server string) {
+	c.removeMatching(func(key Key) bool {
+		return key.Kind == kind && key.Server == server
+	})
+}
+
+// InvalidateResource drops a cached resource read. An empty server matches
+// the URI on every server.
+func (c *Cache) InvalidateResource(server, uri string) {
+	c.removeMatching(func(key Key) bool {
+		return key.Kind == KindResource && key.Name == uri && (server == "" || key.Server == server)
+	})
+}
+
+// Purge drops every entry.
+func (c *Cache) Purge() {
+	c.removeMatching(func(Key) bool { return true })
+}
+
+func (c *Cache) removeMatching(match func(Key) bool) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	removed := 0
+	for key, elem := range c.entries {
+		if match(key) {
+			c.removeLocked(elem)
+			removed++
+		}
+	}
+	if removed > 0 {
+		c.reportSizeLocked()
+	}
+}
+
+func (c *Cache) sweep() {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	removed := 0
+	for _, elem := range c.entries {
+		if !now.Before(elem.Value.(*entry).expires) {
+			c.removeLocked(elem)
+			removed++
+		}
+	}
+	if removed > 0 {
+		c.reportSizeLocked()
+	}
+}
+
+func (c *Cache) removeLocked(elem *list.Element) {
+	if elem == nil {
+		return
+	}
+	cached := c.order.Remove(elem).(*entry)
+	delete(c.entries, cached.key)
+	c.bytes -= len(cached.value)
+}
+
+// Len returns the number of cached entries.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Run invalidates entries on list changes and resource updates and sweeps
+// expired entries until ctx is done. mcpv does not send resources/subscribe
+// upstream, so resources/updated only arrives from servers that push it
+// unsolicited; cached resource reads otherwise live until their TTL.
+func (c *Cache) Run(ctx context.Context) {
+	if c == nil {
+		return
+	}
+	var tools, resources, updates <-chan domain.ListChangeEvent
+	if c.listChanges != nil {
+		tools = c.listChanges.Subscribe(ctx, domain.ListChangeTools)
+		resources = c.listChanges.Subscribe(ctx, domain.ListChangeResources)
+		updates = c.listChanges.Subscribe(ctx, domain.ListChangeResourceUpdated)
+	}
+	ticker := time.NewTicker(defaultSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.sweep()
+		case event, ok := <-tools:
+			if !ok {
+				tools = nil
+				continue
+			}
+			c.InvalidateServer(KindTool, event.ServerType)
+		case event, ok := <-resources:
+			if !ok {
+				resources = nil
+				continue
+			}
+			c.InvalidateServer(KindResource, event.ServerType)
+		case event, ok := <-updates:
+			if !ok {
+				updates = nil
+				continue
+			}
+			c.InvalidateResource(event.ServerType, event.URI)
+		}
+	}
+}
+
+func (c *Cache) recordLookup(key Key, result string) {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.RecordResponseCacheLookup(domain.ResponseCacheLookupMetric{
+		Kind:   key.Kind,
+		Server: key.Server,
+		Result: result,
+	})
+}
+
+func (c *Cache) reportSizeLocked() {
+	if c.metrics == nil {
+		return
+	}
+	c.metrics.SetResponseCacheSize(domain.ResponseCacheSizeMetric{
+		Entries: c.order.Len(),
+		Bytes:   c.bytes,
+	})
+}
+
+// canonicalArgs re-encodes arguments so that key order and whitespace do not
+// split cache entries. Large argument payloads are reduced to a digest.
+func canonicalArgs(args json.RawMessage) (string, error) {
+	trimmed := bytes.TrimSpace(args)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return "{}", nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	if len(encoded) > maxInlineArgsBytes {
+		sum := sha256.Sum256(encoded)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	return string(encoded), nil
+}
+
+func globMatch(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+func cloneRaw(raw json.RawMessage) json.RawMessage {
+	return append(json.RawMessage(nil), raw...)
+}
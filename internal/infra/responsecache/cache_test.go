@@ -0,0 +1,192 @@
+package responsecache
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type countingLoader struct {
+	calls     int
+	value     string
+	cacheable bool
+}
+
+func (l *countingLoader) load(context.Context) (json.RawMessage, bool, error) {
+	l.calls++
+	return json.RawMessage(l.value), l.cacheable, nil
+}
+
+func newTestCache(clock *fakeClock, cfg domain.ResponseCacheConfig) *Cache {
+	cfg.Enabled = true
+	return New(Options{Config: cfg, Now: clock.Now})
+}
+
+func TestCache_ExpiresAfterTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCache(clock, domain.ResponseCacheConfig{TTLSeconds: 10})
+	loader := &countingLoader{value: `{"ok":true}`, cacheable: true}
+	key := ResourceKey(context.Background(), "docs", "file:///a")
+
+	for range 2 {
+		value, err := cache.Fetch(context.Background(), key, 10*time.Second, loader.load)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"ok":true}`, string(value))
+	}
+	require.Equal(t, 1, loader.calls)
+
+	clock.Advance(10 * time.Second)
+	_, err := cache.Fetch(context.Background(), key, 10*time.Second, loader.load)
+	require.NoError(t, err)
+	require.Equal(t, 2, loader.calls)
+}
+
+func TestCache_SkipsUncacheableAndBypass(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCache(clock, domain.ResponseCacheConfig{})
+	key := ResourceKey(context.Background(), "docs", "file:///a")
+
+	failing := &countingLoader{value: `{"isError":true}`}
+	_, _ = cache.Fetch(context.Background(), key, time.Minute, failing.load)
+	_, _ = cache.Fetch(context.Background(), key, time.Minute, failing.load)
+	require.Equal(t, 2, failing.calls)
+	require.Zero(t, cache.Len())
+
+	loader := &countingLoader{value: `{}`, cacheable: true}
+	_, _ = cache.Fetch(context.Background(), key, time.Minute, loader.load)
+	_, _ = cache.Fetch(domain.WithCacheBypass(context.Background()), key, time.Minute, loader.load)
+	require.Equal(t, 2, loader.calls)
+	require.Equal(t, 1, cache.Len())
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCache(clock, domain.ResponseCacheConfig{MaxEntries: 2, MaxEntryBytes: 8, MaxBytes: 64})
+	loader := &countingLoader{value: `"v"`, cacheable: true}
+	ctx := context.Background()
+
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "a"), time.Minute, loader.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "b"), time.Minute, loader.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "a"), time.Minute, loader.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "c"), time.Minute, loader.load)
+	require.Equal(t, 3, loader.calls)
+	require.Equal(t, 2, cache.Len())
+
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "a"), time.Minute, loader.load)
+	require.Equal(t, 3, loader.calls)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "b"), time.Minute, loader.load)
+	require.Equal(t, 4, loader.calls)
+
+	large := &countingLoader{value: `"too large"`, cacheable: true}
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "d"), time.Minute, large.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "s", "d"), time.Minute, large.load)
+	require.Equal(t, 2, large.calls)
+}
+
+func TestCache_Invalidation(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCache(clock, domain.ResponseCacheConfig{})
+	loader := &countingLoader{value: `{}`, cacheable: true}
+	ctx := context.Background()
+
+	toolKey, err := ToolKey(ctx, "github", "list_repos", json.RawMessage(`{"b":1,"a":2}`), "")
+	require.NoError(t, err)
+	_, _ = cache.Fetch(ctx, toolKey, time.Minute, loader.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "github", "repo://a"), time.Minute, loader.load)
+	_, _ = cache.Fetch(ctx, ResourceKey(ctx, "github", "repo://b"), time.Minute, loader.load)
+	require.Equal(t, 3, cache.Len())
+
+	cache.InvalidateResource("github", "repo://a")
+	require.Equal(t, 2, cache.Len())
+	cache.InvalidateServer(KindTool, "github")
+	require.Equal(t, 1, cache.Len())
+	cache.Purge()
+	require.Zero(t, cache.Len())
+}
+
+func TestToolKey_CanonicalizesArguments(t *testing.T) {
+	ctx := context.Background()
+	a, err := ToolKey(ctx, "s", "t", json.RawMessage(`{"b":[1,2],"a":{"y":1,"x":2}}`), "")
+	require.NoError(t, err)
+	b, err := ToolKey(ctx, "s", "t", json.RawMessage(` {"a":{"x":2,"y":1}, "b":[1,2]} `), "")
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	empty, err := ToolKey(ctx, "s", "t", nil, "")
+	require.NoError(t, err)
+	null, err := ToolKey(ctx, "s", "t", json.RawMessage(`null`), "")
+	require.NoError(t, err)
+	require.Equal(t, empty, null)
+
+	_, err = ToolKey(ctx, "s", "t", json.RawMessage(`{`), "")
+	require.Error(t, err)
+}
+
+func TestCache_SeparatesCallers(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := newTestCache(clock, domain.ResponseCacheConfig{})
+	args := json.RawMessage(`{"q":"x"}`)
+	alice := domain.WithRouteContext(context.Background(), domain.RouteContext{Client: "alice"})
+	bob := domain.WithRouteContext(context.Background(), domain.RouteContext{Client: "bob"})
+
+	aliceKey, err := ToolKey(alice, "shared", "search", args, "")
+	require.NoError(t, err)
+	bobKey, err := ToolKey(bob, "shared", "search", args, "")
+	require.NoError(t, err)
+	require.NotEqual(t, aliceKey, bobKey)
+
+	_, err = cache.Fetch(alice, aliceKey, time.Minute, (&countingLoader{value: `"alice"`, cacheable: true}).load)
+	require.NoError(t, err)
+	value, err := cache.Fetch(bob, bobKey, time.Minute, (&countingLoader{value: `"bob"`, cacheable: true}).load)
+	require.NoError(t, err)
+	require.JSONEq(t, `"bob"`, string(value))
+	require.Equal(t, 2, cache.Len())
+
+	routed, err := ToolKey(alice, "shared", "search", args, "session-1")
+	require.NoError(t, err)
+	require.NotEqual(t, aliceKey, routed)
+	withMeta, err := ToolKey(domain.WithUpstreamMeta(alice, map[string]string{"tenant": "a"}), "shared", "search", args, "")
+	require.NoError(t, err)
+	require.NotEqual(t, aliceKey, withMeta)
+}
+
+func TestCache_ToolTTL(t *testing.T) {
+	cache := New(Options{Config: domain.ResponseCacheConfig{
+		Enabled:       true,
+		TTLSeconds:    30,
+		ReadOnlyTools: true,
+		Tools:         []domain.ResponseCacheToolRule{{Server: "github", Tool: "list_*", TTLSeconds: 300}},
+	}})
+
+	ttl, ok := cache.ToolTTL("github", "list_repos", false)
+	require.True(t, ok)
+	require.Equal(t, 300*time.Second, ttl)
+
+	ttl, ok = cache.ToolTTL("fs", "read", true)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, ttl)
+
+	_, ok = cache.ToolTTL("fs", "write", false)
+	require.False(t, ok)
+
+	var disabled *Cache
+	_, ok = disabled.ToolTTL("fs", "read", true)
+	require.False(t, ok)
+}
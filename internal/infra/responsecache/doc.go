@@ -0,0 +1,4 @@
+package responsecache
+
+// Package responsecache implements the control plane cache for read-only tool
+// calls and resource reads, bounded by TTL, entry count and total size.
@@ -0,0 +1,33 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+
+	"mcpv/internal/infra/mcpcodec"
+)
+
+// injectUpstreamMeta merges governance metadata into the request's
+// params._meta. Governance values overwrite keys the caller already sent.
+func injectUpstreamMeta(payload json.RawMessage, meta map[string]string) (json.RawMessage, error) {
+	if len(meta) == 0 {
+		return payload, nil
+	}
+	msg, err := jsonrpc.DecodeMessage(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, ok := msg.(*jsonrpc.Request)
+	if !ok {
+		return nil, errors.New("payload is not a request")
+	}
+
+	encodedParams, err := mcpcodec.MergeParamsMeta(req.Params, meta)
+	if err != nil {
+		return nil, err
+	}
+	req.Params = encodedParams
+	return jsonrpc.EncodeMessage(req)
+}
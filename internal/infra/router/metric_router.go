@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/mcpcodec"
 )
 
 type MetricRouter struct {
@@ -32,19 +33,24 @@ func (r *MetricRouter) RouteWithOptions(ctx context.Context, serverType, specKey
 	}
 	start := time.Now()
 	resp, err := r.inner.RouteWithOptions(ctx, serverType, specKey, routingKey, payload, opts)
-	r.observe(ctx, serverType, time.Since(start), err)
+	r.observe(ctx, serverType, payload, resp, time.Since(start), err)
 	return resp, err
 }
 
-func (r *MetricRouter) observe(ctx context.Context, serverType string, duration time.Duration, err error) {
+func (r *MetricRouter) observe(ctx context.Context, serverType string, payload, resp json.RawMessage, duration time.Duration, err error) {
 	if r.metrics == nil {
 		return
 	}
 	meta, _ := domain.RouteContextFrom(ctx)
+	tool := toolCallName(payload)
 	status, reason := classifyRouteResult(err)
+	if err == nil && tool != "" && toolResponseIsError(resp) {
+		status, reason = domain.RouteStatusError, domain.RouteReasonToolError
+	}
 	r.metrics.ObserveRoute(domain.RouteMetric{
 		ServerType: serverType,
-		Client:     meta.Client,
+		Client:     meta.Principal(),
+		Tool:       tool,
 		Status:     status,
 		Reason:     reason,
 		Duration:   duration,
@@ -82,3 +88,30 @@ func classifyRouteResult(err error) (domain.RouteStatus, domain.RouteReason) {
 	}
 	return domain.RouteStatusError, domain.RouteReasonUnknown
 }
+
+// toolCallName returns the tool named by a tools/call request, or "" for
+// other methods.
+func toolCallName(payload json.RawMessage) string {
+	var req struct {
+		Method string `json:"method"`
+		Params struct {
+			Name string `json:"name"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(payload, &req); err != nil || req.Method != "tools/call" {
+		return ""
+	}
+	return req.Params.Name
+}
+
+// toolResponseIsError reports whether a JSON-RPC tools/call response carries a
+// result with isError set.
+func toolResponseIsError(resp json.RawMessage) bool {
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(resp, &envelope); err != nil {
+		return false
+	}
+	return mcpcodec.ToolResultIsError(envelope.Result)
+}
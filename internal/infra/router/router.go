@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"mcpv/internal/domain"
@@ -56,9 +57,21 @@ func (r *BasicRouter) Route(ctx context.Context, serverType, specKey, routingKey
 }
 
 func (r *BasicRouter) RouteWithOptions(ctx context.Context, serverType, specKey, routingKey string, payload json.RawMessage, opts domain.RouteOptions) (json.RawMessage, error) {
+	ctx, span := telemetry.StartSpan(ctx, "router.send",
+		telemetry.AttrServerType.String(serverType),
+		telemetry.AttrSpecKey.String(specKey),
+	)
+	resp, err := r.route(ctx, serverType, specKey, routingKey, payload, opts)
+	telemetry.EndSpan(span, err)
+	return resp, err
+}
+
+func (r *BasicRouter) route(ctx context.Context, serverType, specKey, routingKey string, payload json.RawMessage, opts domain.RouteOptions) (json.RawMessage, error) {
 	start := time.Now()
+	domain.RecordRouteTarget(ctx, serverType, specKey)
 
 	method, isCall, err := extractMethod(payload)
+	trace.SpanFromContext(ctx).SetAttributes(telemetry.AttrMethod.String(method))
 	if err != nil {
 		decodeErr := domain.Wrap(domain.CodeInvalidArgument, "route decode", err)
 		routeErr := domain.NewRouteError(domain.RouteStageDecode, decodeErr)
@@ -70,6 +83,15 @@ func (r *BasicRouter) RouteWithOptions(ctx context.Context, serverType, specKey,
 		r.logRouteError(ctx, serverType, method, nil, start, routeErr)
 		return nil, routeErr
 	}
+	if meta := domain.UpstreamMetaFromContext(ctx); len(meta) > 0 {
+		payload, err = injectUpstreamMeta(payload, meta)
+		if err != nil {
+			decodeErr := domain.Wrap(domain.CodeInvalidArgument, "route inject meta", err)
+			routeErr := domain.NewRouteError(domain.RouteStageDecode, decodeErr)
+			r.logRouteError(ctx, serverType, method, nil, start, routeErr)
+			return nil, routeErr
+		}
+	}
 
 	var inst *domain.Instance
 	if opts.AllowStart {
@@ -85,6 +107,7 @@ func (r *BasicRouter) RouteWithOptions(ctx context.Context, serverType, specKey,
 		return nil, routeErr
 	}
 	defer func() { _ = r.scheduler.Release(ctx, inst) }()
+	trace.SpanFromContext(ctx).SetAttributes(telemetry.AttrInstanceID.String(inst.ID()))
 
 	if inst.Conn() == nil {
 		err := fmt.Errorf("%w: instance has no connection: %s", domain.ErrConnectionClosed, inst.ID())
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
 )
 
 func TestBasicRouter_RouteSuccess(t *testing.T) {
@@ -130,6 +131,37 @@ func (f *fakeScheduler) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, err
 	return nil, nil
 }
 
+func TestBasicRouter_InjectsUpstreamMeta(t *testing.T) {
+	conn := &fakeConn{resp: json.RawMessage(`{"ok":true}`)}
+	inst := domain.NewInstance(domain.InstanceOptions{ID: "inst1", Conn: conn})
+	inst.SetCapabilities(domain.ServerCapabilities{Tools: &domain.ToolsCapability{}})
+	r := NewBasicRouter(&fakeScheduler{instance: inst}, Options{})
+
+	ctx := domain.WithUpstreamMeta(context.Background(), map[string]string{
+		"user.id":  "alice",
+		"progress": "governed",
+	})
+	payload := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"echo","arguments":{"a":1},"_meta":{"progressToken":"t1","progress":"client"}}}`)
+	_, err := r.Route(ctx, "svc", "spec", "", payload)
+	require.NoError(t, err)
+
+	var sent struct {
+		Params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+			Meta      map[string]any  `json:"_meta"`
+		} `json:"params"`
+	}
+	require.NoError(t, json.Unmarshal(conn.req, &sent))
+	require.Equal(t, "echo", sent.Params.Name)
+	require.JSONEq(t, `{"a":1}`, string(sent.Params.Arguments))
+	require.Equal(t, map[string]any{
+		"progressToken": "t1",
+		"progress":      "governed",
+		"user.id":       "alice",
+	}, sent.Params.Meta)
+}
+
 type fakeConn struct {
 	req  json.RawMessage
 	resp json.RawMessage
@@ -142,3 +174,35 @@ func (f *fakeConn) Call(_ context.Context, payload json.RawMessage) (json.RawMes
 }
 
 func (f *fakeConn) Close() error { return nil }
+
+func TestMetricRouter_LabelsToolCallsAndToolErrors(t *testing.T) {
+	inst := domain.NewInstance(domain.InstanceOptions{
+		ID:   "inst1",
+		Conn: &fakeConn{resp: json.RawMessage(`{"jsonrpc":"2.0","id":1,"result":{"isError":true,"content":[]}}`)},
+	})
+	inst.SetCapabilities(domain.ServerCapabilities{Tools: &domain.ToolsCapability{}})
+	sched := &fakeScheduler{instance: inst}
+	metrics := &recordingMetrics{NoopMetrics: telemetry.NewNoopMetrics()}
+	r := NewMetricRouter(NewBasicRouter(sched, Options{}), metrics)
+
+	_, err := r.Route(context.Background(), "svc", "spec", "", json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}}`))
+	require.NoError(t, err)
+	_, err = r.Route(context.Background(), "svc", "spec", "", json.RawMessage(`{"jsonrpc":"2.0","id":2,"method":"ping"}`))
+	require.NoError(t, err)
+
+	require.Len(t, metrics.routes, 2)
+	require.Equal(t, "search", metrics.routes[0].Tool)
+	require.Equal(t, domain.RouteStatusError, metrics.routes[0].Status)
+	require.Equal(t, domain.RouteReasonToolError, metrics.routes[0].Reason)
+	require.Empty(t, metrics.routes[1].Tool)
+	require.Equal(t, domain.RouteReasonSuccess, metrics.routes[1].Reason)
+}
+
+type recordingMetrics struct {
+	*telemetry.NoopMetrics
+	routes []domain.RouteMetric
+}
+
+func (m *recordingMetrics) ObserveRoute(metric domain.RouteMetric) {
+	m.routes = append(m.routes, metric)
+}
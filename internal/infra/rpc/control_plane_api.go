@@ -6,4 +6,9 @@ type ControlPlaneAPI interface {
 	domain.ControlPlaneCoreAPI
 	domain.AutomationAPI
 	domain.TasksAPI
+	domain.QuotaAPI
+	domain.CallHistoryAPI
+	domain.ToolStatsAPI
+	domain.DiagnosticsAPI
+	domain.ReadinessAPI
 }
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"mcpv/internal/domain"
 	"mcpv/internal/infra/governance"
 	controlv1 "mcpv/pkg/api/control/v1"
 )
@@ -16,6 +17,7 @@ type ControlService struct {
 	control  ControlPlaneAPI
 	executor *governance.Executor
 	guard    governanceGuard
+	metrics  domain.Metrics
 	logger   *zap.Logger
 }
 
@@ -51,15 +53,15 @@ func (s *ControlService) RegisterCaller(ctx context.Context, req *controlv1.Regi
 	if req.GetPid() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "pid must be > 0")
 	}
-	if req.GetServer() != "" && len(req.GetTags()) > 0 {
-		return nil, status.Error(codes.InvalidArgument, "server and tags are mutually exclusive")
-	}
-	registration, err := s.control.RegisterClient(ctx, client, int(req.GetPid()), req.GetTags(), req.GetServer())
+	registration, err := s.control.RegisterClient(ctx, client, int(req.GetPid()), req.GetTags(), req.GetServer(), fromProtoClientInfo(req.GetClientInfo()))
 	if err != nil {
 		return nil, statusFromError("register caller", err)
 	}
+	if suppressed := suppressedToolListChangesFromMetadata(ctx); suppressed > 0 && s.metrics != nil {
+		s.metrics.AddSuppressedToolListChanges(int(suppressed))
+	}
 	return &controlv1.RegisterCallerResponse{
-		Profile: registration.Client,
+		Profile: registration.Profile,
 	}, nil
 }
 
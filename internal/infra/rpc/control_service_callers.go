@@ -0,0 +1,20 @@
+package rpc
+
+import (
+	"context"
+	"sort"
+
+	"mcpv/internal/infra/mapping"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// ListCallers reports registered callers with the client info they reported.
+func (s *ControlService) ListCallers(ctx context.Context, _ *controlv1.ListCallersRequest) (*controlv1.ListCallersResponse, error) {
+	clients, err := s.control.ListActiveClients(ctx)
+	if err != nil {
+		return nil, statusFromError("list callers", err)
+	}
+	callers := mapping.MapSlice(clients, toProtoActiveCaller)
+	sort.Slice(callers, func(i, j int) bool { return callers[i].GetCaller() < callers[j].GetCaller() })
+	return &controlv1.ListCallersResponse{Callers: callers}, nil
+}
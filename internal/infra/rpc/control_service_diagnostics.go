@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"mcpv/internal/domain"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// ExportDiagnostics returns a diagnostics bundle packed as a zip archive.
+func (s *ControlService) ExportDiagnostics(ctx context.Context, req *controlv1.ExportDiagnosticsRequest) (*controlv1.ExportDiagnosticsResponse, error) {
+	mode := domain.DiagnosticsRedactionMode(strings.ToLower(strings.TrimSpace(req.GetMode())))
+	if err := s.guard.applyRequest(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
+		Method:      "mcpv/diagnostics/export",
+		Caller:      req.GetCaller(),
+		RequestJSON: mustMarshalJSON(map[string]any{"mode": string(mode)}),
+	}), "export diagnostics", nil); err != nil {
+		return nil, err
+	}
+	export, err := s.control.ExportDiagnostics(ctx, domain.DiagnosticsExportOptions{
+		Mode:            mode,
+		IncludeSnapshot: req.GetIncludeSnapshot(),
+		IncludeMetrics:  req.GetIncludeMetrics(),
+		IncludeLogs:     req.GetIncludeLogs(),
+		IncludeEvents:   req.GetIncludeEvents(),
+		IncludeStuck:    req.GetIncludeStuck(),
+		LogLevel:        strings.TrimSpace(req.GetLogLevel()),
+		MaxLogEntries:   int(req.GetMaxLogEntries()),
+		MaxEventEntries: int(req.GetMaxEventEntries()),
+		StuckThreshold:  time.Duration(req.GetStuckThresholdMs()) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, statusFromError("export diagnostics", err)
+	}
+	return &controlv1.ExportDiagnosticsResponse{
+		Archive:             export.Archive,
+		GeneratedAtUnixNano: export.GeneratedAt.UnixNano(),
+	}, nil
+}
+
+// WatchDiagnosticsEvents streams diagnostics step events, optionally replaying
+// the buffered ones first.
+func (s *ControlService) WatchDiagnosticsEvents(req *controlv1.WatchDiagnosticsEventsRequest, stream controlv1.ControlPlaneService_WatchDiagnosticsEventsServer) error {
+	ctx := stream.Context()
+	query := domain.DiagnosticsEventQuery{
+		SpecKey:     strings.TrimSpace(req.GetSpecKey()),
+		Server:      strings.TrimSpace(req.GetServer()),
+		Step:        strings.TrimSpace(req.GetStep()),
+		Phase:       strings.ToLower(strings.TrimSpace(req.GetPhase())),
+		AttemptID:   strings.TrimSpace(req.GetAttemptId()),
+		Replay:      req.GetReplay(),
+		ReplayLimit: int(req.GetReplayLimit()),
+		Follow:      req.GetFollow(),
+	}
+	if err := s.guard.applyRequest(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
+		Method: "mcpv/diagnostics/watch",
+		Caller: req.GetCaller(),
+	}), "watch diagnostics events", nil); err != nil {
+		return err
+	}
+	events, err := s.control.WatchDiagnosticsEvents(ctx, query)
+	if err != nil {
+		return statusFromError("watch diagnostics events", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toProtoDiagnosticsEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toProtoDiagnosticsEvent(event domain.DiagnosticsEvent) *controlv1.DiagnosticsEvent {
+	out := &controlv1.DiagnosticsEvent{
+		Seq:               event.Seq,
+		SpecKey:           event.SpecKey,
+		ServerName:        event.ServerName,
+		AttemptId:         event.AttemptID,
+		Step:              event.Step,
+		Phase:             event.Phase,
+		TimestampUnixNano: event.Timestamp.UnixNano(),
+		DurationMs:        event.Duration.Milliseconds(),
+		Error:             event.Error,
+	}
+	if len(event.Attributes) > 0 {
+		if raw, err := json.Marshal(event.Attributes); err == nil {
+			out.AttributesJson = raw
+		}
+	}
+	return out
+}
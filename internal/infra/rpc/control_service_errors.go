@@ -51,7 +51,7 @@ func governanceCode(code string) codes.Code {
 		return codes.Unauthenticated
 	case "unauthorized":
 		return codes.PermissionDenied
-	case "rate_limited":
+	case "rate_limited", "quota_exceeded":
 		return codes.ResourceExhausted
 	case "invalid_request":
 		return codes.InvalidArgument
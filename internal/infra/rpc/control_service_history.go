@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/mapping"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// QueryCallHistory returns recorded tool calls matching the request filters.
+func (s *ControlService) QueryCallHistory(ctx context.Context, req *controlv1.QueryCallHistoryRequest) (*controlv1.QueryCallHistoryResponse, error) {
+	query := domain.CallHistoryQuery{
+		Caller: strings.TrimSpace(req.GetCaller()),
+		Server: strings.TrimSpace(req.GetServer()),
+		Tool:   strings.TrimSpace(req.GetTool()),
+		Status: domain.CallStatus(strings.ToLower(strings.TrimSpace(req.GetStatus()))),
+		Cursor: req.GetCursor(),
+		Limit:  int(req.GetLimit()),
+	}
+	switch query.Status {
+	case "", domain.CallStatusOK, domain.CallStatusError, domain.CallStatusRejected:
+	default:
+		return nil, statusFromError("query call history", domain.ErrInvalidRequest)
+	}
+	if since := req.GetSinceUnixNano(); since > 0 {
+		query.Since = time.Unix(0, since)
+	}
+	if until := req.GetUntilUnixNano(); until > 0 {
+		query.Until = time.Unix(0, until)
+	}
+	if err := s.guard.applyRequest(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
+		Method: "mcpv/history/query",
+		Caller: query.Caller,
+		RequestJSON: mustMarshalJSON(map[string]any{
+			"server": query.Server,
+			"tool":   query.Tool,
+			"status": string(query.Status),
+		}),
+	}), "query call history", nil); err != nil {
+		return nil, err
+	}
+
+	page, err := s.control.QueryCallHistory(ctx, query)
+	if err != nil {
+		return nil, statusFromError("query call history", err)
+	}
+	return &controlv1.QueryCallHistoryResponse{
+		Records:    mapping.MapSlice(page.Records, toProtoCallRecord),
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -18,12 +18,12 @@ func (s *ControlService) ListPrompts(ctx context.Context, req *controlv1.ListPro
 	promptsSnapshot, nextCursor, err := guardedList(guardedListPlan[domain.PromptPage, *controlv1.PromptsSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "prompts/list",
 			Caller:      client,
 			RequestJSON: mustMarshalJSON(map[string]string{"cursor": cursor}),
 		}),
-		responseRequest: withRequestMetadata(ctx, domain.GovernanceRequest{
+		responseRequest: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "prompts/list",
 			Caller: client,
 		}),
@@ -64,7 +64,7 @@ func (s *ControlService) WatchPrompts(req *controlv1.WatchPromptsRequest, stream
 	return guardedWatch(guardedWatchPlan[domain.PromptSnapshot, *controlv1.PromptsSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "prompts/list",
 			Caller: client,
 		}),
@@ -93,7 +93,7 @@ func (s *ControlService) GetPrompt(ctx context.Context, req *controlv1.GetPrompt
 	var result json.RawMessage
 	var err error
 	if s.executor != nil {
-		result, err = s.executor.Execute(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+		result, err = s.executor.Execute(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "prompts/get",
 			Caller:      client,
 			PromptName:  promptName,
@@ -0,0 +1,19 @@
+package rpc
+
+import (
+	"context"
+
+	"mcpv/internal/infra/mapping"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// GetQuotaStatus reports built-in rate limit and daily quota usage.
+func (s *ControlService) GetQuotaStatus(ctx context.Context, req *controlv1.GetQuotaStatusRequest) (*controlv1.GetQuotaStatusResponse, error) {
+	statuses, err := s.control.GetQuotaStatus(ctx, req.GetCaller())
+	if err != nil {
+		return nil, statusFromError("get quota status", err)
+	}
+	return &controlv1.GetQuotaStatusResponse{
+		Statuses: mapping.MapSlice(statuses, toProtoQuotaStatus),
+	}, nil
+}
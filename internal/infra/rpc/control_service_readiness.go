@@ -0,0 +1,21 @@
+package rpc
+
+import (
+	"context"
+
+	"mcpv/internal/infra/mapping"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// GetReadiness reports the per-criterion readiness breakdown served by /readyz.
+func (s *ControlService) GetReadiness(ctx context.Context, _ *controlv1.GetReadinessRequest) (*controlv1.GetReadinessResponse, error) {
+	report, err := s.control.GetReadiness(ctx)
+	if err != nil {
+		return nil, statusFromError("get readiness", err)
+	}
+	return &controlv1.GetReadinessResponse{
+		Ready:             report.Ready,
+		Checks:            mapping.MapSlice(report.Checks, toProtoReadinessCheck),
+		CheckedAtUnixNano: report.CheckedAt.UnixNano(),
+	}, nil
+}
@@ -19,12 +19,12 @@ func (s *ControlService) ListResources(ctx context.Context, req *controlv1.ListR
 	resourcesSnapshot, nextCursor, err := guardedList(guardedListPlan[domain.ResourcePage, *controlv1.ResourcesSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "resources/list",
 			Caller:      client,
 			RequestJSON: mustMarshalJSON(map[string]string{"cursor": cursor}),
 		}),
-		responseRequest: withRequestMetadata(ctx, domain.GovernanceRequest{
+		responseRequest: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "resources/list",
 			Caller: client,
 		}),
@@ -65,7 +65,7 @@ func (s *ControlService) WatchResources(req *controlv1.WatchResourcesRequest, st
 	return guardedWatch(guardedWatchPlan[domain.ResourceSnapshot, *controlv1.ResourcesSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "resources/list",
 			Caller: client,
 		}),
@@ -94,7 +94,7 @@ func (s *ControlService) ReadResource(ctx context.Context, req *controlv1.ReadRe
 	var result json.RawMessage
 	var err error
 	if s.executor != nil {
-		result, err = s.executor.Execute(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+		result, err = s.executor.Execute(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "resources/read",
 			Caller:      client,
 			ResourceURI: uri,
@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"mcpv/internal/domain"
 	controlv1 "mcpv/pkg/api/control/v1"
@@ -9,16 +11,26 @@ import (
 
 func (s *ControlService) StreamLogs(req *controlv1.StreamLogsRequest, stream controlv1.ControlPlaneService_StreamLogsServer) error {
 	ctx := stream.Context()
-	minLevel := fromProtoLogLevel(req.GetMinLevel())
+	query := domain.LogQuery{
+		MinLevel: fromProtoLogLevel(req.GetMinLevel()),
+		Server:   strings.TrimSpace(req.GetServer()),
+		Grep:     req.GetGrep(),
+	}
+	if since := req.GetSinceUnixNano(); since > 0 {
+		query.Since = time.Unix(0, since)
+	}
+	if until := req.GetUntilUnixNano(); until > 0 {
+		query.Until = time.Unix(0, until)
+	}
 	client := req.GetCaller()
-	if err := s.guard.applyRequest(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+	if err := s.guard.applyRequest(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 		Method:      "logging/subscribe",
 		Caller:      client,
-		RequestJSON: mustMarshalJSON(map[string]any{"minLevel": string(minLevel)}),
+		RequestJSON: mustMarshalJSON(map[string]any{"minLevel": string(query.MinLevel)}),
 	}), "stream logs", nil); err != nil {
 		return err
 	}
-	entries, err := s.control.StreamLogs(ctx, client, minLevel)
+	entries, err := s.control.StreamLogs(ctx, client, query)
 	if err != nil {
 		return statusFromError("stream logs", err)
 	}
@@ -49,7 +61,7 @@ func (s *ControlService) WatchRuntimeStatus(req *controlv1.WatchRuntimeStatusReq
 	return guardedWatch(guardedWatchPlan[domain.RuntimeStatusSnapshot, *controlv1.RuntimeStatusSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "mcpv/runtime/watch",
 			Caller: client,
 		}),
@@ -78,7 +90,7 @@ func (s *ControlService) WatchServerInitStatus(req *controlv1.WatchServerInitSta
 	return guardedWatch(guardedWatchPlan[domain.ServerInitStatusSnapshot, *controlv1.ServerInitStatusSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "mcpv/server_init/watch",
 			Caller: client,
 		}),
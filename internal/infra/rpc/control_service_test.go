@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,7 @@ import (
 
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/scheduler"
+	"mcpv/internal/infra/telemetry"
 	controlv1 "mcpv/pkg/api/control/v1"
 )
 
@@ -29,6 +31,28 @@ func TestControlService_CallToolNotFound(t *testing.T) {
 	require.Equal(t, codes.NotFound, status.Code(err))
 }
 
+func TestControlService_RegisterCallerRecordsSuppressedToolListChanges(t *testing.T) {
+	metrics := &suppressedMetrics{}
+	svc := NewControlService(&fakeControlPlane{}, nil, nil)
+	svc.metrics = metrics
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(SuppressedToolListChangesHeader, "3"))
+	_, err := svc.RegisterCaller(ctx, &controlv1.RegisterCallerRequest{Caller: "caller", Pid: 42})
+	require.NoError(t, err)
+	_, err = svc.RegisterCaller(context.Background(), &controlv1.RegisterCallerRequest{Caller: "caller", Pid: 42})
+	require.NoError(t, err)
+	require.Equal(t, 3, metrics.suppressed)
+}
+
+type suppressedMetrics struct {
+	telemetry.NoopMetrics
+	suppressed int
+}
+
+func (m *suppressedMetrics) AddSuppressedToolListChanges(count int) {
+	m.suppressed += count
+}
+
 func TestControlService_CallToolMissingName(t *testing.T) {
 	svc := NewControlService(&fakeControlPlane{}, nil, nil)
 
@@ -115,16 +139,316 @@ func TestControlService_ListTools(t *testing.T) {
 }
 
 func TestControlService_RegisterCaller(t *testing.T) {
-	svc := NewControlService(&fakeControlPlane{
-		registerRegistration: domain.ClientRegistration{Client: "caller"},
-	}, nil, nil)
+	control := &fakeControlPlane{
+		registerRegistration: domain.ClientRegistration{Client: "caller", Profile: "cursor-readonly"},
+	}
+	svc := NewControlService(control, nil, nil)
 
 	resp, err := svc.RegisterCaller(context.Background(), &controlv1.RegisterCallerRequest{
 		Caller: "caller",
 		Pid:    1234,
+		ClientInfo: &controlv1.ClientInfo{
+			Name:          "cursor",
+			Version:       "1.2.0",
+			WorkspaceRoot: "/work/app",
+			Sampling:      true,
+			Roots:         true,
+		},
 	})
 	require.NoError(t, err)
-	require.Equal(t, "caller", resp.GetProfile())
+	require.Equal(t, "cursor-readonly", resp.GetProfile())
+	require.Equal(t, domain.ClientInfo{
+		Name:          "cursor",
+		Version:       "1.2.0",
+		WorkspaceRoot: "/work/app",
+		Capabilities:  domain.ClientCapabilities{Sampling: true, Roots: true},
+	}, control.registerInfo)
+}
+
+func TestControlService_ListCallers(t *testing.T) {
+	heartbeat := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	svc := NewControlService(&fakeControlPlane{
+		activeClients: []domain.ActiveClient{
+			{Client: "vscode", PID: 2, LastHeartbeat: heartbeat},
+			{
+				Client:        "cursor",
+				PID:           1,
+				Profile:       "cursor-readonly",
+				LastHeartbeat: heartbeat,
+				Info: domain.ClientInfo{
+					Name:         "cursor",
+					Version:      "1.2.0",
+					Capabilities: domain.ClientCapabilities{Elicitation: true, Roots: true},
+				},
+			},
+		},
+	}, nil, nil)
+
+	resp, err := svc.ListCallers(context.Background(), &controlv1.ListCallersRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetCallers(), 2)
+	first := resp.GetCallers()[0]
+	require.Equal(t, "cursor", first.GetCaller())
+	require.Equal(t, "cursor-readonly", first.GetProfile())
+	require.Equal(t, heartbeat.UnixNano(), first.GetLastHeartbeatUnixNano())
+	require.Equal(t, "1.2.0", first.GetClientInfo().GetVersion())
+	require.True(t, first.GetClientInfo().GetRoots())
+	require.True(t, first.GetClientInfo().GetElicitation())
+	require.False(t, first.GetClientInfo().GetSampling())
+	require.Nil(t, resp.GetCallers()[1].GetClientInfo())
+}
+
+func TestControlService_GovernanceMetadataIncludesClientInfo(t *testing.T) {
+	svc := NewControlService(&fakeControlPlane{
+		activeClients: []domain.ActiveClient{{
+			Client: "cursor",
+			Info: domain.ClientInfo{
+				Name:          "cursor",
+				Version:       "1.2.0",
+				WorkspaceRoot: "/work/app",
+				Capabilities:  domain.ClientCapabilities{Sampling: true, Roots: true},
+			},
+		}},
+	}, nil, nil)
+
+	req := svc.withRequestMetadata(context.Background(), domain.GovernanceRequest{
+		Caller:   "cursor",
+		Metadata: map[string]string{domain.MetadataClientName: "override"},
+	})
+	require.Equal(t, map[string]string{
+		domain.MetadataClientName:          "override",
+		domain.MetadataClientVersion:       "1.2.0",
+		domain.MetadataClientWorkspaceRoot: "/work/app",
+		domain.MetadataClientCapabilities:  "roots,sampling",
+	}, req.Metadata)
+
+	req = svc.withRequestMetadata(context.Background(), domain.GovernanceRequest{Caller: "unknown"})
+	require.Nil(t, req.Metadata)
+}
+
+func TestControlService_GovernanceCallerIsIdentity(t *testing.T) {
+	info := domain.ClientInfo{Identity: "alice"}
+	svc := NewControlService(&fakeControlPlane{
+		activeClients: []domain.ActiveClient{
+			{Client: "alice:server:context7", Info: info},
+			{Client: "alice:tags:git", Info: info},
+			{Client: "cursor"},
+		},
+	}, nil, nil)
+
+	for _, caller := range []string{"alice:server:context7", "alice:tags:git"} {
+		req := svc.withRequestMetadata(context.Background(), domain.GovernanceRequest{Caller: caller})
+		require.Equal(t, "alice", req.Caller)
+	}
+	req := svc.withRequestMetadata(context.Background(), domain.GovernanceRequest{Caller: "cursor"})
+	require.Equal(t, "cursor", req.Caller)
+}
+
+func TestControlService_GetQuotaStatus(t *testing.T) {
+	resetAt := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	control := &fakeControlPlane{
+		quotaStatuses: []domain.QuotaStatus{{
+			Rule:            "cursor-github",
+			Key:             "cursor",
+			Scope:           domain.RateLimitScopeCaller,
+			Rate:            60,
+			PeriodSeconds:   60,
+			Burst:           60,
+			TokensAvailable: 12.5,
+			DailyQuota:      100,
+			QuotaUsed:       40,
+			QuotaRemaining:  60,
+			ResetAt:         resetAt,
+		}},
+	}
+	svc := NewControlService(control, nil, nil)
+
+	resp, err := svc.GetQuotaStatus(context.Background(), &controlv1.GetQuotaStatusRequest{Caller: "cursor"})
+	require.NoError(t, err)
+	require.Equal(t, "cursor", control.quotaCaller)
+	require.Len(t, resp.GetStatuses(), 1)
+	got := resp.GetStatuses()[0]
+	require.Equal(t, "cursor-github", got.GetRule())
+	require.Equal(t, "caller", got.GetScope())
+	require.InDelta(t, 12.5, got.GetTokensAvailable(), 0)
+	require.Equal(t, int32(60), got.GetQuotaRemaining())
+	require.Equal(t, resetAt.UnixNano(), got.GetResetAtUnixNano())
+}
+
+func TestControlService_QueryCallHistory(t *testing.T) {
+	at := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	control := &fakeControlPlane{
+		historyPage: domain.CallHistoryPage{
+			Records: []domain.CallRecord{{
+				ID:          "0001",
+				Time:        at,
+				Caller:      "cursor",
+				Server:      "github",
+				Tool:        "github.search",
+				Duration:    1500 * time.Millisecond,
+				Status:      domain.CallStatusRejected,
+				Reason:      "rate_limited",
+				ResultBytes: 12,
+			}},
+			NextCursor: "0001",
+		},
+	}
+	svc := NewControlService(control, nil, nil)
+
+	since := at.Add(-time.Hour)
+	resp, err := svc.QueryCallHistory(context.Background(), &controlv1.QueryCallHistoryRequest{
+		Caller:        "cursor",
+		Status:        "Rejected",
+		SinceUnixNano: since.UnixNano(),
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "cursor", control.historyQuery.Caller)
+	require.Equal(t, domain.CallStatusRejected, control.historyQuery.Status)
+	require.True(t, since.Equal(control.historyQuery.Since))
+	require.True(t, control.historyQuery.Until.IsZero())
+	require.Equal(t, 10, control.historyQuery.Limit)
+	require.Equal(t, "0001", resp.GetNextCursor())
+	require.Len(t, resp.GetRecords(), 1)
+	got := resp.GetRecords()[0]
+	require.Equal(t, at.UnixNano(), got.GetTimeUnixNano())
+	require.Equal(t, int64(1500), got.GetDurationMs())
+	require.Equal(t, "rejected", got.GetStatus())
+	require.Equal(t, int64(12), got.GetResultBytes())
+
+	_, err = svc.QueryCallHistory(context.Background(), &controlv1.QueryCallHistoryRequest{Status: "pending"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestControlService_ExportDiagnostics(t *testing.T) {
+	control := &fakeControlPlane{}
+	svc := NewControlService(control, nil, nil)
+
+	resp, err := svc.ExportDiagnostics(context.Background(), &controlv1.ExportDiagnosticsRequest{
+		Mode:             " Deep ",
+		IncludeLogs:      true,
+		MaxLogEntries:    50,
+		StuckThresholdMs: 1500,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("PK"), resp.GetArchive())
+	require.Equal(t, int64(42), resp.GetGeneratedAtUnixNano())
+	require.Equal(t, domain.DiagnosticsRedactionDeep, control.diagnosticsOpts.Mode)
+	require.True(t, control.diagnosticsOpts.IncludeLogs)
+	require.Equal(t, 50, control.diagnosticsOpts.MaxLogEntries)
+	require.Equal(t, 1500*time.Millisecond, control.diagnosticsOpts.StuckThreshold)
+
+	_, err = svc.ExportDiagnostics(context.Background(), &controlv1.ExportDiagnosticsRequest{Mode: "everything"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestControlService_WatchDiagnosticsEvents(t *testing.T) {
+	control := &fakeControlPlane{
+		diagnosticsEvents: []domain.DiagnosticsEvent{{
+			Seq:        7,
+			SpecKey:    "spec-a",
+			ServerName: "github",
+			AttemptID:  "attempt-1",
+			Step:       "initialize_call",
+			Phase:      "error",
+			Timestamp:  time.Unix(0, 99),
+			Duration:   1500 * time.Millisecond,
+			Error:      "timeout",
+			Attributes: map[string]string{"transport": "stdio"},
+		}},
+	}
+	svc := NewControlService(control, nil, nil)
+	stream := &fakeDiagnosticsEventsStream{fakeWatchToolsStream: fakeWatchToolsStream{ctx: context.Background()}}
+
+	err := svc.WatchDiagnosticsEvents(&controlv1.WatchDiagnosticsEventsRequest{
+		Server:      " github ",
+		Phase:       "ERROR",
+		Replay:      true,
+		ReplayLimit: 20,
+		Follow:      true,
+	}, stream)
+	require.NoError(t, err)
+	require.Equal(t, domain.DiagnosticsEventQuery{
+		Server:      "github",
+		Phase:       "error",
+		Replay:      true,
+		ReplayLimit: 20,
+		Follow:      true,
+	}, control.diagnosticsQuery)
+	require.Len(t, stream.events, 1)
+	event := stream.events[0]
+	require.Equal(t, uint64(7), event.GetSeq())
+	require.Equal(t, "attempt-1", event.GetAttemptId())
+	require.Equal(t, int64(99), event.GetTimestampUnixNano())
+	require.Equal(t, int64(1500), event.GetDurationMs())
+	require.JSONEq(t, `{"transport":"stdio"}`, string(event.GetAttributesJson()))
+}
+
+func TestControlService_GetToolStats(t *testing.T) {
+	control := &fakeControlPlane{
+		toolStats: domain.ToolStats{
+			Tools: []domain.ToolLatencyStats{{
+				Server: "github",
+				Tool:   "search",
+				Calls:  10,
+				Errors: 1,
+				P50:    1500 * time.Microsecond,
+				P99:    2 * time.Second,
+			}},
+			SLOs: []domain.ToolSLOStatus{{
+				Name:          "search",
+				LatencyTarget: time.Second,
+				ErrorBudget:   0.01,
+				Window:        time.Hour,
+				WindowCalls:   10,
+				BurnRate:      10,
+			}},
+		},
+	}
+	svc := NewControlService(control, nil, nil)
+
+	resp, err := svc.GetToolStats(context.Background(), &controlv1.GetToolStatsRequest{Server: " github "})
+	require.NoError(t, err)
+	require.Equal(t, "github", control.toolStatsQuery.Server)
+	require.Len(t, resp.GetTools(), 1)
+	require.Equal(t, uint64(1), resp.GetTools()[0].GetErrors())
+	require.InDelta(t, 1.5, resp.GetTools()[0].GetP50Ms(), 1e-9)
+	require.InDelta(t, 2000, resp.GetTools()[0].GetP99Ms(), 1e-9)
+	require.Len(t, resp.GetSlos(), 1)
+	require.Equal(t, int64(1000), resp.GetSlos()[0].GetLatencyTargetMs())
+	require.Equal(t, int64(3600), resp.GetSlos()[0].GetWindowSeconds())
+	require.InDelta(t, 10, resp.GetSlos()[0].GetBurnRate(), 1e-9)
+}
+
+func TestControlService_GetReadiness(t *testing.T) {
+	control := &fakeControlPlane{
+		readiness: domain.ReadinessReport{
+			Checks: []domain.ReadinessCheck{
+				{Name: domain.ReadinessCheckBootstrap, Ready: true},
+				{
+					Name:    domain.ReadinessCheckServers,
+					Message: "1 of 1 servers below minReady",
+					Components: []domain.ReadinessComponent{
+						{Name: "github", Message: "starting: 0/1 ready"},
+					},
+				},
+			},
+			CheckedAt: time.Unix(0, 42),
+		},
+	}
+	svc := NewControlService(control, nil, nil)
+
+	resp, err := svc.GetReadiness(context.Background(), &controlv1.GetReadinessRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.GetReady())
+	require.Equal(t, int64(42), resp.GetCheckedAtUnixNano())
+	require.Len(t, resp.GetChecks(), 2)
+	require.True(t, resp.GetChecks()[0].GetReady())
+	servers := resp.GetChecks()[1]
+	require.Equal(t, domain.ReadinessCheckServers, servers.GetName())
+	require.Len(t, servers.GetComponents(), 1)
+	require.Equal(t, "github", servers.GetComponents()[0].GetName())
+	require.Equal(t, "starting: 0/1 ready", servers.GetComponents()[0].GetMessage())
 }
 
 func TestControlService_ListToolsRequiresCaller(t *testing.T) {
@@ -240,6 +564,16 @@ func (f *fakeWatchToolsStream) SetTrailer(metadata.MD)       {}
 func (f *fakeWatchToolsStream) SendMsg(any) error            { return nil }
 func (f *fakeWatchToolsStream) RecvMsg(any) error            { return nil }
 
+type fakeDiagnosticsEventsStream struct {
+	fakeWatchToolsStream
+	events []*controlv1.DiagnosticsEvent
+}
+
+func (f *fakeDiagnosticsEventsStream) Send(event *controlv1.DiagnosticsEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
 type fakeControlPlane struct {
 	snapshot             domain.ToolSnapshot
 	resourcePage         domain.ResourcePage
@@ -252,13 +586,26 @@ type fakeControlPlane struct {
 	registerErr          error
 	unregisterErr        error
 	watchToolsCh         <-chan domain.ToolSnapshot
+	quotaStatuses        []domain.QuotaStatus
+	quotaCaller          string
+	historyPage          domain.CallHistoryPage
+	historyQuery         domain.CallHistoryQuery
+	toolStats            domain.ToolStats
+	toolStatsQuery       domain.ToolStatsQuery
+	diagnosticsOpts      domain.DiagnosticsExportOptions
+	diagnosticsQuery     domain.DiagnosticsEventQuery
+	diagnosticsEvents    []domain.DiagnosticsEvent
+	readiness            domain.ReadinessReport
+	registerInfo         domain.ClientInfo
+	activeClients        []domain.ActiveClient
 }
 
 func (f *fakeControlPlane) Info(_ context.Context) (domain.ControlPlaneInfo, error) {
 	return domain.ControlPlaneInfo{}, nil
 }
 
-func (f *fakeControlPlane) RegisterClient(_ context.Context, client string, _ int, _ []string, _ string) (domain.ClientRegistration, error) {
+func (f *fakeControlPlane) RegisterClient(_ context.Context, client string, _ int, _ []string, _ string, info domain.ClientInfo) (domain.ClientRegistration, error) {
+	f.registerInfo = info
 	if f.registerErr != nil {
 		return domain.ClientRegistration{}, f.registerErr
 	}
@@ -272,8 +619,17 @@ func (f *fakeControlPlane) UnregisterClient(_ context.Context, _ string) error {
 	return f.unregisterErr
 }
 
+func (f *fakeControlPlane) ClientInfo(client string) (domain.ClientInfo, bool) {
+	for _, active := range f.activeClients {
+		if active.Client == client {
+			return active.Info, true
+		}
+	}
+	return domain.ClientInfo{}, false
+}
+
 func (f *fakeControlPlane) ListActiveClients(_ context.Context) ([]domain.ActiveClient, error) {
-	return nil, nil
+	return f.activeClients, nil
 }
 
 func (f *fakeControlPlane) WatchActiveClients(_ context.Context) (<-chan domain.ActiveClientSnapshot, error) {
@@ -366,14 +722,14 @@ func (f *fakeControlPlane) GetPromptAll(_ context.Context, name string, args jso
 	return f.GetPrompt(context.TODO(), "", name, args)
 }
 
-func (f *fakeControlPlane) StreamLogs(_ context.Context, _ string, _ domain.LogLevel) (<-chan domain.LogEntry, error) {
+func (f *fakeControlPlane) StreamLogs(_ context.Context, _ string, _ domain.LogQuery) (<-chan domain.LogEntry, error) {
 	ch := make(chan domain.LogEntry)
 	close(ch)
 	return ch, nil
 }
 
-func (f *fakeControlPlane) StreamLogsAllServers(_ context.Context, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	return f.StreamLogs(context.TODO(), "", minLevel)
+func (f *fakeControlPlane) StreamLogsAllServers(_ context.Context, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	return f.StreamLogs(context.TODO(), "", query)
 }
 
 func (f *fakeControlPlane) GetCatalog() domain.Catalog {
@@ -438,6 +794,43 @@ func (f *fakeControlPlane) IsSubAgentEnabledForClient(_ string) bool {
 	return false
 }
 
+func (f *fakeControlPlane) GetQuotaStatus(_ context.Context, caller string) ([]domain.QuotaStatus, error) {
+	f.quotaCaller = caller
+	return f.quotaStatuses, nil
+}
+
+func (f *fakeControlPlane) QueryCallHistory(_ context.Context, query domain.CallHistoryQuery) (domain.CallHistoryPage, error) {
+	f.historyQuery = query
+	return f.historyPage, nil
+}
+
+func (f *fakeControlPlane) GetToolStats(_ context.Context, query domain.ToolStatsQuery) (domain.ToolStats, error) {
+	f.toolStatsQuery = query
+	return f.toolStats, nil
+}
+
+func (f *fakeControlPlane) ExportDiagnostics(_ context.Context, opts domain.DiagnosticsExportOptions) (domain.DiagnosticsExport, error) {
+	f.diagnosticsOpts = opts
+	if opts.Mode != domain.DiagnosticsRedactionSafe && opts.Mode != domain.DiagnosticsRedactionDeep {
+		return domain.DiagnosticsExport{}, domain.ErrInvalidRequest
+	}
+	return domain.DiagnosticsExport{Archive: []byte("PK"), GeneratedAt: time.Unix(0, 42)}, nil
+}
+
+func (f *fakeControlPlane) GetReadiness(_ context.Context) (domain.ReadinessReport, error) {
+	return f.readiness, nil
+}
+
+func (f *fakeControlPlane) WatchDiagnosticsEvents(_ context.Context, query domain.DiagnosticsEventQuery) (<-chan domain.DiagnosticsEvent, error) {
+	f.diagnosticsQuery = query
+	ch := make(chan domain.DiagnosticsEvent, len(f.diagnosticsEvents))
+	for _, event := range f.diagnosticsEvents {
+		ch <- event
+	}
+	close(ch)
+	return ch, nil
+}
+
 func (f *fakeControlPlane) CallToolTask(_ context.Context, _, _ string, _ json.RawMessage, _ string, _ domain.TaskCreateOptions) (domain.Task, error) {
 	return domain.Task{}, nil
 }
@@ -0,0 +1,25 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/mapping"
+	controlv1 "mcpv/pkg/api/control/v1"
+)
+
+// GetToolStats reports per-tool latency percentiles and SLO burn rates.
+func (s *ControlService) GetToolStats(ctx context.Context, req *controlv1.GetToolStatsRequest) (*controlv1.GetToolStatsResponse, error) {
+	stats, err := s.control.GetToolStats(ctx, domain.ToolStatsQuery{
+		Server: strings.TrimSpace(req.GetServer()),
+		Tool:   strings.TrimSpace(req.GetTool()),
+	})
+	if err != nil {
+		return nil, statusFromError("get tool stats", err)
+	}
+	return &controlv1.GetToolStatsResponse{
+		Tools: mapping.MapSlice(stats.Tools, toProtoToolStats),
+		Slos:  mapping.MapSlice(stats.SLOs, toProtoToolSLOStatus),
+	}, nil
+}
@@ -18,11 +18,11 @@ func (s *ControlService) ListTools(ctx context.Context, req *controlv1.ListTools
 	protoSnapshot, _, err := guardedList(guardedListPlan[domain.ToolSnapshot, *controlv1.ToolsSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "tools/list",
 			Caller: client,
 		}),
-		responseRequest: withRequestMetadata(ctx, domain.GovernanceRequest{
+		responseRequest: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "tools/list",
 			Caller: client,
 		}),
@@ -53,7 +53,7 @@ func (s *ControlService) WatchTools(req *controlv1.WatchToolsRequest, stream con
 	return guardedWatch(guardedWatchPlan[domain.ToolSnapshot, *controlv1.ToolsSnapshot]{
 		ctx:   ctx,
 		guard: &s.guard,
-		request: withRequestMetadata(ctx, domain.GovernanceRequest{
+		request: s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method: "tools/list",
 			Caller: client,
 		}),
@@ -82,7 +82,7 @@ func (s *ControlService) CallTool(ctx context.Context, req *controlv1.CallToolRe
 	var result json.RawMessage
 	var err error
 	if s.executor != nil {
-		result, err = s.executor.Execute(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+		result, err = s.executor.Execute(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "tools/call",
 			Caller:      client,
 			ToolName:    toolName,
@@ -141,7 +141,7 @@ func (s *ControlService) AutomaticMCP(ctx context.Context, req *controlv1.Automa
 		ForceRefresh: req.GetForceRefresh(),
 	}
 
-	if err := s.guard.applyRequest(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+	if err := s.guard.applyRequest(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 		Method:      "tools/call",
 		Caller:      client,
 		ToolName:    "mcpv.automatic_mcp",
@@ -161,7 +161,7 @@ func (s *ControlService) AutomaticMCP(ctx context.Context, req *controlv1.Automa
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "automatic_mcp: %v", err)
 	}
-	if err := s.guard.applyProtoResponse(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+	if err := s.guard.applyProtoResponse(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 		Method:   "tools/call",
 		Caller:   client,
 		ToolName: "mcpv.automatic_mcp",
@@ -187,7 +187,7 @@ func (s *ControlService) AutomaticEval(ctx context.Context, req *controlv1.Autom
 	var result json.RawMessage
 	var err error
 	if s.executor != nil {
-		result, err = s.executor.Execute(ctx, withRequestMetadata(ctx, domain.GovernanceRequest{
+		result, err = s.executor.Execute(ctx, s.withRequestMetadata(ctx, domain.GovernanceRequest{
 			Method:      "tools/call",
 			Caller:      client,
 			ToolName:    "mcpv.automatic_eval",
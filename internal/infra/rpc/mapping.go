@@ -3,6 +3,7 @@ package rpc
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/mapping"
@@ -151,6 +152,8 @@ func toProtoServerRuntimeStatus(s domain.ServerRuntimeStatus) *controlv1.ServerR
 			SpawnedAtUnixNano:       inst.SpawnedAt.UnixNano(),
 			HandshakedAtUnixNano:    inst.HandshakedAt.UnixNano(),
 			LastHeartbeatAtUnixNano: inst.LastHeartbeatAt.UnixNano(),
+			Pid:                     int32(inst.PID),
+			Resources:               toProtoProcessResources(inst.Resources),
 		}
 	})
 	return &controlv1.ServerRuntimeStatus{
@@ -173,17 +176,34 @@ func toProtoServerRuntimeStatus(s domain.ServerRuntimeStatus) *controlv1.ServerR
 				lastCallAtUnixNano = s.Metrics.LastCallAt.UnixNano()
 			}
 			return &controlv1.PoolMetrics{
-				StartCount:         int32(s.Metrics.StartCount),
-				StopCount:          int32(s.Metrics.StopCount),
-				TotalCalls:         s.Metrics.TotalCalls,
-				TotalErrors:        s.Metrics.TotalErrors,
-				TotalDurationMs:    s.Metrics.TotalDuration.Milliseconds(),
-				LastCallAtUnixNano: lastCallAtUnixNano,
+				StartCount:           int32(s.Metrics.StartCount),
+				StopCount:            int32(s.Metrics.StopCount),
+				TotalCalls:           s.Metrics.TotalCalls,
+				TotalErrors:          s.Metrics.TotalErrors,
+				TotalDurationMs:      s.Metrics.TotalDuration.Milliseconds(),
+				LastCallAtUnixNano:   lastCallAtUnixNano,
+				IdleReaps:            int32(s.Metrics.IdleReaps),
+				SavedInstanceSeconds: s.Metrics.SavedInstanceSeconds,
+				SavedRssByteSeconds:  s.Metrics.SavedRSSByteSeconds,
 			}
 		}(),
 	}
 }
 
+func toProtoProcessResources(resources *domain.ProcessResources) *controlv1.ProcessResources {
+	if resources == nil {
+		return nil
+	}
+	return &controlv1.ProcessResources{
+		Processes:         int32(resources.Processes),
+		CpuTimeMs:         resources.CPUTime.Milliseconds(),
+		RssBytes:          resources.RSSBytes,
+		OpenFds:           int32(resources.OpenFDs),
+		Threads:           int32(resources.Threads),
+		SampledAtUnixNano: resources.SampledAt.UnixNano(),
+	}
+}
+
 func toProtoServerInitStatusSnapshot(snapshot domain.ServerInitStatusSnapshot) *controlv1.ServerInitStatusSnapshot {
 	statuses := mapping.MapSlice(snapshot.Statuses, func(s domain.ServerInitStatus) *controlv1.ServerInitStatus {
 		return &controlv1.ServerInitStatus{
@@ -202,3 +222,159 @@ func toProtoServerInitStatusSnapshot(snapshot domain.ServerInitStatusSnapshot) *
 		GeneratedAtUnixNano: snapshot.GeneratedAt.UnixNano(),
 	}
 }
+
+func toProtoQuotaStatus(s domain.QuotaStatus) *controlv1.QuotaStatus {
+	resetAt := int64(0)
+	if !s.ResetAt.IsZero() {
+		resetAt = s.ResetAt.UnixNano()
+	}
+	return &controlv1.QuotaStatus{
+		Rule:            s.Rule,
+		Key:             s.Key,
+		Scope:           string(s.Scope),
+		Rate:            int32(s.Rate),
+		PeriodSeconds:   int32(s.PeriodSeconds),
+		Burst:           int32(s.Burst),
+		TokensAvailable: s.TokensAvailable,
+		DailyQuota:      int32(s.DailyQuota),
+		QuotaUsed:       int32(s.QuotaUsed),
+		QuotaRemaining:  int32(s.QuotaRemaining),
+		ResetAtUnixNano: resetAt,
+	}
+}
+
+func toProtoCallRecord(r domain.CallRecord) *controlv1.CallRecord {
+	return &controlv1.CallRecord{
+		Id:            r.ID,
+		TimeUnixNano:  r.Time.UnixNano(),
+		Caller:        r.Caller,
+		Server:        r.Server,
+		Tool:          r.Tool,
+		DurationMs:    r.Duration.Milliseconds(),
+		Status:        string(r.Status),
+		Reason:        r.Reason,
+		ArgumentBytes: int64(r.ArgumentBytes),
+		ResultBytes:   int64(r.ResultBytes),
+		ArgumentsJson: r.Arguments,
+		ResultJson:    r.Result,
+	}
+}
+
+func toProtoToolStats(stats domain.ToolLatencyStats) *controlv1.ToolStats {
+	return &controlv1.ToolStats{
+		Server: stats.Server,
+		Tool:   stats.Tool,
+		Calls:  stats.Calls,
+		Errors: stats.Errors,
+		P50Ms:  durationMillis(stats.P50),
+		P95Ms:  durationMillis(stats.P95),
+		P99Ms:  durationMillis(stats.P99),
+		MaxMs:  durationMillis(stats.Max),
+	}
+}
+
+func toProtoToolSLOStatus(status domain.ToolSLOStatus) *controlv1.ToolSLOStatus {
+	return &controlv1.ToolSLOStatus{
+		Name:            status.Name,
+		Server:          status.Server,
+		Tool:            status.Tool,
+		LatencyTargetMs: status.LatencyTarget.Milliseconds(),
+		ErrorBudget:     status.ErrorBudget,
+		WindowSeconds:   int64(status.Window.Seconds()),
+		WindowCalls:     status.WindowCalls,
+		WindowBadCalls:  status.WindowBadCalls,
+		BurnRate:        status.BurnRate,
+	}
+}
+
+func toProtoReadinessCheck(check domain.ReadinessCheck) *controlv1.ReadinessCheck {
+	return &controlv1.ReadinessCheck{
+		Name:       check.Name,
+		Ready:      check.Ready,
+		Message:    check.Message,
+		Components: mapping.MapSlice(check.Components, toProtoReadinessComponent),
+	}
+}
+
+func toProtoReadinessComponent(component domain.ReadinessComponent) *controlv1.ReadinessComponent {
+	return &controlv1.ReadinessComponent{
+		Name:    component.Name,
+		Ready:   component.Ready,
+		Message: component.Message,
+	}
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func fromProtoClientInfo(info *controlv1.ClientInfo) domain.ClientInfo {
+	if info == nil {
+		return domain.ClientInfo{}
+	}
+	return domain.ClientInfo{
+		Name:          info.GetName(),
+		Version:       info.GetVersion(),
+		WorkspaceRoot: info.GetWorkspaceRoot(),
+		Identity:      info.GetIdentity(),
+		Capabilities: domain.ClientCapabilities{
+			Sampling:    info.GetSampling(),
+			Elicitation: info.GetElicitation(),
+			Roots:       info.GetRoots(),
+		},
+		Roots: fromProtoRoots(info.GetRootList()),
+	}
+}
+
+func fromProtoRoots(roots []*controlv1.Root) []domain.Root {
+	if len(roots) == 0 {
+		return nil
+	}
+	out := make([]domain.Root, 0, len(roots))
+	for _, root := range roots {
+		if root.GetUri() == "" {
+			continue
+		}
+		out = append(out, domain.Root{URI: root.GetUri(), Name: root.GetName()})
+	}
+	return out
+}
+
+func toProtoRoots(roots []domain.Root) []*controlv1.Root {
+	if len(roots) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.Root, 0, len(roots))
+	for _, root := range roots {
+		out = append(out, &controlv1.Root{Uri: root.URI, Name: root.Name})
+	}
+	return out
+}
+
+func toProtoClientInfo(info domain.ClientInfo) *controlv1.ClientInfo {
+	if info.IsZero() {
+		return nil
+	}
+	return &controlv1.ClientInfo{
+		Name:          info.Name,
+		Version:       info.Version,
+		WorkspaceRoot: info.WorkspaceRoot,
+		Identity:      info.Identity,
+		Sampling:      info.Capabilities.Sampling,
+		Elicitation:   info.Capabilities.Elicitation,
+		Roots:         info.Capabilities.Roots,
+		RootList:      toProtoRoots(info.Roots),
+	}
+}
+
+func toProtoActiveCaller(client domain.ActiveClient) *controlv1.ActiveCaller {
+	return &controlv1.ActiveCaller{
+		Caller:                client.Client,
+		Pid:                   int64(client.PID),
+		Tags:                  append([]string(nil), client.Tags...),
+		Server:                client.Server,
+		Profile:               client.Profile,
+		LastHeartbeatUnixNano: client.LastHeartbeat.UnixNano(),
+		ClientInfo:            toProtoClientInfo(client.Info),
+	}
+}
@@ -2,14 +2,23 @@ package rpc
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"mcpv/internal/domain"
 	"mcpv/internal/infra/telemetry"
 )
 
+// CacheBypassHeader carries a caller's request to skip the response cache.
+const CacheBypassHeader = "x-mcpv-cache-bypass"
+
+// SuppressedToolListChangesHeader carries the number of tool list changes a
+// gateway held back from pinned sessions since its previous heartbeat.
+const SuppressedToolListChangesHeader = "x-mcpv-suppressed-tool-list-changes"
+
 type requestContextServerStream struct {
 	grpc.ServerStream
 	ctx context.Context
@@ -22,6 +31,7 @@ func (s *requestContextServerStream) Context() context.Context {
 func requestContextUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		ctx, _ = ensureRequestMeta(ctx)
+		ctx = withCacheBypassFromMetadata(ctx)
 		return handler(ctx, req)
 	}
 }
@@ -123,3 +133,46 @@ func injectRequestID(ctx context.Context) context.Context {
 	md.Set(telemetry.RequestIDHeader, requestID)
 	return metadata.NewOutgoingContext(ctx, md)
 }
+
+func withCacheBypassFromMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	for _, value := range md.Get(CacheBypassHeader) {
+		if strings.TrimSpace(value) == "true" {
+			return domain.WithCacheBypass(ctx)
+		}
+	}
+	return ctx
+}
+
+// WithCacheBypassHeader asks the core to skip the response cache for calls made
+// with the returned context.
+func WithCacheBypassHeader(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, CacheBypassHeader, "true")
+}
+
+// WithSuppressedToolListChanges reports suppressed tool list changes on the
+// RegisterCaller heartbeat made with the returned context.
+func WithSuppressedToolListChanges(ctx context.Context, count int64) context.Context {
+	if count <= 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, SuppressedToolListChangesHeader, strconv.FormatInt(count, 10))
+}
+
+func suppressedToolListChangesFromMetadata(ctx context.Context) int64 {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+	var total int64
+	for _, value := range md.Get(SuppressedToolListChangesHeader) {
+		count, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err == nil && count > 0 {
+			total += count
+		}
+	}
+	return total
+}
@@ -24,3 +24,31 @@ func withRequestMetadata(ctx context.Context, req domain.GovernanceRequest) doma
 	req.Metadata[telemetry.FieldRequestID] = requestID
 	return req
 }
+
+// withRequestMetadata adds the request ID and the caller's client info to the
+// governance request metadata. Keys already present are kept. When the caller
+// acts for an identity, governance sees the identity as the caller.
+func (s *ControlService) withRequestMetadata(ctx context.Context, req domain.GovernanceRequest) domain.GovernanceRequest {
+	req = withRequestMetadata(ctx, req)
+	if req.Caller == "" {
+		return req
+	}
+	info, ok := s.control.ClientInfo(req.Caller)
+	if !ok {
+		return req
+	}
+	req.Caller = info.Principal(req.Caller)
+	entries := info.Metadata()
+	if len(entries) == 0 {
+		return req
+	}
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]string, len(entries))
+	}
+	for key, value := range entries {
+		if _, exists := req.Metadata[key]; !exists {
+			req.Metadata[key] = value
+		}
+	}
+	return req
+}
@@ -6,6 +6,8 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -26,16 +28,18 @@ type Server struct {
 	cfg        domain.RPCConfig
 	control    ControlPlaneAPI
 	executor   *governance.Executor
+	metrics    domain.Metrics
 	logger     *zap.Logger
 	grpcServer *grpc.Server
 	listener   net.Listener
 	health     *health.Server
 	network    string
 	address    string
+	serving    atomic.Bool
 }
 
 // NewServer constructs a gRPC server for the control plane.
-func NewServer(control ControlPlaneAPI, executor *governance.Executor, cfg domain.RPCConfig, logger *zap.Logger) *Server {
+func NewServer(control ControlPlaneAPI, executor *governance.Executor, cfg domain.RPCConfig, metrics domain.Metrics, logger *zap.Logger) *Server {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -43,6 +47,7 @@ func NewServer(control ControlPlaneAPI, executor *governance.Executor, cfg domai
 		cfg:      cfg,
 		control:  control,
 		executor: executor,
+		metrics:  metrics,
 		logger:   logger.Named("rpc"),
 	}
 }
@@ -134,12 +139,16 @@ func (s *Server) Run(ctx context.Context) error {
 	s.grpcServer = grpc.NewServer(serverOpts...)
 	s.health = health.NewServer()
 	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.health)
-	controlv1.RegisterControlPlaneServiceServer(s.grpcServer, NewControlService(s.control, s.executor, s.logger))
+	controlService := NewControlService(s.control, s.executor, s.logger)
+	controlService.metrics = s.metrics
+	controlv1.RegisterControlPlaneServiceServer(s.grpcServer, controlService)
 	s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- s.grpcServer.Serve(lis)
+		err := s.grpcServer.Serve(&servingListener{Listener: lis, serving: &s.serving})
+		s.serving.Store(false)
+		errCh <- err
 	}()
 
 	s.logger.Info("rpc server started", zap.String("network", network), zap.String("address", addr))
@@ -157,11 +166,30 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 }
 
+// Serving reports whether the listener is bound and accepting RPCs.
+func (s *Server) Serving() bool {
+	return s != nil && s.serving.Load()
+}
+
+// servingListener marks the server as serving once Serve starts accepting
+// connections; Run clears the mark when Serve returns.
+type servingListener struct {
+	net.Listener
+	serving *atomic.Bool
+	once    sync.Once
+}
+
+func (l *servingListener) Accept() (net.Conn, error) {
+	l.once.Do(func() { l.serving.Store(true) })
+	return l.Listener.Accept()
+}
+
 // Stop gracefully shuts down the server, falling back to a hard stop on timeout.
 func (s *Server) Stop(ctx context.Context) error {
 	if s.grpcServer == nil {
 		return nil
 	}
+	s.serving.Store(false)
 	if s.health != nil {
 		s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 	}
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+)
+
+func TestServer_ServingTracksServeLifetime(t *testing.T) {
+	srv := NewServer(&fakeControlPlane{}, nil, domain.RPCConfig{ListenAddress: "tcp://127.0.0.1:0"}, nil, nil)
+	require.False(t, srv.Serving())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Run(context.Background())
+	}()
+	require.Eventually(t, srv.Serving, 5*time.Second, 10*time.Millisecond)
+
+	// Closing the listener makes Serve fail; Serving must not outlive it.
+	require.NoError(t, srv.listener.Close())
+	select {
+	case err := <-done:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("rpc server did not stop after its listener closed")
+	}
+	require.False(t, srv.Serving())
+}
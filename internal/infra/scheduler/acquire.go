@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/telemetry"
 	"mcpv/internal/infra/telemetry/diagnostics"
 )
 
@@ -16,6 +17,16 @@ func (s *BasicScheduler) Acquire(ctx context.Context, specKey, routingKey string
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	ctx, span := telemetry.StartSpan(ctx, "scheduler.acquire", telemetry.AttrSpecKey.String(specKey))
+	inst, err := s.acquire(ctx, specKey, routingKey)
+	if inst != nil {
+		span.SetAttributes(telemetry.AttrInstanceID.String(inst.ID()))
+	}
+	telemetry.EndSpan(span, err)
+	return inst, err
+}
+
+func (s *BasicScheduler) acquire(ctx context.Context, specKey, routingKey string) (*domain.Instance, error) {
 	spec, ok := s.specForKey(specKey)
 	if !ok {
 		return nil, wrapSchedulerError("scheduler acquire", ErrUnknownSpecKey)
@@ -130,7 +141,12 @@ func (s *BasicScheduler) Acquire(ctx context.Context, specKey, routingKey string
 				}
 			}()
 			s.observeInstanceStartCause(ctx, state.spec.Name)
-			newInst, err = s.lifecycle.StartInstance(startCtx, specKey, state.spec)
+			spanCtx, span := telemetry.StartSpan(startCtx, "scheduler.start",
+				telemetry.AttrServerType.String(state.spec.Name),
+				telemetry.AttrSpecKey.String(specKey),
+			)
+			newInst, err = s.lifecycle.StartInstance(spanCtx, specKey, state.spec)
+			telemetry.EndSpan(span, err)
 			s.observeInstanceStart(state.spec.Name, started, err)
 			if err == nil {
 				s.applyStartCause(ctx, newInst, started)
@@ -183,6 +199,7 @@ func (s *BasicScheduler) Acquire(ctx context.Context, specKey, routingKey string
 		}
 
 		state.instances = append(state.instances, tracked)
+		state.replaceReapedLocked(time.Now())
 		if state.spec.Strategy == domain.StrategyStateful && routingKey != "" {
 			state.bindStickyLocked(routingKey, tracked)
 		}
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/process"
 	"mcpv/internal/infra/telemetry"
 	"mcpv/internal/infra/telemetry/diagnostics"
 )
@@ -32,6 +33,8 @@ type Options struct {
 	Metrics          domain.Metrics
 	Health           *telemetry.HealthTracker
 	DiagnosticsProbe diagnostics.Probe
+	// ResourceSampler samples instance processes; defaults to process.GroupUsage.
+	ResourceSampler ResourceSampler
 }
 
 // BasicScheduler orchestrates instance lifecycle and routing policies.
@@ -48,6 +51,10 @@ type BasicScheduler struct {
 	metrics domain.Metrics
 	health  *telemetry.HealthTracker
 	diag    diagnostics.Probe
+	sampler ResourceSampler
+
+	// lastResourceSample is only touched by the idle manager goroutine.
+	lastResourceSample time.Time
 
 	mu         sync.Mutex
 	idleTicker *time.Ticker
@@ -106,6 +113,14 @@ type poolState struct {
 	lastAcquireReason  domain.AcquireFailureReason
 	lastStartCause     *domain.StartCause
 	lastStartCauseAt   time.Time
+
+	reaped                  []reapedFootprint
+	idleReaps               int
+	savingsAccruedAt        time.Time
+	savedInstanceSeconds    float64
+	savedRSSByteSeconds     float64
+	reportedInstanceSeconds float64
+	reportedRSSByteSeconds  float64
 }
 
 type stopCandidate struct {
@@ -130,6 +145,10 @@ func NewBasicScheduler(lifecycle domain.Lifecycle, specs map[string]domain.Serve
 	if diag == nil {
 		diag = diagnostics.NoopProbe{}
 	}
+	sampler := opts.ResourceSampler
+	if sampler == nil {
+		sampler = process.GroupUsage
+	}
 	return &BasicScheduler{
 		lifecycle: lifecycle,
 		specs:     cloneSpecRegistry(specs),
@@ -139,6 +158,7 @@ func NewBasicScheduler(lifecycle domain.Lifecycle, specs map[string]domain.Serve
 		metrics:   opts.Metrics,
 		health:    opts.Health,
 		diag:      diag,
+		sampler:   sampler,
 		stopIdle:  make(chan struct{}),
 		stopPing:  make(chan struct{}),
 	}, nil
@@ -0,0 +1,122 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/process"
+	"mcpv/internal/infra/telemetry"
+)
+
+type resourceMetrics struct {
+	telemetry.NoopMetrics
+	resources []domain.InstanceResourcesMetric
+	savings   []domain.IdleReapSavingsMetric
+}
+
+func (m *resourceMetrics) SetInstanceResources(metric domain.InstanceResourcesMetric) {
+	m.resources = append(m.resources, metric)
+}
+
+func (m *resourceMetrics) AddIdleReapSavings(metric domain.IdleReapSavingsMetric) {
+	m.savings = append(m.savings, metric)
+}
+
+func TestBasicScheduler_SampleResources(t *testing.T) {
+	lc := &pidLifecycle{pid: 4242}
+	spec := newTestSpec("svc")
+	spec.Strategy = domain.StrategyStateless
+	metrics := &resourceMetrics{}
+	var sampled []int
+	s := newScheduler(t, lc, map[string]domain.ServerSpec{"svc": spec}, Options{
+		Metrics: metrics,
+		ResourceSampler: func(pgids []int) (map[int]process.Usage, error) {
+			sampled = append(sampled, pgids...)
+			return map[int]process.Usage{
+				4242: {Processes: 2, CPUTime: 1500 * time.Millisecond, RSSBytes: 64 << 20, OpenFDs: 12, Threads: 5},
+			}, nil
+		},
+	})
+
+	inst, err := s.Acquire(context.Background(), "svc", "")
+	require.NoError(t, err)
+	require.NoError(t, s.Release(context.Background(), inst))
+
+	now := time.Now()
+	s.sampleResources(now)
+	require.Equal(t, []int{4242}, sampled)
+
+	pools, err := s.GetPoolStatus(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	require.Len(t, pools[0].Instances, 1)
+	info := pools[0].Instances[0]
+	require.Equal(t, 4242, info.PID)
+	require.Equal(t, &domain.ProcessResources{
+		Processes: 2,
+		CPUTime:   1500 * time.Millisecond,
+		RSSBytes:  64 << 20,
+		OpenFDs:   12,
+		Threads:   5,
+		SampledAt: now,
+	}, info.Resources)
+
+	require.Len(t, metrics.resources, 1)
+	require.Equal(t, domain.InstanceResourcesMetric{
+		ServerType: "svc",
+		Instances:  1,
+		CPUTime:    1500 * time.Millisecond,
+		RSSBytes:   64 << 20,
+		OpenFDs:    12,
+		Threads:    5,
+	}, metrics.resources[0])
+}
+
+func TestBasicScheduler_IdleReapSavings(t *testing.T) {
+	lc := &pidLifecycle{pid: 4242}
+	spec := newTestSpec("svc")
+	spec.MinReady = 0
+	spec.Strategy = domain.StrategyStateless
+	metrics := &resourceMetrics{}
+	s := newScheduler(t, lc, map[string]domain.ServerSpec{"svc": spec}, Options{
+		Metrics: metrics,
+		ResourceSampler: func(_ []int) (map[int]process.Usage, error) {
+			return map[int]process.Usage{4242: {Processes: 1, RSSBytes: 1000}}, nil
+		},
+	})
+
+	inst, err := s.Acquire(context.Background(), "svc", "")
+	require.NoError(t, err)
+	require.NoError(t, s.Release(context.Background(), inst))
+	s.sampleResources(time.Now())
+	s.reapIdle()
+	require.Equal(t, domain.InstanceStateStopped, inst.State())
+
+	state := s.snapshotPools()[0].state
+	state.mu.Lock()
+	reapedAt := state.savingsAccruedAt
+	state.mu.Unlock()
+	s.accrueIdleSavings(reapedAt.Add(10 * time.Second))
+
+	pools, err := s.GetPoolStatus(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, pools[0].Metrics.IdleReaps)
+	require.InDelta(t, 10, pools[0].Metrics.SavedInstanceSeconds, 0.001)
+	require.InDelta(t, 10000, pools[0].Metrics.SavedRSSByteSeconds, 0.1)
+	require.Len(t, metrics.savings, 1)
+	require.InDelta(t, 10, metrics.savings[0].InstanceSeconds, 0.001)
+
+	// Starting a replacement stops the accrual.
+	inst, err = s.Acquire(context.Background(), "svc", "")
+	require.NoError(t, err)
+	require.NoError(t, s.Release(context.Background(), inst))
+	state.mu.Lock()
+	require.Empty(t, state.reaped)
+	state.mu.Unlock()
+	s.accrueIdleSavings(time.Now().Add(time.Hour))
+	require.Len(t, metrics.savings, 1)
+}
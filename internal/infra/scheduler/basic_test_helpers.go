@@ -305,3 +305,20 @@ func (s *slowStopLifecycle) stops() int {
 	defer s.mu.Unlock()
 	return s.stopCount
 }
+
+type pidLifecycle struct {
+	fakeLifecycle
+	pid int
+}
+
+func (p *pidLifecycle) StartInstance(_ context.Context, specKey string, spec domain.ServerSpec) (*domain.Instance, error) {
+	p.counter++
+	return domain.NewInstance(domain.InstanceOptions{
+		ID:         spec.Name + "-inst",
+		Spec:       spec,
+		SpecKey:    specKey,
+		State:      domain.InstanceStateReady,
+		PID:        p.pid,
+		LastActive: time.Now(),
+	}), nil
+}
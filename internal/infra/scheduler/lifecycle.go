@@ -100,6 +100,7 @@ func (s *BasicScheduler) SetDesiredMinReady(ctx context.Context, specKey string,
 				continue
 			}
 			state.instances = append(state.instances, &trackedInstance{instance: inst})
+			state.replaceReapedLocked(time.Now())
 			state.signalWaiterLocked()
 			state.mu.Unlock()
 			s.observePoolStats(state)
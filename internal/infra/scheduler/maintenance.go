@@ -43,6 +43,7 @@ func (s *BasicScheduler) StartIdleManager(interval time.Duration) {
 					s.idleBeat.Beat()
 				}
 				s.reapIdle()
+				s.observeResources(time.Now())
 			case <-stop:
 				return
 			}
@@ -162,6 +163,7 @@ func (s *BasicScheduler) reapIdle() {
 			// regardless of IdleSeconds to clean up after bootstrap/temporary usage.
 			if minReady == 0 || idleFor >= spec.IdleDuration() {
 				inst.instance.SetState(domain.InstanceStateDraining)
+				entry.state.recordIdleReapLocked(inst.instance, now)
 				s.logger.Info("idle reap",
 					telemetry.EventField(telemetry.EventIdleReap),
 					telemetry.ServerTypeField(entry.specKey),
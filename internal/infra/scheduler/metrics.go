@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"mcpv/internal/domain"
@@ -17,8 +18,11 @@ func (s *BasicScheduler) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, er
 		entry.state.mu.Lock()
 		instances := make([]domain.InstanceInfo, 0, len(entry.state.instances)+len(entry.state.draining))
 		metrics := domain.PoolMetrics{
-			StartCount: entry.state.startCount,
-			StopCount:  entry.state.stopCount,
+			StartCount:           entry.state.startCount,
+			StopCount:            entry.state.stopCount,
+			IdleReaps:            entry.state.idleReaps,
+			SavedInstanceSeconds: entry.state.savedInstanceSeconds,
+			SavedRSSByteSeconds:  entry.state.savedRSSByteSeconds,
 		}
 
 		// Include active instances
@@ -53,7 +57,18 @@ func (s *BasicScheduler) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, er
 			LastStartCause:     entry.state.lastStartCause,
 			LastStartCauseAt:   entry.state.lastStartCauseAt,
 		}
+		var sticky []domain.StickyBindingInfo
+		for key, binding := range entry.state.sticky {
+			info := domain.StickyBindingInfo{RoutingKey: key, LastAccess: binding.lastAccess}
+			if binding.inst != nil && binding.inst.instance != nil {
+				info.InstanceID = binding.inst.instance.ID()
+			}
+			sticky = append(sticky, info)
+		}
 		entry.state.mu.Unlock()
+		sort.Slice(sticky, func(i, j int) bool {
+			return sticky[i].RoutingKey < sticky[j].RoutingKey
+		})
 
 		result = append(result, domain.PoolInfo{
 			SpecKey:     entry.specKey,
@@ -62,6 +77,7 @@ func (s *BasicScheduler) GetPoolStatus(_ context.Context) ([]domain.PoolInfo, er
 			Instances:   instances,
 			Metrics:     metrics,
 			Diagnostics: diagnostics,
+			Sticky:      sticky,
 		})
 	}
 
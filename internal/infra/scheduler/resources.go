@@ -0,0 +1,174 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/process"
+)
+
+// ResourceSampler samples the resource usage of process groups, keyed by group ID.
+type ResourceSampler func(pgids []int) (map[int]process.Usage, error)
+
+// resourceSampleInterval bounds how often instance processes are sampled and
+// idle reap savings are accrued, which also bounds runtime status churn.
+const resourceSampleInterval = 5 * time.Second
+
+// reapedFootprint remembers an instance stopped by idle reaping until the pool
+// starts a replacement.
+type reapedFootprint struct {
+	rssBytes int64
+}
+
+// recordIdleReapLocked counts an idle reap and starts accruing its savings.
+func (s *poolState) recordIdleReapLocked(inst *domain.Instance, now time.Time) {
+	s.accrueSavingsLocked(now)
+	footprint := reapedFootprint{}
+	if resources := inst.Resources(); resources != nil {
+		footprint.rssBytes = resources.RSSBytes
+	}
+	s.idleReaps++
+	s.reaped = append(s.reaped, footprint)
+}
+
+// replaceReapedLocked stops accruing savings for one reaped instance because
+// the pool started an instance again.
+func (s *poolState) replaceReapedLocked(now time.Time) {
+	if len(s.reaped) == 0 {
+		return
+	}
+	s.accrueSavingsLocked(now)
+	s.reaped = s.reaped[1:]
+	if len(s.reaped) == 0 {
+		s.reaped = nil
+	}
+}
+
+func (s *poolState) accrueSavingsLocked(now time.Time) {
+	if len(s.reaped) > 0 && !s.savingsAccruedAt.IsZero() {
+		elapsed := now.Sub(s.savingsAccruedAt).Seconds()
+		if elapsed > 0 {
+			var rss int64
+			for _, footprint := range s.reaped {
+				rss += footprint.rssBytes
+			}
+			s.savedInstanceSeconds += float64(len(s.reaped)) * elapsed
+			s.savedRSSByteSeconds += float64(rss) * elapsed
+		}
+	}
+	s.savingsAccruedAt = now
+}
+
+// observeResources samples instance processes and accrues idle reap savings
+// once per resourceSampleInterval.
+func (s *BasicScheduler) observeResources(now time.Time) {
+	if !s.lastResourceSample.IsZero() && now.Sub(s.lastResourceSample) < resourceSampleInterval {
+		return
+	}
+	s.lastResourceSample = now
+	s.sampleResources(now)
+	s.accrueIdleSavings(now)
+}
+
+// sampleResources stores a resource sample on every instance with a local
+// process and reports the per-server totals.
+func (s *BasicScheduler) sampleResources(now time.Time) {
+	if s.sampler == nil {
+		return
+	}
+	type poolInstances struct {
+		serverType string
+		instances  []*domain.Instance
+	}
+	var pools []poolInstances
+	var pgids []int
+	for _, entry := range s.snapshotPools() {
+		entry.state.mu.Lock()
+		pool := poolInstances{serverType: entry.state.spec.Name}
+		for _, list := range [][]*trackedInstance{entry.state.instances, entry.state.draining} {
+			for _, inst := range list {
+				if pid := inst.instance.PID(); pid > 0 {
+					pool.instances = append(pool.instances, inst.instance)
+					pgids = append(pgids, pid)
+				}
+			}
+		}
+		entry.state.mu.Unlock()
+		pools = append(pools, pool)
+	}
+	if len(pgids) == 0 && s.metrics == nil {
+		return
+	}
+
+	var usage map[int]process.Usage
+	if len(pgids) > 0 {
+		var err error
+		usage, err = s.sampler(pgids)
+		if err != nil {
+			if errors.Is(err, process.ErrUsageUnsupported) {
+				s.sampler = nil
+				return
+			}
+			s.logger.Warn("resource sample failed", zap.Error(err))
+			return
+		}
+	}
+
+	totals := make(map[string]*domain.InstanceResourcesMetric, len(pools))
+	for _, pool := range pools {
+		total, ok := totals[pool.serverType]
+		if !ok {
+			total = &domain.InstanceResourcesMetric{ServerType: pool.serverType}
+			totals[pool.serverType] = total
+		}
+		for _, inst := range pool.instances {
+			sample, ok := usage[inst.PID()]
+			if !ok {
+				inst.SetResources(nil)
+				continue
+			}
+			inst.SetResources(&domain.ProcessResources{
+				Processes: sample.Processes,
+				CPUTime:   sample.CPUTime,
+				RSSBytes:  sample.RSSBytes,
+				OpenFDs:   sample.OpenFDs,
+				Threads:   sample.Threads,
+				SampledAt: now,
+			})
+			total.Instances++
+			total.CPUTime += sample.CPUTime
+			total.RSSBytes += sample.RSSBytes
+			total.OpenFDs += sample.OpenFDs
+			total.Threads += sample.Threads
+		}
+	}
+	if s.metrics == nil {
+		return
+	}
+	for _, total := range totals {
+		s.metrics.SetInstanceResources(*total)
+	}
+}
+
+// accrueIdleSavings brings the idle reap savings of every pool up to now and
+// reports the increase since the previous report.
+func (s *BasicScheduler) accrueIdleSavings(now time.Time) {
+	for _, entry := range s.snapshotPools() {
+		entry.state.mu.Lock()
+		entry.state.accrueSavingsLocked(now)
+		delta := domain.IdleReapSavingsMetric{
+			ServerType:      entry.state.spec.Name,
+			InstanceSeconds: entry.state.savedInstanceSeconds - entry.state.reportedInstanceSeconds,
+			RSSByteSeconds:  entry.state.savedRSSByteSeconds - entry.state.reportedRSSByteSeconds,
+		}
+		entry.state.reportedInstanceSeconds = entry.state.savedInstanceSeconds
+		entry.state.reportedRSSByteSeconds = entry.state.savedRSSByteSeconds
+		entry.state.mu.Unlock()
+		if s.metrics != nil && (delta.InstanceSeconds > 0 || delta.RSSByteSeconds > 0) {
+			s.metrics.AddIdleReapSavings(delta)
+		}
+	}
+}
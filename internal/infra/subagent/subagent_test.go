@@ -575,6 +575,8 @@ func (m *mockMetrics) SetStartingInstances(_ string, _ int)
 func (m *mockMetrics) SetActiveInstances(_ string, _ int)                                      {}
 func (m *mockMetrics) SetPoolCapacityRatio(_ string, _ float64)                                {}
 func (m *mockMetrics) SetPoolWaiters(_ string, _ int)                                          {}
+func (m *mockMetrics) SetInstanceResources(_ domain.InstanceResourcesMetric)                   {}
+func (m *mockMetrics) AddIdleReapSavings(_ domain.IdleReapSavingsMetric)                       {}
 func (m *mockMetrics) ObservePoolAcquireFailure(_ string, _ domain.AcquireFailureReason)       {}
 func (m *mockMetrics) ObserveSubAgentTokens(_ string, _ string, _ int)                         {}
 func (m *mockMetrics) ObserveSubAgentLatency(_ string, _ string, _ time.Duration)              {}
@@ -586,6 +588,17 @@ func (m *mockMetrics) ObserveReloadApply(_ domain.ReloadApplyMetric)
 func (m *mockMetrics) ObserveReloadRollback(_ domain.ReloadRollbackMetric)                     {}
 func (m *mockMetrics) RecordGovernanceOutcome(_ domain.GovernanceOutcomeMetric)                {}
 func (m *mockMetrics) RecordGovernanceRejection(_ domain.GovernanceRejectionMetric)            {}
+func (m *mockMetrics) RecordGovernanceRedaction(_ domain.GovernanceRedactionMetric)            {}
+func (m *mockMetrics) SetRateLimitState(_ domain.RateLimitStateMetric)                         {}
+func (m *mockMetrics) DeleteRateLimitState(_, _ string)                                        {}
+func (m *mockMetrics) SetToolSLOBurnRate(_ domain.ToolSLOBurnRateMetric)                       {}
+func (m *mockMetrics) RecordResponseCacheLookup(_ domain.ResponseCacheLookupMetric)            {}
+func (m *mockMetrics) AddSuppressedToolListChanges(_ int)                                      {}
+func (m *mockMetrics) SetResponseCacheSize(_ domain.ResponseCacheSizeMetric)                   {}
 func (m *mockMetrics) RecordPluginStart(_ domain.PluginStartMetric)                            {}
 func (m *mockMetrics) RecordPluginHandshake(_ domain.PluginHandshakeMetric)                    {}
 func (m *mockMetrics) SetPluginRunning(_ domain.PluginCategory, _ string, _ bool)              {}
+func (m *mockMetrics) RecordAlertDelivery(_ string, _ domain.AlertDeliveryResult)              {}
+func (m *mockMetrics) ObserveAlertDeliveryAttempt(_ string, _ time.Duration)                   {}
+func (m *mockMetrics) RecordAlertRetry(_ string)                                               {}
+func (m *mockMetrics) RecordAlertSuppressed(_ string, _ domain.AlertSuppressReason)            {}
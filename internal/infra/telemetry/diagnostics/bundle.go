@@ -0,0 +1,511 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	defaultMaxLogEntries   = 200
+	defaultMaxEventEntries = 2000
+	defaultStuckThreshold  = 30 * time.Second
+)
+
+// Bundle is a point-in-time diagnostics export with events, logs, metrics and
+// a human-readable report.
+type Bundle struct {
+	GeneratedAt string                   `json:"generatedAt"`
+	Report      string                   `json:"report,omitempty"`
+	Snapshot    json.RawMessage          `json:"snapshot,omitempty"`
+	Catalog     json.RawMessage          `json:"catalog,omitempty"`
+	Metrics     string                   `json:"metrics,omitempty"`
+	Logs        []BundleLogEntry         `json:"logs,omitempty"`
+	Events      map[string][]BundleEvent `json:"events,omitempty"`
+	Stuck       map[string]BundleStuck   `json:"stuck,omitempty"`
+	Dropped     BundleDropped            `json:"dropped,omitempty"`
+	Redaction   BundleRedaction          `json:"redaction"`
+	Errors      []BundleError            `json:"errors,omitempty"`
+
+	generatedAt time.Time
+}
+
+// BundleDropped counts the events and logs the hub dropped under pressure.
+type BundleDropped struct {
+	Events uint64 `json:"events,omitempty"`
+	Logs   uint64 `json:"logs,omitempty"`
+}
+
+// BundleRedaction records the redaction mode the bundle was built with.
+type BundleRedaction struct {
+	Mode              string `json:"mode"`
+	ContainsSensitive bool   `json:"containsSensitive"`
+}
+
+// BundleError reports a source that could not be collected.
+type BundleError struct {
+	Source  string `json:"source"`
+	Message string `json:"message"`
+}
+
+// BundleLogEntry is a sanitized log entry.
+type BundleLogEntry struct {
+	Logger    string         `json:"logger,omitempty"`
+	Level     string         `json:"level"`
+	Timestamp string         `json:"timestamp"`
+	Data      map[string]any `json:"data,omitempty"`
+}
+
+// BundleEvent is a redacted diagnostics event.
+type BundleEvent struct {
+	SpecKey    string            `json:"specKey,omitempty"`
+	ServerName string            `json:"serverName,omitempty"`
+	AttemptID  string            `json:"attemptId,omitempty"`
+	Step       string            `json:"step"`
+	Phase      string            `json:"phase"`
+	Timestamp  string            `json:"timestamp"`
+	DurationMs int64             `json:"durationMs,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// BundleStuck describes a server whose latest step has not finished within
+// the stuck threshold.
+type BundleStuck struct {
+	Step       string `json:"step"`
+	Since      string `json:"since"`
+	DurationMs int64  `json:"durationMs"`
+	LastError  string `json:"lastError,omitempty"`
+	AttemptID  string `json:"attemptId,omitempty"`
+}
+
+// BundleSources supplies the data a bundle is built from. Nil sources are
+// reported in the bundle errors instead of failing the export.
+type BundleSources struct {
+	Hub *Hub
+	// Snapshot returns a JSON document describing the runtime state. The
+	// report reads its configPath, core and info fields when present.
+	Snapshot func(ctx context.Context) (json.RawMessage, error)
+	// Catalog returns the server catalog, already sanitized for the mode.
+	Catalog func(mode domain.DiagnosticsRedactionMode) (json.RawMessage, error)
+	// Metrics returns the Prometheus text exposition.
+	Metrics func() (string, error)
+}
+
+// BuildBundle collects the selected sections from the sources and renders the
+// report. Collection errors are recorded in the bundle rather than returned.
+func BuildBundle(ctx context.Context, sources BundleSources, opts domain.DiagnosticsExportOptions) Bundle {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	mode := domain.DiagnosticsRedactionMode(strings.TrimSpace(string(opts.Mode)))
+	if mode == "" {
+		mode = domain.DiagnosticsRedactionSafe
+	}
+
+	includeSnapshot := opts.IncludeSnapshot
+	includeMetrics := opts.IncludeMetrics
+	includeLogs := opts.IncludeLogs
+	includeEvents := opts.IncludeEvents
+	includeStuck := opts.IncludeStuck
+	if !includeSnapshot && !includeMetrics && !includeLogs && !includeEvents && !includeStuck {
+		includeSnapshot = true
+		includeMetrics = true
+		includeLogs = true
+		includeEvents = true
+		includeStuck = true
+	}
+
+	now := time.Now().UTC()
+	bundle := Bundle{
+		GeneratedAt: now.Format(time.RFC3339Nano),
+		Redaction: BundleRedaction{
+			Mode:              string(mode),
+			ContainsSensitive: mode == domain.DiagnosticsRedactionDeep,
+		},
+		generatedAt: now,
+	}
+
+	if includeSnapshot {
+		if sources.Snapshot == nil {
+			bundle.addError("snapshot", errors.New("snapshot unavailable"))
+		} else if snapshot, err := sources.Snapshot(ctx); err != nil {
+			bundle.addError("snapshot", err)
+		} else {
+			bundle.Snapshot = snapshot
+		}
+		if sources.Catalog != nil {
+			if catalog, err := sources.Catalog(mode); err != nil {
+				bundle.addError("catalog", err)
+			} else {
+				bundle.Catalog = catalog
+			}
+		}
+	}
+
+	if includeMetrics {
+		if sources.Metrics == nil {
+			bundle.addError("metrics", errors.New("metrics unavailable"))
+		} else if metrics, err := sources.Metrics(); err != nil {
+			bundle.addError("metrics", err)
+		} else {
+			bundle.Metrics = metrics
+		}
+	}
+
+	hub := sources.Hub
+	if hub == nil {
+		if includeEvents || includeLogs || includeStuck {
+			bundle.addError("diagnostics", errors.New("diagnostics hub unavailable"))
+		}
+	} else {
+		bundle.Dropped = BundleDropped{
+			Events: hub.DroppedEvents(),
+			Logs:   hub.DroppedLogs(),
+		}
+	}
+
+	var rawEvents []Event
+	if hub != nil && (includeEvents || includeStuck) {
+		maxEvents := opts.MaxEventEntries
+		if maxEvents <= 0 {
+			maxEvents = defaultMaxEventEntries
+		}
+		rawEvents = hub.Events()
+		if len(rawEvents) > maxEvents {
+			rawEvents = rawEvents[len(rawEvents)-maxEvents:]
+		}
+	}
+	reportEvents := mapEvents(rawEvents, mode)
+	if includeEvents {
+		bundle.Events = reportEvents
+	}
+
+	if includeLogs && hub != nil {
+		maxLogs := opts.MaxLogEntries
+		if maxLogs <= 0 {
+			maxLogs = defaultMaxLogEntries
+		}
+		bundle.Logs = mapLogs(hub.Logs(), parseLogLevel(opts.LogLevel), maxLogs)
+	}
+
+	if includeStuck && len(rawEvents) > 0 {
+		threshold := defaultStuckThreshold
+		if opts.StuckThreshold > 0 {
+			threshold = opts.StuckThreshold
+		}
+		bundle.Stuck = computeStuck(reportEvents, threshold)
+	}
+
+	bundle.Report = buildReport(bundle, reportEvents)
+	return bundle
+}
+
+// GeneratedTime returns when the bundle was built.
+func (b Bundle) GeneratedTime() time.Time {
+	return b.generatedAt
+}
+
+func (b *Bundle) addError(source string, err error) {
+	b.Errors = append(b.Errors, BundleError{Source: source, Message: err.Error()})
+}
+
+// SanitizeServerSpecs returns copies of the specs that are safe to export. Safe
+// mode masks every environment and header value and strips secrets from
+// commands and endpoints; deep mode only masks values under sensitive keys.
+func SanitizeServerSpecs(specs []domain.ServerSpec, mode domain.DiagnosticsRedactionMode) []domain.ServerSpec {
+	if len(specs) == 0 {
+		return nil
+	}
+	out := make([]domain.ServerSpec, 0, len(specs))
+	for _, spec := range specs {
+		sanitized := spec
+		if mode == domain.DiagnosticsRedactionDeep {
+			sanitized.Env = RedactMap(spec.Env)
+		} else {
+			sanitized.Env = maskValues(spec.Env)
+			if len(spec.Cmd) > 0 {
+				sanitized.Cmd = make([]string, len(spec.Cmd))
+				for i, arg := range spec.Cmd {
+					sanitized.Cmd[i] = RedactSecrets(arg)
+				}
+			}
+		}
+		if spec.HTTP != nil {
+			httpConfig := *spec.HTTP
+			if mode == domain.DiagnosticsRedactionDeep {
+				httpConfig.Headers = RedactMap(spec.HTTP.Headers)
+			} else {
+				httpConfig.Headers = maskValues(spec.HTTP.Headers)
+				httpConfig.Endpoint = RedactSecrets(spec.HTTP.Endpoint)
+			}
+			sanitized.HTTP = &httpConfig
+		}
+		out = append(out, sanitized)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+func maskValues(input map[string]string) map[string]string {
+	if len(input) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(input))
+	for key := range input {
+		out[key] = forcedRedactionValue
+	}
+	return out
+}
+
+// RedactEvent converts a hub event for export, redacting its attributes for
+// the mode the same way a bundle does.
+func RedactEvent(event Event, mode domain.DiagnosticsRedactionMode) domain.DiagnosticsEvent {
+	return domain.DiagnosticsEvent{
+		Seq:        event.Seq,
+		SpecKey:    event.SpecKey,
+		ServerName: event.ServerName,
+		AttemptID:  event.AttemptID,
+		Step:       event.Step,
+		Phase:      string(event.Phase),
+		Timestamp:  event.Timestamp,
+		Duration:   event.Duration,
+		Error:      event.Error,
+		Attributes: formatAttributes(event.Attributes, event.Sensitive, mode),
+	}
+}
+
+func mapEvents(events []Event, mode domain.DiagnosticsRedactionMode) map[string][]BundleEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+	result := make(map[string][]BundleEvent)
+	for _, event := range events {
+		payload := BundleEvent{
+			SpecKey:    event.SpecKey,
+			ServerName: event.ServerName,
+			AttemptID:  event.AttemptID,
+			Step:       event.Step,
+			Phase:      string(event.Phase),
+			Timestamp:  event.Timestamp.UTC().Format(time.RFC3339Nano),
+			Error:      event.Error,
+			Attributes: formatAttributes(event.Attributes, event.Sensitive, mode),
+		}
+		if event.Duration > 0 {
+			payload.DurationMs = event.Duration.Milliseconds()
+		}
+		key := event.ServerName
+		if strings.TrimSpace(key) == "" {
+			key = event.SpecKey
+		}
+		result[key] = append(result[key], payload)
+	}
+	return result
+}
+
+func formatAttributes(attrs map[string]string, sensitive map[string]string, mode domain.DiagnosticsRedactionMode) map[string]string {
+	var out map[string]string
+	if mode == domain.DiagnosticsRedactionDeep {
+		out = mergeStringMaps(attrs, sensitive)
+	} else {
+		out = RedactMap(attrs)
+	}
+	out = applyForcedRedactions(out, attrs, sensitive)
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func mergeStringMaps(primary map[string]string, secondary map[string]string) map[string]string {
+	if len(primary) == 0 && len(secondary) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(primary)+len(secondary))
+	for key, value := range primary {
+		out[key] = value
+	}
+	for key, value := range secondary {
+		out[key] = value
+	}
+	return out
+}
+
+const forcedRedactionValue = "***"
+
+// forcedRedactionKeys are masked even in deep mode because they routinely
+// embed credentials.
+var forcedRedactionKeys = map[string]struct{}{
+	"cmd":      {},
+	"endpoint": {},
+	"headers":  {},
+}
+
+func applyForcedRedactions(out map[string]string, attrs map[string]string, sensitive map[string]string) map[string]string {
+	if out == nil {
+		out = make(map[string]string)
+	}
+	for key := range forcedRedactionKeys {
+		if _, ok := sensitive[key]; ok {
+			out[key] = forcedRedactionValue
+			continue
+		}
+		if _, ok := attrs[key]; ok {
+			out[key] = forcedRedactionValue
+		}
+	}
+	return out
+}
+
+func mapLogs(entries []domain.LogEntry, minLevel logLevel, maxEntries int) []BundleLogEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	filtered := make([]BundleLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if logLevelRank(entry.Level) < minLevel.rank {
+			continue
+		}
+		filtered = append(filtered, BundleLogEntry{
+			Logger:    entry.Logger,
+			Level:     string(entry.Level),
+			Timestamp: entry.Timestamp.UTC().Format(time.RFC3339Nano),
+			Data:      sanitizeLogData(entry.Data),
+		})
+	}
+	if len(filtered) > maxEntries {
+		filtered = filtered[len(filtered)-maxEntries:]
+	}
+	return filtered
+}
+
+type logLevel struct {
+	name string
+	rank int
+}
+
+func parseLogLevel(value string) logLevel {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "debug":
+		return logLevel{name: "debug", rank: 10}
+	case "info", "":
+		return logLevel{name: "info", rank: 20}
+	case "notice":
+		return logLevel{name: "notice", rank: 25}
+	case "warning", "warn":
+		return logLevel{name: "warning", rank: 30}
+	case "error":
+		return logLevel{name: "error", rank: 40}
+	case "critical":
+		return logLevel{name: "critical", rank: 50}
+	case "alert":
+		return logLevel{name: "alert", rank: 60}
+	case "emergency":
+		return logLevel{name: "emergency", rank: 70}
+	default:
+		return logLevel{name: value, rank: 20}
+	}
+}
+
+func logLevelRank(level domain.LogLevel) int {
+	return parseLogLevel(string(level)).rank
+}
+
+func sanitizeLogData(data map[string]any) map[string]any {
+	if len(data) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(data))
+	for key, value := range data {
+		out[key] = sanitizeValue(key, value)
+	}
+	return out
+}
+
+func sanitizeValue(key string, value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return sanitizeLogData(typed)
+	case map[string]string:
+		return RedactMap(typed)
+	case []any:
+		result := make([]any, 0, len(typed))
+		for _, item := range typed {
+			result = append(result, sanitizeValue(key, item))
+		}
+		return result
+	case string:
+		return RedactValue(key, typed)
+	default:
+		if ContainsSensitiveKey(key) {
+			return forcedRedactionValue
+		}
+		return value
+	}
+}
+
+func computeStuck(events map[string][]BundleEvent, threshold time.Duration) map[string]BundleStuck {
+	if len(events) == 0 {
+		return nil
+	}
+	stuck := make(map[string]BundleStuck)
+	now := time.Now()
+	for key, serverEvents := range events {
+		var currentStep string
+		var stepStarted time.Time
+		var lastError string
+		var attemptID string
+		for _, event := range serverEvents {
+			timestamp, err := time.Parse(time.RFC3339Nano, event.Timestamp)
+			if err != nil {
+				continue
+			}
+			switch event.Phase {
+			case string(PhaseEnter):
+				currentStep = event.Step
+				stepStarted = timestamp
+				attemptID = event.AttemptID
+			case string(PhaseExit):
+				if currentStep == event.Step {
+					currentStep = ""
+					stepStarted = time.Time{}
+				}
+			case string(PhaseError):
+				lastError = event.Error
+				if currentStep == "" {
+					currentStep = event.Step
+					stepStarted = timestamp
+					attemptID = event.AttemptID
+				}
+			}
+		}
+		if currentStep == "" || stepStarted.IsZero() {
+			continue
+		}
+		duration := now.Sub(stepStarted)
+		if duration < threshold {
+			continue
+		}
+		stuck[key] = BundleStuck{
+			Step:       currentStep,
+			Since:      stepStarted.UTC().Format(time.RFC3339Nano),
+			DurationMs: duration.Milliseconds(),
+			LastError:  lastError,
+			AttemptID:  attemptID,
+		}
+	}
+	if len(stuck) == 0 {
+		return nil
+	}
+	return stuck
+}
@@ -0,0 +1,113 @@
+package diagnostics
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Archive entry names.
+const (
+	ArchiveManifest = "bundle.json"
+	ArchiveReport   = "report.md"
+	ArchiveSnapshot = "snapshot.json"
+	ArchiveCatalog  = "catalog.json"
+	ArchiveMetrics  = "metrics.txt"
+	ArchiveLogs     = "logs.json"
+	ArchiveEvents   = "events.json"
+	ArchiveStuck    = "stuck.json"
+)
+
+// archiveManifest describes the archive contents and the export outcome.
+type archiveManifest struct {
+	GeneratedAt string          `json:"generatedAt"`
+	Redaction   BundleRedaction `json:"redaction"`
+	Dropped     BundleDropped   `json:"dropped,omitempty"`
+	Files       []string        `json:"files"`
+	Errors      []BundleError   `json:"errors,omitempty"`
+}
+
+type archiveEntry struct {
+	name string
+	data []byte
+}
+
+// WriteArchive writes the bundle as a zip archive with one entry per section.
+// Empty sections are omitted; bundle.json lists the entries that are present.
+func WriteArchive(w io.Writer, bundle Bundle) error {
+	var entries []archiveEntry
+	addJSON := func(name string, value any) error {
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+		entries = append(entries, archiveEntry{name: name, data: data})
+		return nil
+	}
+
+	if bundle.Report != "" {
+		entries = append(entries, archiveEntry{name: ArchiveReport, data: []byte(bundle.Report)})
+	}
+	if len(bundle.Snapshot) > 0 {
+		entries = append(entries, archiveEntry{name: ArchiveSnapshot, data: bundle.Snapshot})
+	}
+	if len(bundle.Catalog) > 0 {
+		entries = append(entries, archiveEntry{name: ArchiveCatalog, data: bundle.Catalog})
+	}
+	if bundle.Metrics != "" {
+		entries = append(entries, archiveEntry{name: ArchiveMetrics, data: []byte(bundle.Metrics)})
+	}
+	if len(bundle.Logs) > 0 {
+		if err := addJSON(ArchiveLogs, bundle.Logs); err != nil {
+			return err
+		}
+	}
+	if len(bundle.Events) > 0 {
+		if err := addJSON(ArchiveEvents, bundle.Events); err != nil {
+			return err
+		}
+	}
+	if len(bundle.Stuck) > 0 {
+		if err := addJSON(ArchiveStuck, bundle.Stuck); err != nil {
+			return err
+		}
+	}
+
+	manifest := archiveManifest{
+		GeneratedAt: bundle.GeneratedAt,
+		Redaction:   bundle.Redaction,
+		Dropped:     bundle.Dropped,
+		Errors:      bundle.Errors,
+		Files:       make([]string, 0, len(entries)+1),
+	}
+	manifest.Files = append(manifest.Files, ArchiveManifest)
+	for _, entry := range entries {
+		manifest.Files = append(manifest.Files, entry.name)
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	entries = append([]archiveEntry{{name: ArchiveManifest, data: manifestData}}, entries...)
+
+	modified := bundle.generatedAt
+	if modified.IsZero() {
+		modified = time.Now().UTC()
+	}
+	zw := zip.NewWriter(w)
+	for _, entry := range entries {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(entry.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,222 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// reportSnapshot holds the snapshot fields the report summarizes.
+type reportSnapshot struct {
+	ConfigPath string `json:"configPath"`
+	Core       struct {
+		State    string `json:"state"`
+		UptimeMs int64  `json:"uptimeMs"`
+	} `json:"core"`
+	Info *struct {
+		Version string `json:"version"`
+		Build   string `json:"build"`
+	} `json:"info"`
+}
+
+func buildReport(bundle Bundle, events map[string][]BundleEvent) string {
+	var builder strings.Builder
+	builder.WriteString("-------------------------------------\n")
+	builder.WriteString("MCPV Diagnostics Report (Full Report Below)\n")
+	builder.WriteString("-------------------------------------\n")
+	builder.WriteString(fmt.Sprintf("Generated At:         %s\n", bundle.GeneratedAt))
+	builder.WriteString(fmt.Sprintf("Redaction Mode:       %s\n", bundle.Redaction.Mode))
+	builder.WriteString(fmt.Sprintf("Sensitive Included:   %t\n", bundle.Redaction.ContainsSensitive))
+	builder.WriteString(fmt.Sprintf("Events Captured:      %d\n", countEvents(events)))
+	builder.WriteString(fmt.Sprintf("Logs Captured:        %d\n", len(bundle.Logs)))
+	builder.WriteString(fmt.Sprintf("Events Dropped:       %d\n", bundle.Dropped.Events))
+	builder.WriteString(fmt.Sprintf("Logs Dropped:         %d\n", bundle.Dropped.Logs))
+	builder.WriteString("\n")
+
+	if snapshot := parseReportSnapshot(bundle.Snapshot); snapshot != nil {
+		builder.WriteString("Core Summary\n")
+		builder.WriteString("------------\n")
+		builder.WriteString(fmt.Sprintf("Core State:           %s\n", snapshot.Core.State))
+		builder.WriteString(fmt.Sprintf("Uptime (ms):          %d\n", snapshot.Core.UptimeMs))
+		if snapshot.Info != nil {
+			builder.WriteString(fmt.Sprintf("Version:              %s\n", snapshot.Info.Version))
+			builder.WriteString(fmt.Sprintf("Build:                %s\n", snapshot.Info.Build))
+		}
+		if snapshot.ConfigPath != "" {
+			builder.WriteString(fmt.Sprintf("Config Path:          %s\n", snapshot.ConfigPath))
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("Stuck Analysis\n")
+	builder.WriteString("-------------\n")
+	if len(bundle.Stuck) == 0 {
+		builder.WriteString("No stuck servers detected.\n")
+	} else {
+		for _, key := range sortedKeys(bundle.Stuck) {
+			entry := bundle.Stuck[key]
+			builder.WriteString(fmt.Sprintf("- %s: step=%s duration=%s lastError=%s\n",
+				key,
+				entry.Step,
+				formatDuration(entry.DurationMs),
+				formatInline(entry.LastError),
+			))
+		}
+	}
+	builder.WriteString("\n")
+
+	builder.WriteString("Recent Errors\n")
+	builder.WriteString("-------------\n")
+	errorEvents := collectErrorEvents(events, 12)
+	if len(errorEvents) == 0 {
+		builder.WriteString("No recent error events.\n")
+	} else {
+		for _, entry := range errorEvents {
+			builder.WriteString(fmt.Sprintf("- %s %s %s: %s\n",
+				entry.Timestamp,
+				entry.ServerName,
+				entry.Step,
+				formatInline(entry.Error),
+			))
+		}
+	}
+	builder.WriteString("\n")
+
+	builder.WriteString("Stage Timeline (latest)\n")
+	builder.WriteString("-----------------------\n")
+	if len(events) == 0 {
+		builder.WriteString("No events captured.\n")
+	} else {
+		for _, key := range sortedKeys(events) {
+			builder.WriteString(fmt.Sprintf("\n[%s]\n", key))
+			entries := events[key]
+			if len(entries) > 12 {
+				entries = entries[len(entries)-12:]
+			}
+			for _, event := range entries {
+				builder.WriteString(fmt.Sprintf("%s %-18s %-6s %s\n",
+					event.Timestamp,
+					event.Step,
+					event.Phase,
+					formatInline(event.Error),
+				))
+			}
+		}
+	}
+	builder.WriteString("\n")
+
+	if len(bundle.Logs) > 0 {
+		builder.WriteString("Recent Logs\n")
+		builder.WriteString("-----------\n")
+		logs := bundle.Logs
+		if len(logs) > 10 {
+			logs = logs[len(logs)-10:]
+		}
+		for _, entry := range logs {
+			builder.WriteString(fmt.Sprintf("%s %-7s %s\n",
+				entry.Timestamp,
+				strings.ToUpper(entry.Level),
+				formatInline(formatLogMessage(entry.Data)),
+			))
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(bundle.Errors) > 0 {
+		builder.WriteString("Export Warnings\n")
+		builder.WriteString("---------------\n")
+		for _, exportErr := range bundle.Errors {
+			builder.WriteString(fmt.Sprintf("- %s: %s\n", exportErr.Source, formatInline(exportErr.Message)))
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("Raw Data\n")
+	builder.WriteString("--------\n")
+	builder.WriteString("See the JSON data exported with this report.\n")
+
+	return builder.String()
+}
+
+func parseReportSnapshot(raw json.RawMessage) *reportSnapshot {
+	if len(raw) == 0 {
+		return nil
+	}
+	var snapshot reportSnapshot
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil
+	}
+	return &snapshot
+}
+
+func countEvents(events map[string][]BundleEvent) int {
+	count := 0
+	for _, entries := range events {
+		count += len(entries)
+	}
+	return count
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func collectErrorEvents(events map[string][]BundleEvent, limit int) []BundleEvent {
+	if limit <= 0 {
+		limit = 10
+	}
+	var out []BundleEvent
+	for _, entries := range events {
+		for _, event := range entries {
+			if event.Phase == string(PhaseError) && event.Error != "" {
+				out = append(out, event)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Timestamp > out[j].Timestamp
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func formatDuration(durationMs int64) string {
+	if durationMs <= 0 {
+		return "0ms"
+	}
+	duration := time.Duration(durationMs) * time.Millisecond
+	if duration < time.Second {
+		return fmt.Sprintf("%dms", durationMs)
+	}
+	return duration.String()
+}
+
+func formatInline(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "-"
+	}
+	if len(trimmed) > 160 {
+		return trimmed[:157] + "..."
+	}
+	return trimmed
+}
+
+func formatLogMessage(data map[string]any) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if msg, ok := data["message"].(string); ok {
+		return msg
+	}
+	return ""
+}
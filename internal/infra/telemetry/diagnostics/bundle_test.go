@@ -0,0 +1,191 @@
+package diagnostics
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"mcpv/internal/domain"
+)
+
+func TestComputeStuck(t *testing.T) {
+	now := time.Now().UTC()
+	events := map[string][]BundleEvent{
+		"svc": {
+			{
+				Step:      "transport_connect",
+				Phase:     "enter",
+				Timestamp: now.Add(-2 * time.Minute).Format(time.RFC3339Nano),
+			},
+		},
+		"fresh": {
+			{
+				Step:      "initialize_call",
+				Phase:     "enter",
+				Timestamp: now.Add(-5 * time.Second).Format(time.RFC3339Nano),
+			},
+		},
+	}
+
+	stuck := computeStuck(events, 30*time.Second)
+	if _, ok := stuck["svc"]; !ok {
+		t.Fatalf("expected svc to be stuck")
+	}
+	if _, ok := stuck["fresh"]; ok {
+		t.Fatalf("expected fresh to be below threshold")
+	}
+}
+
+func TestFormatAttributesForcedRedactions(t *testing.T) {
+	attrs := map[string]string{
+		"endpointSafe": "https://example.com/mcp",
+	}
+	sensitive := map[string]string{
+		"cmd":      "./server --token=secret",
+		"endpoint": "https://secret.example.com/mcp",
+		"headers":  "{\"Authorization\":\"Bearer secret\"}",
+		"env":      "{\"TOKEN\":\"secret\"}",
+	}
+
+	outSafe := formatAttributes(attrs, sensitive, domain.DiagnosticsRedactionSafe)
+	if outSafe["cmd"] != "***" {
+		t.Fatalf("expected cmd to be redacted in safe mode")
+	}
+	if outSafe["endpoint"] != "***" {
+		t.Fatalf("expected endpoint to be redacted in safe mode")
+	}
+	if outSafe["headers"] != "***" {
+		t.Fatalf("expected headers to be redacted in safe mode")
+	}
+	if _, ok := outSafe["env"]; ok {
+		t.Fatalf("expected env to be omitted in safe mode")
+	}
+	if outSafe["endpointSafe"] != attrs["endpointSafe"] {
+		t.Fatalf("expected endpointSafe to remain in safe mode")
+	}
+
+	outDeep := formatAttributes(attrs, sensitive, domain.DiagnosticsRedactionDeep)
+	if outDeep["cmd"] != "***" {
+		t.Fatalf("expected cmd to be redacted in deep mode")
+	}
+	if outDeep["endpoint"] != "***" {
+		t.Fatalf("expected endpoint to be redacted in deep mode")
+	}
+	if outDeep["headers"] != "***" {
+		t.Fatalf("expected headers to be redacted in deep mode")
+	}
+	if outDeep["env"] != sensitive["env"] {
+		t.Fatalf("expected env to remain in deep mode")
+	}
+	if outDeep["endpointSafe"] != attrs["endpointSafe"] {
+		t.Fatalf("expected endpointSafe to remain in deep mode")
+	}
+}
+
+func TestSanitizeServerSpecs(t *testing.T) {
+	specs := []domain.ServerSpec{
+		{
+			Name: "remote",
+			HTTP: &domain.StreamableHTTPConfig{
+				Endpoint: "https://example.com/mcp",
+				Headers:  map[string]string{"Authorization": "Bearer abc", "X-Team": "platform"},
+			},
+		},
+		{
+			Name: "local",
+			Cmd:  []string{"./server", "--verbose"},
+			Env:  map[string]string{"API_TOKEN": "secret", "REGION": "eu"},
+		},
+	}
+
+	safe := SanitizeServerSpecs(specs, domain.DiagnosticsRedactionSafe)
+	if safe[0].Name != "local" {
+		t.Fatalf("expected specs sorted by name, got %q first", safe[0].Name)
+	}
+	if safe[0].Env["REGION"] != "***" || safe[0].Env["API_TOKEN"] != "***" {
+		t.Fatalf("expected all env values masked in safe mode, got %v", safe[0].Env)
+	}
+	if safe[1].HTTP.Headers["X-Team"] != "***" {
+		t.Fatalf("expected all header values masked in safe mode, got %v", safe[1].HTTP.Headers)
+	}
+
+	deep := SanitizeServerSpecs(specs, domain.DiagnosticsRedactionDeep)
+	if deep[0].Env["REGION"] != "eu" || deep[0].Env["API_TOKEN"] != "***" {
+		t.Fatalf("expected only sensitive env values masked in deep mode, got %v", deep[0].Env)
+	}
+	if deep[1].HTTP.Headers["Authorization"] != "***" || deep[1].HTTP.Headers["X-Team"] != "platform" {
+		t.Fatalf("expected only sensitive headers masked in deep mode, got %v", deep[1].HTTP.Headers)
+	}
+	if specs[1].Env["API_TOKEN"] != "secret" || specs[0].HTTP.Headers["Authorization"] != "Bearer abc" {
+		t.Fatalf("expected input specs to be left untouched")
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	bundle := BuildBundle(context.Background(), BundleSources{
+		Snapshot: func(context.Context) (json.RawMessage, error) {
+			return json.RawMessage(`{"configPath":"/etc/mcpv.yaml","core":{"state":"running","uptimeMs":42}}`), nil
+		},
+		Catalog: func(domain.DiagnosticsRedactionMode) (json.RawMessage, error) {
+			return json.RawMessage(`[]`), nil
+		},
+		Metrics: func() (string, error) {
+			return "", errors.New("registry unavailable")
+		},
+	}, domain.DiagnosticsExportOptions{})
+
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, bundle); err != nil {
+		t.Fatalf("write archive: %v", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", file.Name, err)
+		}
+		files[file.Name] = string(data)
+	}
+
+	for _, name := range []string{ArchiveManifest, ArchiveReport, ArchiveSnapshot, ArchiveCatalog} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("expected %s in archive, got %v", name, files)
+		}
+	}
+	if _, ok := files[ArchiveMetrics]; ok {
+		t.Fatalf("expected failed metrics to be omitted")
+	}
+	if !strings.Contains(files[ArchiveReport], "Config Path:          /etc/mcpv.yaml") {
+		t.Fatalf("expected report to summarize the snapshot, got:\n%s", files[ArchiveReport])
+	}
+
+	var manifest archiveManifest
+	if err := json.Unmarshal([]byte(files[ArchiveManifest]), &manifest); err != nil {
+		t.Fatalf("decode manifest: %v", err)
+	}
+	if manifest.Redaction.Mode != string(domain.DiagnosticsRedactionSafe) {
+		t.Fatalf("expected safe mode by default, got %q", manifest.Redaction.Mode)
+	}
+	sources := make([]string, 0, len(manifest.Errors))
+	for _, exportErr := range manifest.Errors {
+		sources = append(sources, exportErr.Source)
+	}
+	if strings.Join(sources, ",") != "metrics,diagnostics" {
+		t.Fatalf("unexpected export errors %v", manifest.Errors)
+	}
+}
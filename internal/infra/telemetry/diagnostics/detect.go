@@ -0,0 +1,281 @@
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DetectorKind names a built-in content detector.
+type DetectorKind string
+
+const (
+	// DetectorSecret matches well-known credential formats and sensitive key/value pairs.
+	DetectorSecret DetectorKind = "secret"
+	// DetectorEmail matches email addresses.
+	DetectorEmail DetectorKind = "email"
+	// DetectorPhone matches international and separated phone numbers.
+	DetectorPhone DetectorKind = "phone"
+	// DetectorCreditCard matches card numbers that pass the Luhn check.
+	DetectorCreditCard DetectorKind = "credit_card"
+	// DetectorEntropy matches long tokens with high Shannon entropy.
+	DetectorEntropy DetectorKind = "entropy"
+	// DetectorRegex matches a user-supplied regular expression.
+	DetectorRegex DetectorKind = "regex"
+)
+
+const (
+	// DefaultEntropyMinLength is the shortest token considered by the entropy detector.
+	DefaultEntropyMinLength = 20
+	// DefaultEntropyThreshold is the minimum bits per character for the entropy detector.
+	DefaultEntropyThreshold = 3.5
+)
+
+// secretGroup marks the part of a secret pattern that is masked; the rest
+// (for example the key in "token=...") is kept for context.
+const secretGroup = "secret"
+
+var (
+	secretPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+		regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+		regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}\b`),
+		regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}\b`),
+		regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}\b`),
+		regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}\b`),
+		regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[A-Za-z0-9._~+/-]{16,}=*)`),
+		regexp.MustCompile(`(?i)\b(?:` + secretKeyAlternation() + `)["']?\s*[:=]\s*["']?(?:bearer\s+)?(?P<secret>[^\s"',;&]{6,})`),
+	}
+	emailPattern      = regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)
+	phonePattern      = regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{2,4}\)[\s.-]?|\b\d{2,4}[\s.-])\d{3,4}[\s.-]\d{3,4}\b`)
+	creditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	entropyToken      = regexp.MustCompile(`[A-Za-z0-9+/_-]+=*`)
+)
+
+// Detector finds sensitive spans in free text.
+type Detector struct {
+	Name       string
+	Kind       DetectorKind
+	patterns   []*regexp.Regexp
+	minLength  int
+	minEntropy float64
+}
+
+// DetectorOptions configures NewDetector.
+type DetectorOptions struct {
+	Name       string
+	Kind       DetectorKind
+	Pattern    string
+	MinLength  int
+	MinEntropy float64
+}
+
+// Match is a sensitive span found by a detector.
+type Match struct {
+	Detector string
+	Start    int
+	End      int
+}
+
+// NewDetector builds a detector. Pattern is required for regex detectors;
+// MinLength and MinEntropy tune the entropy detector.
+func NewDetector(opts DetectorOptions) (Detector, error) {
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		name = string(opts.Kind)
+	}
+	detector := Detector{Name: name, Kind: opts.Kind}
+	switch opts.Kind {
+	case DetectorSecret:
+		detector.patterns = secretPatterns
+	case DetectorEmail:
+		detector.patterns = []*regexp.Regexp{emailPattern}
+	case DetectorPhone:
+		detector.patterns = []*regexp.Regexp{phonePattern}
+	case DetectorCreditCard:
+		detector.patterns = []*regexp.Regexp{creditCardPattern}
+	case DetectorEntropy:
+		detector.minLength = opts.MinLength
+		if detector.minLength <= 0 {
+			detector.minLength = DefaultEntropyMinLength
+		}
+		detector.minEntropy = opts.MinEntropy
+		if detector.minEntropy <= 0 {
+			detector.minEntropy = DefaultEntropyThreshold
+		}
+	case DetectorRegex:
+		if strings.TrimSpace(opts.Pattern) == "" {
+			return Detector{}, errors.New("pattern is required for regex detector")
+		}
+		re, err := regexp.Compile(opts.Pattern)
+		if err != nil {
+			return Detector{}, fmt.Errorf("compile pattern: %w", err)
+		}
+		detector.patterns = []*regexp.Regexp{re}
+	default:
+		return Detector{}, fmt.Errorf("unknown detector %q", opts.Kind)
+	}
+	return detector, nil
+}
+
+// IsDetectorKind reports whether kind names a known detector.
+func IsDetectorKind(kind string) bool {
+	switch DetectorKind(kind) {
+	case DetectorSecret, DetectorEmail, DetectorPhone, DetectorCreditCard, DetectorEntropy, DetectorRegex:
+		return true
+	default:
+		return false
+	}
+}
+
+// Find returns the sensitive spans in text.
+func (d Detector) Find(text string) []Match {
+	if text == "" {
+		return nil
+	}
+	if d.Kind == DetectorEntropy {
+		return d.findEntropy(text)
+	}
+	var matches []Match
+	for _, re := range d.patterns {
+		group := re.SubexpIndex(secretGroup)
+		for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if group > 0 && loc[2*group] >= 0 {
+				start, end = loc[2*group], loc[2*group+1]
+			}
+			if d.Kind == DetectorCreditCard && !luhnValid(text[start:end]) {
+				continue
+			}
+			matches = append(matches, Match{Detector: d.Name, Start: start, End: end})
+		}
+	}
+	return matches
+}
+
+func (d Detector) findEntropy(text string) []Match {
+	var matches []Match
+	for _, loc := range entropyToken.FindAllStringIndex(text, -1) {
+		token := text[loc[0]:loc[1]]
+		if len(token) < d.minLength || !mixedAlphaNumeric(token) {
+			continue
+		}
+		if shannonEntropy(token) >= d.minEntropy {
+			matches = append(matches, Match{Detector: d.Name, Start: loc[0], End: loc[1]})
+		}
+	}
+	return matches
+}
+
+// Scan runs every detector over text and returns non-overlapping matches in
+// order. When spans overlap, the earlier detector in the list wins.
+func Scan(text string, detectors []Detector) []Match {
+	var selected []Match
+	for _, detector := range detectors {
+		for _, match := range detector.Find(text) {
+			if overlaps(selected, match) {
+				continue
+			}
+			selected = append(selected, match)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Start < selected[j].Start })
+	return selected
+}
+
+// Mask replaces matched spans using replacement.
+func Mask(text string, matches []Match, replacement func(Match) string) string {
+	if len(matches) == 0 {
+		return text
+	}
+	var b strings.Builder
+	b.Grow(len(text))
+	last := 0
+	for _, match := range matches {
+		b.WriteString(text[last:match.Start])
+		b.WriteString(replacement(match))
+		last = match.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+var defaultSecretDetector = Detector{Name: string(DetectorSecret), Kind: DetectorSecret, patterns: secretPatterns}
+
+// RedactSecrets masks credentials found by the built-in secret detector.
+func RedactSecrets(value string) string {
+	matches := Scan(value, []Detector{defaultSecretDetector})
+	return Mask(value, matches, func(Match) string { return "***" })
+}
+
+func secretKeyAlternation() string {
+	keys := make([]string, 0, len(sensitiveKeys)+2)
+	keys = append(keys, sensitiveKeys...)
+	keys = append(keys, "password", "passwd")
+	return strings.Join(keys, "|")
+}
+
+func overlaps(selected []Match, candidate Match) bool {
+	for _, existing := range selected {
+		if candidate.Start < existing.End && existing.Start < candidate.End {
+			return true
+		}
+	}
+	return false
+}
+
+func luhnValid(number string) bool {
+	sum := 0
+	digits := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+		double = !double
+	}
+	return digits >= 13 && sum%10 == 0
+}
+
+func mixedAlphaNumeric(token string) bool {
+	hasLetter, hasDigit := false, false
+	for _, r := range token {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	return hasLetter && hasDigit
+}
+
+func shannonEntropy(token string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range token {
+		counts[r]++
+	}
+	total := float64(len(token))
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
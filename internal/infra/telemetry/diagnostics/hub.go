@@ -2,6 +2,8 @@ package diagnostics
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"mcpv/internal/domain"
 	"mcpv/internal/infra/telemetry"
@@ -16,6 +18,8 @@ const (
 	DefaultLogBufferSize = 1024
 	// DefaultLogQueueSize is the default channel size for logs.
 	DefaultLogQueueSize = 1024
+	// DefaultSubscriberQueueSize is the channel size of live event subscribers.
+	DefaultSubscriberQueueSize = 256
 )
 
 // Hub stores diagnostics events and logs in a non-blocking buffer.
@@ -23,6 +27,10 @@ type Hub struct {
 	events           *AsyncBuffer[Event]
 	logs             *AsyncBuffer[domain.LogEntry]
 	captureSensitive bool
+
+	seq  atomic.Uint64
+	mu   sync.RWMutex
+	subs map[chan Event]struct{}
 }
 
 // HubOptions configures the diagnostics hub.
@@ -60,6 +68,7 @@ func NewHub(ctx context.Context, logs *telemetry.LogBroadcaster, opts HubOptions
 		events:           NewAsyncBuffer[Event](eventBufferSize, eventQueueSize),
 		logs:             NewAsyncBuffer[domain.LogEntry](logBufferSize, logQueueSize),
 		captureSensitive: opts.CaptureSensitive,
+		subs:             make(map[chan Event]struct{}),
 	}
 	hub.events.Start(ctx)
 	hub.logs.Start(ctx)
@@ -84,12 +93,46 @@ func NewHub(ctx context.Context, logs *telemetry.LogBroadcaster, opts HubOptions
 	return hub
 }
 
-// Record stores a diagnostics event asynchronously.
+// Record assigns the next sequence number to the event, stores it
+// asynchronously and forwards it to live subscribers without blocking.
 func (h *Hub) Record(event Event) {
 	if h == nil || h.events == nil {
 		return
 	}
+	event.Seq = h.seq.Add(1)
 	h.events.Add(event)
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel of events recorded after the call. Events are
+// dropped for a subscriber that falls behind; the channel is closed when ctx
+// is done.
+func (h *Hub) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, DefaultSubscriberQueueSize)
+	if h == nil || h.subs == nil {
+		close(ch)
+		return ch
+	}
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		delete(h.subs, ch)
+		close(ch)
+		h.mu.Unlock()
+	}()
+
+	return ch
 }
 
 // CaptureSensitive reports whether sensitive data collection is enabled.
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"path"
 	"strings"
 )
 
@@ -16,15 +17,13 @@ var sensitiveKeys = []string{
 	"cookie",
 }
 
-// RedactValue masks the value if the key is sensitive.
+// RedactValue masks the value if the key is sensitive and masks embedded
+// secrets found by the default secret detector otherwise.
 func RedactValue(key, value string) string {
-	lower := strings.ToLower(key)
-	for _, needle := range sensitiveKeys {
-		if strings.Contains(lower, needle) {
-			return "***"
-		}
+	if ContainsSensitiveKey(key) {
+		return "***"
 	}
-	return value
+	return RedactSecrets(value)
 }
 
 // RedactMap redacts values for sensitive keys.
@@ -62,6 +61,62 @@ func ContainsSensitiveKey(key string) bool {
 	return false
 }
 
+// KeyRedactor masks values of sensitive object keys in decoded JSON.
+type KeyRedactor struct {
+	patterns []string
+}
+
+// NewKeyRedactor matches the default sensitive keys plus patterns. Patterns
+// with glob metacharacters use path.Match; others match as substrings. Patterns
+// are expected to be lowercase.
+func NewKeyRedactor(patterns []string) KeyRedactor {
+	return KeyRedactor{patterns: patterns}
+}
+
+// Redact returns a copy of a decoded JSON value with sensitive keys masked.
+func (r KeyRedactor) Redact(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, item := range typed {
+			if r.Sensitive(key) {
+				out[key] = "***"
+				continue
+			}
+			out[key] = r.Redact(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(typed))
+		for i, item := range typed {
+			out[i] = r.Redact(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// Sensitive reports whether values under key are masked.
+func (r KeyRedactor) Sensitive(key string) bool {
+	if ContainsSensitiveKey(key) {
+		return true
+	}
+	lower := strings.ToLower(key)
+	for _, pattern := range r.patterns {
+		if strings.ContainsAny(pattern, "*?[") {
+			if ok, err := path.Match(pattern, lower); err == nil && ok {
+				return true
+			}
+			continue
+		}
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
 // HashBytes computes a sha256 hash for the input and returns hex encoding.
 func HashBytes(input []byte) string {
 	if len(input) == 0 {
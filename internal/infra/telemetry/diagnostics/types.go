@@ -37,6 +37,8 @@ const (
 
 // Event captures a single diagnostics stage observation.
 type Event struct {
+	// Seq is assigned by the hub and increases with every recorded event.
+	Seq        uint64
 	SpecKey    string
 	ServerName string
 	AttemptID  string
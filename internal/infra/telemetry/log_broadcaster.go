@@ -3,17 +3,32 @@ package telemetry
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
 	"mcpv/internal/domain"
 )
 
+const (
+	logSinkQueueSize      = 4096
+	logSinkReportInterval = time.Minute
+)
+
+// LogSink receives published entries in order. Writes happen on a background
+// goroutine; entries are dropped and counted when the sink falls behind.
+type LogSink interface {
+	Write(entry domain.LogEntry) error
+}
+
 type LogBroadcaster struct {
 	minLevel zapcore.Level
 	mu       sync.RWMutex
 	subs     map[chan domain.LogEntry]struct{}
+	sink     *logSinkWriter
+	seq      uint64
 }
 
 func NewLogBroadcaster(minLevel zapcore.Level) *LogBroadcaster {
@@ -44,9 +59,29 @@ func (b *LogBroadcaster) Subscribe(ctx context.Context) <-chan domain.LogEntry {
 	return ch
 }
 
+// SetSink sets the sink that persists published entries; nil removes it.
+// Entries queued for the previous sink are written before SetSink returns.
+// Write failures and dropped entries are reported to logger.
+func (b *LogBroadcaster) SetSink(sink LogSink, logger *zap.Logger) {
+	var next *logSinkWriter
+	if sink != nil {
+		next = newLogSinkWriter(sink, logger)
+	}
+	b.mu.Lock()
+	prev := b.sink
+	b.sink = next
+	b.mu.Unlock()
+	prev.stop()
+}
+
+// publish holds the write lock so that sequence numbers reach the sink in
+// order.
 func (b *LogBroadcaster) publish(entry domain.LogEntry) {
-	b.mu.RLock()
-	defer b.mu.RUnlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	entry.Seq = b.seq
+	b.sink.enqueue(entry)
 	for ch := range b.subs {
 		select {
 		case ch <- entry:
@@ -55,6 +90,78 @@ func (b *LogBroadcaster) publish(entry domain.LogEntry) {
 	}
 }
 
+// logSinkWriter writes entries to a sink off the logging path.
+type logSinkWriter struct {
+	sink    LogSink
+	logger  *zap.Logger
+	queue   chan domain.LogEntry
+	done    chan struct{}
+	failed  atomic.Uint64
+	dropped atomic.Uint64
+}
+
+func newLogSinkWriter(sink LogSink, logger *zap.Logger) *logSinkWriter {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	w := &logSinkWriter{
+		sink:   sink,
+		logger: logger,
+		queue:  make(chan domain.LogEntry, logSinkQueueSize),
+		done:   make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *logSinkWriter) enqueue(entry domain.LogEntry) {
+	if w == nil {
+		return
+	}
+	select {
+	case w.queue <- entry:
+	default:
+		w.dropped.Add(1)
+	}
+}
+
+// stop closes the queue and waits for queued entries to be written.
+func (w *logSinkWriter) stop() {
+	if w == nil {
+		return
+	}
+	close(w.queue)
+	<-w.done
+}
+
+// run writes queued entries. Failures are reported at most once per interval
+// because the report is itself a log entry headed for the same sink.
+func (w *logSinkWriter) run() {
+	defer close(w.done)
+	var lastReport time.Time
+	var reportedDrops uint64
+	for entry := range w.queue {
+		err := w.sink.Write(entry)
+		if err != nil {
+			w.failed.Add(1)
+		}
+		dropped := w.dropped.Load()
+		if err == nil && dropped == reportedDrops {
+			continue
+		}
+		if time.Since(lastReport) < logSinkReportInterval {
+			continue
+		}
+		lastReport = time.Now()
+		reportedDrops = dropped
+		w.logger.Warn("log store is missing entries",
+			zap.Error(err),
+			zap.Uint64("writeFailures", w.failed.Load()),
+			zap.Uint64("dropped", dropped),
+		)
+	}
+}
+
 type logBroadcasterCore struct {
 	broadcaster *LogBroadcaster
 	fields      []zapcore.Field
@@ -0,0 +1,96 @@
+package telemetry
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"mcpv/internal/domain"
+)
+
+// LogFilter matches log entries against a log query.
+type LogFilter struct {
+	minRank int
+	server  string
+	grep    *regexp.Regexp
+	query   domain.LogQuery
+}
+
+// NewLogFilter compiles a log query. An invalid grep expression is reported as
+// domain.ErrInvalidRequest.
+func NewLogFilter(query domain.LogQuery) (LogFilter, error) {
+	filter := LogFilter{
+		minRank: logLevelRank(query.MinLevel),
+		server:  strings.TrimSpace(query.Server),
+		query:   query,
+	}
+	if pattern := strings.TrimSpace(query.Grep); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return LogFilter{}, fmt.Errorf("%w: invalid grep pattern: %v", domain.ErrInvalidRequest, err)
+		}
+		filter.grep = re
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return LogFilter{}, fmt.Errorf("%w: until is before since", domain.ErrInvalidRequest)
+	}
+	return filter, nil
+}
+
+// Match reports whether the entry satisfies the query.
+func (f LogFilter) Match(entry domain.LogEntry) bool {
+	if logLevelRank(entry.Level) < f.minRank {
+		return false
+	}
+	if !f.query.Since.IsZero() && entry.Timestamp.Before(f.query.Since) {
+		return false
+	}
+	if !f.query.Until.IsZero() && entry.Timestamp.After(f.query.Until) {
+		return false
+	}
+	if f.server != "" && LogEntryServer(entry) != f.server {
+		return false
+	}
+	if f.grep != nil {
+		message, _ := entry.Data["message"].(string)
+		if !f.grep.MatchString(message) {
+			return false
+		}
+	}
+	return true
+}
+
+// Query returns the query the filter was built from.
+func (f LogFilter) Query() domain.LogQuery {
+	return f.query
+}
+
+// LogEntryServer returns the server an entry was logged by or about.
+func LogEntryServer(entry domain.LogEntry) string {
+	fields, _ := entry.Data["fields"].(map[string]any)
+	serverType, _ := fields[FieldServerType].(string)
+	return serverType
+}
+
+func logLevelRank(level domain.LogLevel) int {
+	switch level {
+	case domain.LogLevelDebug:
+		return 0
+	case domain.LogLevelInfo:
+		return 1
+	case domain.LogLevelNotice:
+		return 2
+	case domain.LogLevelWarning:
+		return 3
+	case domain.LogLevelError:
+		return 4
+	case domain.LogLevelCritical:
+		return 5
+	case domain.LogLevelAlert:
+		return 6
+	case domain.LogLevelEmergency:
+		return 7
+	default:
+		return 0
+	}
+}
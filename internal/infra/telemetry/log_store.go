@@ -0,0 +1,516 @@
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mcpv/internal/domain"
+)
+
+const (
+	logStoreFileMode os.FileMode = 0o600
+	logStoreDirMode  os.FileMode = 0o700
+
+	logStoreExt        = ".jsonl"
+	coreLogBase        = "core"
+	serverLogPrefix    = "server-"
+	logPruneInterval   = time.Hour
+	maxLogLineBytes    = 4 << 20
+	maxLogReadEntries  = 10_000
+	defaultLogFileSize = 10 << 20
+)
+
+// LogStoreOptions configures a LogStore.
+type LogStoreOptions struct {
+	Dir string
+	// MaxSizeBytes rotates a log file once it grows past this size.
+	MaxSizeBytes int64
+	// MaxAge deletes rotated files older than this; zero keeps them.
+	MaxAge time.Duration
+	// MaxBackups limits rotated files kept per log; zero is unlimited.
+	MaxBackups int
+	MinLevel   domain.LogLevel
+	Now        func() time.Time
+}
+
+// LogStore persists log entries as JSON lines. Core entries go to core.jsonl
+// and downstream server output goes to server-<name>.jsonl; each file rotates
+// to <base>.<unixnano>.jsonl once it reaches the size limit.
+type LogStore struct {
+	opts    LogStoreOptions
+	minRank int
+
+	mu        sync.Mutex
+	files     map[string]*logStoreFile
+	lastPrune time.Time
+	closed    bool
+}
+
+type logStoreFile struct {
+	file *os.File
+	size int64
+}
+
+type storedLogEntry struct {
+	Time   time.Time       `json:"time"`
+	Level  domain.LogLevel `json:"level"`
+	Logger string          `json:"logger,omitempty"`
+	Data   map[string]any  `json:"data,omitempty"`
+	// Run and Seq identify entries published by a LogBroadcaster; Seq is only
+	// restored for entries written by the current process.
+	Run string `json:"run,omitempty"`
+	Seq uint64 `json:"seq,omitempty"`
+}
+
+// logRunID distinguishes sequence numbers of this process from those of
+// earlier runs sharing the store directory.
+var logRunID = fmt.Sprintf("%d-%d", os.Getpid(), time.Now().UnixNano())
+
+// OpenLogStore creates the log directory and applies retention to files left
+// by previous runs.
+func OpenLogStore(opts LogStoreOptions) (*LogStore, error) {
+	dir := strings.TrimSpace(opts.Dir)
+	if dir == "" {
+		return nil, errors.New("log store dir is required")
+	}
+	opts.Dir = dir
+	if opts.MaxSizeBytes <= 0 {
+		opts.MaxSizeBytes = defaultLogFileSize
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if err := os.MkdirAll(dir, logStoreDirMode); err != nil {
+		return nil, fmt.Errorf("create log store dir: %w", err)
+	}
+	s := &LogStore{
+		opts:    opts,
+		minRank: logLevelRank(opts.MinLevel),
+		files:   make(map[string]*logStoreFile),
+	}
+	if err := s.pruneAll(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Write appends an entry to its log file, rotating the file when it is full.
+func (s *LogStore) Write(entry domain.LogEntry) error {
+	if s == nil || logLevelRank(entry.Level) < s.minRank {
+		return nil
+	}
+	stored := storedLogEntry{
+		Time:   entry.Timestamp.UTC(),
+		Level:  entry.Level,
+		Logger: entry.Logger,
+		Data:   entry.Data,
+	}
+	if entry.Seq != 0 {
+		stored.Run = logRunID
+		stored.Seq = entry.Seq
+	}
+	line, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("encode log entry: %w", err)
+	}
+	line = append(line, '\n')
+	base := logFileBase(entry)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	current, err := s.openFile(base)
+	if err != nil {
+		return err
+	}
+	if current.size > 0 && current.size+int64(len(line)) > s.opts.MaxSizeBytes {
+		if err := s.rotate(base); err != nil {
+			return err
+		}
+		if current, err = s.openFile(base); err != nil {
+			return err
+		}
+	}
+	n, err := current.file.Write(line)
+	current.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write log entry: %w", err)
+	}
+	if now := s.opts.Now(); now.Sub(s.lastPrune) >= logPruneInterval {
+		_ = s.pruneAll()
+	}
+	return nil
+}
+
+// Read returns persisted entries matching the filter in chronological order.
+// Only the newest entries are kept when the match count exceeds the read cap;
+// files are read newest first and older files are skipped once they can no
+// longer contribute.
+func (s *LogStore) Read(filter LogFilter) ([]domain.LogEntry, error) {
+	if s == nil {
+		return nil, nil
+	}
+	query := filter.Query()
+	bases := []string{}
+	if filter.server != "" {
+		bases = append(bases, coreLogBase, serverLogPrefix+sanitizeLogName(filter.server))
+	} else {
+		all, err := s.listBases()
+		if err != nil {
+			return nil, err
+		}
+		bases = all
+	}
+
+	window := &logReadWindow{limit: maxLogReadEntries}
+	for _, base := range bases {
+		files, err := s.filesFor(base, query.Since)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if window.excludes(file.latest) {
+				break
+			}
+			if err := readLogFile(file.path, filter, window); err != nil {
+				return nil, err
+			}
+		}
+	}
+	window.compact()
+	return window.entries, nil
+}
+
+// logReadWindow keeps the newest limit entries added to it. Entries are
+// compacted whenever the buffer doubles, bounding memory to twice the limit.
+type logReadWindow struct {
+	limit   int
+	entries []domain.LogEntry
+}
+
+func (w *logReadWindow) add(entry domain.LogEntry) {
+	w.entries = append(w.entries, entry)
+	if len(w.entries) >= 2*w.limit {
+		w.compact()
+	}
+}
+
+// compact sorts the entries chronologically and drops all but the newest.
+func (w *logReadWindow) compact() {
+	sort.SliceStable(w.entries, func(i, j int) bool {
+		return w.entries[i].Timestamp.Before(w.entries[j].Timestamp)
+	})
+	if over := len(w.entries) - w.limit; over > 0 {
+		w.entries = append(w.entries[:0], w.entries[over:]...)
+	}
+}
+
+// excludes reports whether entries no later than latest would all be dropped
+// from the window. A zero latest is unbounded.
+func (w *logReadWindow) excludes(latest time.Time) bool {
+	if latest.IsZero() || len(w.entries) < w.limit {
+		return false
+	}
+	w.compact()
+	return !latest.After(w.entries[0].Timestamp)
+}
+
+// Close closes all open log files.
+func (s *LogStore) Close() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	var errs []error
+	for base, current := range s.files {
+		if err := current.file.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(s.files, base)
+	}
+	return errors.Join(errs...)
+}
+
+func (s *LogStore) openFile(base string) (*logStoreFile, error) {
+	if current, ok := s.files[base]; ok {
+		return current, nil
+	}
+	path := filepath.Join(s.opts.Dir, base+logStoreExt)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logStoreFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("stat log file: %w", err)
+	}
+	current := &logStoreFile{file: file, size: info.Size()}
+	s.files[base] = current
+	return current, nil
+}
+
+func (s *LogStore) rotate(base string) error {
+	if current, ok := s.files[base]; ok {
+		_ = current.file.Close()
+		delete(s.files, base)
+	}
+	active := filepath.Join(s.opts.Dir, base+logStoreExt)
+	nanos := s.opts.Now().UnixNano()
+	rotated := rotatedLogPath(s.opts.Dir, base, nanos)
+	for {
+		if _, err := os.Stat(rotated); errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		nanos++
+		rotated = rotatedLogPath(s.opts.Dir, base, nanos)
+	}
+	if err := os.Rename(active, rotated); err != nil {
+		return fmt.Errorf("rotate log file: %w", err)
+	}
+	return s.prune(base)
+}
+
+func rotatedLogPath(dir, base string, nanos int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.%d%s", base, nanos, logStoreExt))
+}
+
+func (s *LogStore) pruneAll() error {
+	s.lastPrune = s.opts.Now()
+	bases, err := s.listBases()
+	if err != nil {
+		return err
+	}
+	for _, base := range bases {
+		if err := s.prune(base); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prune deletes rotated files beyond the backup limit or older than the
+// retention age.
+func (s *LogStore) prune(base string) error {
+	rotated, err := s.rotatedFiles(base)
+	if err != nil {
+		return err
+	}
+	var cutoff time.Time
+	if s.opts.MaxAge > 0 {
+		cutoff = s.opts.Now().Add(-s.opts.MaxAge)
+	}
+	// rotatedFiles is sorted oldest first; walk newest first.
+	kept := 0
+	for i := len(rotated) - 1; i >= 0; i-- {
+		file := rotated[i]
+		overLimit := s.opts.MaxBackups > 0 && kept >= s.opts.MaxBackups
+		expired := !cutoff.IsZero() && file.rotatedAt.Before(cutoff)
+		if !overLimit && !expired {
+			kept++
+			continue
+		}
+		if err := os.Remove(file.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("remove rotated log file: %w", err)
+		}
+	}
+	return nil
+}
+
+type rotatedLogFile struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// rotatedFiles lists the rotated files of a log, oldest first.
+func (s *LogStore) rotatedFiles(base string) ([]rotatedLogFile, error) {
+	dirEntries, err := os.ReadDir(s.opts.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("read log store dir: %w", err)
+	}
+	var files []rotatedLogFile
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasPrefix(name, base+".") || !strings.HasSuffix(name, logStoreExt) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, base+"."), logStoreExt)
+		nanos, err := strconv.ParseInt(stamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, rotatedLogFile{
+			path:      filepath.Join(s.opts.Dir, name),
+			rotatedAt: time.Unix(0, nanos),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].rotatedAt.Before(files[j].rotatedAt)
+	})
+	return files, nil
+}
+
+type logReadFile struct {
+	path string
+	// latest bounds the entry times in the file; zero for the active file.
+	latest time.Time
+}
+
+// filesFor returns the files of a log that may hold entries at or after
+// since, newest first. A rotated file is named after its rotation time, which
+// is later than every entry it contains.
+func (s *LogStore) filesFor(base string, since time.Time) ([]logReadFile, error) {
+	rotated, err := s.rotatedFiles(base)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]logReadFile, 0, len(rotated)+1)
+	files = append(files, logReadFile{path: filepath.Join(s.opts.Dir, base+logStoreExt)})
+	for i := len(rotated) - 1; i >= 0; i-- {
+		file := rotated[i]
+		if !since.IsZero() && file.rotatedAt.Before(since) {
+			break
+		}
+		files = append(files, logReadFile{path: file.path, latest: file.rotatedAt})
+	}
+	return files, nil
+}
+
+// listBases returns the name of every log in the store directory.
+func (s *LogStore) listBases() ([]string, error) {
+	dirEntries, err := os.ReadDir(s.opts.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("read log store dir: %w", err)
+	}
+	seen := make(map[string]struct{})
+	var bases []string
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasSuffix(name, logStoreExt) {
+			continue
+		}
+		base, _, _ := strings.Cut(strings.TrimSuffix(name, logStoreExt), ".")
+		if base != coreLogBase && !strings.HasPrefix(base, serverLogPrefix) {
+			continue
+		}
+		if _, ok := seen[base]; ok {
+			continue
+		}
+		seen[base] = struct{}{}
+		bases = append(bases, base)
+	}
+	sort.Strings(bases)
+	return bases, nil
+}
+
+func readLogFile(path string, filter LogFilter, window *logReadWindow) error {
+	file, err := os.Open(path)
+	if err != nil {
+		// Files can be rotated or pruned between listing and reading.
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("open log file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 64*1024)
+	for {
+		line, err := readLogLine(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read log file: %w", err)
+		}
+		var stored storedLogEntry
+		// A line being appended concurrently may be incomplete, and an
+		// oversized line comes back empty; skip both.
+		if err := json.Unmarshal(line, &stored); err != nil {
+			continue
+		}
+		entry := domain.LogEntry{
+			Logger:    stored.Logger,
+			Level:     stored.Level,
+			Timestamp: stored.Time,
+			Data:      stored.Data,
+		}
+		if stored.Run == logRunID {
+			entry.Seq = stored.Seq
+		}
+		if filter.Match(entry) {
+			window.add(entry)
+		}
+	}
+}
+
+// readLogLine returns the next line without its newline. Lines longer than
+// maxLogLineBytes are consumed and returned empty so one huge entry cannot
+// fail the whole read. It returns io.EOF once no bytes remain.
+func readLogLine(reader *bufio.Reader) ([]byte, error) {
+	var line []byte
+	oversized := false
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if !oversized {
+			if len(line)+len(chunk) > maxLogLineBytes+1 {
+				oversized = true
+				line = nil
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if errors.Is(err, io.EOF) && (oversized || len(line) > 0) {
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if oversized {
+			return []byte{}, nil
+		}
+		return bytes.TrimSuffix(line, []byte{'\n'}), nil
+	}
+}
+
+// logFileBase routes downstream server output to a per-server log and
+// everything else to the core log.
+func logFileBase(entry domain.LogEntry) string {
+	fields, _ := entry.Data["fields"].(map[string]any)
+	source, _ := fields[FieldLogSource].(string)
+	server := LogEntryServer(entry)
+	if source == LogSourceDownstream && server != "" {
+		return serverLogPrefix + sanitizeLogName(server)
+	}
+	return coreLogBase
+}
+
+func sanitizeLogName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
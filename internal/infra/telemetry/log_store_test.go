@@ -0,0 +1,223 @@
+package telemetry
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+)
+
+func TestLogStoreRoutesEntriesByServer(t *testing.T) {
+	dir := t.TempDir()
+	store, err := OpenLogStore(LogStoreOptions{Dir: dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Unix(1_700_000_000, 0).UTC()
+	require.NoError(t, store.Write(testLogEntry(base, domain.LogLevelInfo, "core started", nil)))
+	require.NoError(t, store.Write(testLogEntry(base.Add(time.Second), domain.LogLevelInfo, "github stderr", map[string]any{
+		FieldLogSource:  LogSourceDownstream,
+		FieldServerType: "github",
+	})))
+	require.NoError(t, store.Write(testLogEntry(base.Add(2*time.Second), domain.LogLevelWarning, "github restarted", map[string]any{
+		FieldServerType: "github",
+	})))
+
+	require.FileExists(t, filepath.Join(dir, "core.jsonl"))
+	require.FileExists(t, filepath.Join(dir, "server-github.jsonl"))
+
+	filter, err := NewLogFilter(domain.LogQuery{Server: "github"})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"github stderr", "github restarted"}, logMessages(entries))
+
+	filter, err = NewLogFilter(domain.LogQuery{MinLevel: domain.LogLevelWarning})
+	require.NoError(t, err)
+	entries, err = store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"github restarted"}, logMessages(entries))
+}
+
+func TestLogStoreReadFiltersWindowAndGrep(t *testing.T) {
+	store, err := OpenLogStore(LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Unix(1_700_000_000, 0).UTC()
+	for i, message := range []string{"dial failed", "dial ok", "ping failed", "shutdown"} {
+		require.NoError(t, store.Write(testLogEntry(base.Add(time.Duration(i)*time.Minute), domain.LogLevelInfo, message, nil)))
+	}
+
+	filter, err := NewLogFilter(domain.LogQuery{
+		Grep:  "failed$",
+		Since: base.Add(time.Minute),
+		Until: base.Add(3 * time.Minute),
+	})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ping failed"}, logMessages(entries))
+
+	_, err = NewLogFilter(domain.LogQuery{Grep: "("})
+	require.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestLogStoreSkipsEntriesBelowMinLevel(t *testing.T) {
+	store, err := OpenLogStore(LogStoreOptions{Dir: t.TempDir(), MinLevel: domain.LogLevelInfo})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Unix(1_700_000_000, 0).UTC()
+	require.NoError(t, store.Write(testLogEntry(base, domain.LogLevelDebug, "noisy", nil)))
+	require.NoError(t, store.Write(testLogEntry(base, domain.LogLevelInfo, "kept", nil)))
+
+	filter, err := NewLogFilter(domain.LogQuery{})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kept"}, logMessages(entries))
+}
+
+func TestLogStoreSkipsOversizedLines(t *testing.T) {
+	store, err := OpenLogStore(LogStoreOptions{Dir: t.TempDir(), MaxSizeBytes: 64 << 20})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	base := time.Unix(1_700_000_000, 0).UTC()
+	require.NoError(t, store.Write(testLogEntry(base, domain.LogLevelInfo, "before", nil)))
+	require.NoError(t, store.Write(testLogEntry(base.Add(time.Second), domain.LogLevelInfo, strings.Repeat("x", maxLogLineBytes), nil)))
+	require.NoError(t, store.Write(testLogEntry(base.Add(2*time.Second), domain.LogLevelInfo, "after", nil)))
+
+	filter, err := NewLogFilter(domain.LogQuery{})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"before", "after"}, logMessages(entries))
+}
+
+func TestLogStoreRotatesAndPrunes(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Unix(1_700_000_000, 0).UTC()
+	opts := LogStoreOptions{
+		Dir:          dir,
+		MaxSizeBytes: 1,
+		MaxAge:       time.Hour,
+		MaxBackups:   2,
+		Now:          func() time.Time { return now },
+	}
+	store, err := OpenLogStore(opts)
+	require.NoError(t, err)
+
+	// Each write after the first rotates the previous file away.
+	for i, message := range []string{"one", "two", "three", "four"} {
+		now = now.Add(time.Minute)
+		require.NoError(t, store.Write(testLogEntry(now, domain.LogLevelInfo, message, nil)))
+		require.LessOrEqual(t, len(rotatedNames(t, dir)), 2, "write %d", i)
+	}
+	require.Len(t, rotatedNames(t, dir), 2)
+
+	filter, err := NewLogFilter(domain.LogQuery{})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"two", "three", "four"}, logMessages(entries))
+	require.NoError(t, store.Close())
+
+	// Reopening after the retention age drops every rotated file.
+	now = now.Add(2 * time.Hour)
+	store, err = OpenLogStore(opts)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	require.Empty(t, rotatedNames(t, dir))
+	entries, err = store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"four"}, logMessages(entries))
+}
+
+func TestLogReadWindowKeepsNewestEntries(t *testing.T) {
+	base := time.Unix(1_700_000_000, 0).UTC()
+	window := &logReadWindow{limit: 3}
+	for _, offset := range []int{5, 1, 7, 3, 6, 2, 4} {
+		window.add(testLogEntry(base.Add(time.Duration(offset)*time.Second), domain.LogLevelInfo, strconv.Itoa(offset), nil))
+	}
+	require.LessOrEqual(t, len(window.entries), 2*window.limit)
+	require.True(t, window.excludes(base.Add(5*time.Second)))
+	require.False(t, window.excludes(base.Add(6*time.Second)))
+	require.False(t, window.excludes(time.Time{}))
+	window.compact()
+	require.Equal(t, []string{"5", "6", "7"}, logMessages(window.entries))
+}
+
+func TestLogStoreRestoresSequenceOfCurrentRun(t *testing.T) {
+	store, err := OpenLogStore(LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	entry := testLogEntry(time.Now(), domain.LogLevelInfo, "sequenced", nil)
+	entry.Seq = 42
+	require.NoError(t, store.Write(entry))
+
+	filter, err := NewLogFilter(domain.LogQuery{})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(42), entries[0].Seq)
+}
+
+func TestLogBroadcasterWritesToSink(t *testing.T) {
+	store, err := OpenLogStore(LogStoreOptions{Dir: t.TempDir()})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	broadcaster := NewLogBroadcaster(0)
+	broadcaster.SetSink(store, nil)
+	broadcaster.publish(testLogEntry(time.Now(), domain.LogLevelInfo, "persisted", nil))
+	broadcaster.SetSink(nil, nil)
+	broadcaster.publish(testLogEntry(time.Now(), domain.LogLevelInfo, "dropped", nil))
+
+	filter, err := NewLogFilter(domain.LogQuery{})
+	require.NoError(t, err)
+	entries, err := store.Read(filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"persisted"}, logMessages(entries))
+}
+
+func testLogEntry(at time.Time, level domain.LogLevel, message string, fields map[string]any) domain.LogEntry {
+	data := map[string]any{"message": message}
+	if fields != nil {
+		data["fields"] = fields
+	}
+	return domain.LogEntry{Logger: "test", Level: level, Timestamp: at, Data: data}
+}
+
+func logMessages(entries []domain.LogEntry) []string {
+	messages := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		message, _ := entry.Data["message"].(string)
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func rotatedNames(t *testing.T, dir string) []string {
+	t.Helper()
+	dirEntries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range dirEntries {
+		if entry.Name() != "core.jsonl" {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -32,6 +32,10 @@ func (n *NoopMetrics) SetPoolCapacityRatio(_ string, _ float64) {}
 
 func (n *NoopMetrics) SetPoolWaiters(_ string, _ int) {}
 
+func (n *NoopMetrics) SetInstanceResources(_ domain.InstanceResourcesMetric) {}
+
+func (n *NoopMetrics) AddIdleReapSavings(_ domain.IdleReapSavingsMetric) {}
+
 func (n *NoopMetrics) ObservePoolAcquireFailure(_ string, _ domain.AcquireFailureReason) {}
 
 func (n *NoopMetrics) ObserveSubAgentTokens(_ string, _ string, _ int) {}
@@ -49,8 +53,19 @@ func (n *NoopMetrics) ObserveReloadApply(_ domain.ReloadApplyMetric)
 func (n *NoopMetrics) ObserveReloadRollback(_ domain.ReloadRollbackMetric)                     {}
 func (n *NoopMetrics) RecordGovernanceOutcome(_ domain.GovernanceOutcomeMetric)                {}
 func (n *NoopMetrics) RecordGovernanceRejection(_ domain.GovernanceRejectionMetric)            {}
+func (n *NoopMetrics) RecordGovernanceRedaction(_ domain.GovernanceRedactionMetric)            {}
+func (n *NoopMetrics) SetRateLimitState(_ domain.RateLimitStateMetric)                         {}
+func (n *NoopMetrics) DeleteRateLimitState(_, _ string)                                        {}
+func (n *NoopMetrics) SetToolSLOBurnRate(_ domain.ToolSLOBurnRateMetric)                       {}
+func (n *NoopMetrics) RecordResponseCacheLookup(_ domain.ResponseCacheLookupMetric)            {}
+func (n *NoopMetrics) SetResponseCacheSize(_ domain.ResponseCacheSizeMetric)                   {}
+func (n *NoopMetrics) AddSuppressedToolListChanges(_ int)                                      {}
 func (n *NoopMetrics) RecordPluginStart(_ domain.PluginStartMetric)                            {}
 func (n *NoopMetrics) RecordPluginHandshake(_ domain.PluginHandshakeMetric)                    {}
 func (n *NoopMetrics) SetPluginRunning(_ domain.PluginCategory, _ string, _ bool)              {}
+func (n *NoopMetrics) RecordAlertDelivery(_ string, _ domain.AlertDeliveryResult)              {}
+func (n *NoopMetrics) ObserveAlertDeliveryAttempt(_ string, _ time.Duration)                   {}
+func (n *NoopMetrics) RecordAlertRetry(_ string)                                               {}
+func (n *NoopMetrics) RecordAlertSuppressed(_ string, _ domain.AlertSuppressReason)            {}
 
 var _ domain.Metrics = (*NoopMetrics)(nil)
@@ -2,6 +2,8 @@ package telemetry
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"strings"
 	"sync"
 
@@ -16,7 +18,12 @@ type ObservabilityControllerOptions struct {
 	DefaultHealthzEnabled bool
 	Registry              prometheus.Gatherer
 	Health                *HealthTracker
+	Tracing               *Tracing
 	Logger                *zap.Logger
+	// Readiness evaluates the readiness criteria for /readyz.
+	Readiness func(ctx context.Context) (domain.ReadinessReport, error)
+	// DebugState dumps the core state for /debug/state.
+	DebugState func(ctx context.Context) (json.RawMessage, error)
 }
 
 type ObservabilityController struct {
@@ -31,16 +38,26 @@ type observabilityState struct {
 	addr           string
 	metricsEnabled bool
 	healthzEnabled bool
+	pprofEnabled   bool
+	stateEnabled   bool
+	debugToken     string
+}
+
+func (s observabilityState) debugEnabled() bool {
+	return s.pprofEnabled || s.stateEnabled
 }
 
 func (s observabilityState) enabled() bool {
-	return s.metricsEnabled || s.healthzEnabled
+	return s.metricsEnabled || s.healthzEnabled || s.debugEnabled()
 }
 
 func (s observabilityState) equal(other observabilityState) bool {
 	return s.addr == other.addr &&
 		s.metricsEnabled == other.metricsEnabled &&
-		s.healthzEnabled == other.healthzEnabled
+		s.healthzEnabled == other.healthzEnabled &&
+		s.pprofEnabled == other.pprofEnabled &&
+		s.stateEnabled == other.stateEnabled &&
+		s.debugToken == other.debugToken
 }
 
 func NewObservabilityController(opts ObservabilityControllerOptions) *ObservabilityController {
@@ -59,7 +76,17 @@ func (c *ObservabilityController) Apply(ctx context.Context, cfg domain.Observab
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if err := c.defaults.Tracing.Apply(ctx, cfg.Tracing); err != nil {
+		c.defaults.Logger.Warn("tracing apply failed", zap.Error(err))
+	}
 	state := resolveObservabilityState(c.defaults, cfg)
+	if state.debugEnabled() && state.debugToken == "" {
+		c.defaults.Logger.Warn("observability debug endpoints disabled: token is not set",
+			zap.String("tokenEnv", cfg.Debug.TokenEnv),
+		)
+		state.pprofEnabled = false
+		state.stateEnabled = false
+	}
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -95,11 +122,16 @@ func (c *ObservabilityController) Apply(ctx context.Context, cfg domain.Observab
 	c.defaults.Logger.Info("starting observability server", zap.String("addr", state.addr))
 	go func() {
 		err := StartHTTPServer(runCtx, HTTPServerOptions{
-			Addr:          state.addr,
-			EnableMetrics: state.metricsEnabled,
-			EnableHealthz: state.healthzEnabled,
-			Health:        c.defaults.Health,
-			Registry:      c.defaults.Registry,
+			Addr:             state.addr,
+			EnableMetrics:    state.metricsEnabled,
+			EnableHealthz:    state.healthzEnabled,
+			Health:           c.defaults.Health,
+			Readiness:        c.defaults.Readiness,
+			Registry:         c.defaults.Registry,
+			EnablePprof:      state.pprofEnabled,
+			EnableDebugState: state.stateEnabled,
+			DebugToken:       state.debugToken,
+			DebugState:       c.defaults.DebugState,
 		}, c.defaults.Logger)
 		if err != nil {
 			c.defaults.Logger.Error("observability server failed", zap.Error(err))
@@ -131,5 +163,19 @@ func resolveObservabilityState(defaults ObservabilityControllerOptions, cfg doma
 		addr:           addr,
 		metricsEnabled: metricsEnabled,
 		healthzEnabled: healthzEnabled,
+		pprofEnabled:   cfg.Debug.PprofEnabled,
+		stateEnabled:   cfg.Debug.StateEnabled,
+		debugToken:     resolveDebugToken(cfg.Debug),
+	}
+}
+
+// resolveDebugToken returns the configured token or reads it from TokenEnv.
+func resolveDebugToken(cfg domain.ObservabilityDebugConfig) string {
+	if token := strings.TrimSpace(cfg.Token); token != "" {
+		return token
+	}
+	if key := strings.TrimSpace(cfg.TokenEnv); key != "" {
+		return strings.TrimSpace(os.Getenv(key))
 	}
+	return ""
 }
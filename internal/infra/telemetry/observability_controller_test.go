@@ -29,6 +29,25 @@ func TestResolveObservabilityState(t *testing.T) {
 	require.False(t, state.metricsEnabled)
 	require.True(t, state.healthzEnabled)
 	require.Equal(t, domain.DefaultObservabilityListenAddress, state.addr)
+	require.False(t, state.debugEnabled())
+}
+
+func TestResolveObservabilityState_Debug(t *testing.T) {
+	t.Setenv("MCPV_TEST_DEBUG_TOKEN", " env-token ")
+
+	state := resolveObservabilityState(ObservabilityControllerOptions{}, domain.ObservabilityConfig{
+		Debug: domain.ObservabilityDebugConfig{PprofEnabled: true, TokenEnv: "MCPV_TEST_DEBUG_TOKEN"},
+	})
+	require.True(t, state.enabled())
+	require.True(t, state.pprofEnabled)
+	require.False(t, state.stateEnabled)
+	require.Equal(t, "env-token", state.debugToken)
+
+	next := resolveObservabilityState(ObservabilityControllerOptions{}, domain.ObservabilityConfig{
+		Debug: domain.ObservabilityDebugConfig{PprofEnabled: true, StateEnabled: true, Token: "inline"},
+	})
+	require.Equal(t, "inline", next.debugToken)
+	require.False(t, state.equal(next))
 }
 
 func boolPtr(value bool) *bool {
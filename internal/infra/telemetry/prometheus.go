@@ -11,6 +11,7 @@ import (
 
 type PrometheusMetrics struct {
 	routeDuration           *prometheus.HistogramVec
+	toolLabels              *toolLabels
 	inflightRoutes          *prometheus.GaugeVec
 	poolWaitDuration        *prometheus.HistogramVec
 	instanceStarts          *prometheus.CounterVec
@@ -23,6 +24,12 @@ type PrometheusMetrics struct {
 	activeInstances         *prometheus.GaugeVec
 	poolCapacityRatio       *prometheus.GaugeVec
 	poolWaiters             *prometheus.GaugeVec
+	instanceCPUSeconds      *prometheus.GaugeVec
+	instanceRSSBytes        *prometheus.GaugeVec
+	instanceOpenFDs         *prometheus.GaugeVec
+	instanceThreads         *prometheus.GaugeVec
+	idleReapSavedSeconds    *prometheus.CounterVec
+	idleReapSavedRSS        *prometheus.CounterVec
 	poolAcquireFailures     *prometheus.CounterVec
 	subAgentTokens          *prometheus.CounterVec
 	subAgentLatency         *prometheus.HistogramVec
@@ -36,9 +43,22 @@ type PrometheusMetrics struct {
 	reloadRollbackDuration  *prometheus.HistogramVec
 	governanceOutcome       *prometheus.HistogramVec
 	governanceRejections    *prometheus.CounterVec
+	governanceRedactions    *prometheus.CounterVec
+	rateLimitTokens         *prometheus.GaugeVec
+	quotaUsed               *prometheus.GaugeVec
+	quotaRemaining          *prometheus.GaugeVec
+	toolSLOBurnRate         *prometheus.GaugeVec
+	responseCacheLookups    *prometheus.CounterVec
+	responseCacheEntries    prometheus.Gauge
+	responseCacheBytes      prometheus.Gauge
+	toolListSuppressed      prometheus.Counter
 	pluginLifecycle         *prometheus.CounterVec
 	pluginHandshakeDuration *prometheus.HistogramVec
 	pluginStatus            *prometheus.GaugeVec
+	alertDeliveries         *prometheus.CounterVec
+	alertDeliveryDuration   *prometheus.HistogramVec
+	alertRetries            *prometheus.CounterVec
+	alertsSuppressed        *prometheus.CounterVec
 }
 
 func NewPrometheusMetrics(registerer prometheus.Registerer) *PrometheusMetrics {
@@ -54,8 +74,9 @@ func NewPrometheusMetrics(registerer prometheus.Registerer) *PrometheusMetrics {
 				Help:    "Duration of route requests in seconds",
 				Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
 			},
-			[]string{"server_type", "client", "status", "reason"},
+			[]string{"server_type", "client", "tool", "status", "reason"},
 		),
+		toolLabels: newToolLabels(domain.DefaultToolStatsMaxToolsPerServer),
 		inflightRoutes: factory.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: "mcpv_inflight_routes",
@@ -142,6 +163,48 @@ func NewPrometheusMetrics(registerer prometheus.Registerer) *PrometheusMetrics {
 			},
 			[]string{"server_type"},
 		),
+		instanceCPUSeconds: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_instance_cpu_seconds",
+				Help: "CPU time consumed by the running instances of a server",
+			},
+			[]string{"server_type"},
+		),
+		instanceRSSBytes: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_instance_rss_bytes",
+				Help: "Resident memory of the running instances of a server",
+			},
+			[]string{"server_type"},
+		),
+		instanceOpenFDs: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_instance_open_fds",
+				Help: "Open file descriptors of the running instances of a server",
+			},
+			[]string{"server_type"},
+		),
+		instanceThreads: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_instance_threads",
+				Help: "Threads of the running instances of a server",
+			},
+			[]string{"server_type"},
+		),
+		idleReapSavedSeconds: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_idle_reap_saved_instance_seconds_total",
+				Help: "Instance-seconds saved by idle reaping",
+			},
+			[]string{"server_type"},
+		),
+		idleReapSavedRSS: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_idle_reap_saved_rss_byte_seconds_total",
+				Help: "Resident memory byte-seconds saved by idle reaping",
+			},
+			[]string{"server_type"},
+		),
 		poolAcquireFailures: factory.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "mcpv_pool_acquire_fail_total",
@@ -260,13 +323,117 @@ func NewPrometheusMetrics(registerer prometheus.Registerer) *PrometheusMetrics {
 			},
 			[]string{"category", "plugin", "flow", "code"},
 		),
+		governanceRedactions: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_governance_redactions_total",
+				Help: "Total number of response matches masked or rejected by redaction rules",
+			},
+			[]string{"plugin", "rule", "action"},
+		),
+		rateLimitTokens: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_ratelimit_tokens",
+				Help: "Tokens currently available in a rate limit bucket",
+			},
+			[]string{"rule", "key"},
+		),
+		quotaUsed: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_quota_used",
+				Help: "Calls counted against a daily quota in the current UTC day",
+			},
+			[]string{"rule", "key"},
+		),
+		quotaRemaining: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_quota_remaining",
+				Help: "Calls remaining in a daily quota for the current UTC day",
+			},
+			[]string{"rule", "key"},
+		),
+		toolSLOBurnRate: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcpv_tool_slo_burn_rate",
+				Help: "Ratio of the observed bad call rate to the SLO error budget over the SLO window",
+			},
+			[]string{"slo", "server", "tool"},
+		),
+		responseCacheLookups: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_response_cache_lookups_total",
+				Help: "Response cache lookups by kind, server and result (hit, miss, bypass)",
+			},
+			[]string{"kind", "server_type", "result"},
+		),
+		responseCacheEntries: factory.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "mcpv_response_cache_entries",
+				Help: "Number of responses held in the response cache",
+			},
+		),
+		responseCacheBytes: factory.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "mcpv_response_cache_bytes",
+				Help: "Total size of responses held in the response cache",
+			},
+		),
+		toolListSuppressed: factory.NewCounter(
+			prometheus.CounterOpts{
+				Name: "mcpv_gateway_tool_list_changes_suppressed_total",
+				Help: "Total number of tool list changes held back from gateway sessions with pinned tool lists",
+			},
+		),
+		alertDeliveries: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_alert_deliveries_total",
+				Help: "Total alert deliveries by sink and final result",
+			},
+			[]string{"sink", "result"},
+		),
+		alertDeliveryDuration: factory.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "mcpv_alert_delivery_duration_seconds",
+				Help:    "Duration of alert delivery attempts in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"sink"},
+		),
+		alertRetries: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_alert_delivery_retries_total",
+				Help: "Total alert delivery retries by sink",
+			},
+			[]string{"sink"},
+		),
+		alertsSuppressed: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "mcpv_alerts_suppressed_total",
+				Help: "Total alerts not delivered to a sink because of debounce or a full queue",
+			},
+			[]string{"sink", "reason"},
+		),
 	}
 }
 
+// SetMaxToolsPerServer caps the tool label values exported per server; tools
+// outside the most called ones are reported as OtherToolLabel.
+func (p *PrometheusMetrics) SetMaxToolsPerServer(limit int) {
+	p.toolLabels.setLimit(limit)
+}
+
 func (p *PrometheusMetrics) ObserveRoute(metric domain.RouteMetric) {
+	tool := ""
+	if metric.Tool != "" {
+		var evicted string
+		tool, evicted = p.toolLabels.label(metric.ServerType, metric.Tool)
+		if evicted != "" {
+			p.routeDuration.DeletePartialMatch(prometheus.Labels{"server_type": metric.ServerType, "tool": evicted})
+		}
+	}
 	p.routeDuration.WithLabelValues(
 		metric.ServerType,
 		metric.Client,
+		tool,
 		string(metric.Status),
 		string(metric.Reason),
 	).Observe(metric.Duration.Seconds())
@@ -322,6 +489,28 @@ func (p *PrometheusMetrics) SetPoolWaiters(serverType string, count int) {
 	p.poolWaiters.WithLabelValues(serverType).Set(float64(count))
 }
 
+func (p *PrometheusMetrics) SetInstanceResources(metric domain.InstanceResourcesMetric) {
+	if p.instanceCPUSeconds == nil || metric.ServerType == "" {
+		return
+	}
+	p.instanceCPUSeconds.WithLabelValues(metric.ServerType).Set(metric.CPUTime.Seconds())
+	p.instanceRSSBytes.WithLabelValues(metric.ServerType).Set(float64(metric.RSSBytes))
+	p.instanceOpenFDs.WithLabelValues(metric.ServerType).Set(float64(metric.OpenFDs))
+	p.instanceThreads.WithLabelValues(metric.ServerType).Set(float64(metric.Threads))
+}
+
+func (p *PrometheusMetrics) AddIdleReapSavings(metric domain.IdleReapSavingsMetric) {
+	if p.idleReapSavedSeconds == nil || metric.ServerType == "" {
+		return
+	}
+	if metric.InstanceSeconds > 0 {
+		p.idleReapSavedSeconds.WithLabelValues(metric.ServerType).Add(metric.InstanceSeconds)
+	}
+	if metric.RSSByteSeconds > 0 {
+		p.idleReapSavedRSS.WithLabelValues(metric.ServerType).Add(metric.RSSByteSeconds)
+	}
+}
+
 func (p *PrometheusMetrics) ObservePoolAcquireFailure(serverType string, reason domain.AcquireFailureReason) {
 	p.poolAcquireFailures.WithLabelValues(serverType, string(reason)).Inc()
 }
@@ -423,6 +612,77 @@ func (p *PrometheusMetrics) RecordGovernanceRejection(metric domain.GovernanceRe
 	).Inc()
 }
 
+func (p *PrometheusMetrics) RecordGovernanceRedaction(metric domain.GovernanceRedactionMetric) {
+	if p.governanceRedactions == nil || metric.Count <= 0 {
+		return
+	}
+	plugin := metric.Plugin
+	if plugin == "" {
+		plugin = "unnamed"
+	}
+	p.governanceRedactions.WithLabelValues(plugin, metric.Rule, metric.Action).Add(float64(metric.Count))
+}
+
+func (p *PrometheusMetrics) SetRateLimitState(metric domain.RateLimitStateMetric) {
+	if p.rateLimitTokens == nil || metric.Rule == "" {
+		return
+	}
+	key := rateLimitKeyLabel(metric.Key)
+	p.rateLimitTokens.WithLabelValues(metric.Rule, key).Set(metric.Tokens)
+	if metric.QuotaLimit > 0 {
+		p.quotaUsed.WithLabelValues(metric.Rule, key).Set(float64(metric.QuotaUsed))
+		p.quotaRemaining.WithLabelValues(metric.Rule, key).Set(float64(max(metric.QuotaLimit-metric.QuotaUsed, 0)))
+	}
+}
+
+// DeleteRateLimitState removes the series of a rate limit key that is no
+// longer tracked.
+func (p *PrometheusMetrics) DeleteRateLimitState(rule, key string) {
+	if p.rateLimitTokens == nil || rule == "" {
+		return
+	}
+	key = rateLimitKeyLabel(key)
+	p.rateLimitTokens.DeleteLabelValues(rule, key)
+	p.quotaUsed.DeleteLabelValues(rule, key)
+	p.quotaRemaining.DeleteLabelValues(rule, key)
+}
+
+func rateLimitKeyLabel(key string) string {
+	if key == "" {
+		return "shared"
+	}
+	return key
+}
+
+func (p *PrometheusMetrics) SetToolSLOBurnRate(metric domain.ToolSLOBurnRateMetric) {
+	if p.toolSLOBurnRate == nil || metric.Name == "" {
+		return
+	}
+	p.toolSLOBurnRate.WithLabelValues(metric.Name, metric.Server, metric.Tool).Set(metric.BurnRate)
+}
+
+func (p *PrometheusMetrics) RecordResponseCacheLookup(metric domain.ResponseCacheLookupMetric) {
+	if p.responseCacheLookups == nil || metric.Result == "" {
+		return
+	}
+	p.responseCacheLookups.WithLabelValues(metric.Kind, metric.Server, metric.Result).Inc()
+}
+
+func (p *PrometheusMetrics) SetResponseCacheSize(metric domain.ResponseCacheSizeMetric) {
+	if p.responseCacheEntries == nil {
+		return
+	}
+	p.responseCacheEntries.Set(float64(metric.Entries))
+	p.responseCacheBytes.Set(float64(metric.Bytes))
+}
+
+func (p *PrometheusMetrics) AddSuppressedToolListChanges(count int) {
+	if p.toolListSuppressed == nil || count <= 0 {
+		return
+	}
+	p.toolListSuppressed.Add(float64(count))
+}
+
 func (p *PrometheusMetrics) RecordPluginStart(metric domain.PluginStartMetric) {
 	if p.pluginLifecycle == nil || metric.Plugin == "" {
 		return
@@ -457,6 +717,34 @@ func (p *PrometheusMetrics) RecordPluginHandshake(metric domain.PluginHandshakeM
 	p.pluginHandshakeDuration.WithLabelValues(category, metric.Plugin, outcome).Observe(duration)
 }
 
+func (p *PrometheusMetrics) RecordAlertDelivery(sink string, result domain.AlertDeliveryResult) {
+	if p.alertDeliveries == nil || sink == "" {
+		return
+	}
+	p.alertDeliveries.WithLabelValues(sink, string(result)).Inc()
+}
+
+func (p *PrometheusMetrics) ObserveAlertDeliveryAttempt(sink string, duration time.Duration) {
+	if p.alertDeliveryDuration == nil || sink == "" {
+		return
+	}
+	p.alertDeliveryDuration.WithLabelValues(sink).Observe(max(duration.Seconds(), 0))
+}
+
+func (p *PrometheusMetrics) RecordAlertRetry(sink string) {
+	if p.alertRetries == nil || sink == "" {
+		return
+	}
+	p.alertRetries.WithLabelValues(sink).Inc()
+}
+
+func (p *PrometheusMetrics) RecordAlertSuppressed(sink string, reason domain.AlertSuppressReason) {
+	if p.alertsSuppressed == nil || sink == "" {
+		return
+	}
+	p.alertsSuppressed.WithLabelValues(sink, string(reason)).Inc()
+}
+
 func (p *PrometheusMetrics) SetPluginRunning(category domain.PluginCategory, name string, running bool) {
 	if p.pluginStatus == nil || name == "" {
 		return
@@ -59,6 +59,19 @@ func TestNewPrometheusMetrics_UsesProvidedRegistry(t *testing.T) {
 	m.SetActiveInstances("test-server", 1)
 	m.SetPoolCapacityRatio("test-server", 0.2)
 	m.SetPoolWaiters("test-server", 3)
+	m.SetInstanceResources(domain.InstanceResourcesMetric{
+		ServerType: "test-server",
+		Instances:  1,
+		CPUTime:    2 * time.Second,
+		RSSBytes:   4096,
+		OpenFDs:    8,
+		Threads:    3,
+	})
+	m.AddIdleReapSavings(domain.IdleReapSavingsMetric{
+		ServerType:      "test-server",
+		InstanceSeconds: 1.5,
+		RSSByteSeconds:  6144,
+	})
 	m.ObservePoolAcquireFailure("test-server", domain.AcquireFailureNoCapacity)
 	m.ObserveSubAgentTokens("openai", "gpt-4o", 128)
 	m.ObserveSubAgentLatency("openai", "gpt-4o", 500*time.Millisecond)
@@ -100,6 +113,12 @@ func TestNewPrometheusMetrics_UsesProvidedRegistry(t *testing.T) {
 	assert.Contains(t, names, "mcpv_active_instances")
 	assert.Contains(t, names, "mcpv_pool_capacity_ratio")
 	assert.Contains(t, names, "mcpv_pool_waiters")
+	assert.Contains(t, names, "mcpv_instance_cpu_seconds")
+	assert.Contains(t, names, "mcpv_instance_rss_bytes")
+	assert.Contains(t, names, "mcpv_instance_open_fds")
+	assert.Contains(t, names, "mcpv_instance_threads")
+	assert.Contains(t, names, "mcpv_idle_reap_saved_instance_seconds_total")
+	assert.Contains(t, names, "mcpv_idle_reap_saved_rss_byte_seconds_total")
 	assert.Contains(t, names, "mcpv_pool_acquire_fail_total")
 	assert.Contains(t, names, "mcpv_subagent_tokens_total")
 	assert.Contains(t, names, "mcpv_subagent_latency_seconds")
@@ -125,8 +144,9 @@ func TestPrometheusMetrics_ObserveRoute(t *testing.T) {
 				Help:    "Test route duration",
 				Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
 			},
-			[]string{"server_type", "client", "status", "reason"},
+			[]string{"server_type", "client", "tool", "status", "reason"},
 		),
+		toolLabels:      newToolLabels(domain.DefaultToolStatsMaxToolsPerServer),
 		instanceStarts:  prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_starts"}, []string{"server_type"}),
 		instanceStops:   prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_stops"}, []string{"server_type"}),
 		activeInstances: prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_active"}, []string{"server_type"}),
@@ -168,6 +188,70 @@ func TestPrometheusMetrics_ObserveRoute(t *testing.T) {
 	}
 }
 
+func TestPrometheusMetrics_ObserveRouteFoldsToolsBeyondLimit(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := NewPrometheusMetrics(registry)
+	m.SetMaxToolsPerServer(2)
+	for _, tool := range []string{"a", "b", "c", "d", "a"} {
+		m.ObserveRoute(domain.RouteMetric{
+			ServerType: "github",
+			Tool:       tool,
+			Status:     domain.RouteStatusSuccess,
+			Reason:     domain.RouteReasonSuccess,
+			Duration:   time.Millisecond,
+		})
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	counts := map[string]uint64{}
+	for _, family := range families {
+		if family.GetName() != "mcpv_route_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "tool" {
+					counts[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]uint64{"a": 2, "b": 1, OtherToolLabel: 2}, counts)
+}
+
+func TestPrometheusMetrics_ObserveRouteRanksToolsByCalls(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := NewPrometheusMetrics(registry)
+	m.SetMaxToolsPerServer(2)
+	for _, tool := range []string{"a", "b", "c", "c", "c"} {
+		m.ObserveRoute(domain.RouteMetric{
+			ServerType: "github",
+			Tool:       tool,
+			Status:     domain.RouteStatusSuccess,
+			Reason:     domain.RouteReasonSuccess,
+			Duration:   time.Millisecond,
+		})
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	counts := map[string]uint64{}
+	for _, family := range families {
+		if family.GetName() != "mcpv_route_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "tool" {
+					counts[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]uint64{"a": 1, "c": 2, OtherToolLabel: 1}, counts)
+}
+
 func TestPrometheusMetrics_ObserveInstanceStart(t *testing.T) {
 	m := &PrometheusMetrics{
 		routeDuration:  prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_route2"}, []string{"server_type", "client", "status", "reason"}),
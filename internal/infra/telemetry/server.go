@@ -2,14 +2,19 @@ package telemetry
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/pprof"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
 )
 
 type HTTPServerOptions struct {
@@ -18,13 +23,27 @@ type HTTPServerOptions struct {
 	EnableHealthz bool
 	Health        *HealthTracker
 	Registry      prometheus.Gatherer
+	// Readiness backs /readyz, served alongside /healthz.
+	Readiness func(ctx context.Context) (domain.ReadinessReport, error)
+	// EnablePprof serves the Go profiler under /debug/pprof/.
+	EnablePprof bool
+	// EnableDebugState serves the DebugState dump under /debug/state.
+	EnableDebugState bool
+	// DebugToken is the bearer token required by the debug endpoints. They
+	// stay disabled without it.
+	DebugToken string
+	DebugState func(ctx context.Context) (json.RawMessage, error)
+}
+
+func (o HTTPServerOptions) debugEnabled() bool {
+	return (o.EnablePprof || o.EnableDebugState) && o.DebugToken != ""
 }
 
 func StartHTTPServer(ctx context.Context, opts HTTPServerOptions, logger *zap.Logger) error {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	if !opts.EnableMetrics && !opts.EnableHealthz {
+	if !opts.EnableMetrics && !opts.EnableHealthz && !opts.debugEnabled() {
 		return nil
 	}
 
@@ -38,17 +57,9 @@ func StartHTTPServer(ctx context.Context, opts HTTPServerOptions, logger *zap.Lo
 		registry = prometheus.DefaultGatherer
 	}
 
-	mux := http.NewServeMux()
-	if opts.EnableMetrics {
-		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
-	}
-	if opts.EnableHealthz {
-		mux.Handle("/healthz", healthHandler(opts.Health))
-	}
-
 	server := &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: newObservabilityMux(opts, registry),
 	}
 
 	errChan := make(chan error, 1)
@@ -57,6 +68,8 @@ func StartHTTPServer(ctx context.Context, opts HTTPServerOptions, logger *zap.Lo
 			zap.String("addr", server.Addr),
 			zap.Bool("metrics", opts.EnableMetrics),
 			zap.Bool("healthz", opts.EnableHealthz),
+			zap.Bool("pprof", opts.EnablePprof && opts.debugEnabled()),
+			zap.Bool("debugState", opts.EnableDebugState && opts.debugEnabled()),
 		)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			errChan <- err
@@ -78,6 +91,33 @@ func StartHTTPServer(ctx context.Context, opts HTTPServerOptions, logger *zap.Lo
 	}
 }
 
+func newObservabilityMux(opts HTTPServerOptions, registry prometheus.Gatherer) *http.ServeMux {
+	mux := http.NewServeMux()
+	if opts.EnableMetrics {
+		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	}
+	if opts.EnableHealthz {
+		mux.Handle("/healthz", healthHandler(opts.Health))
+		if opts.Readiness != nil {
+			mux.Handle("/readyz", readinessHandler(opts.Readiness))
+		}
+	}
+	if !opts.debugEnabled() {
+		return mux
+	}
+	if opts.EnablePprof {
+		mux.Handle("/debug/pprof/", requireBearerToken(opts.DebugToken, http.HandlerFunc(pprof.Index)))
+		mux.Handle("/debug/pprof/cmdline", requireBearerToken(opts.DebugToken, http.HandlerFunc(pprof.Cmdline)))
+		mux.Handle("/debug/pprof/profile", requireBearerToken(opts.DebugToken, http.HandlerFunc(pprof.Profile)))
+		mux.Handle("/debug/pprof/symbol", requireBearerToken(opts.DebugToken, http.HandlerFunc(pprof.Symbol)))
+		mux.Handle("/debug/pprof/trace", requireBearerToken(opts.DebugToken, http.HandlerFunc(pprof.Trace)))
+	}
+	if opts.EnableDebugState {
+		mux.Handle("/debug/state", requireBearerToken(opts.DebugToken, debugStateHandler(opts.DebugState)))
+	}
+	return mux
+}
+
 func StartMetricsServer(ctx context.Context, port int, logger *zap.Logger) error {
 	addr := fmt.Sprintf("0.0.0.0:%d", port)
 	return StartHTTPServer(ctx, HTTPServerOptions{
@@ -103,3 +143,57 @@ func healthHandler(tracker *HealthTracker) http.Handler {
 		_ = json.NewEncoder(w).Encode(report)
 	})
 }
+
+// readinessHandler answers 200 when every readiness check passes and 503
+// otherwise, with the per-check breakdown as the body.
+func readinessHandler(readiness func(ctx context.Context) (domain.ReadinessReport, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, err := readiness(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		status := http.StatusOK
+		if !report.Ready {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+func debugStateHandler(state func(ctx context.Context) (json.RawMessage, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if state == nil {
+			http.Error(w, "debug state unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		payload, err := state(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(payload)
+	})
+}
+
+// requireBearerToken rejects requests without "Authorization: Bearer <token>".
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	expected := []byte(token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := strings.TrimSpace(r.Header.Get("Authorization"))
+		if len(value) < len(bearerScheme) || !strings.EqualFold(value[:len(bearerScheme)], bearerScheme) ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimSpace(value[len(bearerScheme):])), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="mcpv"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+const bearerScheme = "Bearer "
@@ -8,13 +8,17 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+
+	"mcpv/internal/domain"
 )
 
 func TestStartMetricsServer_Success(t *testing.T) {
@@ -167,3 +171,87 @@ func waitForHTTPStatus(t *testing.T, url string, status int, expectJSON bool) {
 		return true
 	}, 2*time.Second, 25*time.Millisecond)
 }
+
+func TestObservabilityMux_DebugEndpointsRequireToken(t *testing.T) {
+	mux := newObservabilityMux(HTTPServerOptions{
+		EnableHealthz:    true,
+		EnablePprof:      true,
+		EnableDebugState: true,
+		DebugToken:       "secret",
+		DebugState: func(context.Context) (json.RawMessage, error) {
+			return json.RawMessage(`{"pools":[]}`), nil
+		},
+	}, prometheus.NewRegistry())
+
+	get := func(path, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, get("/healthz", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("/debug/state", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("/debug/state", "Bearer wrong").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("/debug/pprof/", "secret").Code)
+
+	rec := get("/debug/state", "bearer secret")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"pools":[]}`, rec.Body.String())
+
+	rec = get("/debug/pprof/goroutine?debug=1", "Bearer secret")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goroutine")
+}
+
+func TestObservabilityMux_DebugEndpointsDisabledWithoutToken(t *testing.T) {
+	mux := newObservabilityMux(HTTPServerOptions{
+		EnablePprof:      true,
+		EnableDebugState: true,
+	}, prometheus.NewRegistry())
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/state", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestObservabilityMux_Readyz(t *testing.T) {
+	report := domain.ReadinessReport{
+		Checks: []domain.ReadinessCheck{
+			{Name: domain.ReadinessCheckBootstrap, Ready: true},
+			{Name: domain.ReadinessCheckRPCListener, Message: "rpc listener is not serving"},
+		},
+	}
+	mux := newObservabilityMux(HTTPServerOptions{
+		EnableHealthz: true,
+		Readiness: func(context.Context) (domain.ReadinessReport, error) {
+			return report, nil
+		},
+	}, prometheus.NewRegistry())
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, get("/healthz").Code)
+
+	rec := get("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var body domain.ReadinessReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.False(t, body.Ready)
+	require.Len(t, body.Checks, 2)
+	assert.Equal(t, "rpc listener is not serving", body.Checks[1].Message)
+
+	report.Ready = true
+	report.Checks[1] = domain.ReadinessCheck{Name: domain.ReadinessCheckRPCListener, Ready: true}
+	assert.Equal(t, http.StatusOK, get("/readyz").Code)
+}
@@ -0,0 +1,84 @@
+package telemetry
+
+import "sync"
+
+// OtherToolLabel replaces the tool label of tools outside a server's most
+// called tools.
+const OtherToolLabel = "_other"
+
+// toolLabels admits the limit most called tools of each server as label values
+// and folds the rest into OtherToolLabel. A tool that overtakes the least
+// called admitted tool takes its place.
+type toolLabels struct {
+	mu      sync.Mutex
+	limit   int
+	servers map[string]*serverToolLabels
+}
+
+type serverToolLabels struct {
+	calls    map[string]uint64
+	admitted map[string]struct{}
+}
+
+func newToolLabels(limit int) *toolLabels {
+	return &toolLabels{
+		limit:   limit,
+		servers: make(map[string]*serverToolLabels),
+	}
+}
+
+// setLimit changes the cap for tools not yet admitted; non-positive limits
+// are ignored.
+func (l *toolLabels) setLimit(limit int) {
+	if limit <= 0 {
+		return
+	}
+	l.mu.Lock()
+	l.limit = limit
+	l.mu.Unlock()
+}
+
+// label counts a call and returns the label value for the tool. evicted names
+// the tool that dropped out of the admitted set, whose series the caller
+// should remove; it is empty when the set did not change.
+func (l *toolLabels) label(server, tool string) (label, evicted string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s, ok := l.servers[server]
+	if !ok {
+		s = &serverToolLabels{
+			calls:    make(map[string]uint64),
+			admitted: make(map[string]struct{}),
+		}
+		l.servers[server] = s
+	}
+	s.calls[tool]++
+	if _, ok := s.admitted[tool]; ok {
+		return tool, ""
+	}
+	if len(s.admitted) < l.limit {
+		s.admitted[tool] = struct{}{}
+		return tool, ""
+	}
+	weakest := s.weakest()
+	if weakest == "" || s.calls[tool] <= s.calls[weakest] {
+		return OtherToolLabel, ""
+	}
+	delete(s.admitted, weakest)
+	s.admitted[tool] = struct{}{}
+	return tool, weakest
+}
+
+// weakest returns the admitted tool with the fewest calls, breaking ties by
+// the greater name so the choice is deterministic.
+func (s *serverToolLabels) weakest() string {
+	var name string
+	var calls uint64
+	for tool := range s.admitted {
+		count := s.calls[tool]
+		if name == "" || count < calls || (count == calls && tool > name) {
+			name, calls = tool, count
+		}
+	}
+	return name
+}
@@ -0,0 +1,286 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"mcpv/internal/buildinfo"
+	"mcpv/internal/domain"
+)
+
+// TracerName is the instrumentation scope of mcpv spans.
+const TracerName = "mcpv"
+
+// Span attribute keys shared across components.
+const (
+	AttrCaller     = attribute.Key("mcpv.caller")
+	AttrServerType = attribute.Key("mcpv.server_type")
+	AttrSpecKey    = attribute.Key("mcpv.spec_key")
+	AttrInstanceID = attribute.Key("mcpv.instance_id")
+	AttrTool       = attribute.Key("mcpv.tool")
+	AttrMethod     = attribute.Key("rpc.method")
+)
+
+// TracingOptions configures a Tracing instance.
+type TracingOptions struct {
+	ServiceName string
+	Logger      *zap.Logger
+}
+
+// Tracing owns the process tracer provider. The provider lives for the whole
+// process so instrumentation created early keeps working; Apply swaps the
+// exporter and sampler underneath it when the configuration changes.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	sampler  *switchSampler
+	logger   *zap.Logger
+
+	mu        sync.Mutex
+	processor sdktrace.SpanProcessor
+	current   domain.TracingConfig
+	applied   bool
+}
+
+// NewTracing constructs a tracer provider that samples nothing until Apply
+// enables an exporter.
+func NewTracing(opts TracingOptions) *Tracing {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	serviceName := strings.TrimSpace(opts.ServiceName)
+	if serviceName == "" {
+		serviceName = domain.DefaultTracingServiceName
+	}
+	sampler := newSwitchSampler()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", buildinfo.Version),
+		)),
+	)
+	return &Tracing{
+		provider: provider,
+		sampler:  sampler,
+		logger:   logger.Named("tracing"),
+	}
+}
+
+// Install makes the provider and the W3C trace context propagator the
+// process-wide defaults used by StartSpan and the gRPC stats handlers.
+func (t *Tracing) Install() {
+	if t == nil {
+		return
+	}
+	otel.SetTracerProvider(t.provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// Apply exports spans according to cfg. Unchanged configs are a no-op.
+func (t *Tracing) Apply(ctx context.Context, cfg domain.TracingConfig) error {
+	if t == nil {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.applied && tracingConfigEqual(t.current, cfg) {
+		return nil
+	}
+
+	var next sdktrace.SpanProcessor
+	if cfg.Enabled {
+		exporter, err := newSpanExporter(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("create %s exporter: %w", cfg.Exporter, err)
+		}
+		next = sdktrace.NewBatchSpanProcessor(exporter)
+		t.provider.RegisterSpanProcessor(next)
+		t.sampler.set(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio)))
+		t.logger.Info("tracing enabled",
+			zap.String("exporter", string(cfg.Exporter)),
+			zap.String("endpoint", cfg.Endpoint),
+			zap.Float64("samplingRatio", cfg.SamplingRatio),
+		)
+	} else {
+		t.sampler.set(sdktrace.NeverSample())
+	}
+
+	if prev := t.processor; prev != nil {
+		// Unregistering flushes the old exporter, which may wait on an
+		// unreachable collector.
+		go t.provider.UnregisterSpanProcessor(prev)
+	}
+	t.processor = next
+	t.current = cfg
+	t.applied = true
+	return nil
+}
+
+// ForceFlush exports all finished spans.
+func (t *Tracing) ForceFlush(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	return t.provider.ForceFlush(ctx)
+}
+
+// Shutdown flushes pending spans and stops the exporter.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+func newSpanExporter(ctx context.Context, cfg domain.TracingConfig) (sdktrace.SpanExporter, error) {
+	endpoint := strings.TrimSpace(cfg.Endpoint)
+	hasScheme := strings.Contains(endpoint, "://")
+	switch cfg.Exporter {
+	case domain.TracingExporterOTLPHTTP:
+		var opts []otlptracehttp.Option
+		switch {
+		case endpoint == "":
+		case hasScheme:
+			opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+		default:
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	case domain.TracingExporterOTLPGRPC, "":
+		var opts []otlptracegrpc.Option
+		switch {
+		case endpoint == "":
+		case hasScheme:
+			opts = append(opts, otlptracegrpc.WithEndpointURL(endpoint))
+		default:
+			opts = append(opts, otlptracegrpc.WithEndpoint(endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", cfg.Exporter)
+	}
+}
+
+// tracingConfigEqual compares the fields Apply can change. ServiceName is
+// fixed in the resource built by NewTracing and needs a restart.
+func tracingConfigEqual(a, b domain.TracingConfig) bool {
+	return a.Enabled == b.Enabled &&
+		a.Exporter == b.Exporter &&
+		a.Endpoint == b.Endpoint &&
+		a.Insecure == b.Insecure &&
+		maps.Equal(a.Headers, b.Headers) &&
+		a.SamplingRatio == b.SamplingRatio
+}
+
+// switchSampler delegates to a sampler that can be replaced at runtime.
+type switchSampler struct {
+	current atomic.Pointer[samplerHolder]
+}
+
+type samplerHolder struct {
+	sampler sdktrace.Sampler
+}
+
+func newSwitchSampler() *switchSampler {
+	s := &switchSampler{}
+	s.set(sdktrace.NeverSample())
+	return s
+}
+
+func (s *switchSampler) set(sampler sdktrace.Sampler) {
+	s.current.Store(&samplerHolder{sampler: sampler})
+}
+
+func (s *switchSampler) ShouldSample(params sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return s.current.Load().sampler.ShouldSample(params)
+}
+
+func (s *switchSampler) Description() string {
+	return "Switch{" + s.current.Load().sampler.Description() + "}"
+}
+
+// StartSpan starts a span on the process tracer.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartClientSpan starts a span for an outgoing call.
+func StartClientSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// EndSpan records err on the span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceContextMeta returns the W3C trace context of a sampled span in ctx as
+// MCP _meta entries, or nil when there is nothing to propagate.
+func TraceContextMeta(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ContextWithTraceMeta continues a trace whose W3C trace context arrived in
+// MCP _meta entries.
+func ContextWithTraceMeta(ctx context.Context, meta map[string]any) context.Context {
+	traceparent, _ := meta["traceparent"].(string)
+	if traceparent == "" {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	if tracestate, ok := meta["tracestate"].(string); ok && tracestate != "" {
+		carrier["tracestate"] = tracestate
+	}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
@@ -0,0 +1,149 @@
+package telemetry
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"mcpv/internal/domain"
+)
+
+type spanCollector struct {
+	collectortracev1.UnimplementedTraceServiceServer
+
+	mu    sync.Mutex
+	names []string
+}
+
+func (c *spanCollector) Export(_ context.Context, req *collectortracev1.ExportTraceServiceRequest) (*collectortracev1.ExportTraceServiceResponse, error) {
+	c.record(req)
+	return &collectortracev1.ExportTraceServiceResponse{}, nil
+}
+
+func (c *spanCollector) record(req *collectortracev1.ExportTraceServiceRequest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			for _, span := range ss.GetSpans() {
+				c.names = append(c.names, span.GetName())
+			}
+		}
+	}
+}
+
+func (c *spanCollector) spanNames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.names...)
+}
+
+func TestTracingExportsOverOTLPGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	collector := &spanCollector{}
+	server := grpc.NewServer()
+	collectortracev1.RegisterTraceServiceServer(server, collector)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	tracing := NewTracing(TracingOptions{})
+	t.Cleanup(func() { _ = tracing.Shutdown(context.Background()) })
+	require.NoError(t, tracing.Apply(context.Background(), domain.TracingConfig{
+		Enabled:       true,
+		Exporter:      domain.TracingExporterOTLPGRPC,
+		Endpoint:      lis.Addr().String(),
+		Insecure:      true,
+		SamplingRatio: 1,
+	}))
+
+	_, span := tracing.provider.Tracer(TracerName).Start(context.Background(), "router.send")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, tracing.ForceFlush(ctx))
+	require.Equal(t, []string{"router.send"}, collector.spanNames())
+}
+
+func TestTracingExportsOverOTLPHTTP(t *testing.T) {
+	collector := &spanCollector{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req collectortracev1.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		collector.record(&req)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	tracing := NewTracing(TracingOptions{})
+	t.Cleanup(func() { _ = tracing.Shutdown(context.Background()) })
+	require.NoError(t, tracing.Apply(context.Background(), domain.TracingConfig{
+		Enabled:       true,
+		Exporter:      domain.TracingExporterOTLPHTTP,
+		Endpoint:      server.URL,
+		SamplingRatio: 1,
+	}))
+
+	_, span := tracing.provider.Tracer(TracerName).Start(context.Background(), "gateway.tool_call")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, tracing.ForceFlush(ctx))
+	require.Equal(t, []string{"gateway.tool_call"}, collector.spanNames())
+}
+
+func TestTracingDisabledSamplesNothing(t *testing.T) {
+	tracing := NewTracing(TracingOptions{})
+	t.Cleanup(func() { _ = tracing.Shutdown(context.Background()) })
+	require.NoError(t, tracing.Apply(context.Background(), domain.TracingConfig{}))
+
+	ctx, span := tracing.provider.Tracer(TracerName).Start(context.Background(), "scheduler.acquire")
+	defer span.End()
+	require.False(t, span.SpanContext().IsSampled())
+	require.Nil(t, TraceContextMeta(ctx))
+}
+
+func TestTraceContextMetaRoundTrip(t *testing.T) {
+	tracing := NewTracing(TracingOptions{})
+	t.Cleanup(func() { _ = tracing.Shutdown(context.Background()) })
+	tracing.sampler.set(sdktrace.AlwaysSample())
+
+	ctx, span := tracing.provider.Tracer(TracerName).Start(context.Background(), "mcp.call tools/call")
+	defer span.End()
+
+	meta := TraceContextMeta(ctx)
+	require.Contains(t, meta, "traceparent")
+
+	wire := map[string]any{"traceparent": meta["traceparent"], "other": "value"}
+	remote := trace.SpanContextFromContext(ContextWithTraceMeta(context.Background(), wire))
+	require.True(t, remote.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+}
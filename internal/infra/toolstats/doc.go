@@ -0,0 +1,5 @@
+package toolstats
+
+// Package toolstats tracks per-tool call latency and error rates, exports them
+// as Prometheus metrics with bounded tool cardinality, and computes SLO burn
+// rates.
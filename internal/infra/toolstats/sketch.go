@@ -0,0 +1,74 @@
+package toolstats
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	sketchRelativeAccuracy = 0.01
+	// sketchMinValue is the smallest tracked value in seconds; faster samples
+	// share the zero bucket.
+	sketchMinValue = 1e-6
+)
+
+// sketch is a log-bucketed quantile sketch. Every quantile it returns is
+// within the relative accuracy of the true value, and memory grows with the
+// logarithm of the value range instead of the sample count.
+type sketch struct {
+	gamma    float64
+	logGamma float64
+	buckets  map[int]uint64
+	zero     uint64
+	count    uint64
+	max      float64
+}
+
+func newSketch() *sketch {
+	gamma := (1 + sketchRelativeAccuracy) / (1 - sketchRelativeAccuracy)
+	return &sketch{
+		gamma:    gamma,
+		logGamma: math.Log(gamma),
+		buckets:  make(map[int]uint64),
+	}
+}
+
+func (s *sketch) add(value float64) {
+	s.count++
+	if value > s.max {
+		s.max = value
+	}
+	if value <= sketchMinValue {
+		s.zero++
+		return
+	}
+	s.buckets[int(math.Ceil(math.Log(value)/s.logGamma))]++
+}
+
+// quantile returns the estimated value at q in [0, 1], or zero when empty.
+func (s *sketch) quantile(q float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(q * float64(s.count)))
+	if rank == 0 {
+		rank = 1
+	}
+	if rank <= s.zero {
+		return 0
+	}
+	seen := s.zero
+	keys := make([]int, 0, len(s.buckets))
+	for key := range s.buckets {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	for _, key := range keys {
+		seen += s.buckets[key]
+		if seen >= rank {
+			value := 2 * math.Pow(s.gamma, float64(key)) / (s.gamma + 1)
+			return math.Min(value, s.max)
+		}
+	}
+	return s.max
+}
@@ -0,0 +1,39 @@
+package toolstats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchQuantilesWithinRelativeAccuracy(t *testing.T) {
+	s := newSketch()
+	for i := 1; i <= 10_000; i++ {
+		s.add(float64(i) / 1000)
+	}
+
+	for _, tc := range []struct {
+		q    float64
+		want float64
+	}{
+		{q: 0.50, want: 5},
+		{q: 0.95, want: 9.5},
+		{q: 0.99, want: 9.9},
+	} {
+		got := s.quantile(tc.q)
+		require.LessOrEqual(t, math.Abs(got-tc.want)/tc.want, sketchRelativeAccuracy, "q=%v got %v", tc.q, got)
+	}
+	require.InDelta(t, 10, s.quantile(1), 1e-9)
+}
+
+func TestSketchEmptyAndTinyValues(t *testing.T) {
+	s := newSketch()
+	require.Zero(t, s.quantile(0.5))
+
+	s.add(0)
+	s.add(0)
+	s.add(1)
+	require.Zero(t, s.quantile(0.5))
+	require.InDelta(t, 1, s.quantile(0.99), 0.01)
+}
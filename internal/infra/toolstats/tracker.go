@@ -0,0 +1,251 @@
+package toolstats
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry"
+)
+
+// Options configures a Tracker.
+type Options struct {
+	Config domain.ToolStatsConfig
+	// Metrics receives SLO burn rates; nil uses no-op metrics.
+	Metrics domain.Metrics
+	Now     func() time.Time
+}
+
+// sloRefreshInterval matches the SLO bucket width so burn rate gauges decay
+// while no calls arrive.
+const sloRefreshInterval = time.Minute
+
+// Tracker observes governed tool calls that reached an upstream server. It
+// keeps a quantile sketch per tool for GetToolStats and maintains SLO burn
+// rates, which are pushed to the metrics on every observed call and by Run
+// every minute. The per-tool
+// latency histogram comes from route metrics. Calls rejected by governance
+// are not counted.
+type Tracker struct {
+	now     func() time.Time
+	metrics domain.Metrics
+
+	mu    sync.Mutex
+	tools map[toolKey]*toolStats
+	slos  []*sloTracker
+}
+
+type toolKey struct {
+	server string
+	tool   string
+}
+
+type toolStats struct {
+	calls   uint64
+	errors  uint64
+	latency *sketch
+}
+
+// NewTracker builds a tracker.
+func NewTracker(opts Options) *Tracker {
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	metrics := opts.Metrics
+	if metrics == nil {
+		metrics = telemetry.NewNoopMetrics()
+	}
+	t := &Tracker{
+		now:     now,
+		metrics: metrics,
+		tools:   make(map[toolKey]*toolStats),
+	}
+	for _, cfg := range opts.Config.SLOs {
+		t.slos = append(t.slos, newSLOTracker(cfg))
+	}
+	return t
+}
+
+// ObserveExecution records a completed tool call.
+func (t *Tracker) ObserveExecution(_ context.Context, execution governance.Execution) {
+	req := execution.Request
+	if t == nil || req.Method != "tools/call" || req.Server == "" || req.ToolName == "" {
+		return
+	}
+	if _, rejected := execution.Rejected(); rejected {
+		return
+	}
+	failed := execution.Err != nil || execution.ToolResultIsError()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := toolKey{server: req.Server, tool: req.ToolName}
+	stats, ok := t.tools[key]
+	if !ok {
+		stats = &toolStats{latency: newSketch()}
+		t.tools[key] = stats
+	}
+	stats.calls++
+	if failed {
+		stats.errors++
+	}
+	stats.latency.add(execution.Duration.Seconds())
+
+	minute := t.now().Unix() / 60
+	for _, slo := range t.slos {
+		if slo.matches(key) {
+			bad := failed || (slo.target > 0 && execution.Duration > slo.target)
+			slo.record(minute, bad)
+		}
+		// Every SLO is refreshed so burn rates decay as calls age out.
+		t.reportSLO(slo.status(minute))
+	}
+}
+
+// Run refreshes the SLO burn rate gauges every minute until ctx is done.
+func (t *Tracker) Run(ctx context.Context) {
+	if t == nil || len(t.slos) == 0 {
+		return
+	}
+	ticker := time.NewTicker(sloRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.refreshSLOs()
+		}
+	}
+}
+
+func (t *Tracker) refreshSLOs() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	minute := t.now().Unix() / 60
+	for _, slo := range t.slos {
+		t.reportSLO(slo.status(minute))
+	}
+}
+
+// GetToolStats returns latency statistics for the matching tools, sorted by
+// server and tool, and the status of every SLO.
+func (t *Tracker) GetToolStats(_ context.Context, query domain.ToolStatsQuery) (domain.ToolStats, error) {
+	if t == nil {
+		return domain.ToolStats{}, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var out domain.ToolStats
+	for key, stats := range t.tools {
+		if (query.Server != "" && key.server != query.Server) || (query.Tool != "" && key.tool != query.Tool) {
+			continue
+		}
+		out.Tools = append(out.Tools, domain.ToolLatencyStats{
+			Server: key.server,
+			Tool:   key.tool,
+			Calls:  stats.calls,
+			Errors: stats.errors,
+			P50:    seconds(stats.latency.quantile(0.50)),
+			P95:    seconds(stats.latency.quantile(0.95)),
+			P99:    seconds(stats.latency.quantile(0.99)),
+			Max:    seconds(stats.latency.max),
+		})
+	}
+	sort.Slice(out.Tools, func(i, j int) bool {
+		if out.Tools[i].Server != out.Tools[j].Server {
+			return out.Tools[i].Server < out.Tools[j].Server
+		}
+		return out.Tools[i].Tool < out.Tools[j].Tool
+	})
+	minute := t.now().Unix() / 60
+	for _, slo := range t.slos {
+		status := slo.status(minute)
+		t.reportSLO(status)
+		out.SLOs = append(out.SLOs, status)
+	}
+	return out, nil
+}
+
+func (t *Tracker) reportSLO(status domain.ToolSLOStatus) {
+	t.metrics.SetToolSLOBurnRate(domain.ToolSLOBurnRateMetric{
+		Name:     status.Name,
+		Server:   status.Server,
+		Tool:     status.Tool,
+		BurnRate: status.BurnRate,
+	})
+}
+
+// sloTracker counts calls and bad calls in one-minute buckets over the SLO
+// window.
+type sloTracker struct {
+	cfg     domain.ToolSLOConfig
+	target  time.Duration
+	buckets []sloBucket
+}
+
+type sloBucket struct {
+	minute int64
+	total  uint64
+	bad    uint64
+}
+
+func newSLOTracker(cfg domain.ToolSLOConfig) *sloTracker {
+	window := cfg.WindowMinutes
+	if window <= 0 {
+		window = domain.DefaultToolSLOWindowMinutes
+	}
+	return &sloTracker{
+		cfg:     cfg,
+		target:  time.Duration(cfg.LatencyTargetMs) * time.Millisecond,
+		buckets: make([]sloBucket, window),
+	}
+}
+
+func (s *sloTracker) matches(key toolKey) bool {
+	return (s.cfg.Server == "" || s.cfg.Server == key.server) && (s.cfg.Tool == "" || s.cfg.Tool == key.tool)
+}
+
+func (s *sloTracker) record(minute int64, bad bool) {
+	bucket := &s.buckets[minute%int64(len(s.buckets))]
+	if bucket.minute != minute {
+		*bucket = sloBucket{minute: minute}
+	}
+	bucket.total++
+	if bad {
+		bucket.bad++
+	}
+}
+
+func (s *sloTracker) status(minute int64) domain.ToolSLOStatus {
+	status := domain.ToolSLOStatus{
+		Name:          s.cfg.Name,
+		Server:        s.cfg.Server,
+		Tool:          s.cfg.Tool,
+		LatencyTarget: s.target,
+		ErrorBudget:   s.cfg.ErrorBudget,
+		Window:        time.Duration(len(s.buckets)) * time.Minute,
+	}
+	oldest := minute - int64(len(s.buckets))
+	for _, bucket := range s.buckets {
+		if bucket.minute > oldest && bucket.minute <= minute {
+			status.WindowCalls += bucket.total
+			status.WindowBadCalls += bucket.bad
+		}
+	}
+	if status.WindowCalls > 0 && s.cfg.ErrorBudget > 0 {
+		badRatio := float64(status.WindowBadCalls) / float64(status.WindowCalls)
+		status.BurnRate = badRatio / s.cfg.ErrorBudget
+	}
+	return status
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
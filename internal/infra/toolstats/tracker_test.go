@@ -0,0 +1,125 @@
+package toolstats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"mcpv/internal/domain"
+	"mcpv/internal/infra/governance"
+	"mcpv/internal/infra/telemetry"
+)
+
+func TestTrackerReportsPerToolStats(t *testing.T) {
+	tracker := NewTracker(Options{})
+	ctx := context.Background()
+
+	for i := 1; i <= 100; i++ {
+		tracker.ObserveExecution(ctx, toolExecution("github", "search", time.Duration(i)*time.Millisecond, nil, nil))
+	}
+	tracker.ObserveExecution(ctx, toolExecution("github", "create_issue", time.Second, errors.New("boom"), nil))
+	tracker.ObserveExecution(ctx, toolExecution("github", "create_issue", time.Second, nil, json.RawMessage(`{"isError":true}`)))
+	// Rejected calls and other methods are ignored.
+	rejected := toolExecution("github", "search", time.Hour, nil, nil)
+	rejected.Rejection = &domain.GovernanceDecision{RejectCode: "rate_limited"}
+	tracker.ObserveExecution(ctx, rejected)
+	listing := toolExecution("github", "search", time.Hour, nil, nil)
+	listing.Request.Method = "tools/list"
+	tracker.ObserveExecution(ctx, listing)
+
+	stats, err := tracker.GetToolStats(ctx, domain.ToolStatsQuery{Server: "github"})
+	require.NoError(t, err)
+	require.Len(t, stats.Tools, 2)
+
+	issue := stats.Tools[0]
+	require.Equal(t, "create_issue", issue.Tool)
+	require.Equal(t, uint64(2), issue.Calls)
+	require.Equal(t, uint64(2), issue.Errors)
+
+	search := stats.Tools[1]
+	require.Equal(t, "search", search.Tool)
+	require.Equal(t, uint64(100), search.Calls)
+	require.Zero(t, search.Errors)
+	require.InEpsilon(t, float64(50*time.Millisecond), float64(search.P50), 0.02)
+	require.InEpsilon(t, float64(95*time.Millisecond), float64(search.P95), 0.02)
+	require.InEpsilon(t, float64(99*time.Millisecond), float64(search.P99), 0.02)
+	require.Equal(t, 100*time.Millisecond, search.Max)
+
+	stats, err = tracker.GetToolStats(ctx, domain.ToolStatsQuery{Tool: "missing"})
+	require.NoError(t, err)
+	require.Empty(t, stats.Tools)
+}
+
+func TestTrackerSLOBurnRate(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	now := time.Unix(1_700_000_000, 0)
+	tracker := NewTracker(Options{
+		Config: domain.ToolStatsConfig{SLOs: []domain.ToolSLOConfig{{
+			Name:            "search",
+			Server:          "github",
+			Tool:            "search",
+			LatencyTargetMs: 100,
+			ErrorBudget:     0.1,
+			WindowMinutes:   10,
+		}}},
+		Metrics: telemetry.NewPrometheusMetrics(registry),
+		Now:     func() time.Time { return now },
+	})
+	ctx := context.Background()
+
+	// Two bad calls out of ten: one slow, one failed.
+	for i := 0; i < 8; i++ {
+		tracker.ObserveExecution(ctx, toolExecution("github", "search", 10*time.Millisecond, nil, nil))
+	}
+	tracker.ObserveExecution(ctx, toolExecution("github", "search", time.Second, nil, nil))
+	tracker.ObserveExecution(ctx, toolExecution("github", "search", time.Millisecond, errors.New("boom"), nil))
+	// Other tools are outside the SLO.
+	tracker.ObserveExecution(ctx, toolExecution("github", "create_issue", time.Minute, nil, nil))
+
+	stats, err := tracker.GetToolStats(ctx, domain.ToolStatsQuery{})
+	require.NoError(t, err)
+	require.Len(t, stats.SLOs, 1)
+	slo := stats.SLOs[0]
+	require.Equal(t, uint64(10), slo.WindowCalls)
+	require.Equal(t, uint64(2), slo.WindowBadCalls)
+	require.InDelta(t, 2, slo.BurnRate, 1e-9)
+	require.Equal(t, 10*time.Minute, slo.Window)
+
+	expected := `
+# HELP mcpv_tool_slo_burn_rate Ratio of the observed bad call rate to the SLO error budget over the SLO window
+# TYPE mcpv_tool_slo_burn_rate gauge
+mcpv_tool_slo_burn_rate{server="github",slo="search",tool="search"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "mcpv_tool_slo_burn_rate"))
+
+	// Calls age out of the window; the periodic refresh updates the gauge
+	// without new calls or queries.
+	now = now.Add(11 * time.Minute)
+	tracker.refreshSLOs()
+	expected = strings.Replace(expected, "} 2", "} 0", 1)
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "mcpv_tool_slo_burn_rate"))
+	stats, err = tracker.GetToolStats(ctx, domain.ToolStatsQuery{})
+	require.NoError(t, err)
+	require.Zero(t, stats.SLOs[0].WindowCalls)
+	require.Zero(t, stats.SLOs[0].BurnRate)
+}
+
+func toolExecution(server, tool string, duration time.Duration, err error, response json.RawMessage) governance.Execution {
+	return governance.Execution{
+		Request: domain.GovernanceRequest{
+			Method:   "tools/call",
+			Server:   server,
+			ToolName: tool,
+		},
+		Response: response,
+		Err:      err,
+		Duration: duration,
+	}
+}
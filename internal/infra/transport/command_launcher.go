@@ -199,7 +199,7 @@ func (l *CommandLauncher) Start(ctx context.Context, specKey string, spec domain
 		return process.Wait(stopCtx, cmd)
 	}
 
-	return domain.IOStreams{Reader: stdout, Writer: stdin}, stop, nil
+	return domain.IOStreams{Reader: stdout, Writer: stdin, PID: cmd.Process.Pid}, stop, nil
 }
 
 const maxStderrLineLength = 32 * 1024 // 32KB per line
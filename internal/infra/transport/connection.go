@@ -13,6 +13,8 @@ import (
 	"go.uber.org/zap"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/mcpcodec"
+	"mcpv/internal/infra/telemetry"
 )
 
 type clientConn struct {
@@ -21,6 +23,8 @@ type clientConn struct {
 	emitter     domain.ListChangeEmitter
 	sampling    domain.SamplingHandler
 	elicitation domain.ElicitationHandler
+	roots       domain.RootsProvider
+	bindsCaller bool
 	serverType  string
 	specKey     string
 	logger      *zap.Logger
@@ -32,6 +36,15 @@ type clientConn struct {
 	closeOnce sync.Once
 	cancel    context.CancelFunc
 	closed    chan struct{}
+
+	callerMu sync.Mutex
+	caller   string
+	// shared is set once a second caller reaches the instance; from then on
+	// roots/list answers with no roots.
+	shared bool
+	// rootsListed is set once the server asked for roots, so the switch to
+	// a shared instance only notifies servers that use them.
+	rootsListed bool
 }
 
 type clientConnOptions struct {
@@ -39,8 +52,12 @@ type clientConnOptions struct {
 	ListChangeEmitter  domain.ListChangeEmitter
 	SamplingHandler    domain.SamplingHandler
 	ElicitationHandler domain.ElicitationHandler
-	ServerType         string
-	SpecKey            string
+	RootsProvider      domain.RootsProvider
+	// BindsCaller marks instances of stateful servers, whose sessions belong
+	// to one caller. Only these instances see caller roots.
+	BindsCaller bool
+	ServerType  string
+	SpecKey     string
 }
 
 type callResult struct {
@@ -60,6 +77,8 @@ func newClientConn(conn mcp.Connection, opts clientConnOptions) *clientConn {
 		emitter:     opts.ListChangeEmitter,
 		sampling:    opts.SamplingHandler,
 		elicitation: opts.ElicitationHandler,
+		roots:       opts.RootsProvider,
+		bindsCaller: opts.BindsCaller,
 		serverType:  opts.ServerType,
 		specKey:     opts.SpecKey,
 		logger:      logger,
@@ -67,6 +86,9 @@ func newClientConn(conn mcp.Connection, opts clientConnOptions) *clientConn {
 		closed:      make(chan struct{}),
 	}
 	go c.readLoop(ctx)
+	if c.roots != nil {
+		go c.watchRoots(ctx, c.roots.SubscribeRootsChanges(ctx))
+	}
 	return c
 }
 
@@ -82,6 +104,25 @@ func (c *clientConn) Call(ctx context.Context, payload json.RawMessage) (json.Ra
 	if !ok || !req.ID.IsValid() {
 		return nil, errors.New("request id is required")
 	}
+
+	ctx, span := telemetry.StartClientSpan(ctx, "mcp.call "+req.Method,
+		telemetry.AttrMethod.String(req.Method),
+		telemetry.AttrServerType.String(c.serverType),
+		telemetry.AttrSpecKey.String(c.specKey),
+	)
+	resp, err := c.call(ctx, req)
+	telemetry.EndSpan(span, err)
+	return resp, err
+}
+
+func (c *clientConn) call(ctx context.Context, req *jsonrpc.Request) (json.RawMessage, error) {
+	if meta := telemetry.TraceContextMeta(ctx); meta != nil {
+		params, err := mcpcodec.MergeParamsMeta(req.Params, meta)
+		if err != nil {
+			return nil, fmt.Errorf("inject trace context: %w", err)
+		}
+		req.Params = params
+	}
 	key, err := idKey(req.ID)
 	if err != nil {
 		return nil, err
@@ -96,6 +137,7 @@ func (c *clientConn) Call(ctx context.Context, payload json.RawMessage) (json.Ra
 	c.pending[key] = resultCh
 	c.mu.Unlock()
 
+	c.trackCaller(ctx)
 	if err := c.conn.Write(ctx, req); err != nil {
 		c.removePending(key)
 		return nil, fmt.Errorf("write request: %w", err)
@@ -196,6 +238,8 @@ func (c *clientConn) handleServerCall(ctx context.Context, req *jsonrpc.Request)
 		resp = c.handleSamplingCall(ctx, req)
 	case "elicitation/create":
 		resp = c.handleElicitationCall(ctx, req)
+	case "roots/list":
+		resp = c.handleRootsCall(req)
 	default:
 		resp = newMethodNotFoundResponse(req.ID)
 	}
@@ -242,6 +286,78 @@ func (c *clientConn) handleElicitationCall(ctx context.Context, req *jsonrpc.Req
 	return &jsonrpc.Response{ID: req.ID, Result: raw}
 }
 
+func (c *clientConn) handleRootsCall(req *jsonrpc.Request) *jsonrpc.Response {
+	if c.roots == nil {
+		return newMethodNotFoundResponse(req.ID)
+	}
+	c.callerMu.Lock()
+	caller := c.caller
+	if c.shared {
+		caller = ""
+	}
+	c.rootsListed = true
+	c.callerMu.Unlock()
+
+	var roots []domain.Root
+	if caller != "" {
+		roots = c.roots.CallerRoots(caller)
+	}
+	if roots == nil {
+		roots = []domain.Root{}
+	}
+	raw, err := json.Marshal(map[string]any{"roots": roots})
+	if err != nil {
+		return &jsonrpc.Response{ID: req.ID, Error: fmt.Errorf("encode roots result: %w", err)}
+	}
+	return &jsonrpc.Response{ID: req.ID, Result: raw}
+}
+
+// trackCaller binds a caller-bound instance to the first caller it serves so
+// roots/list answers with that caller's roots. Shared instances never see
+// caller roots. When a second caller reaches a bound instance it becomes
+// shared, and a server that listed roots is told to list them again before
+// the request reaches it.
+func (c *clientConn) trackCaller(ctx context.Context) {
+	if c.roots == nil || !c.bindsCaller {
+		return
+	}
+	meta, ok := domain.RouteContextFrom(ctx)
+	if !ok || meta.Client == "" || meta.Client == domain.InternalUIClientName {
+		return
+	}
+	c.callerMu.Lock()
+	notify := false
+	switch {
+	case c.shared || c.caller == meta.Client:
+	case c.caller == "":
+		c.caller = meta.Client
+	default:
+		c.shared = true
+		notify = c.rootsListed && len(c.roots.CallerRoots(c.caller)) > 0
+	}
+	c.callerMu.Unlock()
+	if notify {
+		c.notifyRootsChanged(ctx)
+	}
+}
+
+func (c *clientConn) watchRoots(ctx context.Context, changes <-chan string) {
+	for caller := range changes {
+		c.callerMu.Lock()
+		serving := !c.shared && c.caller == caller
+		c.callerMu.Unlock()
+		if serving {
+			c.notifyRootsChanged(ctx)
+		}
+	}
+}
+
+func (c *clientConn) notifyRootsChanged(ctx context.Context) {
+	if err := c.Notify(ctx, "notifications/roots/list_changed", nil); err != nil && !errors.Is(err, domain.ErrConnectionClosed) {
+		c.logger.Debug("roots list_changed notification failed", zap.Error(err))
+	}
+}
+
 func (c *clientConn) handleNotification(req *jsonrpc.Request) {
 	switch req.Method {
 	case "notifications/tools/list_changed":
@@ -250,9 +366,29 @@ func (c *clientConn) handleNotification(req *jsonrpc.Request) {
 		c.emitListChange(domain.ListChangeResources)
 	case "notifications/prompts/list_changed":
 		c.emitListChange(domain.ListChangePrompts)
+	case "notifications/resources/updated":
+		c.emitResourceUpdated(req.Params)
 	}
 }
 
+func (c *clientConn) emitResourceUpdated(raw json.RawMessage) {
+	if c.emitter == nil {
+		return
+	}
+	var params struct {
+		URI string `json:"uri"`
+	}
+	if err := json.Unmarshal(raw, &params); err != nil || params.URI == "" {
+		return
+	}
+	c.emitter.EmitListChange(domain.ListChangeEvent{
+		Kind:       domain.ListChangeResourceUpdated,
+		ServerType: c.serverType,
+		SpecKey:    c.specKey,
+		URI:        params.URI,
+	})
+}
+
 func (c *clientConn) emitListChange(kind domain.ListChangeKind) {
 	if c.emitter == nil {
 		return
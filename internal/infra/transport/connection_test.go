@@ -9,9 +9,11 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"mcpv/internal/domain"
+	"mcpv/internal/infra/notifications"
 )
 
 type fakeConn struct {
@@ -207,3 +209,196 @@ func TestConnectionUnsupportedMethod(t *testing.T) {
 		t.Fatal("timed out waiting for unsupported method response")
 	}
 }
+
+func TestConnectionRootsListUsesServedCaller(t *testing.T) {
+	conn := newFakeConn()
+	hub := notifications.NewRootsHub()
+	hub.SetCallerRoots("cursor-1", []domain.Root{{URI: "file:///work/app", Name: "app"}})
+	client := newClientConn(conn, clientConnOptions{
+		Logger:        zap.NewNop(),
+		RootsProvider: hub,
+		BindsCaller:   true,
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	callID, err := jsonrpc.MakeID("call-1")
+	require.NoError(t, err)
+	payload, err := jsonrpc.EncodeMessage(&jsonrpc.Request{ID: callID, Method: "tools/list", Params: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	ctx := domain.WithRouteContext(context.Background(), domain.RouteContext{Client: "cursor-1"})
+	callDone := make(chan error, 1)
+	go func() {
+		_, err := client.Call(ctx, payload)
+		callDone <- err
+	}()
+	select {
+	case <-conn.writeCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for routed request")
+	}
+
+	rootsID, err := jsonrpc.MakeID("roots-1")
+	require.NoError(t, err)
+	conn.readCh <- &jsonrpc.Request{ID: rootsID, Method: "roots/list"}
+	select {
+	case msg := <-conn.writeCh:
+		resp, ok := msg.(*jsonrpc.Response)
+		require.True(t, ok)
+		require.Nil(t, resp.Error)
+		require.JSONEq(t, `{"roots":[{"uri":"file:///work/app","name":"app"}]}`, string(resp.Result))
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for roots response")
+	}
+
+	hub.SetCallerRoots("cursor-1", []domain.Root{{URI: "file:///work/lib"}})
+	select {
+	case msg := <-conn.writeCh:
+		req, ok := msg.(*jsonrpc.Request)
+		require.True(t, ok)
+		require.Equal(t, "notifications/roots/list_changed", req.Method)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for roots list_changed notification")
+	}
+
+	conn.readCh <- &jsonrpc.Response{ID: callID, Result: json.RawMessage(`{"tools":[]}`)}
+	require.NoError(t, <-callDone)
+}
+
+func TestConnectionRootsListHidesRootsFromSharedInstances(t *testing.T) {
+	hub := notifications.NewRootsHub()
+	hub.SetCallerRoots("cursor-1", []domain.Root{{URI: "file:///work/app"}})
+	hub.SetCallerRoots("cursor-2", []domain.Root{{URI: "file:///work/other"}})
+
+	t.Run("shared instance", func(t *testing.T) {
+		conn := newFakeConn()
+		client := newClientConn(conn, clientConnOptions{Logger: zap.NewNop(), RootsProvider: hub})
+		t.Cleanup(func() { _ = client.Close() })
+
+		callRooted(t, conn, client, "cursor-1", "call-1")
+		require.JSONEq(t, `{"roots":[]}`, listRoots(t, conn, "roots-1"))
+	})
+
+	t.Run("interleaved callers on a bound instance", func(t *testing.T) {
+		conn := newFakeConn()
+		client := newClientConn(conn, clientConnOptions{Logger: zap.NewNop(), RootsProvider: hub, BindsCaller: true})
+		t.Cleanup(func() { _ = client.Close() })
+
+		callRooted(t, conn, client, "cursor-1", "call-1")
+		require.JSONEq(t, `{"roots":[{"uri":"file:///work/app"}]}`, listRoots(t, conn, "roots-1"))
+
+		ctx := domain.WithRouteContext(context.Background(), domain.RouteContext{Client: "cursor-2"})
+		callID, err := jsonrpc.MakeID("call-2")
+		require.NoError(t, err)
+		payload, err := jsonrpc.EncodeMessage(&jsonrpc.Request{ID: callID, Method: "tools/list", Params: json.RawMessage(`{}`)})
+		require.NoError(t, err)
+		callDone := make(chan error, 1)
+		go func() {
+			_, err := client.Call(ctx, payload)
+			callDone <- err
+		}()
+		select {
+		case msg := <-conn.writeCh:
+			req, ok := msg.(*jsonrpc.Request)
+			require.True(t, ok)
+			require.Equal(t, "notifications/roots/list_changed", req.Method)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for roots list_changed notification")
+		}
+		<-conn.writeCh
+		conn.readCh <- &jsonrpc.Response{ID: callID, Result: json.RawMessage(`{"tools":[]}`)}
+		require.NoError(t, <-callDone)
+
+		require.JSONEq(t, `{"roots":[]}`, listRoots(t, conn, "roots-2"))
+		callRooted(t, conn, client, "cursor-1", "call-3")
+		require.JSONEq(t, `{"roots":[]}`, listRoots(t, conn, "roots-3"))
+	})
+}
+
+// callRooted completes one tools/list call on behalf of caller.
+func callRooted(t *testing.T, conn *fakeConn, client *clientConn, caller, id string) {
+	t.Helper()
+	callID, err := jsonrpc.MakeID(id)
+	require.NoError(t, err)
+	payload, err := jsonrpc.EncodeMessage(&jsonrpc.Request{ID: callID, Method: "tools/list", Params: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	ctx := domain.WithRouteContext(context.Background(), domain.RouteContext{Client: caller})
+	callDone := make(chan error, 1)
+	go func() {
+		_, err := client.Call(ctx, payload)
+		callDone <- err
+	}()
+	select {
+	case <-conn.writeCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for routed request")
+	}
+	conn.readCh <- &jsonrpc.Response{ID: callID, Result: json.RawMessage(`{"tools":[]}`)}
+	require.NoError(t, <-callDone)
+}
+
+// listRoots sends roots/list from the server side and returns the result.
+func listRoots(t *testing.T, conn *fakeConn, id string) string {
+	t.Helper()
+	rootsID, err := jsonrpc.MakeID(id)
+	require.NoError(t, err)
+	conn.readCh <- &jsonrpc.Request{ID: rootsID, Method: "roots/list"}
+	select {
+	case msg := <-conn.writeCh:
+		resp, ok := msg.(*jsonrpc.Response)
+		require.True(t, ok)
+		require.Nil(t, resp.Error)
+		return string(resp.Result)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for roots response")
+	}
+	return ""
+}
+
+func TestConnectionCallPropagatesTraceContext(t *testing.T) {
+	conn := newFakeConn()
+	client := newClientConn(conn, clientConnOptions{Logger: zap.NewNop()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	callID, err := jsonrpc.MakeID("call-1")
+	require.NoError(t, err)
+	payload, err := jsonrpc.EncodeMessage(&jsonrpc.Request{
+		ID:     callID,
+		Method: "tools/call",
+		Params: json.RawMessage(`{"name":"echo","_meta":{"tenant":"acme"}}`),
+	})
+	require.NoError(t, err)
+	callDone := make(chan error, 1)
+	go func() {
+		_, err := client.Call(ctx, payload)
+		callDone <- err
+	}()
+
+	select {
+	case msg := <-conn.writeCh:
+		req, ok := msg.(*jsonrpc.Request)
+		require.True(t, ok)
+		var params struct {
+			Name string            `json:"name"`
+			Meta map[string]string `json:"_meta"`
+		}
+		require.NoError(t, json.Unmarshal(req.Params, &params))
+		require.Equal(t, "echo", params.Name)
+		require.Equal(t, "acme", params.Meta["tenant"])
+		require.Contains(t, params.Meta["traceparent"], traceID.String())
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for routed request")
+	}
+
+	conn.readCh <- &jsonrpc.Response{ID: callID, Result: json.RawMessage(`{}`)}
+	require.NoError(t, <-callDone)
+}
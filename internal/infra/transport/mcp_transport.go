@@ -19,6 +19,7 @@ type MCPTransport struct {
 	listChangeEmitter  domain.ListChangeEmitter
 	samplingHandler    domain.SamplingHandler
 	elicitationHandler domain.ElicitationHandler
+	rootsProvider      domain.RootsProvider
 	probe              diagnostics.Probe
 }
 
@@ -28,6 +29,7 @@ type MCPTransportOptions struct {
 	ListChangeEmitter  domain.ListChangeEmitter
 	SamplingHandler    domain.SamplingHandler
 	ElicitationHandler domain.ElicitationHandler
+	RootsProvider      domain.RootsProvider
 	Probe              diagnostics.Probe
 }
 
@@ -46,6 +48,7 @@ func NewMCPTransport(opts MCPTransportOptions) *MCPTransport {
 		listChangeEmitter:  opts.ListChangeEmitter,
 		samplingHandler:    opts.SamplingHandler,
 		elicitationHandler: opts.ElicitationHandler,
+		rootsProvider:      opts.RootsProvider,
 		probe:              probe,
 	}
 }
@@ -128,6 +131,8 @@ func (t *MCPTransport) Connect(ctx context.Context, specKey string, spec domain.
 		ListChangeEmitter:  t.listChangeEmitter,
 		SamplingHandler:    t.samplingHandler,
 		ElicitationHandler: t.elicitationHandler,
+		RootsProvider:      t.rootsProvider,
+		BindsCaller:        spec.Strategy == domain.StrategyStateful,
 		ServerType:         spec.Name,
 		SpecKey:            specKey,
 	}), nil
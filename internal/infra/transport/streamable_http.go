@@ -25,6 +25,7 @@ type StreamableHTTPTransport struct {
 	listChangeEmitter  domain.ListChangeEmitter
 	samplingHandler    domain.SamplingHandler
 	elicitationHandler domain.ElicitationHandler
+	rootsProvider      domain.RootsProvider
 	probe              diagnostics.Probe
 }
 
@@ -34,6 +35,7 @@ type StreamableHTTPTransportOptions struct {
 	ListChangeEmitter  domain.ListChangeEmitter
 	SamplingHandler    domain.SamplingHandler
 	ElicitationHandler domain.ElicitationHandler
+	RootsProvider      domain.RootsProvider
 	Probe              diagnostics.Probe
 }
 
@@ -52,6 +54,7 @@ func NewStreamableHTTPTransport(opts StreamableHTTPTransportOptions) *Streamable
 		listChangeEmitter:  opts.ListChangeEmitter,
 		samplingHandler:    opts.SamplingHandler,
 		elicitationHandler: opts.ElicitationHandler,
+		rootsProvider:      opts.RootsProvider,
 		probe:              probe,
 	}
 }
@@ -196,6 +199,8 @@ func (t *StreamableHTTPTransport) Connect(ctx context.Context, specKey string, s
 		ListChangeEmitter:  t.listChangeEmitter,
 		SamplingHandler:    t.samplingHandler,
 		ElicitationHandler: t.elicitationHandler,
+		RootsProvider:      t.rootsProvider,
+		BindsCaller:        spec.Strategy == domain.StrategyStateful,
 		ServerType:         spec.Name,
 		SpecKey:            specKey,
 	}), nil
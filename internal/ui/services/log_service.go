@@ -101,7 +101,7 @@ func (s *LogService) StartLogStream(ctx context.Context, minLevel string) error
 		)
 	}
 
-	logCh, err := cp.StreamLogsAllServers(streamCtx, level)
+	logCh, err := cp.StreamLogsAllServers(streamCtx, domain.LogQuery{MinLevel: level})
 	if err != nil {
 		s.logger.Error("StreamLogs failed", zap.Error(err))
 		s.finishLogSession(session)
@@ -305,10 +305,14 @@ func (f *fakeControlPlane) Info(_ context.Context) (domain.ControlPlaneInfo, err
 	return domain.ControlPlaneInfo{}, nil
 }
 
-func (f *fakeControlPlane) RegisterClient(_ context.Context, client string, _ int, _ []string, _ string) (domain.ClientRegistration, error) {
+func (f *fakeControlPlane) RegisterClient(_ context.Context, client string, _ int, _ []string, _ string, _ domain.ClientInfo) (domain.ClientRegistration, error) {
 	return domain.ClientRegistration{Client: client}, nil
 }
 
+func (f *fakeControlPlane) ClientInfo(_ string) (domain.ClientInfo, bool) {
+	return domain.ClientInfo{}, false
+}
+
 func (f *fakeControlPlane) UnregisterClient(_ context.Context, _ string) error {
 	return nil
 }
@@ -389,11 +393,11 @@ func (f *fakeControlPlane) GetPromptAll(ctx context.Context, name string, args j
 	return f.GetPrompt(ctx, "", name, args)
 }
 
-func (f *fakeControlPlane) StreamLogs(ctx context.Context, _ string, minLevel domain.LogLevel) (<-chan domain.LogEntry, error) {
-	return f.StreamLogsAllServers(ctx, minLevel)
+func (f *fakeControlPlane) StreamLogs(ctx context.Context, _ string, query domain.LogQuery) (<-chan domain.LogEntry, error) {
+	return f.StreamLogsAllServers(ctx, query)
 }
 
-func (f *fakeControlPlane) StreamLogsAllServers(ctx context.Context, _ domain.LogLevel) (<-chan domain.LogEntry, error) {
+func (f *fakeControlPlane) StreamLogsAllServers(ctx context.Context, _ domain.LogQuery) (<-chan domain.LogEntry, error) {
 	f.recordStream(ctx)
 	ch := make(chan domain.LogEntry)
 	go func() {
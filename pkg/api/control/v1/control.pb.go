@@ -190,6 +190,7 @@ type RegisterCallerRequest struct {
 	Pid           int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
 	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 	Server        string                 `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	ClientInfo    *ClientInfo            `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -252,16 +253,181 @@ func (x *RegisterCallerRequest) GetServer() string {
 	return ""
 }
 
-type RegisterCallerResponse struct {
+func (x *RegisterCallerRequest) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+// ClientInfo describes the MCP client behind a caller, as reported at
+// initialization.
+type ClientInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	WorkspaceRoot string                 `protobuf:"bytes,3,opt,name=workspace_root,json=workspaceRoot,proto3" json:"workspace_root,omitempty"`
+	Sampling      bool                   `protobuf:"varint,4,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	Elicitation   bool                   `protobuf:"varint,5,opt,name=elicitation,proto3" json:"elicitation,omitempty"`
+	Roots         bool                   `protobuf:"varint,6,opt,name=roots,proto3" json:"roots,omitempty"`
+	// Roots the client listed; empty when it does not support roots.
+	RootList []*Root `protobuf:"bytes,7,rep,name=root_list,json=rootList,proto3" json:"root_list,omitempty"`
+	// Authenticated principal the caller acts for, e.g. a gateway token identity.
+	Identity      string `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClientInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetWorkspaceRoot() string {
+	if x != nil {
+		return x.WorkspaceRoot
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetSampling() bool {
+	if x != nil {
+		return x.Sampling
+	}
+	return false
+}
+
+func (x *ClientInfo) GetElicitation() bool {
+	if x != nil {
+		return x.Elicitation
+	}
+	return false
+}
+
+func (x *ClientInfo) GetRoots() bool {
+	if x != nil {
+		return x.Roots
+	}
+	return false
+}
+
+func (x *ClientInfo) GetRootList() []*Root {
+	if x != nil {
+		return x.RootList
+	}
+	return nil
+}
+
+func (x *ClientInfo) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// Root is a location the downstream client exposes to servers.
+type Root struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       string                 `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Root) Reset() {
+	*x = Root{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Root) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Root) ProtoMessage() {}
+
+func (x *Root) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Root.ProtoReflect.Descriptor instead.
+func (*Root) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Root) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Root) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterCallerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matched client profile name; empty when no profile matched.
+	Profile       string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterCallerResponse) Reset() {
 	*x = RegisterCallerResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[3]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -273,7 +439,7 @@ func (x *RegisterCallerResponse) String() string {
 func (*RegisterCallerResponse) ProtoMessage() {}
 
 func (x *RegisterCallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[3]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +452,7 @@ func (x *RegisterCallerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterCallerResponse.ProtoReflect.Descriptor instead.
 func (*RegisterCallerResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{3}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegisterCallerResponse) GetProfile() string {
@@ -305,7 +471,7 @@ type UnregisterCallerRequest struct {
 
 func (x *UnregisterCallerRequest) Reset() {
 	*x = UnregisterCallerRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[4]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +483,7 @@ func (x *UnregisterCallerRequest) String() string {
 func (*UnregisterCallerRequest) ProtoMessage() {}
 
 func (x *UnregisterCallerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[4]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +496,7 @@ func (x *UnregisterCallerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterCallerRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterCallerRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{4}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UnregisterCallerRequest) GetCaller() string {
@@ -348,7 +514,7 @@ type UnregisterCallerResponse struct {
 
 func (x *UnregisterCallerResponse) Reset() {
 	*x = UnregisterCallerResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[5]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +526,7 @@ func (x *UnregisterCallerResponse) String() string {
 func (*UnregisterCallerResponse) ProtoMessage() {}
 
 func (x *UnregisterCallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[5]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +539,7 @@ func (x *UnregisterCallerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterCallerResponse.ProtoReflect.Descriptor instead.
 func (*UnregisterCallerResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{5}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{7}
 }
 
 type ListToolsRequest struct {
@@ -385,7 +551,7 @@ type ListToolsRequest struct {
 
 func (x *ListToolsRequest) Reset() {
 	*x = ListToolsRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[6]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +563,7 @@ func (x *ListToolsRequest) String() string {
 func (*ListToolsRequest) ProtoMessage() {}
 
 func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[6]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +576,7 @@ func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListToolsRequest.ProtoReflect.Descriptor instead.
 func (*ListToolsRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{6}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListToolsRequest) GetCaller() string {
@@ -429,7 +595,7 @@ type ListToolsResponse struct {
 
 func (x *ListToolsResponse) Reset() {
 	*x = ListToolsResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[7]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +607,7 @@ func (x *ListToolsResponse) String() string {
 func (*ListToolsResponse) ProtoMessage() {}
 
 func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[7]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +620,7 @@ func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListToolsResponse.ProtoReflect.Descriptor instead.
 func (*ListToolsResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{7}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListToolsResponse) GetSnapshot() *ToolsSnapshot {
@@ -474,7 +640,7 @@ type WatchToolsRequest struct {
 
 func (x *WatchToolsRequest) Reset() {
 	*x = WatchToolsRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[8]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +652,7 @@ func (x *WatchToolsRequest) String() string {
 func (*WatchToolsRequest) ProtoMessage() {}
 
 func (x *WatchToolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[8]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +665,7 @@ func (x *WatchToolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchToolsRequest.ProtoReflect.Descriptor instead.
 func (*WatchToolsRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{8}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WatchToolsRequest) GetCaller() string {
@@ -526,7 +692,7 @@ type ToolsSnapshot struct {
 
 func (x *ToolsSnapshot) Reset() {
 	*x = ToolsSnapshot{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[9]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +704,7 @@ func (x *ToolsSnapshot) String() string {
 func (*ToolsSnapshot) ProtoMessage() {}
 
 func (x *ToolsSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[9]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +717,7 @@ func (x *ToolsSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolsSnapshot.ProtoReflect.Descriptor instead.
 func (*ToolsSnapshot) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{9}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ToolsSnapshot) GetEtag() string {
@@ -579,7 +745,7 @@ type ToolDefinition struct {
 
 func (x *ToolDefinition) Reset() {
 	*x = ToolDefinition{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[10]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +757,7 @@ func (x *ToolDefinition) String() string {
 func (*ToolDefinition) ProtoMessage() {}
 
 func (x *ToolDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[10]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +770,7 @@ func (x *ToolDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolDefinition.ProtoReflect.Descriptor instead.
 func (*ToolDefinition) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{10}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ToolDefinition) GetName() string {
@@ -634,7 +800,7 @@ type CallToolRequest struct {
 
 func (x *CallToolRequest) Reset() {
 	*x = CallToolRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[11]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -646,7 +812,7 @@ func (x *CallToolRequest) String() string {
 func (*CallToolRequest) ProtoMessage() {}
 
 func (x *CallToolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[11]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +825,7 @@ func (x *CallToolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolRequest.ProtoReflect.Descriptor instead.
 func (*CallToolRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{11}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CallToolRequest) GetCaller() string {
@@ -700,7 +866,7 @@ type CallToolResponse struct {
 
 func (x *CallToolResponse) Reset() {
 	*x = CallToolResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[12]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +878,7 @@ func (x *CallToolResponse) String() string {
 func (*CallToolResponse) ProtoMessage() {}
 
 func (x *CallToolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[12]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +891,7 @@ func (x *CallToolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolResponse.ProtoReflect.Descriptor instead.
 func (*CallToolResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{12}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CallToolResponse) GetResultJson() []byte {
@@ -750,7 +916,7 @@ type CallToolTaskRequest struct {
 
 func (x *CallToolTaskRequest) Reset() {
 	*x = CallToolTaskRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[13]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +928,7 @@ func (x *CallToolTaskRequest) String() string {
 func (*CallToolTaskRequest) ProtoMessage() {}
 
 func (x *CallToolTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[13]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +941,7 @@ func (x *CallToolTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolTaskRequest.ProtoReflect.Descriptor instead.
 func (*CallToolTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{13}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CallToolTaskRequest) GetCaller() string {
@@ -829,7 +995,7 @@ type CallToolTaskResponse struct {
 
 func (x *CallToolTaskResponse) Reset() {
 	*x = CallToolTaskResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[14]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +1007,7 @@ func (x *CallToolTaskResponse) String() string {
 func (*CallToolTaskResponse) ProtoMessage() {}
 
 func (x *CallToolTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[14]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1020,7 @@ func (x *CallToolTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolTaskResponse.ProtoReflect.Descriptor instead.
 func (*CallToolTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{14}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CallToolTaskResponse) GetTask() *Task {
@@ -874,7 +1040,7 @@ type TasksGetRequest struct {
 
 func (x *TasksGetRequest) Reset() {
 	*x = TasksGetRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[15]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1052,7 @@ func (x *TasksGetRequest) String() string {
 func (*TasksGetRequest) ProtoMessage() {}
 
 func (x *TasksGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[15]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1065,7 @@ func (x *TasksGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksGetRequest.ProtoReflect.Descriptor instead.
 func (*TasksGetRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{15}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TasksGetRequest) GetCaller() string {
@@ -925,7 +1091,7 @@ type TasksGetResponse struct {
 
 func (x *TasksGetResponse) Reset() {
 	*x = TasksGetResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[16]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1103,7 @@ func (x *TasksGetResponse) String() string {
 func (*TasksGetResponse) ProtoMessage() {}
 
 func (x *TasksGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[16]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1116,7 @@ func (x *TasksGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksGetResponse.ProtoReflect.Descriptor instead.
 func (*TasksGetResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{16}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TasksGetResponse) GetTask() *Task {
@@ -971,7 +1137,7 @@ type TasksListRequest struct {
 
 func (x *TasksListRequest) Reset() {
 	*x = TasksListRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[17]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -983,7 +1149,7 @@ func (x *TasksListRequest) String() string {
 func (*TasksListRequest) ProtoMessage() {}
 
 func (x *TasksListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[17]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1162,7 @@ func (x *TasksListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksListRequest.ProtoReflect.Descriptor instead.
 func (*TasksListRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{17}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TasksListRequest) GetCaller() string {
@@ -1030,7 +1196,7 @@ type TasksListResponse struct {
 
 func (x *TasksListResponse) Reset() {
 	*x = TasksListResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[18]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1042,7 +1208,7 @@ func (x *TasksListResponse) String() string {
 func (*TasksListResponse) ProtoMessage() {}
 
 func (x *TasksListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[18]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1221,7 @@ func (x *TasksListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksListResponse.ProtoReflect.Descriptor instead.
 func (*TasksListResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{18}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TasksListResponse) GetTasks() []*Task {
@@ -1082,7 +1248,7 @@ type TasksResultRequest struct {
 
 func (x *TasksResultRequest) Reset() {
 	*x = TasksResultRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[19]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1260,7 @@ func (x *TasksResultRequest) String() string {
 func (*TasksResultRequest) ProtoMessage() {}
 
 func (x *TasksResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[19]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1273,7 @@ func (x *TasksResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksResultRequest.ProtoReflect.Descriptor instead.
 func (*TasksResultRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{19}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TasksResultRequest) GetCaller() string {
@@ -1133,7 +1299,7 @@ type TasksResultResponse struct {
 
 func (x *TasksResultResponse) Reset() {
 	*x = TasksResultResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[20]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +1311,7 @@ func (x *TasksResultResponse) String() string {
 func (*TasksResultResponse) ProtoMessage() {}
 
 func (x *TasksResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[20]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1324,7 @@ func (x *TasksResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksResultResponse.ProtoReflect.Descriptor instead.
 func (*TasksResultResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{20}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TasksResultResponse) GetResult() *TaskResult {
@@ -1178,7 +1344,7 @@ type TasksCancelRequest struct {
 
 func (x *TasksCancelRequest) Reset() {
 	*x = TasksCancelRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[21]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1356,7 @@ func (x *TasksCancelRequest) String() string {
 func (*TasksCancelRequest) ProtoMessage() {}
 
 func (x *TasksCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[21]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1369,7 @@ func (x *TasksCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksCancelRequest.ProtoReflect.Descriptor instead.
 func (*TasksCancelRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{21}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TasksCancelRequest) GetCaller() string {
@@ -1229,7 +1395,7 @@ type TasksCancelResponse struct {
 
 func (x *TasksCancelResponse) Reset() {
 	*x = TasksCancelResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[22]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1241,7 +1407,7 @@ func (x *TasksCancelResponse) String() string {
 func (*TasksCancelResponse) ProtoMessage() {}
 
 func (x *TasksCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[22]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,7 +1420,7 @@ func (x *TasksCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksCancelResponse.ProtoReflect.Descriptor instead.
 func (*TasksCancelResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{22}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TasksCancelResponse) GetTask() *Task {
@@ -1279,7 +1445,7 @@ type Task struct {
 
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[23]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1291,7 +1457,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[23]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +1470,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{23}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Task) GetTaskId() string {
@@ -1369,7 +1535,7 @@ type TaskResult struct {
 
 func (x *TaskResult) Reset() {
 	*x = TaskResult{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[24]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1381,7 +1547,7 @@ func (x *TaskResult) String() string {
 func (*TaskResult) ProtoMessage() {}
 
 func (x *TaskResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[24]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1560,7 @@ func (x *TaskResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult.ProtoReflect.Descriptor instead.
 func (*TaskResult) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{24}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TaskResult) GetStatus() string {
@@ -1442,7 +1608,7 @@ type ListResourcesRequest struct {
 
 func (x *ListResourcesRequest) Reset() {
 	*x = ListResourcesRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[25]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1454,7 +1620,7 @@ func (x *ListResourcesRequest) String() string {
 func (*ListResourcesRequest) ProtoMessage() {}
 
 func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[25]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,7 +1633,7 @@ func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{25}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListResourcesRequest) GetCaller() string {
@@ -1494,7 +1660,7 @@ type ListResourcesResponse struct {
 
 func (x *ListResourcesResponse) Reset() {
 	*x = ListResourcesResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[26]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1672,7 @@ func (x *ListResourcesResponse) String() string {
 func (*ListResourcesResponse) ProtoMessage() {}
 
 func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[26]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1685,7 @@ func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{26}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListResourcesResponse) GetSnapshot() *ResourcesSnapshot {
@@ -1546,7 +1712,7 @@ type WatchResourcesRequest struct {
 
 func (x *WatchResourcesRequest) Reset() {
 	*x = WatchResourcesRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[27]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1558,7 +1724,7 @@ func (x *WatchResourcesRequest) String() string {
 func (*WatchResourcesRequest) ProtoMessage() {}
 
 func (x *WatchResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[27]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,7 +1737,7 @@ func (x *WatchResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchResourcesRequest.ProtoReflect.Descriptor instead.
 func (*WatchResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{27}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *WatchResourcesRequest) GetCaller() string {
@@ -1598,7 +1764,7 @@ type ResourcesSnapshot struct {
 
 func (x *ResourcesSnapshot) Reset() {
 	*x = ResourcesSnapshot{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[28]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1610,7 +1776,7 @@ func (x *ResourcesSnapshot) String() string {
 func (*ResourcesSnapshot) ProtoMessage() {}
 
 func (x *ResourcesSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[28]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1623,7 +1789,7 @@ func (x *ResourcesSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourcesSnapshot.ProtoReflect.Descriptor instead.
 func (*ResourcesSnapshot) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{28}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ResourcesSnapshot) GetEtag() string {
@@ -1651,7 +1817,7 @@ type ResourceDefinition struct {
 
 func (x *ResourceDefinition) Reset() {
 	*x = ResourceDefinition{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[29]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1663,7 +1829,7 @@ func (x *ResourceDefinition) String() string {
 func (*ResourceDefinition) ProtoMessage() {}
 
 func (x *ResourceDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[29]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +1842,7 @@ func (x *ResourceDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceDefinition.ProtoReflect.Descriptor instead.
 func (*ResourceDefinition) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{29}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ResourceDefinition) GetUri() string {
@@ -1703,7 +1869,7 @@ type ReadResourceRequest struct {
 
 func (x *ReadResourceRequest) Reset() {
 	*x = ReadResourceRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[30]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1715,7 +1881,7 @@ func (x *ReadResourceRequest) String() string {
 func (*ReadResourceRequest) ProtoMessage() {}
 
 func (x *ReadResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[30]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +1894,7 @@ func (x *ReadResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadResourceRequest.ProtoReflect.Descriptor instead.
 func (*ReadResourceRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{30}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ReadResourceRequest) GetCaller() string {
@@ -1755,7 +1921,7 @@ type ReadResourceResponse struct {
 
 func (x *ReadResourceResponse) Reset() {
 	*x = ReadResourceResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[31]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1767,7 +1933,7 @@ func (x *ReadResourceResponse) String() string {
 func (*ReadResourceResponse) ProtoMessage() {}
 
 func (x *ReadResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[31]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,7 +1946,7 @@ func (x *ReadResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadResourceResponse.ProtoReflect.Descriptor instead.
 func (*ReadResourceResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{31}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ReadResourceResponse) GetResultJson() []byte {
@@ -1800,7 +1966,7 @@ type ListPromptsRequest struct {
 
 func (x *ListPromptsRequest) Reset() {
 	*x = ListPromptsRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[32]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1812,7 +1978,7 @@ func (x *ListPromptsRequest) String() string {
 func (*ListPromptsRequest) ProtoMessage() {}
 
 func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[32]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,7 +1991,7 @@ func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
 func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{32}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListPromptsRequest) GetCaller() string {
@@ -1852,7 +2018,7 @@ type ListPromptsResponse struct {
 
 func (x *ListPromptsResponse) Reset() {
 	*x = ListPromptsResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[33]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1864,7 +2030,7 @@ func (x *ListPromptsResponse) String() string {
 func (*ListPromptsResponse) ProtoMessage() {}
 
 func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[33]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,7 +2043,7 @@ func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
 func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{33}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListPromptsResponse) GetSnapshot() *PromptsSnapshot {
@@ -1904,7 +2070,7 @@ type WatchPromptsRequest struct {
 
 func (x *WatchPromptsRequest) Reset() {
 	*x = WatchPromptsRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[34]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1916,7 +2082,7 @@ func (x *WatchPromptsRequest) String() string {
 func (*WatchPromptsRequest) ProtoMessage() {}
 
 func (x *WatchPromptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[34]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +2095,7 @@ func (x *WatchPromptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchPromptsRequest.ProtoReflect.Descriptor instead.
 func (*WatchPromptsRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{34}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *WatchPromptsRequest) GetCaller() string {
@@ -1956,7 +2122,7 @@ type PromptsSnapshot struct {
 
 func (x *PromptsSnapshot) Reset() {
 	*x = PromptsSnapshot{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[35]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1968,7 +2134,7 @@ func (x *PromptsSnapshot) String() string {
 func (*PromptsSnapshot) ProtoMessage() {}
 
 func (x *PromptsSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[35]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2147,7 @@ func (x *PromptsSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PromptsSnapshot.ProtoReflect.Descriptor instead.
 func (*PromptsSnapshot) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{35}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PromptsSnapshot) GetEtag() string {
@@ -2009,7 +2175,7 @@ type PromptDefinition struct {
 
 func (x *PromptDefinition) Reset() {
 	*x = PromptDefinition{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[36]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2021,7 +2187,7 @@ func (x *PromptDefinition) String() string {
 func (*PromptDefinition) ProtoMessage() {}
 
 func (x *PromptDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[36]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2034,7 +2200,7 @@ func (x *PromptDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PromptDefinition.ProtoReflect.Descriptor instead.
 func (*PromptDefinition) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{36}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PromptDefinition) GetName() string {
@@ -2063,7 +2229,7 @@ type GetPromptRequest struct {
 
 func (x *GetPromptRequest) Reset() {
 	*x = GetPromptRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[37]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2075,7 +2241,7 @@ func (x *GetPromptRequest) String() string {
 func (*GetPromptRequest) ProtoMessage() {}
 
 func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[37]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,7 +2254,7 @@ func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
 func (*GetPromptRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{37}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPromptRequest) GetCaller() string {
@@ -2122,7 +2288,7 @@ type GetPromptResponse struct {
 
 func (x *GetPromptResponse) Reset() {
 	*x = GetPromptResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[38]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2134,7 +2300,7 @@ func (x *GetPromptResponse) String() string {
 func (*GetPromptResponse) ProtoMessage() {}
 
 func (x *GetPromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[38]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,7 +2313,7 @@ func (x *GetPromptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPromptResponse.ProtoReflect.Descriptor instead.
 func (*GetPromptResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{38}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetPromptResponse) GetResultJson() []byte {
@@ -2158,16 +2324,24 @@ func (x *GetPromptResponse) GetResultJson() []byte {
 }
 
 type StreamLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
-	MinLevel      LogLevel               `protobuf:"varint,2,opt,name=min_level,json=minLevel,proto3,enum=mcpv.control.v1.LogLevel" json:"min_level,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Caller   string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	MinLevel LogLevel               `protobuf:"varint,2,opt,name=min_level,json=minLevel,proto3,enum=mcpv.control.v1.LogLevel" json:"min_level,omitempty"`
+	// Matches entries logged by or about one server.
+	Server string `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	// Regular expression matched against the log message.
+	Grep string `protobuf:"bytes,4,opt,name=grep,proto3" json:"grep,omitempty"`
+	// When since or until is set, persisted entries in that window are sent
+	// first. A zero until keeps the stream open for live entries.
+	SinceUnixNano int64 `protobuf:"varint,5,opt,name=since_unix_nano,json=sinceUnixNano,proto3" json:"since_unix_nano,omitempty"`
+	UntilUnixNano int64 `protobuf:"varint,6,opt,name=until_unix_nano,json=untilUnixNano,proto3" json:"until_unix_nano,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StreamLogsRequest) Reset() {
 	*x = StreamLogsRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[39]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2179,7 +2353,7 @@ func (x *StreamLogsRequest) String() string {
 func (*StreamLogsRequest) ProtoMessage() {}
 
 func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[39]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,7 +2366,7 @@ func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
 func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{39}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StreamLogsRequest) GetCaller() string {
@@ -2209,6 +2383,34 @@ func (x *StreamLogsRequest) GetMinLevel() LogLevel {
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
+func (x *StreamLogsRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetGrep() string {
+	if x != nil {
+		return x.Grep
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetSinceUnixNano() int64 {
+	if x != nil {
+		return x.SinceUnixNano
+	}
+	return 0
+}
+
+func (x *StreamLogsRequest) GetUntilUnixNano() int64 {
+	if x != nil {
+		return x.UntilUnixNano
+	}
+	return 0
+}
+
 type LogEntry struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Logger            string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
@@ -2222,7 +2424,7 @@ type LogEntry struct {
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[40]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2234,7 +2436,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[40]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,7 +2449,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{40}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *LogEntry) GetLogger() string {
@@ -2288,7 +2490,7 @@ type WatchRuntimeStatusRequest struct {
 
 func (x *WatchRuntimeStatusRequest) Reset() {
 	*x = WatchRuntimeStatusRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[41]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2300,7 +2502,7 @@ func (x *WatchRuntimeStatusRequest) String() string {
 func (*WatchRuntimeStatusRequest) ProtoMessage() {}
 
 func (x *WatchRuntimeStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[41]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2313,7 +2515,7 @@ func (x *WatchRuntimeStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchRuntimeStatusRequest.ProtoReflect.Descriptor instead.
 func (*WatchRuntimeStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{41}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *WatchRuntimeStatusRequest) GetCaller() string {
@@ -2341,7 +2543,7 @@ type RuntimeStatusSnapshot struct {
 
 func (x *RuntimeStatusSnapshot) Reset() {
 	*x = RuntimeStatusSnapshot{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[42]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2353,7 +2555,7 @@ func (x *RuntimeStatusSnapshot) String() string {
 func (*RuntimeStatusSnapshot) ProtoMessage() {}
 
 func (x *RuntimeStatusSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[42]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,7 +2568,7 @@ func (x *RuntimeStatusSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeStatusSnapshot.ProtoReflect.Descriptor instead.
 func (*RuntimeStatusSnapshot) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{42}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *RuntimeStatusSnapshot) GetEtag() string {
@@ -2403,7 +2605,7 @@ type ServerRuntimeStatus struct {
 
 func (x *ServerRuntimeStatus) Reset() {
 	*x = ServerRuntimeStatus{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[43]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2415,7 +2617,7 @@ func (x *ServerRuntimeStatus) String() string {
 func (*ServerRuntimeStatus) ProtoMessage() {}
 
 func (x *ServerRuntimeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[43]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,7 +2630,7 @@ func (x *ServerRuntimeStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerRuntimeStatus.ProtoReflect.Descriptor instead.
 func (*ServerRuntimeStatus) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{43}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ServerRuntimeStatus) GetSpecKey() string {
@@ -2475,13 +2677,15 @@ type InstanceStatus struct {
 	SpawnedAtUnixNano       int64                  `protobuf:"varint,5,opt,name=spawned_at_unix_nano,json=spawnedAtUnixNano,proto3" json:"spawned_at_unix_nano,omitempty"`
 	HandshakedAtUnixNano    int64                  `protobuf:"varint,6,opt,name=handshaked_at_unix_nano,json=handshakedAtUnixNano,proto3" json:"handshaked_at_unix_nano,omitempty"`
 	LastHeartbeatAtUnixNano int64                  `protobuf:"varint,7,opt,name=last_heartbeat_at_unix_nano,json=lastHeartbeatAtUnixNano,proto3" json:"last_heartbeat_at_unix_nano,omitempty"`
+	Pid                     int32                  `protobuf:"varint,8,opt,name=pid,proto3" json:"pid,omitempty"`
+	Resources               *ProcessResources      `protobuf:"bytes,9,opt,name=resources,proto3" json:"resources,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *InstanceStatus) Reset() {
 	*x = InstanceStatus{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[44]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2493,7 +2697,7 @@ func (x *InstanceStatus) String() string {
 func (*InstanceStatus) ProtoMessage() {}
 
 func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[44]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,7 +2710,7 @@ func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceStatus.ProtoReflect.Descriptor instead.
 func (*InstanceStatus) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{44}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *InstanceStatus) GetId() string {
@@ -2558,6 +2762,104 @@ func (x *InstanceStatus) GetLastHeartbeatAtUnixNano() int64 {
 	return 0
 }
 
+func (x *InstanceStatus) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *InstanceStatus) GetResources() *ProcessResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type ProcessResources struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Processes         int32                  `protobuf:"varint,1,opt,name=processes,proto3" json:"processes,omitempty"`
+	CpuTimeMs         int64                  `protobuf:"varint,2,opt,name=cpu_time_ms,json=cpuTimeMs,proto3" json:"cpu_time_ms,omitempty"`
+	RssBytes          int64                  `protobuf:"varint,3,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	OpenFds           int32                  `protobuf:"varint,4,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	Threads           int32                  `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	SampledAtUnixNano int64                  `protobuf:"varint,6,opt,name=sampled_at_unix_nano,json=sampledAtUnixNano,proto3" json:"sampled_at_unix_nano,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProcessResources) Reset() {
+	*x = ProcessResources{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResources) ProtoMessage() {}
+
+func (x *ProcessResources) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResources.ProtoReflect.Descriptor instead.
+func (*ProcessResources) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProcessResources) GetProcesses() int32 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetCpuTimeMs() int64 {
+	if x != nil {
+		return x.CpuTimeMs
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetRssBytes() int64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetOpenFds() int32 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetSampledAtUnixNano() int64 {
+	if x != nil {
+		return x.SampledAtUnixNano
+	}
+	return 0
+}
+
 type PoolStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -2574,7 +2876,7 @@ type PoolStats struct {
 
 func (x *PoolStats) Reset() {
 	*x = PoolStats{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[45]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2586,7 +2888,7 @@ func (x *PoolStats) String() string {
 func (*PoolStats) ProtoMessage() {}
 
 func (x *PoolStats) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[45]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,7 +2901,7 @@ func (x *PoolStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolStats.ProtoReflect.Descriptor instead.
 func (*PoolStats) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{45}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *PoolStats) GetTotal() int32 {
@@ -2659,20 +2961,23 @@ func (x *PoolStats) GetHandshaking() int32 {
 }
 
 type PoolMetrics struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	StartCount         int32                  `protobuf:"varint,1,opt,name=start_count,json=startCount,proto3" json:"start_count,omitempty"`
-	StopCount          int32                  `protobuf:"varint,2,opt,name=stop_count,json=stopCount,proto3" json:"stop_count,omitempty"`
-	TotalCalls         int64                  `protobuf:"varint,3,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls,omitempty"`
-	TotalErrors        int64                  `protobuf:"varint,4,opt,name=total_errors,json=totalErrors,proto3" json:"total_errors,omitempty"`
-	TotalDurationMs    int64                  `protobuf:"varint,5,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	LastCallAtUnixNano int64                  `protobuf:"varint,6,opt,name=last_call_at_unix_nano,json=lastCallAtUnixNano,proto3" json:"last_call_at_unix_nano,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StartCount           int32                  `protobuf:"varint,1,opt,name=start_count,json=startCount,proto3" json:"start_count,omitempty"`
+	StopCount            int32                  `protobuf:"varint,2,opt,name=stop_count,json=stopCount,proto3" json:"stop_count,omitempty"`
+	TotalCalls           int64                  `protobuf:"varint,3,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls,omitempty"`
+	TotalErrors          int64                  `protobuf:"varint,4,opt,name=total_errors,json=totalErrors,proto3" json:"total_errors,omitempty"`
+	TotalDurationMs      int64                  `protobuf:"varint,5,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	LastCallAtUnixNano   int64                  `protobuf:"varint,6,opt,name=last_call_at_unix_nano,json=lastCallAtUnixNano,proto3" json:"last_call_at_unix_nano,omitempty"`
+	IdleReaps            int32                  `protobuf:"varint,7,opt,name=idle_reaps,json=idleReaps,proto3" json:"idle_reaps,omitempty"`
+	SavedInstanceSeconds float64                `protobuf:"fixed64,8,opt,name=saved_instance_seconds,json=savedInstanceSeconds,proto3" json:"saved_instance_seconds,omitempty"`
+	SavedRssByteSeconds  float64                `protobuf:"fixed64,9,opt,name=saved_rss_byte_seconds,json=savedRssByteSeconds,proto3" json:"saved_rss_byte_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *PoolMetrics) Reset() {
 	*x = PoolMetrics{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[46]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2684,7 +2989,7 @@ func (x *PoolMetrics) String() string {
 func (*PoolMetrics) ProtoMessage() {}
 
 func (x *PoolMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[46]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2697,7 +3002,7 @@ func (x *PoolMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolMetrics.ProtoReflect.Descriptor instead.
 func (*PoolMetrics) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{46}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *PoolMetrics) GetStartCount() int32 {
@@ -2742,6 +3047,27 @@ func (x *PoolMetrics) GetLastCallAtUnixNano() int64 {
 	return 0
 }
 
+func (x *PoolMetrics) GetIdleReaps() int32 {
+	if x != nil {
+		return x.IdleReaps
+	}
+	return 0
+}
+
+func (x *PoolMetrics) GetSavedInstanceSeconds() float64 {
+	if x != nil {
+		return x.SavedInstanceSeconds
+	}
+	return 0
+}
+
+func (x *PoolMetrics) GetSavedRssByteSeconds() float64 {
+	if x != nil {
+		return x.SavedRssByteSeconds
+	}
+	return 0
+}
+
 type WatchServerInitStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -2751,7 +3077,7 @@ type WatchServerInitStatusRequest struct {
 
 func (x *WatchServerInitStatusRequest) Reset() {
 	*x = WatchServerInitStatusRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[47]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2763,7 +3089,7 @@ func (x *WatchServerInitStatusRequest) String() string {
 func (*WatchServerInitStatusRequest) ProtoMessage() {}
 
 func (x *WatchServerInitStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[47]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +3102,7 @@ func (x *WatchServerInitStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchServerInitStatusRequest.ProtoReflect.Descriptor instead.
 func (*WatchServerInitStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{47}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *WatchServerInitStatusRequest) GetCaller() string {
@@ -2796,7 +3122,7 @@ type ServerInitStatusSnapshot struct {
 
 func (x *ServerInitStatusSnapshot) Reset() {
 	*x = ServerInitStatusSnapshot{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[48]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2808,7 +3134,7 @@ func (x *ServerInitStatusSnapshot) String() string {
 func (*ServerInitStatusSnapshot) ProtoMessage() {}
 
 func (x *ServerInitStatusSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[48]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2821,7 +3147,7 @@ func (x *ServerInitStatusSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInitStatusSnapshot.ProtoReflect.Descriptor instead.
 func (*ServerInitStatusSnapshot) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{48}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ServerInitStatusSnapshot) GetStatuses() []*ServerInitStatus {
@@ -2854,7 +3180,7 @@ type ServerInitStatus struct {
 
 func (x *ServerInitStatus) Reset() {
 	*x = ServerInitStatus{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[49]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2866,7 +3192,7 @@ func (x *ServerInitStatus) String() string {
 func (*ServerInitStatus) ProtoMessage() {}
 
 func (x *ServerInitStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[49]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2879,7 +3205,7 @@ func (x *ServerInitStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInitStatus.ProtoReflect.Descriptor instead.
 func (*ServerInitStatus) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{49}
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ServerInitStatus) GetSpecKey() string {
@@ -2950,7 +3276,7 @@ type AutomaticMCPRequest struct {
 
 func (x *AutomaticMCPRequest) Reset() {
 	*x = AutomaticMCPRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[50]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2962,7 +3288,1532 @@ func (x *AutomaticMCPRequest) String() string {
 func (*AutomaticMCPRequest) ProtoMessage() {}
 
 func (x *AutomaticMCPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[50]
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomaticMCPRequest.ProtoReflect.Descriptor instead.
+func (*AutomaticMCPRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AutomaticMCPRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AutomaticMCPRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AutomaticMCPRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AutomaticMCPRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type AutomaticMCPResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Etag           string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	ToolsJson      [][]byte               `protobuf:"bytes,2,rep,name=tools_json,json=toolsJson,proto3" json:"tools_json,omitempty"`
+	TotalAvailable int32                  `protobuf:"varint,3,opt,name=total_available,json=totalAvailable,proto3" json:"total_available,omitempty"`
+	Filtered       int32                  `protobuf:"varint,4,opt,name=filtered,proto3" json:"filtered,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AutomaticMCPResponse) Reset() {
+	*x = AutomaticMCPResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomaticMCPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomaticMCPResponse) ProtoMessage() {}
+
+func (x *AutomaticMCPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomaticMCPResponse.ProtoReflect.Descriptor instead.
+func (*AutomaticMCPResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AutomaticMCPResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *AutomaticMCPResponse) GetToolsJson() [][]byte {
+	if x != nil {
+		return x.ToolsJson
+	}
+	return nil
+}
+
+func (x *AutomaticMCPResponse) GetTotalAvailable() int32 {
+	if x != nil {
+		return x.TotalAvailable
+	}
+	return 0
+}
+
+func (x *AutomaticMCPResponse) GetFiltered() int32 {
+	if x != nil {
+		return x.Filtered
+	}
+	return 0
+}
+
+type AutomaticEvalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	ToolName      string                 `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	ArgumentsJson []byte                 `protobuf:"bytes,3,opt,name=arguments_json,json=argumentsJson,proto3" json:"arguments_json,omitempty"`
+	RoutingKey    string                 `protobuf:"bytes,4,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutomaticEvalRequest) Reset() {
+	*x = AutomaticEvalRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomaticEvalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomaticEvalRequest) ProtoMessage() {}
+
+func (x *AutomaticEvalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomaticEvalRequest.ProtoReflect.Descriptor instead.
+func (*AutomaticEvalRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AutomaticEvalRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AutomaticEvalRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *AutomaticEvalRequest) GetArgumentsJson() []byte {
+	if x != nil {
+		return x.ArgumentsJson
+	}
+	return nil
+}
+
+func (x *AutomaticEvalRequest) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+type AutomaticEvalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultJson    []byte                 `protobuf:"bytes,1,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutomaticEvalResponse) Reset() {
+	*x = AutomaticEvalResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutomaticEvalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomaticEvalResponse) ProtoMessage() {}
+
+func (x *AutomaticEvalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomaticEvalResponse.ProtoReflect.Descriptor instead.
+func (*AutomaticEvalResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AutomaticEvalResponse) GetResultJson() []byte {
+	if x != nil {
+		return x.ResultJson
+	}
+	return nil
+}
+
+type IsSubAgentEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsSubAgentEnabledRequest) Reset() {
+	*x = IsSubAgentEnabledRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsSubAgentEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsSubAgentEnabledRequest) ProtoMessage() {}
+
+func (x *IsSubAgentEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsSubAgentEnabledRequest.ProtoReflect.Descriptor instead.
+func (*IsSubAgentEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *IsSubAgentEnabledRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+type IsSubAgentEnabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsSubAgentEnabledResponse) Reset() {
+	*x = IsSubAgentEnabledResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsSubAgentEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsSubAgentEnabledResponse) ProtoMessage() {}
+
+func (x *IsSubAgentEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsSubAgentEnabledResponse.ProtoReflect.Descriptor instead.
+func (*IsSubAgentEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *IsSubAgentEnabledResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type GetQuotaStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty caller reports every tracked key.
+	Caller        string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaStatusRequest) Reset() {
+	*x = GetQuotaStatusRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaStatusRequest) ProtoMessage() {}
+
+func (x *GetQuotaStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetQuotaStatusRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+type GetQuotaStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*QuotaStatus         `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaStatusResponse) Reset() {
+	*x = GetQuotaStatusResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaStatusResponse) ProtoMessage() {}
+
+func (x *GetQuotaStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetQuotaStatusResponse) GetStatuses() []*QuotaStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type QuotaStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rule  string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Caller name for per-caller rules; empty for shared rules.
+	Key             string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Scope           string  `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	Rate            int32   `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	PeriodSeconds   int32   `protobuf:"varint,5,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	Burst           int32   `protobuf:"varint,6,opt,name=burst,proto3" json:"burst,omitempty"`
+	TokensAvailable float64 `protobuf:"fixed64,7,opt,name=tokens_available,json=tokensAvailable,proto3" json:"tokens_available,omitempty"`
+	DailyQuota      int32   `protobuf:"varint,8,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	QuotaUsed       int32   `protobuf:"varint,9,opt,name=quota_used,json=quotaUsed,proto3" json:"quota_used,omitempty"`
+	QuotaRemaining  int32   `protobuf:"varint,10,opt,name=quota_remaining,json=quotaRemaining,proto3" json:"quota_remaining,omitempty"`
+	ResetAtUnixNano int64   `protobuf:"varint,11,opt,name=reset_at_unix_nano,json=resetAtUnixNano,proto3" json:"reset_at_unix_nano,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaStatus) Reset() {
+	*x = QuotaStatus{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaStatus) ProtoMessage() {}
+
+func (x *QuotaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaStatus.ProtoReflect.Descriptor instead.
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuotaStatus) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *QuotaStatus) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *QuotaStatus) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *QuotaStatus) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetPeriodSeconds() int32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetTokensAvailable() float64 {
+	if x != nil {
+		return x.TokensAvailable
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetQuotaUsed() int32 {
+	if x != nil {
+		return x.QuotaUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetQuotaRemaining() int32 {
+	if x != nil {
+		return x.QuotaRemaining
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetResetAtUnixNano() int64 {
+	if x != nil {
+		return x.ResetAtUnixNano
+	}
+	return 0
+}
+
+type ListCallersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallersRequest) Reset() {
+	*x = ListCallersRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallersRequest) ProtoMessage() {}
+
+func (x *ListCallersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallersRequest.ProtoReflect.Descriptor instead.
+func (*ListCallersRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{62}
+}
+
+type ListCallersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Callers       []*ActiveCaller        `protobuf:"bytes,1,rep,name=callers,proto3" json:"callers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallersResponse) Reset() {
+	*x = ListCallersResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallersResponse) ProtoMessage() {}
+
+func (x *ListCallersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallersResponse.ProtoReflect.Descriptor instead.
+func (*ListCallersResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListCallersResponse) GetCallers() []*ActiveCaller {
+	if x != nil {
+		return x.Callers
+	}
+	return nil
+}
+
+type ActiveCaller struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Caller                string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Pid                   int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Tags                  []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Server                string                 `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	Profile               string                 `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	LastHeartbeatUnixNano int64                  `protobuf:"varint,6,opt,name=last_heartbeat_unix_nano,json=lastHeartbeatUnixNano,proto3" json:"last_heartbeat_unix_nano,omitempty"`
+	ClientInfo            *ClientInfo            `protobuf:"bytes,7,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ActiveCaller) Reset() {
+	*x = ActiveCaller{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveCaller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveCaller) ProtoMessage() {}
+
+func (x *ActiveCaller) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveCaller.ProtoReflect.Descriptor instead.
+func (*ActiveCaller) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ActiveCaller) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ActiveCaller) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ActiveCaller) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ActiveCaller) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ActiveCaller) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ActiveCaller) GetLastHeartbeatUnixNano() int64 {
+	if x != nil {
+		return x.LastHeartbeatUnixNano
+	}
+	return 0
+}
+
+func (x *ActiveCaller) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+type QueryCallHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Server string                 `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Tool   string                 `protobuf:"bytes,3,opt,name=tool,proto3" json:"tool,omitempty"`
+	// One of ok, error or rejected; empty matches every status.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SinceUnixNano int64  `protobuf:"varint,5,opt,name=since_unix_nano,json=sinceUnixNano,proto3" json:"since_unix_nano,omitempty"`
+	UntilUnixNano int64  `protobuf:"varint,6,opt,name=until_unix_nano,json=untilUnixNano,proto3" json:"until_unix_nano,omitempty"`
+	Cursor        string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCallHistoryRequest) Reset() {
+	*x = QueryCallHistoryRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCallHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCallHistoryRequest) ProtoMessage() {}
+
+func (x *QueryCallHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCallHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryCallHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QueryCallHistoryRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *QueryCallHistoryRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *QueryCallHistoryRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *QueryCallHistoryRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryCallHistoryRequest) GetSinceUnixNano() int64 {
+	if x != nil {
+		return x.SinceUnixNano
+	}
+	return 0
+}
+
+func (x *QueryCallHistoryRequest) GetUntilUnixNano() int64 {
+	if x != nil {
+		return x.UntilUnixNano
+	}
+	return 0
+}
+
+func (x *QueryCallHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *QueryCallHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryCallHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Records are ordered newest first.
+	Records       []*CallRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextCursor    string        `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryCallHistoryResponse) Reset() {
+	*x = QueryCallHistoryResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCallHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCallHistoryResponse) ProtoMessage() {}
+
+func (x *QueryCallHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCallHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryCallHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QueryCallHistoryResponse) GetRecords() []*CallRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *QueryCallHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CallRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimeUnixNano  int64                  `protobuf:"varint,2,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Caller        string                 `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Server        string                 `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	Tool          string                 `protobuf:"bytes,5,opt,name=tool,proto3" json:"tool,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	ArgumentBytes int64                  `protobuf:"varint,9,opt,name=argument_bytes,json=argumentBytes,proto3" json:"argument_bytes,omitempty"`
+	ResultBytes   int64                  `protobuf:"varint,10,opt,name=result_bytes,json=resultBytes,proto3" json:"result_bytes,omitempty"`
+	// Redacted payloads; set only when the core stores payloads.
+	ArgumentsJson []byte `protobuf:"bytes,11,opt,name=arguments_json,json=argumentsJson,proto3" json:"arguments_json,omitempty"`
+	ResultJson    []byte `protobuf:"bytes,12,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallRecord) Reset() {
+	*x = CallRecord{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallRecord) ProtoMessage() {}
+
+func (x *CallRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallRecord.ProtoReflect.Descriptor instead.
+func (*CallRecord) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CallRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallRecord) GetTimeUnixNano() int64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+func (x *CallRecord) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *CallRecord) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *CallRecord) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *CallRecord) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *CallRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CallRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CallRecord) GetArgumentBytes() int64 {
+	if x != nil {
+		return x.ArgumentBytes
+	}
+	return 0
+}
+
+func (x *CallRecord) GetResultBytes() int64 {
+	if x != nil {
+		return x.ResultBytes
+	}
+	return 0
+}
+
+func (x *CallRecord) GetArgumentsJson() []byte {
+	if x != nil {
+		return x.ArgumentsJson
+	}
+	return nil
+}
+
+func (x *CallRecord) GetResultJson() []byte {
+	if x != nil {
+		return x.ResultJson
+	}
+	return nil
+}
+
+type GetToolStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Empty server or tool matches every value.
+	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Tool          string `protobuf:"bytes,3,opt,name=tool,proto3" json:"tool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolStatsRequest) Reset() {
+	*x = GetToolStatsRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolStatsRequest) ProtoMessage() {}
+
+func (x *GetToolStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetToolStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetToolStatsRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *GetToolStatsRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *GetToolStatsRequest) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+type GetToolStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tools are ordered by server, then tool.
+	Tools         []*ToolStats     `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+	Slos          []*ToolSLOStatus `protobuf:"bytes,2,rep,name=slos,proto3" json:"slos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolStatsResponse) Reset() {
+	*x = GetToolStatsResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolStatsResponse) ProtoMessage() {}
+
+func (x *GetToolStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetToolStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetToolStatsResponse) GetTools() []*ToolStats {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *GetToolStatsResponse) GetSlos() []*ToolSLOStatus {
+	if x != nil {
+		return x.Slos
+	}
+	return nil
+}
+
+type ToolStats struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Server string                 `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Tool   string                 `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	Calls  uint64                 `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	Errors uint64                 `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	// Approximate latency percentiles since the core started.
+	P50Ms         float64 `protobuf:"fixed64,5,opt,name=p50_ms,json=p50Ms,proto3" json:"p50_ms,omitempty"`
+	P95Ms         float64 `protobuf:"fixed64,6,opt,name=p95_ms,json=p95Ms,proto3" json:"p95_ms,omitempty"`
+	P99Ms         float64 `protobuf:"fixed64,7,opt,name=p99_ms,json=p99Ms,proto3" json:"p99_ms,omitempty"`
+	MaxMs         float64 `protobuf:"fixed64,8,opt,name=max_ms,json=maxMs,proto3" json:"max_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolStats) Reset() {
+	*x = ToolStats{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolStats) ProtoMessage() {}
+
+func (x *ToolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolStats.ProtoReflect.Descriptor instead.
+func (*ToolStats) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ToolStats) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ToolStats) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ToolStats) GetCalls() uint64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *ToolStats) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *ToolStats) GetP50Ms() float64 {
+	if x != nil {
+		return x.P50Ms
+	}
+	return 0
+}
+
+func (x *ToolStats) GetP95Ms() float64 {
+	if x != nil {
+		return x.P95Ms
+	}
+	return 0
+}
+
+func (x *ToolStats) GetP99Ms() float64 {
+	if x != nil {
+		return x.P99Ms
+	}
+	return 0
+}
+
+func (x *ToolStats) GetMaxMs() float64 {
+	if x != nil {
+		return x.MaxMs
+	}
+	return 0
+}
+
+type ToolSLOStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Server          string                 `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Tool            string                 `protobuf:"bytes,3,opt,name=tool,proto3" json:"tool,omitempty"`
+	LatencyTargetMs int64                  `protobuf:"varint,4,opt,name=latency_target_ms,json=latencyTargetMs,proto3" json:"latency_target_ms,omitempty"`
+	ErrorBudget     float64                `protobuf:"fixed64,5,opt,name=error_budget,json=errorBudget,proto3" json:"error_budget,omitempty"`
+	WindowSeconds   int64                  `protobuf:"varint,6,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	WindowCalls     uint64                 `protobuf:"varint,7,opt,name=window_calls,json=windowCalls,proto3" json:"window_calls,omitempty"`
+	WindowBadCalls  uint64                 `protobuf:"varint,8,opt,name=window_bad_calls,json=windowBadCalls,proto3" json:"window_bad_calls,omitempty"`
+	// Observed bad call ratio divided by the error budget; above 1 the budget
+	// is being spent faster than allowed.
+	BurnRate      float64 `protobuf:"fixed64,9,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolSLOStatus) Reset() {
+	*x = ToolSLOStatus{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolSLOStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolSLOStatus) ProtoMessage() {}
+
+func (x *ToolSLOStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolSLOStatus.ProtoReflect.Descriptor instead.
+func (*ToolSLOStatus) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ToolSLOStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolSLOStatus) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ToolSLOStatus) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *ToolSLOStatus) GetLatencyTargetMs() int64 {
+	if x != nil {
+		return x.LatencyTargetMs
+	}
+	return 0
+}
+
+func (x *ToolSLOStatus) GetErrorBudget() float64 {
+	if x != nil {
+		return x.ErrorBudget
+	}
+	return 0
+}
+
+func (x *ToolSLOStatus) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ToolSLOStatus) GetWindowCalls() uint64 {
+	if x != nil {
+		return x.WindowCalls
+	}
+	return 0
+}
+
+func (x *ToolSLOStatus) GetWindowBadCalls() uint64 {
+	if x != nil {
+		return x.WindowBadCalls
+	}
+	return 0
+}
+
+func (x *ToolSLOStatus) GetBurnRate() float64 {
+	if x != nil {
+		return x.BurnRate
+	}
+	return 0
+}
+
+type ExportDiagnosticsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// "safe" (default) or "deep". Deep mode includes sensitive attributes
+	// captured by the diagnostics hub and is rejected unless
+	// observability.debug.deepDiagnosticsEnabled is set.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// When no include flag is set every section is exported.
+	IncludeSnapshot bool `protobuf:"varint,3,opt,name=include_snapshot,json=includeSnapshot,proto3" json:"include_snapshot,omitempty"`
+	IncludeMetrics  bool `protobuf:"varint,4,opt,name=include_metrics,json=includeMetrics,proto3" json:"include_metrics,omitempty"`
+	IncludeLogs     bool `protobuf:"varint,5,opt,name=include_logs,json=includeLogs,proto3" json:"include_logs,omitempty"`
+	IncludeEvents   bool `protobuf:"varint,6,opt,name=include_events,json=includeEvents,proto3" json:"include_events,omitempty"`
+	IncludeStuck    bool `protobuf:"varint,7,opt,name=include_stuck,json=includeStuck,proto3" json:"include_stuck,omitempty"`
+	// Minimum log level; defaults to info.
+	LogLevel         string `protobuf:"bytes,8,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	MaxLogEntries    int32  `protobuf:"varint,9,opt,name=max_log_entries,json=maxLogEntries,proto3" json:"max_log_entries,omitempty"`
+	MaxEventEntries  int32  `protobuf:"varint,10,opt,name=max_event_entries,json=maxEventEntries,proto3" json:"max_event_entries,omitempty"`
+	StuckThresholdMs int64  `protobuf:"varint,11,opt,name=stuck_threshold_ms,json=stuckThresholdMs,proto3" json:"stuck_threshold_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExportDiagnosticsRequest) Reset() {
+	*x = ExportDiagnosticsRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiagnosticsRequest) ProtoMessage() {}
+
+func (x *ExportDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportDiagnosticsRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ExportDiagnosticsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ExportDiagnosticsRequest) GetIncludeSnapshot() bool {
+	if x != nil {
+		return x.IncludeSnapshot
+	}
+	return false
+}
+
+func (x *ExportDiagnosticsRequest) GetIncludeMetrics() bool {
+	if x != nil {
+		return x.IncludeMetrics
+	}
+	return false
+}
+
+func (x *ExportDiagnosticsRequest) GetIncludeLogs() bool {
+	if x != nil {
+		return x.IncludeLogs
+	}
+	return false
+}
+
+func (x *ExportDiagnosticsRequest) GetIncludeEvents() bool {
+	if x != nil {
+		return x.IncludeEvents
+	}
+	return false
+}
+
+func (x *ExportDiagnosticsRequest) GetIncludeStuck() bool {
+	if x != nil {
+		return x.IncludeStuck
+	}
+	return false
+}
+
+func (x *ExportDiagnosticsRequest) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *ExportDiagnosticsRequest) GetMaxLogEntries() int32 {
+	if x != nil {
+		return x.MaxLogEntries
+	}
+	return 0
+}
+
+func (x *ExportDiagnosticsRequest) GetMaxEventEntries() int32 {
+	if x != nil {
+		return x.MaxEventEntries
+	}
+	return 0
+}
+
+func (x *ExportDiagnosticsRequest) GetStuckThresholdMs() int64 {
+	if x != nil {
+		return x.StuckThresholdMs
+	}
+	return 0
+}
+
+type ExportDiagnosticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Zip archive with bundle.json, report.md and one entry per section.
+	Archive             []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	GeneratedAtUnixNano int64  `protobuf:"varint,2,opt,name=generated_at_unix_nano,json=generatedAtUnixNano,proto3" json:"generated_at_unix_nano,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExportDiagnosticsResponse) Reset() {
+	*x = ExportDiagnosticsResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiagnosticsResponse) ProtoMessage() {}
+
+func (x *ExportDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ExportDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportDiagnosticsResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportDiagnosticsResponse) GetGeneratedAtUnixNano() int64 {
+	if x != nil {
+		return x.GeneratedAtUnixNano
+	}
+	return 0
+}
+
+type WatchDiagnosticsEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Caller string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Empty filters match every event.
+	SpecKey   string `protobuf:"bytes,2,opt,name=spec_key,json=specKey,proto3" json:"spec_key,omitempty"`
+	Server    string `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	Step      string `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+	Phase     string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	AttemptId string `protobuf:"bytes,6,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	// Replay matching events still held in the diagnostics buffer first.
+	Replay bool `protobuf:"varint,7,opt,name=replay,proto3" json:"replay,omitempty"`
+	// Replay only the latest matching events; 0 replays all buffered ones.
+	ReplayLimit int32 `protobuf:"varint,8,opt,name=replay_limit,json=replayLimit,proto3" json:"replay_limit,omitempty"`
+	// Keep streaming new events; without it the stream ends after the replay.
+	Follow        bool `protobuf:"varint,9,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDiagnosticsEventsRequest) Reset() {
+	*x = WatchDiagnosticsEventsRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDiagnosticsEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDiagnosticsEventsRequest) ProtoMessage() {}
+
+func (x *WatchDiagnosticsEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2973,64 +4824,109 @@ func (x *AutomaticMCPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutomaticMCPRequest.ProtoReflect.Descriptor instead.
-func (*AutomaticMCPRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use WatchDiagnosticsEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDiagnosticsEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AutomaticMCPRequest) GetCaller() string {
+func (x *WatchDiagnosticsEventsRequest) GetCaller() string {
 	if x != nil {
 		return x.Caller
 	}
 	return ""
 }
 
-func (x *AutomaticMCPRequest) GetQuery() string {
+func (x *WatchDiagnosticsEventsRequest) GetSpecKey() string {
 	if x != nil {
-		return x.Query
+		return x.SpecKey
 	}
 	return ""
 }
 
-func (x *AutomaticMCPRequest) GetSessionId() string {
+func (x *WatchDiagnosticsEventsRequest) GetServer() string {
 	if x != nil {
-		return x.SessionId
+		return x.Server
 	}
 	return ""
 }
 
-func (x *AutomaticMCPRequest) GetForceRefresh() bool {
+func (x *WatchDiagnosticsEventsRequest) GetStep() string {
 	if x != nil {
-		return x.ForceRefresh
+		return x.Step
+	}
+	return ""
+}
+
+func (x *WatchDiagnosticsEventsRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *WatchDiagnosticsEventsRequest) GetAttemptId() string {
+	if x != nil {
+		return x.AttemptId
+	}
+	return ""
+}
+
+func (x *WatchDiagnosticsEventsRequest) GetReplay() bool {
+	if x != nil {
+		return x.Replay
 	}
 	return false
 }
 
-type AutomaticMCPResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Etag           string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
-	ToolsJson      [][]byte               `protobuf:"bytes,2,rep,name=tools_json,json=toolsJson,proto3" json:"tools_json,omitempty"`
-	TotalAvailable int32                  `protobuf:"varint,3,opt,name=total_available,json=totalAvailable,proto3" json:"total_available,omitempty"`
-	Filtered       int32                  `protobuf:"varint,4,opt,name=filtered,proto3" json:"filtered,omitempty"`
+func (x *WatchDiagnosticsEventsRequest) GetReplayLimit() int32 {
+	if x != nil {
+		return x.ReplayLimit
+	}
+	return 0
+}
+
+func (x *WatchDiagnosticsEventsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type DiagnosticsEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Increases with every event recorded by the core.
+	Seq        uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	SpecKey    string `protobuf:"bytes,2,opt,name=spec_key,json=specKey,proto3" json:"spec_key,omitempty"`
+	ServerName string `protobuf:"bytes,3,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	AttemptId  string `protobuf:"bytes,4,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	Step       string `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`
+	// "enter", "exit" or "error".
+	Phase             string `protobuf:"bytes,6,opt,name=phase,proto3" json:"phase,omitempty"`
+	TimestampUnixNano int64  `protobuf:"varint,7,opt,name=timestamp_unix_nano,json=timestampUnixNano,proto3" json:"timestamp_unix_nano,omitempty"`
+	DurationMs        int64  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Error             string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// JSON object of string attributes, redacted as in a safe-mode
+	// diagnostics bundle.
+	AttributesJson []byte `protobuf:"bytes,10,opt,name=attributes_json,json=attributesJson,proto3" json:"attributes_json,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AutomaticMCPResponse) Reset() {
-	*x = AutomaticMCPResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[51]
+func (x *DiagnosticsEvent) Reset() {
+	*x = DiagnosticsEvent{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutomaticMCPResponse) String() string {
+func (x *DiagnosticsEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutomaticMCPResponse) ProtoMessage() {}
+func (*DiagnosticsEvent) ProtoMessage() {}
 
-func (x *AutomaticMCPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[51]
+func (x *DiagnosticsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3041,64 +4937,103 @@ func (x *AutomaticMCPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutomaticMCPResponse.ProtoReflect.Descriptor instead.
-func (*AutomaticMCPResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DiagnosticsEvent.ProtoReflect.Descriptor instead.
+func (*DiagnosticsEvent) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AutomaticMCPResponse) GetEtag() string {
+func (x *DiagnosticsEvent) GetSeq() uint64 {
 	if x != nil {
-		return x.Etag
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *DiagnosticsEvent) GetSpecKey() string {
+	if x != nil {
+		return x.SpecKey
 	}
 	return ""
 }
 
-func (x *AutomaticMCPResponse) GetToolsJson() [][]byte {
+func (x *DiagnosticsEvent) GetServerName() string {
 	if x != nil {
-		return x.ToolsJson
+		return x.ServerName
 	}
-	return nil
+	return ""
 }
 
-func (x *AutomaticMCPResponse) GetTotalAvailable() int32 {
+func (x *DiagnosticsEvent) GetAttemptId() string {
 	if x != nil {
-		return x.TotalAvailable
+		return x.AttemptId
+	}
+	return ""
+}
+
+func (x *DiagnosticsEvent) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *DiagnosticsEvent) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *DiagnosticsEvent) GetTimestampUnixNano() int64 {
+	if x != nil {
+		return x.TimestampUnixNano
 	}
 	return 0
 }
 
-func (x *AutomaticMCPResponse) GetFiltered() int32 {
+func (x *DiagnosticsEvent) GetDurationMs() int64 {
 	if x != nil {
-		return x.Filtered
+		return x.DurationMs
 	}
 	return 0
 }
 
-type AutomaticEvalRequest struct {
+func (x *DiagnosticsEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DiagnosticsEvent) GetAttributesJson() []byte {
+	if x != nil {
+		return x.AttributesJson
+	}
+	return nil
+}
+
+type GetReadinessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
-	ToolName      string                 `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
-	ArgumentsJson []byte                 `protobuf:"bytes,3,opt,name=arguments_json,json=argumentsJson,proto3" json:"arguments_json,omitempty"`
-	RoutingKey    string                 `protobuf:"bytes,4,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutomaticEvalRequest) Reset() {
-	*x = AutomaticEvalRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[52]
+func (x *GetReadinessRequest) Reset() {
+	*x = GetReadinessRequest{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutomaticEvalRequest) String() string {
+func (x *GetReadinessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutomaticEvalRequest) ProtoMessage() {}
+func (*GetReadinessRequest) ProtoMessage() {}
 
-func (x *AutomaticEvalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[52]
+func (x *GetReadinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,61 +5044,43 @@ func (x *AutomaticEvalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutomaticEvalRequest.ProtoReflect.Descriptor instead.
-func (*AutomaticEvalRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetReadinessRequest.ProtoReflect.Descriptor instead.
+func (*GetReadinessRequest) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AutomaticEvalRequest) GetCaller() string {
+func (x *GetReadinessRequest) GetCaller() string {
 	if x != nil {
 		return x.Caller
 	}
 	return ""
 }
 
-func (x *AutomaticEvalRequest) GetToolName() string {
-	if x != nil {
-		return x.ToolName
-	}
-	return ""
-}
-
-func (x *AutomaticEvalRequest) GetArgumentsJson() []byte {
-	if x != nil {
-		return x.ArgumentsJson
-	}
-	return nil
-}
-
-func (x *AutomaticEvalRequest) GetRoutingKey() string {
-	if x != nil {
-		return x.RoutingKey
-	}
-	return ""
-}
-
-type AutomaticEvalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResultJson    []byte                 `protobuf:"bytes,1,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetReadinessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when every check is ready.
+	Ready             bool              `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Checks            []*ReadinessCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	CheckedAtUnixNano int64             `protobuf:"varint,3,opt,name=checked_at_unix_nano,json=checkedAtUnixNano,proto3" json:"checked_at_unix_nano,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *AutomaticEvalResponse) Reset() {
-	*x = AutomaticEvalResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[53]
+func (x *GetReadinessResponse) Reset() {
+	*x = GetReadinessResponse{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutomaticEvalResponse) String() string {
+func (x *GetReadinessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutomaticEvalResponse) ProtoMessage() {}
+func (*GetReadinessResponse) ProtoMessage() {}
 
-func (x *AutomaticEvalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[53]
+func (x *GetReadinessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3174,40 +5091,59 @@ func (x *AutomaticEvalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutomaticEvalResponse.ProtoReflect.Descriptor instead.
-func (*AutomaticEvalResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetReadinessResponse.ProtoReflect.Descriptor instead.
+func (*GetReadinessResponse) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *AutomaticEvalResponse) GetResultJson() []byte {
+func (x *GetReadinessResponse) GetReady() bool {
 	if x != nil {
-		return x.ResultJson
+		return x.Ready
+	}
+	return false
+}
+
+func (x *GetReadinessResponse) GetChecks() []*ReadinessCheck {
+	if x != nil {
+		return x.Checks
 	}
 	return nil
 }
 
-type IsSubAgentEnabledRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Caller        string                 `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+func (x *GetReadinessResponse) GetCheckedAtUnixNano() int64 {
+	if x != nil {
+		return x.CheckedAtUnixNano
+	}
+	return 0
+}
+
+type ReadinessCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "bootstrap", "requiredPlugins", "servers" or "rpcListener".
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ready   bool   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Plugins or servers covered by the check.
+	Components    []*ReadinessComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsSubAgentEnabledRequest) Reset() {
-	*x = IsSubAgentEnabledRequest{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[54]
+func (x *ReadinessCheck) Reset() {
+	*x = ReadinessCheck{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsSubAgentEnabledRequest) String() string {
+func (x *ReadinessCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsSubAgentEnabledRequest) ProtoMessage() {}
+func (*ReadinessCheck) ProtoMessage() {}
 
-func (x *IsSubAgentEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[54]
+func (x *ReadinessCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3218,40 +5154,63 @@ func (x *IsSubAgentEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsSubAgentEnabledRequest.ProtoReflect.Descriptor instead.
-func (*IsSubAgentEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ReadinessCheck.ProtoReflect.Descriptor instead.
+func (*ReadinessCheck) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *IsSubAgentEnabledRequest) GetCaller() string {
+func (x *ReadinessCheck) GetName() string {
 	if x != nil {
-		return x.Caller
+		return x.Name
 	}
 	return ""
 }
 
-type IsSubAgentEnabledResponse struct {
+func (x *ReadinessCheck) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ReadinessCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReadinessCheck) GetComponents() []*ReadinessComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type ReadinessComponent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ready         bool                   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsSubAgentEnabledResponse) Reset() {
-	*x = IsSubAgentEnabledResponse{}
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[55]
+func (x *ReadinessComponent) Reset() {
+	*x = ReadinessComponent{}
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsSubAgentEnabledResponse) String() string {
+func (x *ReadinessComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsSubAgentEnabledResponse) ProtoMessage() {}
+func (*ReadinessComponent) ProtoMessage() {}
 
-func (x *IsSubAgentEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mcpv_control_v1_control_proto_msgTypes[55]
+func (x *ReadinessComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_mcpv_control_v1_control_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,18 +5221,32 @@ func (x *IsSubAgentEnabledResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsSubAgentEnabledResponse.ProtoReflect.Descriptor instead.
-func (*IsSubAgentEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ReadinessComponent.ProtoReflect.Descriptor instead.
+func (*ReadinessComponent) Descriptor() ([]byte, []int) {
+	return file_mcpv_control_v1_control_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *IsSubAgentEnabledResponse) GetEnabled() bool {
+func (x *ReadinessComponent) GetName() string {
 	if x != nil {
-		return x.Enabled
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReadinessComponent) GetReady() bool {
+	if x != nil {
+		return x.Ready
 	}
 	return false
 }
 
+func (x *ReadinessComponent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_mcpv_control_v1_control_proto protoreflect.FileDescriptor
 
 const file_mcpv_control_v1_control_proto_rawDesc = "" +
@@ -3283,12 +5256,27 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x0fGetInfoResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
-	"\x05build\x18\x03 \x01(\tR\x05build\"m\n" +
+	"\x05build\x18\x03 \x01(\tR\x05build\"\xab\x01\n" +
 	"\x15RegisterCallerRequest\x12\x16\n" +
 	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x10\n" +
 	"\x03pid\x18\x02 \x01(\x03R\x03pid\x12\x12\n" +
 	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x16\n" +
-	"\x06server\x18\x04 \x01(\tR\x06server\"2\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12<\n" +
+	"\vclient_info\x18\x05 \x01(\v2\x1b.mcpv.control.v1.ClientInfoR\n" +
+	"clientInfo\"\x85\x02\n" +
+	"\n" +
+	"ClientInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12%\n" +
+	"\x0eworkspace_root\x18\x03 \x01(\tR\rworkspaceRoot\x12\x1a\n" +
+	"\bsampling\x18\x04 \x01(\bR\bsampling\x12 \n" +
+	"\velicitation\x18\x05 \x01(\bR\velicitation\x12\x14\n" +
+	"\x05roots\x18\x06 \x01(\bR\x05roots\x122\n" +
+	"\troot_list\x18\a \x03(\v2\x15.mcpv.control.v1.RootR\brootList\x12\x1a\n" +
+	"\bidentity\x18\b \x01(\tR\bidentity\",\n" +
+	"\x04Root\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"2\n" +
 	"\x16RegisterCallerResponse\x12\x18\n" +
 	"\aprofile\x18\x01 \x01(\tR\aprofile\"1\n" +
 	"\x17UnregisterCallerRequest\x12\x16\n" +
@@ -3412,10 +5400,14 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x0earguments_json\x18\x03 \x01(\fR\rargumentsJson\"4\n" +
 	"\x11GetPromptResponse\x12\x1f\n" +
 	"\vresult_json\x18\x01 \x01(\fR\n" +
-	"resultJson\"c\n" +
+	"resultJson\"\xdf\x01\n" +
 	"\x11StreamLogsRequest\x12\x16\n" +
 	"\x06caller\x18\x01 \x01(\tR\x06caller\x126\n" +
-	"\tmin_level\x18\x02 \x01(\x0e2\x19.mcpv.control.v1.LogLevelR\bminLevel\"\xa0\x01\n" +
+	"\tmin_level\x18\x02 \x01(\x0e2\x19.mcpv.control.v1.LogLevelR\bminLevel\x12\x16\n" +
+	"\x06server\x18\x03 \x01(\tR\x06server\x12\x12\n" +
+	"\x04grep\x18\x04 \x01(\tR\x04grep\x12&\n" +
+	"\x0fsince_unix_nano\x18\x05 \x01(\x03R\rsinceUnixNano\x12&\n" +
+	"\x0funtil_unix_nano\x18\x06 \x01(\x03R\runtilUnixNano\"\xa0\x01\n" +
 	"\bLogEntry\x12\x16\n" +
 	"\x06logger\x18\x01 \x01(\tR\x06logger\x12/\n" +
 	"\x05level\x18\x02 \x01(\x0e2\x19.mcpv.control.v1.LogLevelR\x05level\x12.\n" +
@@ -3434,7 +5426,7 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"serverName\x12=\n" +
 	"\tinstances\x18\x03 \x03(\v2\x1f.mcpv.control.v1.InstanceStatusR\tinstances\x120\n" +
 	"\x05stats\x18\x04 \x01(\v2\x1a.mcpv.control.v1.PoolStatsR\x05stats\x126\n" +
-	"\ametrics\x18\x05 \x01(\v2\x1c.mcpv.control.v1.PoolMetricsR\ametrics\"\xae\x02\n" +
+	"\ametrics\x18\x05 \x01(\v2\x1c.mcpv.control.v1.PoolMetricsR\ametrics\"\x81\x03\n" +
 	"\x0eInstanceStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
@@ -3443,7 +5435,16 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x15last_active_unix_nano\x18\x04 \x01(\x03R\x12lastActiveUnixNano\x12/\n" +
 	"\x14spawned_at_unix_nano\x18\x05 \x01(\x03R\x11spawnedAtUnixNano\x125\n" +
 	"\x17handshaked_at_unix_nano\x18\x06 \x01(\x03R\x14handshakedAtUnixNano\x12<\n" +
-	"\x1blast_heartbeat_at_unix_nano\x18\a \x01(\x03R\x17lastHeartbeatAtUnixNano\"\xe1\x01\n" +
+	"\x1blast_heartbeat_at_unix_nano\x18\a \x01(\x03R\x17lastHeartbeatAtUnixNano\x12\x10\n" +
+	"\x03pid\x18\b \x01(\x05R\x03pid\x12?\n" +
+	"\tresources\x18\t \x01(\v2!.mcpv.control.v1.ProcessResourcesR\tresources\"\xd3\x01\n" +
+	"\x10ProcessResources\x12\x1c\n" +
+	"\tprocesses\x18\x01 \x01(\x05R\tprocesses\x12\x1e\n" +
+	"\vcpu_time_ms\x18\x02 \x01(\x03R\tcpuTimeMs\x12\x1b\n" +
+	"\trss_bytes\x18\x03 \x01(\x03R\brssBytes\x12\x19\n" +
+	"\bopen_fds\x18\x04 \x01(\x05R\aopenFds\x12\x18\n" +
+	"\athreads\x18\x05 \x01(\x05R\athreads\x12/\n" +
+	"\x14sampled_at_unix_nano\x18\x06 \x01(\x03R\x11sampledAtUnixNano\"\xe1\x01\n" +
 	"\tPoolStats\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
 	"\x05ready\x18\x02 \x01(\x05R\x05ready\x12\x12\n" +
@@ -3452,7 +5453,7 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\bdraining\x18\x05 \x01(\x05R\bdraining\x12\x16\n" +
 	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12\"\n" +
 	"\finitializing\x18\a \x01(\x05R\finitializing\x12 \n" +
-	"\vhandshaking\x18\b \x01(\x05R\vhandshaking\"\xf1\x01\n" +
+	"\vhandshaking\x18\b \x01(\x05R\vhandshaking\"\xfb\x02\n" +
 	"\vPoolMetrics\x12\x1f\n" +
 	"\vstart_count\x18\x01 \x01(\x05R\n" +
 	"startCount\x12\x1d\n" +
@@ -3462,7 +5463,11 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"totalCalls\x12!\n" +
 	"\ftotal_errors\x18\x04 \x01(\x03R\vtotalErrors\x12*\n" +
 	"\x11total_duration_ms\x18\x05 \x01(\x03R\x0ftotalDurationMs\x122\n" +
-	"\x16last_call_at_unix_nano\x18\x06 \x01(\x03R\x12lastCallAtUnixNano\"6\n" +
+	"\x16last_call_at_unix_nano\x18\x06 \x01(\x03R\x12lastCallAtUnixNano\x12\x1d\n" +
+	"\n" +
+	"idle_reaps\x18\a \x01(\x05R\tidleReaps\x124\n" +
+	"\x16saved_instance_seconds\x18\b \x01(\x01R\x14savedInstanceSeconds\x123\n" +
+	"\x16saved_rss_byte_seconds\x18\t \x01(\x01R\x13savedRssByteSeconds\"6\n" +
 	"\x1cWatchServerInitStatusRequest\x12\x16\n" +
 	"\x06caller\x18\x01 \x01(\tR\x06caller\"\x8e\x01\n" +
 	"\x18ServerInitStatusSnapshot\x12=\n" +
@@ -3503,7 +5508,153 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x18IsSubAgentEnabledRequest\x12\x16\n" +
 	"\x06caller\x18\x01 \x01(\tR\x06caller\"5\n" +
 	"\x19IsSubAgentEnabledResponse\x12\x18\n" +
-	"\aenabled\x18\x01 \x01(\bR\aenabled*\xd6\x01\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"/\n" +
+	"\x15GetQuotaStatusRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\"R\n" +
+	"\x16GetQuotaStatusResponse\x128\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1c.mcpv.control.v1.QuotaStatusR\bstatuses\"\xdb\x02\n" +
+	"\vQuotaStatus\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x05R\x04rate\x12%\n" +
+	"\x0eperiod_seconds\x18\x05 \x01(\x05R\rperiodSeconds\x12\x14\n" +
+	"\x05burst\x18\x06 \x01(\x05R\x05burst\x12)\n" +
+	"\x10tokens_available\x18\a \x01(\x01R\x0ftokensAvailable\x12\x1f\n" +
+	"\vdaily_quota\x18\b \x01(\x05R\n" +
+	"dailyQuota\x12\x1d\n" +
+	"\n" +
+	"quota_used\x18\t \x01(\x05R\tquotaUsed\x12'\n" +
+	"\x0fquota_remaining\x18\n" +
+	" \x01(\x05R\x0equotaRemaining\x12+\n" +
+	"\x12reset_at_unix_nano\x18\v \x01(\x03R\x0fresetAtUnixNano\"\x14\n" +
+	"\x12ListCallersRequest\"N\n" +
+	"\x13ListCallersResponse\x127\n" +
+	"\acallers\x18\x01 \x03(\v2\x1d.mcpv.control.v1.ActiveCallerR\acallers\"\xf5\x01\n" +
+	"\fActiveCaller\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x10\n" +
+	"\x03pid\x18\x02 \x01(\x03R\x03pid\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12\x18\n" +
+	"\aprofile\x18\x05 \x01(\tR\aprofile\x127\n" +
+	"\x18last_heartbeat_unix_nano\x18\x06 \x01(\x03R\x15lastHeartbeatUnixNano\x12<\n" +
+	"\vclient_info\x18\a \x01(\v2\x1b.mcpv.control.v1.ClientInfoR\n" +
+	"clientInfo\"\xf3\x01\n" +
+	"\x17QueryCallHistoryRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x03 \x01(\tR\x04tool\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12&\n" +
+	"\x0fsince_unix_nano\x18\x05 \x01(\x03R\rsinceUnixNano\x12&\n" +
+	"\x0funtil_unix_nano\x18\x06 \x01(\x03R\runtilUnixNano\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\"r\n" +
+	"\x18QueryCallHistoryResponse\x125\n" +
+	"\arecords\x18\x01 \x03(\v2\x1b.mcpv.control.v1.CallRecordR\arecords\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xe9\x02\n" +
+	"\n" +
+	"CallRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0etime_unix_nano\x18\x02 \x01(\x03R\ftimeUnixNano\x12\x16\n" +
+	"\x06caller\x18\x03 \x01(\tR\x06caller\x12\x16\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x05 \x01(\tR\x04tool\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12%\n" +
+	"\x0eargument_bytes\x18\t \x01(\x03R\rargumentBytes\x12!\n" +
+	"\fresult_bytes\x18\n" +
+	" \x01(\x03R\vresultBytes\x12%\n" +
+	"\x0earguments_json\x18\v \x01(\fR\rargumentsJson\x12\x1f\n" +
+	"\vresult_json\x18\f \x01(\fR\n" +
+	"resultJson\"Y\n" +
+	"\x13GetToolStatsRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x03 \x01(\tR\x04tool\"|\n" +
+	"\x14GetToolStatsResponse\x120\n" +
+	"\x05tools\x18\x01 \x03(\v2\x1a.mcpv.control.v1.ToolStatsR\x05tools\x122\n" +
+	"\x04slos\x18\x02 \x03(\v2\x1e.mcpv.control.v1.ToolSLOStatusR\x04slos\"\xc1\x01\n" +
+	"\tToolStats\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x02 \x01(\tR\x04tool\x12\x14\n" +
+	"\x05calls\x18\x03 \x01(\x04R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x04 \x01(\x04R\x06errors\x12\x15\n" +
+	"\x06p50_ms\x18\x05 \x01(\x01R\x05p50Ms\x12\x15\n" +
+	"\x06p95_ms\x18\x06 \x01(\x01R\x05p95Ms\x12\x15\n" +
+	"\x06p99_ms\x18\a \x01(\x01R\x05p99Ms\x12\x15\n" +
+	"\x06max_ms\x18\b \x01(\x01R\x05maxMs\"\xaf\x02\n" +
+	"\rToolSLOStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x12\n" +
+	"\x04tool\x18\x03 \x01(\tR\x04tool\x12*\n" +
+	"\x11latency_target_ms\x18\x04 \x01(\x03R\x0flatencyTargetMs\x12!\n" +
+	"\ferror_budget\x18\x05 \x01(\x01R\verrorBudget\x12%\n" +
+	"\x0ewindow_seconds\x18\x06 \x01(\x03R\rwindowSeconds\x12!\n" +
+	"\fwindow_calls\x18\a \x01(\x04R\vwindowCalls\x12(\n" +
+	"\x10window_bad_calls\x18\b \x01(\x04R\x0ewindowBadCalls\x12\x1b\n" +
+	"\tburn_rate\x18\t \x01(\x01R\bburnRate\"\xa8\x03\n" +
+	"\x18ExportDiagnosticsRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12)\n" +
+	"\x10include_snapshot\x18\x03 \x01(\bR\x0fincludeSnapshot\x12'\n" +
+	"\x0finclude_metrics\x18\x04 \x01(\bR\x0eincludeMetrics\x12!\n" +
+	"\finclude_logs\x18\x05 \x01(\bR\vincludeLogs\x12%\n" +
+	"\x0einclude_events\x18\x06 \x01(\bR\rincludeEvents\x12#\n" +
+	"\rinclude_stuck\x18\a \x01(\bR\fincludeStuck\x12\x1b\n" +
+	"\tlog_level\x18\b \x01(\tR\blogLevel\x12&\n" +
+	"\x0fmax_log_entries\x18\t \x01(\x05R\rmaxLogEntries\x12*\n" +
+	"\x11max_event_entries\x18\n" +
+	" \x01(\x05R\x0fmaxEventEntries\x12,\n" +
+	"\x12stuck_threshold_ms\x18\v \x01(\x03R\x10stuckThresholdMs\"j\n" +
+	"\x19ExportDiagnosticsResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x123\n" +
+	"\x16generated_at_unix_nano\x18\x02 \x01(\x03R\x13generatedAtUnixNano\"\x86\x02\n" +
+	"\x1dWatchDiagnosticsEventsRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\x12\x19\n" +
+	"\bspec_key\x18\x02 \x01(\tR\aspecKey\x12\x16\n" +
+	"\x06server\x18\x03 \x01(\tR\x06server\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\tR\x04step\x12\x14\n" +
+	"\x05phase\x18\x05 \x01(\tR\x05phase\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x06 \x01(\tR\tattemptId\x12\x16\n" +
+	"\x06replay\x18\a \x01(\bR\x06replay\x12!\n" +
+	"\freplay_limit\x18\b \x01(\x05R\vreplayLimit\x12\x16\n" +
+	"\x06follow\x18\t \x01(\bR\x06follow\"\xb9\x02\n" +
+	"\x10DiagnosticsEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12\x19\n" +
+	"\bspec_key\x18\x02 \x01(\tR\aspecKey\x12\x1f\n" +
+	"\vserver_name\x18\x03 \x01(\tR\n" +
+	"serverName\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x04 \x01(\tR\tattemptId\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12\x14\n" +
+	"\x05phase\x18\x06 \x01(\tR\x05phase\x12.\n" +
+	"\x13timestamp_unix_nano\x18\a \x01(\x03R\x11timestampUnixNano\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12'\n" +
+	"\x0fattributes_json\x18\n" +
+	" \x01(\fR\x0eattributesJson\"-\n" +
+	"\x13GetReadinessRequest\x12\x16\n" +
+	"\x06caller\x18\x01 \x01(\tR\x06caller\"\x96\x01\n" +
+	"\x14GetReadinessResponse\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready\x127\n" +
+	"\x06checks\x18\x02 \x03(\v2\x1f.mcpv.control.v1.ReadinessCheckR\x06checks\x12/\n" +
+	"\x14checked_at_unix_nano\x18\x03 \x01(\x03R\x11checkedAtUnixNano\"\x99\x01\n" +
+	"\x0eReadinessCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05ready\x18\x02 \x01(\bR\x05ready\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12C\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2#.mcpv.control.v1.ReadinessComponentR\n" +
+	"components\"X\n" +
+	"\x12ReadinessComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05ready\x18\x02 \x01(\bR\x05ready\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*\xd6\x01\n" +
 	"\bLogLevel\x12\x19\n" +
 	"\x15LOG_LEVEL_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fLOG_LEVEL_DEBUG\x10\x01\x12\x12\n" +
@@ -3513,7 +5664,7 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x0fLOG_LEVEL_ERROR\x10\x05\x12\x16\n" +
 	"\x12LOG_LEVEL_CRITICAL\x10\x06\x12\x13\n" +
 	"\x0fLOG_LEVEL_ALERT\x10\a\x12\x17\n" +
-	"\x13LOG_LEVEL_EMERGENCY\x10\b2\xdc\x10\n" +
+	"\x13LOG_LEVEL_EMERGENCY\x10\b2\x97\x16\n" +
 	"\x13ControlPlaneService\x12L\n" +
 	"\aGetInfo\x12\x1f.mcpv.control.v1.GetInfoRequest\x1a .mcpv.control.v1.GetInfoResponse\x12a\n" +
 	"\x0eRegisterCaller\x12&.mcpv.control.v1.RegisterCallerRequest\x1a'.mcpv.control.v1.RegisterCallerResponse\x12g\n" +
@@ -3539,7 +5690,14 @@ const file_mcpv_control_v1_control_proto_rawDesc = "" +
 	"\x15WatchServerInitStatus\x12-.mcpv.control.v1.WatchServerInitStatusRequest\x1a).mcpv.control.v1.ServerInitStatusSnapshot0\x01\x12[\n" +
 	"\fAutomaticMCP\x12$.mcpv.control.v1.AutomaticMCPRequest\x1a%.mcpv.control.v1.AutomaticMCPResponse\x12^\n" +
 	"\rAutomaticEval\x12%.mcpv.control.v1.AutomaticEvalRequest\x1a&.mcpv.control.v1.AutomaticEvalResponse\x12j\n" +
-	"\x11IsSubAgentEnabled\x12).mcpv.control.v1.IsSubAgentEnabledRequest\x1a*.mcpv.control.v1.IsSubAgentEnabledResponseB#Z!mcpv/pkg/api/control/v1;controlv1b\x06proto3"
+	"\x11IsSubAgentEnabled\x12).mcpv.control.v1.IsSubAgentEnabledRequest\x1a*.mcpv.control.v1.IsSubAgentEnabledResponse\x12a\n" +
+	"\x0eGetQuotaStatus\x12&.mcpv.control.v1.GetQuotaStatusRequest\x1a'.mcpv.control.v1.GetQuotaStatusResponse\x12X\n" +
+	"\vListCallers\x12#.mcpv.control.v1.ListCallersRequest\x1a$.mcpv.control.v1.ListCallersResponse\x12g\n" +
+	"\x10QueryCallHistory\x12(.mcpv.control.v1.QueryCallHistoryRequest\x1a).mcpv.control.v1.QueryCallHistoryResponse\x12[\n" +
+	"\fGetToolStats\x12$.mcpv.control.v1.GetToolStatsRequest\x1a%.mcpv.control.v1.GetToolStatsResponse\x12j\n" +
+	"\x11ExportDiagnostics\x12).mcpv.control.v1.ExportDiagnosticsRequest\x1a*.mcpv.control.v1.ExportDiagnosticsResponse\x12m\n" +
+	"\x16WatchDiagnosticsEvents\x12..mcpv.control.v1.WatchDiagnosticsEventsRequest\x1a!.mcpv.control.v1.DiagnosticsEvent0\x01\x12[\n" +
+	"\fGetReadiness\x12$.mcpv.control.v1.GetReadinessRequest\x1a%.mcpv.control.v1.GetReadinessResponseB#Z!mcpv/pkg/api/control/v1;controlv1b\x06proto3"
 
 var (
 	file_mcpv_control_v1_control_proto_rawDescOnce sync.Once
@@ -3554,136 +5712,185 @@ func file_mcpv_control_v1_control_proto_rawDescGZIP() []byte {
 }
 
 var file_mcpv_control_v1_control_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mcpv_control_v1_control_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_mcpv_control_v1_control_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_mcpv_control_v1_control_proto_goTypes = []any{
-	(LogLevel)(0),                        // 0: mcpv.control.v1.LogLevel
-	(*GetInfoRequest)(nil),               // 1: mcpv.control.v1.GetInfoRequest
-	(*GetInfoResponse)(nil),              // 2: mcpv.control.v1.GetInfoResponse
-	(*RegisterCallerRequest)(nil),        // 3: mcpv.control.v1.RegisterCallerRequest
-	(*RegisterCallerResponse)(nil),       // 4: mcpv.control.v1.RegisterCallerResponse
-	(*UnregisterCallerRequest)(nil),      // 5: mcpv.control.v1.UnregisterCallerRequest
-	(*UnregisterCallerResponse)(nil),     // 6: mcpv.control.v1.UnregisterCallerResponse
-	(*ListToolsRequest)(nil),             // 7: mcpv.control.v1.ListToolsRequest
-	(*ListToolsResponse)(nil),            // 8: mcpv.control.v1.ListToolsResponse
-	(*WatchToolsRequest)(nil),            // 9: mcpv.control.v1.WatchToolsRequest
-	(*ToolsSnapshot)(nil),                // 10: mcpv.control.v1.ToolsSnapshot
-	(*ToolDefinition)(nil),               // 11: mcpv.control.v1.ToolDefinition
-	(*CallToolRequest)(nil),              // 12: mcpv.control.v1.CallToolRequest
-	(*CallToolResponse)(nil),             // 13: mcpv.control.v1.CallToolResponse
-	(*CallToolTaskRequest)(nil),          // 14: mcpv.control.v1.CallToolTaskRequest
-	(*CallToolTaskResponse)(nil),         // 15: mcpv.control.v1.CallToolTaskResponse
-	(*TasksGetRequest)(nil),              // 16: mcpv.control.v1.TasksGetRequest
-	(*TasksGetResponse)(nil),             // 17: mcpv.control.v1.TasksGetResponse
-	(*TasksListRequest)(nil),             // 18: mcpv.control.v1.TasksListRequest
-	(*TasksListResponse)(nil),            // 19: mcpv.control.v1.TasksListResponse
-	(*TasksResultRequest)(nil),           // 20: mcpv.control.v1.TasksResultRequest
-	(*TasksResultResponse)(nil),          // 21: mcpv.control.v1.TasksResultResponse
-	(*TasksCancelRequest)(nil),           // 22: mcpv.control.v1.TasksCancelRequest
-	(*TasksCancelResponse)(nil),          // 23: mcpv.control.v1.TasksCancelResponse
-	(*Task)(nil),                         // 24: mcpv.control.v1.Task
-	(*TaskResult)(nil),                   // 25: mcpv.control.v1.TaskResult
-	(*ListResourcesRequest)(nil),         // 26: mcpv.control.v1.ListResourcesRequest
-	(*ListResourcesResponse)(nil),        // 27: mcpv.control.v1.ListResourcesResponse
-	(*WatchResourcesRequest)(nil),        // 28: mcpv.control.v1.WatchResourcesRequest
-	(*ResourcesSnapshot)(nil),            // 29: mcpv.control.v1.ResourcesSnapshot
-	(*ResourceDefinition)(nil),           // 30: mcpv.control.v1.ResourceDefinition
-	(*ReadResourceRequest)(nil),          // 31: mcpv.control.v1.ReadResourceRequest
-	(*ReadResourceResponse)(nil),         // 32: mcpv.control.v1.ReadResourceResponse
-	(*ListPromptsRequest)(nil),           // 33: mcpv.control.v1.ListPromptsRequest
-	(*ListPromptsResponse)(nil),          // 34: mcpv.control.v1.ListPromptsResponse
-	(*WatchPromptsRequest)(nil),          // 35: mcpv.control.v1.WatchPromptsRequest
-	(*PromptsSnapshot)(nil),              // 36: mcpv.control.v1.PromptsSnapshot
-	(*PromptDefinition)(nil),             // 37: mcpv.control.v1.PromptDefinition
-	(*GetPromptRequest)(nil),             // 38: mcpv.control.v1.GetPromptRequest
-	(*GetPromptResponse)(nil),            // 39: mcpv.control.v1.GetPromptResponse
-	(*StreamLogsRequest)(nil),            // 40: mcpv.control.v1.StreamLogsRequest
-	(*LogEntry)(nil),                     // 41: mcpv.control.v1.LogEntry
-	(*WatchRuntimeStatusRequest)(nil),    // 42: mcpv.control.v1.WatchRuntimeStatusRequest
-	(*RuntimeStatusSnapshot)(nil),        // 43: mcpv.control.v1.RuntimeStatusSnapshot
-	(*ServerRuntimeStatus)(nil),          // 44: mcpv.control.v1.ServerRuntimeStatus
-	(*InstanceStatus)(nil),               // 45: mcpv.control.v1.InstanceStatus
-	(*PoolStats)(nil),                    // 46: mcpv.control.v1.PoolStats
-	(*PoolMetrics)(nil),                  // 47: mcpv.control.v1.PoolMetrics
-	(*WatchServerInitStatusRequest)(nil), // 48: mcpv.control.v1.WatchServerInitStatusRequest
-	(*ServerInitStatusSnapshot)(nil),     // 49: mcpv.control.v1.ServerInitStatusSnapshot
-	(*ServerInitStatus)(nil),             // 50: mcpv.control.v1.ServerInitStatus
-	(*AutomaticMCPRequest)(nil),          // 51: mcpv.control.v1.AutomaticMCPRequest
-	(*AutomaticMCPResponse)(nil),         // 52: mcpv.control.v1.AutomaticMCPResponse
-	(*AutomaticEvalRequest)(nil),         // 53: mcpv.control.v1.AutomaticEvalRequest
-	(*AutomaticEvalResponse)(nil),        // 54: mcpv.control.v1.AutomaticEvalResponse
-	(*IsSubAgentEnabledRequest)(nil),     // 55: mcpv.control.v1.IsSubAgentEnabledRequest
-	(*IsSubAgentEnabledResponse)(nil),    // 56: mcpv.control.v1.IsSubAgentEnabledResponse
+	(LogLevel)(0),                         // 0: mcpv.control.v1.LogLevel
+	(*GetInfoRequest)(nil),                // 1: mcpv.control.v1.GetInfoRequest
+	(*GetInfoResponse)(nil),               // 2: mcpv.control.v1.GetInfoResponse
+	(*RegisterCallerRequest)(nil),         // 3: mcpv.control.v1.RegisterCallerRequest
+	(*ClientInfo)(nil),                    // 4: mcpv.control.v1.ClientInfo
+	(*Root)(nil),                          // 5: mcpv.control.v1.Root
+	(*RegisterCallerResponse)(nil),        // 6: mcpv.control.v1.RegisterCallerResponse
+	(*UnregisterCallerRequest)(nil),       // 7: mcpv.control.v1.UnregisterCallerRequest
+	(*UnregisterCallerResponse)(nil),      // 8: mcpv.control.v1.UnregisterCallerResponse
+	(*ListToolsRequest)(nil),              // 9: mcpv.control.v1.ListToolsRequest
+	(*ListToolsResponse)(nil),             // 10: mcpv.control.v1.ListToolsResponse
+	(*WatchToolsRequest)(nil),             // 11: mcpv.control.v1.WatchToolsRequest
+	(*ToolsSnapshot)(nil),                 // 12: mcpv.control.v1.ToolsSnapshot
+	(*ToolDefinition)(nil),                // 13: mcpv.control.v1.ToolDefinition
+	(*CallToolRequest)(nil),               // 14: mcpv.control.v1.CallToolRequest
+	(*CallToolResponse)(nil),              // 15: mcpv.control.v1.CallToolResponse
+	(*CallToolTaskRequest)(nil),           // 16: mcpv.control.v1.CallToolTaskRequest
+	(*CallToolTaskResponse)(nil),          // 17: mcpv.control.v1.CallToolTaskResponse
+	(*TasksGetRequest)(nil),               // 18: mcpv.control.v1.TasksGetRequest
+	(*TasksGetResponse)(nil),              // 19: mcpv.control.v1.TasksGetResponse
+	(*TasksListRequest)(nil),              // 20: mcpv.control.v1.TasksListRequest
+	(*TasksListResponse)(nil),             // 21: mcpv.control.v1.TasksListResponse
+	(*TasksResultRequest)(nil),            // 22: mcpv.control.v1.TasksResultRequest
+	(*TasksResultResponse)(nil),           // 23: mcpv.control.v1.TasksResultResponse
+	(*TasksCancelRequest)(nil),            // 24: mcpv.control.v1.TasksCancelRequest
+	(*TasksCancelResponse)(nil),           // 25: mcpv.control.v1.TasksCancelResponse
+	(*Task)(nil),                          // 26: mcpv.control.v1.Task
+	(*TaskResult)(nil),                    // 27: mcpv.control.v1.TaskResult
+	(*ListResourcesRequest)(nil),          // 28: mcpv.control.v1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),         // 29: mcpv.control.v1.ListResourcesResponse
+	(*WatchResourcesRequest)(nil),         // 30: mcpv.control.v1.WatchResourcesRequest
+	(*ResourcesSnapshot)(nil),             // 31: mcpv.control.v1.ResourcesSnapshot
+	(*ResourceDefinition)(nil),            // 32: mcpv.control.v1.ResourceDefinition
+	(*ReadResourceRequest)(nil),           // 33: mcpv.control.v1.ReadResourceRequest
+	(*ReadResourceResponse)(nil),          // 34: mcpv.control.v1.ReadResourceResponse
+	(*ListPromptsRequest)(nil),            // 35: mcpv.control.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),           // 36: mcpv.control.v1.ListPromptsResponse
+	(*WatchPromptsRequest)(nil),           // 37: mcpv.control.v1.WatchPromptsRequest
+	(*PromptsSnapshot)(nil),               // 38: mcpv.control.v1.PromptsSnapshot
+	(*PromptDefinition)(nil),              // 39: mcpv.control.v1.PromptDefinition
+	(*GetPromptRequest)(nil),              // 40: mcpv.control.v1.GetPromptRequest
+	(*GetPromptResponse)(nil),             // 41: mcpv.control.v1.GetPromptResponse
+	(*StreamLogsRequest)(nil),             // 42: mcpv.control.v1.StreamLogsRequest
+	(*LogEntry)(nil),                      // 43: mcpv.control.v1.LogEntry
+	(*WatchRuntimeStatusRequest)(nil),     // 44: mcpv.control.v1.WatchRuntimeStatusRequest
+	(*RuntimeStatusSnapshot)(nil),         // 45: mcpv.control.v1.RuntimeStatusSnapshot
+	(*ServerRuntimeStatus)(nil),           // 46: mcpv.control.v1.ServerRuntimeStatus
+	(*InstanceStatus)(nil),                // 47: mcpv.control.v1.InstanceStatus
+	(*ProcessResources)(nil),              // 48: mcpv.control.v1.ProcessResources
+	(*PoolStats)(nil),                     // 49: mcpv.control.v1.PoolStats
+	(*PoolMetrics)(nil),                   // 50: mcpv.control.v1.PoolMetrics
+	(*WatchServerInitStatusRequest)(nil),  // 51: mcpv.control.v1.WatchServerInitStatusRequest
+	(*ServerInitStatusSnapshot)(nil),      // 52: mcpv.control.v1.ServerInitStatusSnapshot
+	(*ServerInitStatus)(nil),              // 53: mcpv.control.v1.ServerInitStatus
+	(*AutomaticMCPRequest)(nil),           // 54: mcpv.control.v1.AutomaticMCPRequest
+	(*AutomaticMCPResponse)(nil),          // 55: mcpv.control.v1.AutomaticMCPResponse
+	(*AutomaticEvalRequest)(nil),          // 56: mcpv.control.v1.AutomaticEvalRequest
+	(*AutomaticEvalResponse)(nil),         // 57: mcpv.control.v1.AutomaticEvalResponse
+	(*IsSubAgentEnabledRequest)(nil),      // 58: mcpv.control.v1.IsSubAgentEnabledRequest
+	(*IsSubAgentEnabledResponse)(nil),     // 59: mcpv.control.v1.IsSubAgentEnabledResponse
+	(*GetQuotaStatusRequest)(nil),         // 60: mcpv.control.v1.GetQuotaStatusRequest
+	(*GetQuotaStatusResponse)(nil),        // 61: mcpv.control.v1.GetQuotaStatusResponse
+	(*QuotaStatus)(nil),                   // 62: mcpv.control.v1.QuotaStatus
+	(*ListCallersRequest)(nil),            // 63: mcpv.control.v1.ListCallersRequest
+	(*ListCallersResponse)(nil),           // 64: mcpv.control.v1.ListCallersResponse
+	(*ActiveCaller)(nil),                  // 65: mcpv.control.v1.ActiveCaller
+	(*QueryCallHistoryRequest)(nil),       // 66: mcpv.control.v1.QueryCallHistoryRequest
+	(*QueryCallHistoryResponse)(nil),      // 67: mcpv.control.v1.QueryCallHistoryResponse
+	(*CallRecord)(nil),                    // 68: mcpv.control.v1.CallRecord
+	(*GetToolStatsRequest)(nil),           // 69: mcpv.control.v1.GetToolStatsRequest
+	(*GetToolStatsResponse)(nil),          // 70: mcpv.control.v1.GetToolStatsResponse
+	(*ToolStats)(nil),                     // 71: mcpv.control.v1.ToolStats
+	(*ToolSLOStatus)(nil),                 // 72: mcpv.control.v1.ToolSLOStatus
+	(*ExportDiagnosticsRequest)(nil),      // 73: mcpv.control.v1.ExportDiagnosticsRequest
+	(*ExportDiagnosticsResponse)(nil),     // 74: mcpv.control.v1.ExportDiagnosticsResponse
+	(*WatchDiagnosticsEventsRequest)(nil), // 75: mcpv.control.v1.WatchDiagnosticsEventsRequest
+	(*DiagnosticsEvent)(nil),              // 76: mcpv.control.v1.DiagnosticsEvent
+	(*GetReadinessRequest)(nil),           // 77: mcpv.control.v1.GetReadinessRequest
+	(*GetReadinessResponse)(nil),          // 78: mcpv.control.v1.GetReadinessResponse
+	(*ReadinessCheck)(nil),                // 79: mcpv.control.v1.ReadinessCheck
+	(*ReadinessComponent)(nil),            // 80: mcpv.control.v1.ReadinessComponent
 }
 var file_mcpv_control_v1_control_proto_depIdxs = []int32{
-	10, // 0: mcpv.control.v1.ListToolsResponse.snapshot:type_name -> mcpv.control.v1.ToolsSnapshot
-	11, // 1: mcpv.control.v1.ToolsSnapshot.tools:type_name -> mcpv.control.v1.ToolDefinition
-	24, // 2: mcpv.control.v1.CallToolTaskResponse.task:type_name -> mcpv.control.v1.Task
-	24, // 3: mcpv.control.v1.TasksGetResponse.task:type_name -> mcpv.control.v1.Task
-	24, // 4: mcpv.control.v1.TasksListResponse.tasks:type_name -> mcpv.control.v1.Task
-	25, // 5: mcpv.control.v1.TasksResultResponse.result:type_name -> mcpv.control.v1.TaskResult
-	24, // 6: mcpv.control.v1.TasksCancelResponse.task:type_name -> mcpv.control.v1.Task
-	29, // 7: mcpv.control.v1.ListResourcesResponse.snapshot:type_name -> mcpv.control.v1.ResourcesSnapshot
-	30, // 8: mcpv.control.v1.ResourcesSnapshot.resources:type_name -> mcpv.control.v1.ResourceDefinition
-	36, // 9: mcpv.control.v1.ListPromptsResponse.snapshot:type_name -> mcpv.control.v1.PromptsSnapshot
-	37, // 10: mcpv.control.v1.PromptsSnapshot.prompts:type_name -> mcpv.control.v1.PromptDefinition
-	0,  // 11: mcpv.control.v1.StreamLogsRequest.min_level:type_name -> mcpv.control.v1.LogLevel
-	0,  // 12: mcpv.control.v1.LogEntry.level:type_name -> mcpv.control.v1.LogLevel
-	44, // 13: mcpv.control.v1.RuntimeStatusSnapshot.statuses:type_name -> mcpv.control.v1.ServerRuntimeStatus
-	45, // 14: mcpv.control.v1.ServerRuntimeStatus.instances:type_name -> mcpv.control.v1.InstanceStatus
-	46, // 15: mcpv.control.v1.ServerRuntimeStatus.stats:type_name -> mcpv.control.v1.PoolStats
-	47, // 16: mcpv.control.v1.ServerRuntimeStatus.metrics:type_name -> mcpv.control.v1.PoolMetrics
-	50, // 17: mcpv.control.v1.ServerInitStatusSnapshot.statuses:type_name -> mcpv.control.v1.ServerInitStatus
-	1,  // 18: mcpv.control.v1.ControlPlaneService.GetInfo:input_type -> mcpv.control.v1.GetInfoRequest
-	3,  // 19: mcpv.control.v1.ControlPlaneService.RegisterCaller:input_type -> mcpv.control.v1.RegisterCallerRequest
-	5,  // 20: mcpv.control.v1.ControlPlaneService.UnregisterCaller:input_type -> mcpv.control.v1.UnregisterCallerRequest
-	7,  // 21: mcpv.control.v1.ControlPlaneService.ListTools:input_type -> mcpv.control.v1.ListToolsRequest
-	9,  // 22: mcpv.control.v1.ControlPlaneService.WatchTools:input_type -> mcpv.control.v1.WatchToolsRequest
-	12, // 23: mcpv.control.v1.ControlPlaneService.CallTool:input_type -> mcpv.control.v1.CallToolRequest
-	14, // 24: mcpv.control.v1.ControlPlaneService.CallToolTask:input_type -> mcpv.control.v1.CallToolTaskRequest
-	16, // 25: mcpv.control.v1.ControlPlaneService.TasksGet:input_type -> mcpv.control.v1.TasksGetRequest
-	18, // 26: mcpv.control.v1.ControlPlaneService.TasksList:input_type -> mcpv.control.v1.TasksListRequest
-	20, // 27: mcpv.control.v1.ControlPlaneService.TasksResult:input_type -> mcpv.control.v1.TasksResultRequest
-	22, // 28: mcpv.control.v1.ControlPlaneService.TasksCancel:input_type -> mcpv.control.v1.TasksCancelRequest
-	26, // 29: mcpv.control.v1.ControlPlaneService.ListResources:input_type -> mcpv.control.v1.ListResourcesRequest
-	28, // 30: mcpv.control.v1.ControlPlaneService.WatchResources:input_type -> mcpv.control.v1.WatchResourcesRequest
-	31, // 31: mcpv.control.v1.ControlPlaneService.ReadResource:input_type -> mcpv.control.v1.ReadResourceRequest
-	33, // 32: mcpv.control.v1.ControlPlaneService.ListPrompts:input_type -> mcpv.control.v1.ListPromptsRequest
-	35, // 33: mcpv.control.v1.ControlPlaneService.WatchPrompts:input_type -> mcpv.control.v1.WatchPromptsRequest
-	38, // 34: mcpv.control.v1.ControlPlaneService.GetPrompt:input_type -> mcpv.control.v1.GetPromptRequest
-	40, // 35: mcpv.control.v1.ControlPlaneService.StreamLogs:input_type -> mcpv.control.v1.StreamLogsRequest
-	42, // 36: mcpv.control.v1.ControlPlaneService.WatchRuntimeStatus:input_type -> mcpv.control.v1.WatchRuntimeStatusRequest
-	48, // 37: mcpv.control.v1.ControlPlaneService.WatchServerInitStatus:input_type -> mcpv.control.v1.WatchServerInitStatusRequest
-	51, // 38: mcpv.control.v1.ControlPlaneService.AutomaticMCP:input_type -> mcpv.control.v1.AutomaticMCPRequest
-	53, // 39: mcpv.control.v1.ControlPlaneService.AutomaticEval:input_type -> mcpv.control.v1.AutomaticEvalRequest
-	55, // 40: mcpv.control.v1.ControlPlaneService.IsSubAgentEnabled:input_type -> mcpv.control.v1.IsSubAgentEnabledRequest
-	2,  // 41: mcpv.control.v1.ControlPlaneService.GetInfo:output_type -> mcpv.control.v1.GetInfoResponse
-	4,  // 42: mcpv.control.v1.ControlPlaneService.RegisterCaller:output_type -> mcpv.control.v1.RegisterCallerResponse
-	6,  // 43: mcpv.control.v1.ControlPlaneService.UnregisterCaller:output_type -> mcpv.control.v1.UnregisterCallerResponse
-	8,  // 44: mcpv.control.v1.ControlPlaneService.ListTools:output_type -> mcpv.control.v1.ListToolsResponse
-	10, // 45: mcpv.control.v1.ControlPlaneService.WatchTools:output_type -> mcpv.control.v1.ToolsSnapshot
-	13, // 46: mcpv.control.v1.ControlPlaneService.CallTool:output_type -> mcpv.control.v1.CallToolResponse
-	15, // 47: mcpv.control.v1.ControlPlaneService.CallToolTask:output_type -> mcpv.control.v1.CallToolTaskResponse
-	17, // 48: mcpv.control.v1.ControlPlaneService.TasksGet:output_type -> mcpv.control.v1.TasksGetResponse
-	19, // 49: mcpv.control.v1.ControlPlaneService.TasksList:output_type -> mcpv.control.v1.TasksListResponse
-	21, // 50: mcpv.control.v1.ControlPlaneService.TasksResult:output_type -> mcpv.control.v1.TasksResultResponse
-	23, // 51: mcpv.control.v1.ControlPlaneService.TasksCancel:output_type -> mcpv.control.v1.TasksCancelResponse
-	27, // 52: mcpv.control.v1.ControlPlaneService.ListResources:output_type -> mcpv.control.v1.ListResourcesResponse
-	29, // 53: mcpv.control.v1.ControlPlaneService.WatchResources:output_type -> mcpv.control.v1.ResourcesSnapshot
-	32, // 54: mcpv.control.v1.ControlPlaneService.ReadResource:output_type -> mcpv.control.v1.ReadResourceResponse
-	34, // 55: mcpv.control.v1.ControlPlaneService.ListPrompts:output_type -> mcpv.control.v1.ListPromptsResponse
-	36, // 56: mcpv.control.v1.ControlPlaneService.WatchPrompts:output_type -> mcpv.control.v1.PromptsSnapshot
-	39, // 57: mcpv.control.v1.ControlPlaneService.GetPrompt:output_type -> mcpv.control.v1.GetPromptResponse
-	41, // 58: mcpv.control.v1.ControlPlaneService.StreamLogs:output_type -> mcpv.control.v1.LogEntry
-	43, // 59: mcpv.control.v1.ControlPlaneService.WatchRuntimeStatus:output_type -> mcpv.control.v1.RuntimeStatusSnapshot
-	49, // 60: mcpv.control.v1.ControlPlaneService.WatchServerInitStatus:output_type -> mcpv.control.v1.ServerInitStatusSnapshot
-	52, // 61: mcpv.control.v1.ControlPlaneService.AutomaticMCP:output_type -> mcpv.control.v1.AutomaticMCPResponse
-	54, // 62: mcpv.control.v1.ControlPlaneService.AutomaticEval:output_type -> mcpv.control.v1.AutomaticEvalResponse
-	56, // 63: mcpv.control.v1.ControlPlaneService.IsSubAgentEnabled:output_type -> mcpv.control.v1.IsSubAgentEnabledResponse
-	41, // [41:64] is the sub-list for method output_type
-	18, // [18:41] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	4,  // 0: mcpv.control.v1.RegisterCallerRequest.client_info:type_name -> mcpv.control.v1.ClientInfo
+	5,  // 1: mcpv.control.v1.ClientInfo.root_list:type_name -> mcpv.control.v1.Root
+	12, // 2: mcpv.control.v1.ListToolsResponse.snapshot:type_name -> mcpv.control.v1.ToolsSnapshot
+	13, // 3: mcpv.control.v1.ToolsSnapshot.tools:type_name -> mcpv.control.v1.ToolDefinition
+	26, // 4: mcpv.control.v1.CallToolTaskResponse.task:type_name -> mcpv.control.v1.Task
+	26, // 5: mcpv.control.v1.TasksGetResponse.task:type_name -> mcpv.control.v1.Task
+	26, // 6: mcpv.control.v1.TasksListResponse.tasks:type_name -> mcpv.control.v1.Task
+	27, // 7: mcpv.control.v1.TasksResultResponse.result:type_name -> mcpv.control.v1.TaskResult
+	26, // 8: mcpv.control.v1.TasksCancelResponse.task:type_name -> mcpv.control.v1.Task
+	31, // 9: mcpv.control.v1.ListResourcesResponse.snapshot:type_name -> mcpv.control.v1.ResourcesSnapshot
+	32, // 10: mcpv.control.v1.ResourcesSnapshot.resources:type_name -> mcpv.control.v1.ResourceDefinition
+	38, // 11: mcpv.control.v1.ListPromptsResponse.snapshot:type_name -> mcpv.control.v1.PromptsSnapshot
+	39, // 12: mcpv.control.v1.PromptsSnapshot.prompts:type_name -> mcpv.control.v1.PromptDefinition
+	0,  // 13: mcpv.control.v1.StreamLogsRequest.min_level:type_name -> mcpv.control.v1.LogLevel
+	0,  // 14: mcpv.control.v1.LogEntry.level:type_name -> mcpv.control.v1.LogLevel
+	46, // 15: mcpv.control.v1.RuntimeStatusSnapshot.statuses:type_name -> mcpv.control.v1.ServerRuntimeStatus
+	47, // 16: mcpv.control.v1.ServerRuntimeStatus.instances:type_name -> mcpv.control.v1.InstanceStatus
+	49, // 17: mcpv.control.v1.ServerRuntimeStatus.stats:type_name -> mcpv.control.v1.PoolStats
+	50, // 18: mcpv.control.v1.ServerRuntimeStatus.metrics:type_name -> mcpv.control.v1.PoolMetrics
+	48, // 19: mcpv.control.v1.InstanceStatus.resources:type_name -> mcpv.control.v1.ProcessResources
+	53, // 20: mcpv.control.v1.ServerInitStatusSnapshot.statuses:type_name -> mcpv.control.v1.ServerInitStatus
+	62, // 21: mcpv.control.v1.GetQuotaStatusResponse.statuses:type_name -> mcpv.control.v1.QuotaStatus
+	65, // 22: mcpv.control.v1.ListCallersResponse.callers:type_name -> mcpv.control.v1.ActiveCaller
+	4,  // 23: mcpv.control.v1.ActiveCaller.client_info:type_name -> mcpv.control.v1.ClientInfo
+	68, // 24: mcpv.control.v1.QueryCallHistoryResponse.records:type_name -> mcpv.control.v1.CallRecord
+	71, // 25: mcpv.control.v1.GetToolStatsResponse.tools:type_name -> mcpv.control.v1.ToolStats
+	72, // 26: mcpv.control.v1.GetToolStatsResponse.slos:type_name -> mcpv.control.v1.ToolSLOStatus
+	79, // 27: mcpv.control.v1.GetReadinessResponse.checks:type_name -> mcpv.control.v1.ReadinessCheck
+	80, // 28: mcpv.control.v1.ReadinessCheck.components:type_name -> mcpv.control.v1.ReadinessComponent
+	1,  // 29: mcpv.control.v1.ControlPlaneService.GetInfo:input_type -> mcpv.control.v1.GetInfoRequest
+	3,  // 30: mcpv.control.v1.ControlPlaneService.RegisterCaller:input_type -> mcpv.control.v1.RegisterCallerRequest
+	7,  // 31: mcpv.control.v1.ControlPlaneService.UnregisterCaller:input_type -> mcpv.control.v1.UnregisterCallerRequest
+	9,  // 32: mcpv.control.v1.ControlPlaneService.ListTools:input_type -> mcpv.control.v1.ListToolsRequest
+	11, // 33: mcpv.control.v1.ControlPlaneService.WatchTools:input_type -> mcpv.control.v1.WatchToolsRequest
+	14, // 34: mcpv.control.v1.ControlPlaneService.CallTool:input_type -> mcpv.control.v1.CallToolRequest
+	16, // 35: mcpv.control.v1.ControlPlaneService.CallToolTask:input_type -> mcpv.control.v1.CallToolTaskRequest
+	18, // 36: mcpv.control.v1.ControlPlaneService.TasksGet:input_type -> mcpv.control.v1.TasksGetRequest
+	20, // 37: mcpv.control.v1.ControlPlaneService.TasksList:input_type -> mcpv.control.v1.TasksListRequest
+	22, // 38: mcpv.control.v1.ControlPlaneService.TasksResult:input_type -> mcpv.control.v1.TasksResultRequest
+	24, // 39: mcpv.control.v1.ControlPlaneService.TasksCancel:input_type -> mcpv.control.v1.TasksCancelRequest
+	28, // 40: mcpv.control.v1.ControlPlaneService.ListResources:input_type -> mcpv.control.v1.ListResourcesRequest
+	30, // 41: mcpv.control.v1.ControlPlaneService.WatchResources:input_type -> mcpv.control.v1.WatchResourcesRequest
+	33, // 42: mcpv.control.v1.ControlPlaneService.ReadResource:input_type -> mcpv.control.v1.ReadResourceRequest
+	35, // 43: mcpv.control.v1.ControlPlaneService.ListPrompts:input_type -> mcpv.control.v1.ListPromptsRequest
+	37, // 44: mcpv.control.v1.ControlPlaneService.WatchPrompts:input_type -> mcpv.control.v1.WatchPromptsRequest
+	40, // 45: mcpv.control.v1.ControlPlaneService.GetPrompt:input_type -> mcpv.control.v1.GetPromptRequest
+	42, // 46: mcpv.control.v1.ControlPlaneService.StreamLogs:input_type -> mcpv.control.v1.StreamLogsRequest
+	44, // 47: mcpv.control.v1.ControlPlaneService.WatchRuntimeStatus:input_type -> mcpv.control.v1.WatchRuntimeStatusRequest
+	51, // 48: mcpv.control.v1.ControlPlaneService.WatchServerInitStatus:input_type -> mcpv.control.v1.WatchServerInitStatusRequest
+	54, // 49: mcpv.control.v1.ControlPlaneService.AutomaticMCP:input_type -> mcpv.control.v1.AutomaticMCPRequest
+	56, // 50: mcpv.control.v1.ControlPlaneService.AutomaticEval:input_type -> mcpv.control.v1.AutomaticEvalRequest
+	58, // 51: mcpv.control.v1.ControlPlaneService.IsSubAgentEnabled:input_type -> mcpv.control.v1.IsSubAgentEnabledRequest
+	60, // 52: mcpv.control.v1.ControlPlaneService.GetQuotaStatus:input_type -> mcpv.control.v1.GetQuotaStatusRequest
+	63, // 53: mcpv.control.v1.ControlPlaneService.ListCallers:input_type -> mcpv.control.v1.ListCallersRequest
+	66, // 54: mcpv.control.v1.ControlPlaneService.QueryCallHistory:input_type -> mcpv.control.v1.QueryCallHistoryRequest
+	69, // 55: mcpv.control.v1.ControlPlaneService.GetToolStats:input_type -> mcpv.control.v1.GetToolStatsRequest
+	73, // 56: mcpv.control.v1.ControlPlaneService.ExportDiagnostics:input_type -> mcpv.control.v1.ExportDiagnosticsRequest
+	75, // 57: mcpv.control.v1.ControlPlaneService.WatchDiagnosticsEvents:input_type -> mcpv.control.v1.WatchDiagnosticsEventsRequest
+	77, // 58: mcpv.control.v1.ControlPlaneService.GetReadiness:input_type -> mcpv.control.v1.GetReadinessRequest
+	2,  // 59: mcpv.control.v1.ControlPlaneService.GetInfo:output_type -> mcpv.control.v1.GetInfoResponse
+	6,  // 60: mcpv.control.v1.ControlPlaneService.RegisterCaller:output_type -> mcpv.control.v1.RegisterCallerResponse
+	8,  // 61: mcpv.control.v1.ControlPlaneService.UnregisterCaller:output_type -> mcpv.control.v1.UnregisterCallerResponse
+	10, // 62: mcpv.control.v1.ControlPlaneService.ListTools:output_type -> mcpv.control.v1.ListToolsResponse
+	12, // 63: mcpv.control.v1.ControlPlaneService.WatchTools:output_type -> mcpv.control.v1.ToolsSnapshot
+	15, // 64: mcpv.control.v1.ControlPlaneService.CallTool:output_type -> mcpv.control.v1.CallToolResponse
+	17, // 65: mcpv.control.v1.ControlPlaneService.CallToolTask:output_type -> mcpv.control.v1.CallToolTaskResponse
+	19, // 66: mcpv.control.v1.ControlPlaneService.TasksGet:output_type -> mcpv.control.v1.TasksGetResponse
+	21, // 67: mcpv.control.v1.ControlPlaneService.TasksList:output_type -> mcpv.control.v1.TasksListResponse
+	23, // 68: mcpv.control.v1.ControlPlaneService.TasksResult:output_type -> mcpv.control.v1.TasksResultResponse
+	25, // 69: mcpv.control.v1.ControlPlaneService.TasksCancel:output_type -> mcpv.control.v1.TasksCancelResponse
+	29, // 70: mcpv.control.v1.ControlPlaneService.ListResources:output_type -> mcpv.control.v1.ListResourcesResponse
+	31, // 71: mcpv.control.v1.ControlPlaneService.WatchResources:output_type -> mcpv.control.v1.ResourcesSnapshot
+	34, // 72: mcpv.control.v1.ControlPlaneService.ReadResource:output_type -> mcpv.control.v1.ReadResourceResponse
+	36, // 73: mcpv.control.v1.ControlPlaneService.ListPrompts:output_type -> mcpv.control.v1.ListPromptsResponse
+	38, // 74: mcpv.control.v1.ControlPlaneService.WatchPrompts:output_type -> mcpv.control.v1.PromptsSnapshot
+	41, // 75: mcpv.control.v1.ControlPlaneService.GetPrompt:output_type -> mcpv.control.v1.GetPromptResponse
+	43, // 76: mcpv.control.v1.ControlPlaneService.StreamLogs:output_type -> mcpv.control.v1.LogEntry
+	45, // 77: mcpv.control.v1.ControlPlaneService.WatchRuntimeStatus:output_type -> mcpv.control.v1.RuntimeStatusSnapshot
+	52, // 78: mcpv.control.v1.ControlPlaneService.WatchServerInitStatus:output_type -> mcpv.control.v1.ServerInitStatusSnapshot
+	55, // 79: mcpv.control.v1.ControlPlaneService.AutomaticMCP:output_type -> mcpv.control.v1.AutomaticMCPResponse
+	57, // 80: mcpv.control.v1.ControlPlaneService.AutomaticEval:output_type -> mcpv.control.v1.AutomaticEvalResponse
+	59, // 81: mcpv.control.v1.ControlPlaneService.IsSubAgentEnabled:output_type -> mcpv.control.v1.IsSubAgentEnabledResponse
+	61, // 82: mcpv.control.v1.ControlPlaneService.GetQuotaStatus:output_type -> mcpv.control.v1.GetQuotaStatusResponse
+	64, // 83: mcpv.control.v1.ControlPlaneService.ListCallers:output_type -> mcpv.control.v1.ListCallersResponse
+	67, // 84: mcpv.control.v1.ControlPlaneService.QueryCallHistory:output_type -> mcpv.control.v1.QueryCallHistoryResponse
+	70, // 85: mcpv.control.v1.ControlPlaneService.GetToolStats:output_type -> mcpv.control.v1.GetToolStatsResponse
+	74, // 86: mcpv.control.v1.ControlPlaneService.ExportDiagnostics:output_type -> mcpv.control.v1.ExportDiagnosticsResponse
+	76, // 87: mcpv.control.v1.ControlPlaneService.WatchDiagnosticsEvents:output_type -> mcpv.control.v1.DiagnosticsEvent
+	78, // 88: mcpv.control.v1.ControlPlaneService.GetReadiness:output_type -> mcpv.control.v1.GetReadinessResponse
+	59, // [59:89] is the sub-list for method output_type
+	29, // [29:59] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_mcpv_control_v1_control_proto_init() }
@@ -3697,7 +5904,7 @@ func file_mcpv_control_v1_control_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcpv_control_v1_control_proto_rawDesc), len(file_mcpv_control_v1_control_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   56,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,29 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ControlPlaneService_GetInfo_FullMethodName               = "/mcpv.control.v1.ControlPlaneService/GetInfo"
-	ControlPlaneService_RegisterCaller_FullMethodName        = "/mcpv.control.v1.ControlPlaneService/RegisterCaller"
-	ControlPlaneService_UnregisterCaller_FullMethodName      = "/mcpv.control.v1.ControlPlaneService/UnregisterCaller"
-	ControlPlaneService_ListTools_FullMethodName             = "/mcpv.control.v1.ControlPlaneService/ListTools"
-	ControlPlaneService_WatchTools_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/WatchTools"
-	ControlPlaneService_CallTool_FullMethodName              = "/mcpv.control.v1.ControlPlaneService/CallTool"
-	ControlPlaneService_CallToolTask_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/CallToolTask"
-	ControlPlaneService_TasksGet_FullMethodName              = "/mcpv.control.v1.ControlPlaneService/TasksGet"
-	ControlPlaneService_TasksList_FullMethodName             = "/mcpv.control.v1.ControlPlaneService/TasksList"
-	ControlPlaneService_TasksResult_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/TasksResult"
-	ControlPlaneService_TasksCancel_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/TasksCancel"
-	ControlPlaneService_ListResources_FullMethodName         = "/mcpv.control.v1.ControlPlaneService/ListResources"
-	ControlPlaneService_WatchResources_FullMethodName        = "/mcpv.control.v1.ControlPlaneService/WatchResources"
-	ControlPlaneService_ReadResource_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/ReadResource"
-	ControlPlaneService_ListPrompts_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/ListPrompts"
-	ControlPlaneService_WatchPrompts_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/WatchPrompts"
-	ControlPlaneService_GetPrompt_FullMethodName             = "/mcpv.control.v1.ControlPlaneService/GetPrompt"
-	ControlPlaneService_StreamLogs_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/StreamLogs"
-	ControlPlaneService_WatchRuntimeStatus_FullMethodName    = "/mcpv.control.v1.ControlPlaneService/WatchRuntimeStatus"
-	ControlPlaneService_WatchServerInitStatus_FullMethodName = "/mcpv.control.v1.ControlPlaneService/WatchServerInitStatus"
-	ControlPlaneService_AutomaticMCP_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/AutomaticMCP"
-	ControlPlaneService_AutomaticEval_FullMethodName         = "/mcpv.control.v1.ControlPlaneService/AutomaticEval"
-	ControlPlaneService_IsSubAgentEnabled_FullMethodName     = "/mcpv.control.v1.ControlPlaneService/IsSubAgentEnabled"
+	ControlPlaneService_GetInfo_FullMethodName                = "/mcpv.control.v1.ControlPlaneService/GetInfo"
+	ControlPlaneService_RegisterCaller_FullMethodName         = "/mcpv.control.v1.ControlPlaneService/RegisterCaller"
+	ControlPlaneService_UnregisterCaller_FullMethodName       = "/mcpv.control.v1.ControlPlaneService/UnregisterCaller"
+	ControlPlaneService_ListTools_FullMethodName              = "/mcpv.control.v1.ControlPlaneService/ListTools"
+	ControlPlaneService_WatchTools_FullMethodName             = "/mcpv.control.v1.ControlPlaneService/WatchTools"
+	ControlPlaneService_CallTool_FullMethodName               = "/mcpv.control.v1.ControlPlaneService/CallTool"
+	ControlPlaneService_CallToolTask_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/CallToolTask"
+	ControlPlaneService_TasksGet_FullMethodName               = "/mcpv.control.v1.ControlPlaneService/TasksGet"
+	ControlPlaneService_TasksList_FullMethodName              = "/mcpv.control.v1.ControlPlaneService/TasksList"
+	ControlPlaneService_TasksResult_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/TasksResult"
+	ControlPlaneService_TasksCancel_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/TasksCancel"
+	ControlPlaneService_ListResources_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/ListResources"
+	ControlPlaneService_WatchResources_FullMethodName         = "/mcpv.control.v1.ControlPlaneService/WatchResources"
+	ControlPlaneService_ReadResource_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/ReadResource"
+	ControlPlaneService_ListPrompts_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/ListPrompts"
+	ControlPlaneService_WatchPrompts_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/WatchPrompts"
+	ControlPlaneService_GetPrompt_FullMethodName              = "/mcpv.control.v1.ControlPlaneService/GetPrompt"
+	ControlPlaneService_StreamLogs_FullMethodName             = "/mcpv.control.v1.ControlPlaneService/StreamLogs"
+	ControlPlaneService_WatchRuntimeStatus_FullMethodName     = "/mcpv.control.v1.ControlPlaneService/WatchRuntimeStatus"
+	ControlPlaneService_WatchServerInitStatus_FullMethodName  = "/mcpv.control.v1.ControlPlaneService/WatchServerInitStatus"
+	ControlPlaneService_AutomaticMCP_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/AutomaticMCP"
+	ControlPlaneService_AutomaticEval_FullMethodName          = "/mcpv.control.v1.ControlPlaneService/AutomaticEval"
+	ControlPlaneService_IsSubAgentEnabled_FullMethodName      = "/mcpv.control.v1.ControlPlaneService/IsSubAgentEnabled"
+	ControlPlaneService_GetQuotaStatus_FullMethodName         = "/mcpv.control.v1.ControlPlaneService/GetQuotaStatus"
+	ControlPlaneService_ListCallers_FullMethodName            = "/mcpv.control.v1.ControlPlaneService/ListCallers"
+	ControlPlaneService_QueryCallHistory_FullMethodName       = "/mcpv.control.v1.ControlPlaneService/QueryCallHistory"
+	ControlPlaneService_GetToolStats_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/GetToolStats"
+	ControlPlaneService_ExportDiagnostics_FullMethodName      = "/mcpv.control.v1.ControlPlaneService/ExportDiagnostics"
+	ControlPlaneService_WatchDiagnosticsEvents_FullMethodName = "/mcpv.control.v1.ControlPlaneService/WatchDiagnosticsEvents"
+	ControlPlaneService_GetReadiness_FullMethodName           = "/mcpv.control.v1.ControlPlaneService/GetReadiness"
 )
 
 // ControlPlaneServiceClient is the client API for ControlPlaneService service.
@@ -72,6 +79,20 @@ type ControlPlaneServiceClient interface {
 	AutomaticMCP(ctx context.Context, in *AutomaticMCPRequest, opts ...grpc.CallOption) (*AutomaticMCPResponse, error)
 	AutomaticEval(ctx context.Context, in *AutomaticEvalRequest, opts ...grpc.CallOption) (*AutomaticEvalResponse, error)
 	IsSubAgentEnabled(ctx context.Context, in *IsSubAgentEnabledRequest, opts ...grpc.CallOption) (*IsSubAgentEnabledResponse, error)
+	// Built-in rate limits and daily quotas
+	GetQuotaStatus(ctx context.Context, in *GetQuotaStatusRequest, opts ...grpc.CallOption) (*GetQuotaStatusResponse, error)
+	// Registered callers with their client info
+	ListCallers(ctx context.Context, in *ListCallersRequest, opts ...grpc.CallOption) (*ListCallersResponse, error)
+	// Persistent journal of governed tool calls
+	QueryCallHistory(ctx context.Context, in *QueryCallHistoryRequest, opts ...grpc.CallOption) (*QueryCallHistoryResponse, error)
+	// Per-tool latency percentiles and SLO burn rates
+	GetToolStats(ctx context.Context, in *GetToolStatsRequest, opts ...grpc.CallOption) (*GetToolStatsResponse, error)
+	// Diagnostics bundle with events, logs, snapshots and report as a zip archive
+	ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (*ExportDiagnosticsResponse, error)
+	// Diagnostics step events with buffered replay and live follow
+	WatchDiagnosticsEvents(ctx context.Context, in *WatchDiagnosticsEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsEvent], error)
+	// Per-criterion readiness breakdown, the same data /readyz serves
+	GetReadiness(ctx context.Context, in *GetReadinessRequest, opts ...grpc.CallOption) (*GetReadinessResponse, error)
 }
 
 type controlPlaneServiceClient struct {
@@ -366,6 +387,85 @@ func (c *controlPlaneServiceClient) IsSubAgentEnabled(ctx context.Context, in *I
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) GetQuotaStatus(ctx context.Context, in *GetQuotaStatusRequest, opts ...grpc.CallOption) (*GetQuotaStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaStatusResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetQuotaStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListCallers(ctx context.Context, in *ListCallersRequest, opts ...grpc.CallOption) (*ListCallersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCallersResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListCallers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) QueryCallHistory(ctx context.Context, in *QueryCallHistoryRequest, opts ...grpc.CallOption) (*QueryCallHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCallHistoryResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_QueryCallHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) GetToolStats(ctx context.Context, in *GetToolStatsRequest, opts ...grpc.CallOption) (*GetToolStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetToolStatsResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetToolStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (*ExportDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ExportDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) WatchDiagnosticsEvents(ctx context.Context, in *WatchDiagnosticsEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlPlaneService_ServiceDesc.Streams[6], ControlPlaneService_WatchDiagnosticsEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDiagnosticsEventsRequest, DiagnosticsEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlaneService_WatchDiagnosticsEventsClient = grpc.ServerStreamingClient[DiagnosticsEvent]
+
+func (c *controlPlaneServiceClient) GetReadiness(ctx context.Context, in *GetReadinessRequest, opts ...grpc.CallOption) (*GetReadinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadinessResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetReadiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlPlaneServiceServer is the server API for ControlPlaneService service.
 // All implementations must embed UnimplementedControlPlaneServiceServer
 // for forward compatibility.
@@ -394,6 +494,20 @@ type ControlPlaneServiceServer interface {
 	AutomaticMCP(context.Context, *AutomaticMCPRequest) (*AutomaticMCPResponse, error)
 	AutomaticEval(context.Context, *AutomaticEvalRequest) (*AutomaticEvalResponse, error)
 	IsSubAgentEnabled(context.Context, *IsSubAgentEnabledRequest) (*IsSubAgentEnabledResponse, error)
+	// Built-in rate limits and daily quotas
+	GetQuotaStatus(context.Context, *GetQuotaStatusRequest) (*GetQuotaStatusResponse, error)
+	// Registered callers with their client info
+	ListCallers(context.Context, *ListCallersRequest) (*ListCallersResponse, error)
+	// Persistent journal of governed tool calls
+	QueryCallHistory(context.Context, *QueryCallHistoryRequest) (*QueryCallHistoryResponse, error)
+	// Per-tool latency percentiles and SLO burn rates
+	GetToolStats(context.Context, *GetToolStatsRequest) (*GetToolStatsResponse, error)
+	// Diagnostics bundle with events, logs, snapshots and report as a zip archive
+	ExportDiagnostics(context.Context, *ExportDiagnosticsRequest) (*ExportDiagnosticsResponse, error)
+	// Diagnostics step events with buffered replay and live follow
+	WatchDiagnosticsEvents(*WatchDiagnosticsEventsRequest, grpc.ServerStreamingServer[DiagnosticsEvent]) error
+	// Per-criterion readiness breakdown, the same data /readyz serves
+	GetReadiness(context.Context, *GetReadinessRequest) (*GetReadinessResponse, error)
 	mustEmbedUnimplementedControlPlaneServiceServer()
 }
 
@@ -473,6 +587,27 @@ func (UnimplementedControlPlaneServiceServer) AutomaticEval(context.Context, *Au
 func (UnimplementedControlPlaneServiceServer) IsSubAgentEnabled(context.Context, *IsSubAgentEnabledRequest) (*IsSubAgentEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSubAgentEnabled not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) GetQuotaStatus(context.Context, *GetQuotaStatusRequest) (*GetQuotaStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaStatus not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListCallers(context.Context, *ListCallersRequest) (*ListCallersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallers not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) QueryCallHistory(context.Context, *QueryCallHistoryRequest) (*QueryCallHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCallHistory not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) GetToolStats(context.Context, *GetToolStatsRequest) (*GetToolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToolStats not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ExportDiagnostics(context.Context, *ExportDiagnosticsRequest) (*ExportDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDiagnostics not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) WatchDiagnosticsEvents(*WatchDiagnosticsEventsRequest, grpc.ServerStreamingServer[DiagnosticsEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDiagnosticsEvents not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) GetReadiness(context.Context, *GetReadinessRequest) (*GetReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadiness not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) mustEmbedUnimplementedControlPlaneServiceServer() {}
 func (UnimplementedControlPlaneServiceServer) testEmbeddedByValue()                             {}
 
@@ -866,6 +1001,125 @@ func _ControlPlaneService_IsSubAgentEnabled_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_GetQuotaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetQuotaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetQuotaStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetQuotaStatus(ctx, req.(*GetQuotaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListCallers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListCallers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListCallers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListCallers(ctx, req.(*ListCallersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_QueryCallHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).QueryCallHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_QueryCallHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).QueryCallHistory(ctx, req.(*QueryCallHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_GetToolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetToolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetToolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetToolStats(ctx, req.(*GetToolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ExportDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ExportDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ExportDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ExportDiagnostics(ctx, req.(*ExportDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_WatchDiagnosticsEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDiagnosticsEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlPlaneServiceServer).WatchDiagnosticsEvents(m, &grpc.GenericServerStream[WatchDiagnosticsEventsRequest, DiagnosticsEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlaneService_WatchDiagnosticsEventsServer = grpc.ServerStreamingServer[DiagnosticsEvent]
+
+func _ControlPlaneService_GetReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetReadiness(ctx, req.(*GetReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControlPlaneService_ServiceDesc is the grpc.ServiceDesc for ControlPlaneService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -941,6 +1195,30 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsSubAgentEnabled",
 			Handler:    _ControlPlaneService_IsSubAgentEnabled_Handler,
 		},
+		{
+			MethodName: "GetQuotaStatus",
+			Handler:    _ControlPlaneService_GetQuotaStatus_Handler,
+		},
+		{
+			MethodName: "ListCallers",
+			Handler:    _ControlPlaneService_ListCallers_Handler,
+		},
+		{
+			MethodName: "QueryCallHistory",
+			Handler:    _ControlPlaneService_QueryCallHistory_Handler,
+		},
+		{
+			MethodName: "GetToolStats",
+			Handler:    _ControlPlaneService_GetToolStats_Handler,
+		},
+		{
+			MethodName: "ExportDiagnostics",
+			Handler:    _ControlPlaneService_ExportDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetReadiness",
+			Handler:    _ControlPlaneService_GetReadiness_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -973,6 +1251,11 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ControlPlaneService_WatchServerInitStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchDiagnosticsEvents",
+			Handler:       _ControlPlaneService_WatchDiagnosticsEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "mcpv/control/v1/control.proto",
 }
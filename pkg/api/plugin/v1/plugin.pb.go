@@ -353,8 +353,12 @@ type PluginHandleResponse struct {
 	ResponseJson  []byte                 `protobuf:"bytes,3,opt,name=response_json,json=responseJson,proto3" json:"response_json,omitempty"`
 	RejectCode    string                 `protobuf:"bytes,4,opt,name=reject_code,json=rejectCode,proto3" json:"reject_code,omitempty"`
 	RejectMessage string                 `protobuf:"bytes,5,opt,name=reject_message,json=rejectMessage,proto3" json:"reject_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Metadata keys to add or overwrite for later plugins and the upstream call.
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Metadata keys to remove.
+	RemoveMetadata []string `protobuf:"bytes,7,rep,name=remove_metadata,json=removeMetadata,proto3" json:"remove_metadata,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PluginHandleResponse) Reset() {
@@ -422,6 +426,20 @@ func (x *PluginHandleResponse) GetRejectMessage() string {
 	return ""
 }
 
+func (x *PluginHandleResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PluginHandleResponse) GetRemoveMetadata() []string {
+	if x != nil {
+		return x.RemoveMetadata
+	}
+	return nil
+}
+
 var File_mcpv_plugin_v1_plugin_proto protoreflect.FileDescriptor
 
 const file_mcpv_plugin_v1_plugin_proto_rawDesc = "" +
@@ -457,14 +475,19 @@ const file_mcpv_plugin_v1_plugin_proto_rawDesc = "" +
 	"\bmetadata\x18\v \x03(\v21.mcpv.plugin.v1.PluginHandleRequest.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc2\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x02\n" +
 	"\x14PluginHandleResponse\x12\x1a\n" +
 	"\bcontinue\x18\x01 \x01(\bR\bcontinue\x12!\n" +
 	"\frequest_json\x18\x02 \x01(\fR\vrequestJson\x12#\n" +
 	"\rresponse_json\x18\x03 \x01(\fR\fresponseJson\x12\x1f\n" +
 	"\vreject_code\x18\x04 \x01(\tR\n" +
 	"rejectCode\x12%\n" +
-	"\x0ereject_message\x18\x05 \x01(\tR\rrejectMessage2\xf4\x03\n" +
+	"\x0ereject_message\x18\x05 \x01(\tR\rrejectMessage\x12N\n" +
+	"\bmetadata\x18\x06 \x03(\v22.mcpv.plugin.v1.PluginHandleResponse.MetadataEntryR\bmetadata\x12'\n" +
+	"\x0fremove_metadata\x18\a \x03(\tR\x0eremoveMetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xf4\x03\n" +
 	"\rPluginService\x12E\n" +
 	"\vGetMetadata\x12\x16.google.protobuf.Empty\x1a\x1e.mcpv.plugin.v1.PluginMetadata\x12\\\n" +
 	"\tConfigure\x12&.mcpv.plugin.v1.PluginConfigureRequest\x1a'.mcpv.plugin.v1.PluginConfigureResponse\x12I\n" +
@@ -486,7 +509,7 @@ func file_mcpv_plugin_v1_plugin_proto_rawDescGZIP() []byte {
 	return file_mcpv_plugin_v1_plugin_proto_rawDescData
 }
 
-var file_mcpv_plugin_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mcpv_plugin_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_mcpv_plugin_v1_plugin_proto_goTypes = []any{
 	(*PluginMetadata)(nil),          // 0: mcpv.plugin.v1.PluginMetadata
 	(*PluginConfigureRequest)(nil),  // 1: mcpv.plugin.v1.PluginConfigureRequest
@@ -495,27 +518,29 @@ var file_mcpv_plugin_v1_plugin_proto_goTypes = []any{
 	(*PluginHandleRequest)(nil),     // 4: mcpv.plugin.v1.PluginHandleRequest
 	(*PluginHandleResponse)(nil),    // 5: mcpv.plugin.v1.PluginHandleResponse
 	nil,                             // 6: mcpv.plugin.v1.PluginHandleRequest.MetadataEntry
-	(*emptypb.Empty)(nil),           // 7: google.protobuf.Empty
+	nil,                             // 7: mcpv.plugin.v1.PluginHandleResponse.MetadataEntry
+	(*emptypb.Empty)(nil),           // 8: google.protobuf.Empty
 }
 var file_mcpv_plugin_v1_plugin_proto_depIdxs = []int32{
 	6, // 0: mcpv.plugin.v1.PluginHandleRequest.metadata:type_name -> mcpv.plugin.v1.PluginHandleRequest.MetadataEntry
-	7, // 1: mcpv.plugin.v1.PluginService.GetMetadata:input_type -> google.protobuf.Empty
-	1, // 2: mcpv.plugin.v1.PluginService.Configure:input_type -> mcpv.plugin.v1.PluginConfigureRequest
-	7, // 3: mcpv.plugin.v1.PluginService.CheckReady:input_type -> google.protobuf.Empty
-	4, // 4: mcpv.plugin.v1.PluginService.HandleRequest:input_type -> mcpv.plugin.v1.PluginHandleRequest
-	4, // 5: mcpv.plugin.v1.PluginService.HandleResponse:input_type -> mcpv.plugin.v1.PluginHandleRequest
-	7, // 6: mcpv.plugin.v1.PluginService.Shutdown:input_type -> google.protobuf.Empty
-	0, // 7: mcpv.plugin.v1.PluginService.GetMetadata:output_type -> mcpv.plugin.v1.PluginMetadata
-	2, // 8: mcpv.plugin.v1.PluginService.Configure:output_type -> mcpv.plugin.v1.PluginConfigureResponse
-	3, // 9: mcpv.plugin.v1.PluginService.CheckReady:output_type -> mcpv.plugin.v1.PluginReadyResponse
-	5, // 10: mcpv.plugin.v1.PluginService.HandleRequest:output_type -> mcpv.plugin.v1.PluginHandleResponse
-	5, // 11: mcpv.plugin.v1.PluginService.HandleResponse:output_type -> mcpv.plugin.v1.PluginHandleResponse
-	7, // 12: mcpv.plugin.v1.PluginService.Shutdown:output_type -> google.protobuf.Empty
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 1: mcpv.plugin.v1.PluginHandleResponse.metadata:type_name -> mcpv.plugin.v1.PluginHandleResponse.MetadataEntry
+	8, // 2: mcpv.plugin.v1.PluginService.GetMetadata:input_type -> google.protobuf.Empty
+	1, // 3: mcpv.plugin.v1.PluginService.Configure:input_type -> mcpv.plugin.v1.PluginConfigureRequest
+	8, // 4: mcpv.plugin.v1.PluginService.CheckReady:input_type -> google.protobuf.Empty
+	4, // 5: mcpv.plugin.v1.PluginService.HandleRequest:input_type -> mcpv.plugin.v1.PluginHandleRequest
+	4, // 6: mcpv.plugin.v1.PluginService.HandleResponse:input_type -> mcpv.plugin.v1.PluginHandleRequest
+	8, // 7: mcpv.plugin.v1.PluginService.Shutdown:input_type -> google.protobuf.Empty
+	0, // 8: mcpv.plugin.v1.PluginService.GetMetadata:output_type -> mcpv.plugin.v1.PluginMetadata
+	2, // 9: mcpv.plugin.v1.PluginService.Configure:output_type -> mcpv.plugin.v1.PluginConfigureResponse
+	3, // 10: mcpv.plugin.v1.PluginService.CheckReady:output_type -> mcpv.plugin.v1.PluginReadyResponse
+	5, // 11: mcpv.plugin.v1.PluginService.HandleRequest:output_type -> mcpv.plugin.v1.PluginHandleResponse
+	5, // 12: mcpv.plugin.v1.PluginService.HandleResponse:output_type -> mcpv.plugin.v1.PluginHandleResponse
+	8, // 13: mcpv.plugin.v1.PluginService.Shutdown:output_type -> google.protobuf.Empty
+	8, // [8:14] is the sub-list for method output_type
+	2, // [2:8] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_mcpv_plugin_v1_plugin_proto_init() }
@@ -529,7 +554,7 @@ func file_mcpv_plugin_v1_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcpv_plugin_v1_plugin_proto_rawDesc), len(file_mcpv_plugin_v1_plugin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},